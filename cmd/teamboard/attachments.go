@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"io"
+
+	"github.com/KungurtsevNII/team-board-back/src/config"
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/repository/storage"
+	"github.com/pkg/errors"
+)
+
+// Запас на заголовки multipart сверх максимального размера файла.
+const multipartOverhead = 1 << 20
+
+type attachmentStorage interface {
+	Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error
+	Open(ctx context.Context, key string) (io.ReadSeekCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// newAttachmentStorage выбирает хранилище содержимого вложений по конфигу.
+func newAttachmentStorage(cfg config.AttachmentsConfig) (attachmentStorage, error) {
+	const op = "newAttachmentStorage"
+
+	switch cfg.Storage {
+	case "local":
+		s, err := storage.NewLocal(cfg.LocalDir)
+		if err != nil {
+			return nil, errors.Wrap(err, op)
+		}
+		return s, nil
+	case "s3":
+		s, err := storage.NewS3(storage.S3Config{
+			Endpoint:  cfg.S3.Endpoint,
+			Region:    cfg.S3.Region,
+			Bucket:    cfg.S3.Bucket,
+			AccessKey: cfg.S3.AccessKey,
+			SecretKey: string(cfg.S3.SecretKey),
+			PathStyle: cfg.S3.PathStyle,
+			Timeout:   cfg.S3.Timeout,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, op)
+		}
+		return s, nil
+	default:
+		return nil, errors.Wrap(errors.Errorf("unknown storage %q", cfg.Storage), op)
+	}
+}
+
+func attachmentPolicy(cfg config.AttachmentsConfig) domain.AttachmentPolicy {
+	return domain.AttachmentPolicy{
+		MaxSize:      cfg.MaxSize,
+		AllowedTypes: cfg.AllowedTypes,
+	}
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/config"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/notifyduesoon"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// Прогон дописывается даже после сигнала остановки, но не дольше этого
+	dueSoonRunTimeout = 30 * time.Second
+	// Сколько ждать текущий прогон при остановке сервиса
+	dueSoonShutdownTimeout = dueSoonRunTimeout + 5*time.Second
+)
+
+var dueSoonRuns = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "teamboard",
+	Subsystem: "notifications",
+	Name:      "due_soon_runs_total",
+	Help:      "Due-soon reminder runs by result.",
+}, []string{"result"})
+
+// dueSoonWorker периодически напоминает исполнителям о задачах,
+// срок цели или спринта которых наступает сегодня или завтра.
+type dueSoonWorker struct {
+	uc  *notifyduesoon.UC
+	cfg config.DueSoonConfig
+	log *slog.Logger
+}
+
+func newDueSoonWorker(repo notifyduesoon.Repo, cfg config.DueSoonConfig) *dueSoonWorker {
+	prometheus.MustRegister(dueSoonRuns)
+
+	return &dueSoonWorker{
+		uc:  notifyduesoon.NewUC(repo),
+		cfg: cfg,
+		log: slog.Default().With("op", "dueSoonWorker"),
+	}
+}
+
+// start запускает прогоны сразу и далее раз в Interval.
+// Возвращенный канал закрывается, когда ctx отменен и текущий прогон завершен.
+func (w *dueSoonWorker) start(ctx context.Context) <-chan struct{} {
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(w.cfg.Interval)
+		defer ticker.Stop()
+
+		for {
+			runCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), dueSoonRunTimeout)
+			count, err := w.uc.Handle(runCtx, notifyduesoon.NewCommand())
+			cancel()
+
+			if err != nil {
+				dueSoonRuns.WithLabelValues("error").Inc()
+				w.log.Error("due-soon reminders failed", slog.Any("error", err))
+			} else {
+				dueSoonRuns.WithLabelValues("ok").Inc()
+				if count > 0 {
+					w.log.Debug("due-soon reminders done", slog.Int("tasks", count))
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return done
+}
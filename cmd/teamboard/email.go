@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/config"
+	"github.com/KungurtsevNII/team-board-back/src/repository/email"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/sendnotificationemails"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Сколько ждать отправку текущей пачки писем при остановке сервиса.
+const emailShutdownTimeout = 15 * time.Second
+
+var notificationEmails = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "teamboard",
+	Subsystem: "notifications",
+	Name:      "emails_total",
+	Help:      "Notification emails by result.",
+}, []string{"result"})
+
+// emailWorker отправляет уведомления на почту: мгновенные письма и дайджесты.
+type emailWorker struct {
+	uc  *sendnotificationemails.UC
+	cfg config.EmailConfig
+	log *slog.Logger
+}
+
+func newEmailWorker(repo sendnotificationemails.Repo, cfg config.EmailConfig) *emailWorker {
+	prometheus.MustRegister(notificationEmails)
+
+	sender := email.New(email.Config{
+		Host:     cfg.Host,
+		Port:     cfg.Port,
+		Username: cfg.Username,
+		Password: string(cfg.Password),
+		From:     cfg.From,
+		BaseURL:  cfg.BaseURL,
+		Timeout:  cfg.Timeout,
+	})
+
+	return &emailWorker{
+		uc:  sendnotificationemails.NewUC(repo, sender, cfg.DigestHour),
+		cfg: cfg,
+		log: slog.Default().With("op", "emailWorker"),
+	}
+}
+
+// start проверяет очередь писем раз в PollInterval, пока есть что отправлять - без паузы.
+// Возвращенный канал закрывается, когда ctx отменен и текущая пачка отправлена.
+func (w *emailWorker) start(ctx context.Context) <-chan struct{} {
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		cmd, err := sendnotificationemails.NewCommand(w.cfg.BatchSize)
+		if err != nil {
+			w.log.Error("invalid email config", slog.Any("error", err))
+			return
+		}
+
+		ticker := time.NewTicker(w.cfg.PollInterval)
+		defer ticker.Stop()
+
+		for {
+			for ctx.Err() == nil {
+				stats, err := w.uc.Handle(context.WithoutCancel(ctx), cmd)
+				notificationEmails.WithLabelValues("sent").Add(float64(stats.Sent))
+				notificationEmails.WithLabelValues("failed").Add(float64(stats.Failed))
+				if err != nil {
+					w.log.Error("notification emails failed", slog.Any("error", err))
+					break
+				}
+				// при ошибках SMTP ждем следующего тика, а не повторяем сразу
+				if stats.Total() == 0 || stats.Failed > 0 {
+					break
+				}
+				w.log.Debug("email batch done",
+					slog.Int("sent", stats.Sent),
+					slog.Int("digests", stats.Digests))
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return done
+}
@@ -45,6 +45,7 @@ type HttpServer struct {
 func initAndStartHTTPServer(
 	cfg *config.Config,
 	handlers *handlers.HttpHandler,
+	idempotencyStore middlewares.IdempotencyStore,
 ) (*HttpServer, <-chan error) {
 	log := slog.Default()
 	const op = "initAndStartHttpServer"
@@ -57,10 +58,10 @@ func initAndStartHTTPServer(
 
 	//TODO: Поменять AllowOrigins: []string{"*"}, на хост фронта
 	router.Use(cors.New(cors.Config{
-		AllowOrigins:     []string{"*"},                                                           // Разрешенные источники
-		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE"},                       // Разрешенные методы
-		AllowHeaders:     []string{"Origin", "Authorization", "Content-Type", "token", "User-ID"}, // Разрешенные заголовки
-		ExposeHeaders:    []string{"Content-Length"},                                              // Заголовки, которые могут быть доступны клиенту
+		AllowOrigins:     []string{"*"},                                                                              // Разрешенные источники
+		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE"},                                          // Разрешенные методы
+		AllowHeaders:     []string{"Origin", "Authorization", "Content-Type", "token", "User-ID", "Idempotency-Key", "Range"}, // Разрешенные заголовки
+		ExposeHeaders:    []string{"Content-Length", "Idempotent-Replayed", "Content-Range", "Content-Disposition", "ETag"},                                          // Заголовки, которые могут быть доступны клиенту
 		AllowCredentials: true,                                                                    // Разрешить отправку учетных данных (например, куки)
 		MaxAge:           12 * time.Hour,                                                          // Время кэширования preflight-запросов
 	}))
@@ -86,20 +87,95 @@ func initAndStartHTTPServer(
 
 	mainGroup.GET("/healthcheck", handlers.Healthcheck)
 
+	// Повторы создания задач и досок с тем же Idempotency-Key не создают дубликаты
+	idempotent := middlewares.Idempotency(idempotencyStore, cfg.IdempotencyConfig.TTL)
+	attachmentBody := middlewares.MaxBodySize(cfg.AttachmentsConfig.MaxSize + multipartOverhead)
+
 	v1Group := mainGroup.Group("/v1")
 	{
 		v1Group.POST("/boards/:board_id/columns", handlers.CreateColumn)
 		v1Group.DELETE("/columns/:column_id", handlers.DeleteColumn)
-		v1Group.POST("/boards", handlers.CreateBoard)
-		v1Group.POST("/tasks", handlers.CreateTask)
+		v1Group.POST("/boards", idempotent, handlers.CreateBoard)
+		v1Group.POST("/tasks", idempotent, handlers.CreateTask)
 		v1Group.GET("/tasks/:task_id", handlers.GetTask)
 		v1Group.DELETE("/tasks/:task_id", handlers.DeleteTask)
 		v1Group.POST("/tasks/search", handlers.SearchTasks)
+		v1Group.POST("/tasks/bulk", handlers.BulkTasks)
 		v1Group.PUT("/tasks/:task_id", handlers.PutTask)
 		v1Group.GET("/boards", handlers.GetBoards)
 		v1Group.DELETE("/boards/:id", handlers.DeleteBoard)
 		v1Group.GET("/boards/:id", handlers.GetBoard)
 		v1Group.PUT("/tasks/:task_id/move", handlers.MoveTask)
+		v1Group.GET("/boards/:id/tags", handlers.GetBoardTags)
+		v1Group.GET("/tags", handlers.GetTags)
+		v1Group.GET("/tasks/:task_id/children", handlers.GetSubtasks)
+		v1Group.POST("/tasks/:task_id/children", handlers.AttachSubtask)
+		v1Group.DELETE("/tasks/:task_id/children/:child_id", handlers.DetachSubtask)
+		v1Group.GET("/tasks/:task_id/links", handlers.GetTaskLinks)
+		v1Group.POST("/tasks/:task_id/links", handlers.CreateTaskLink)
+		v1Group.DELETE("/tasks/:task_id/links/:link_id", handlers.DeleteTaskLink)
+		v1Group.PUT("/columns/:column_id", handlers.PutColumn)
+		v1Group.POST("/milestones", handlers.CreateMilestone)
+		v1Group.GET("/milestones", handlers.GetMilestones)
+		v1Group.GET("/milestones/:milestone_id", handlers.GetMilestone)
+		v1Group.PUT("/milestones/:milestone_id", handlers.PutMilestone)
+		v1Group.DELETE("/milestones/:milestone_id", handlers.DeleteMilestone)
+		v1Group.GET("/milestones/:milestone_id/progress", handlers.GetMilestoneProgress)
+		v1Group.PUT("/tasks/:task_id/milestone", handlers.SetTaskMilestone)
+		v1Group.POST("/boards/:id/sprints", handlers.CreateSprint)
+		v1Group.GET("/boards/:id/sprints", handlers.GetSprints)
+		v1Group.PUT("/sprints/:sprint_id", handlers.PutSprint)
+		v1Group.POST("/sprints/:sprint_id/start", handlers.StartSprint)
+		v1Group.POST("/sprints/:sprint_id/close", handlers.CloseSprint)
+		v1Group.POST("/sprints/:sprint_id/tasks", handlers.AddSprintTask)
+		v1Group.DELETE("/sprints/:sprint_id/tasks/:task_id", handlers.RemoveSprintTask)
+		v1Group.POST("/board-templates", handlers.CreateBoardTemplate)
+		v1Group.GET("/board-templates", handlers.GetBoardTemplates)
+		v1Group.DELETE("/board-templates/:template_id", handlers.DeleteBoardTemplate)
+		v1Group.POST("/board-templates/:template_id/boards", handlers.CreateBoardFromTemplate)
+		v1Group.POST("/boards/:id/duplicate", handlers.DuplicateBoard)
+		v1Group.GET("/trash", handlers.GetTrashBoards)
+		v1Group.GET("/boards/:id/trash", handlers.GetTrash)
+		v1Group.POST("/boards/:id/restore", handlers.RestoreBoard)
+		v1Group.POST("/columns/:column_id/restore", handlers.RestoreColumn)
+		v1Group.POST("/tasks/:task_id/restore", handlers.RestoreTask)
+		v1Group.POST("/boards/:id/archive", handlers.ArchiveBoard)
+		v1Group.POST("/boards/:id/unarchive", handlers.UnarchiveBoard)
+		v1Group.POST("/tasks/:task_id/move-to-board", handlers.MoveTaskToBoard)
+		v1Group.GET("/tasks/:task_id/history", handlers.GetTaskHistory)
+		v1Group.GET("/task-keys/:key", handlers.ResolveTaskKey)
+		v1Group.POST("/boards/:id/webhooks", handlers.CreateWebhook)
+		v1Group.GET("/boards/:id/webhooks", handlers.GetWebhooks)
+		v1Group.DELETE("/webhooks/:webhook_id", handlers.DeleteWebhook)
+		v1Group.GET("/webhooks/:webhook_id/deliveries", handlers.GetWebhookDeliveries)
+		v1Group.POST("/webhooks/:webhook_id/deliveries/:delivery_id/redeliver", handlers.RedeliverWebhook)
+		v1Group.GET("/notifications", handlers.GetNotifications)
+		v1Group.GET("/notifications/unread-count", handlers.CountUnreadNotifications)
+		v1Group.POST("/notifications/read-all", handlers.ReadAllNotifications)
+		v1Group.POST("/notifications/:notification_id/read", handlers.ReadNotification)
+		v1Group.GET("/notifications/preferences", handlers.GetEmailPreference)
+		v1Group.PUT("/notifications/preferences", handlers.PutEmailPreference)
+		v1Group.POST("/tasks/:task_id/watchers", handlers.WatchTask)
+		v1Group.DELETE("/tasks/:task_id/watchers", handlers.UnwatchTask)
+		v1Group.GET("/tasks/:task_id/watchers", handlers.GetTaskWatchers)
+		v1Group.POST("/boards/:id/watchers", handlers.WatchBoard)
+		v1Group.DELETE("/boards/:id/watchers", handlers.UnwatchBoard)
+		v1Group.GET("/boards/:id/watchers", handlers.GetBoardWatchers)
+		v1Group.POST("/tasks/:task_id/attachments", attachmentBody, handlers.UploadAttachment)
+		v1Group.GET("/tasks/:task_id/attachments", handlers.GetAttachments)
+		v1Group.GET("/tasks/:task_id/attachments/:attachment_id", handlers.DownloadAttachment)
+		v1Group.DELETE("/tasks/:task_id/attachments/:attachment_id", handlers.DeleteAttachment)
+		v1Group.POST("/tasks/:task_id/worklogs", handlers.AddWorklog)
+		v1Group.GET("/tasks/:task_id/worklogs", handlers.GetWorklogs)
+		v1Group.PUT("/tasks/:task_id/worklogs/:worklog_id", handlers.PutWorklog)
+		v1Group.DELETE("/tasks/:task_id/worklogs/:worklog_id", handlers.DeleteWorklog)
+		v1Group.PUT("/tasks/:task_id/estimate", handlers.SetTaskEstimate)
+		v1Group.GET("/reports/time", handlers.GetTimeReport)
+		v1Group.GET("/boards/:id/story-points", handlers.GetStoryPointScale)
+		v1Group.PUT("/boards/:id/story-points", handlers.SetStoryPointScale)
+		v1Group.PUT("/tasks/:task_id/story-points", handlers.SetTaskStoryPoints)
+		v1Group.GET("/boards/:id/velocity", handlers.GetVelocity)
+		v1Group.GET("/boards/:id/metrics", handlers.GetBoardMetrics)
 	}
 
 	p := ginprometheus.NewPrometheus("gin")
@@ -1,26 +1,102 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"log/slog"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/KungurtsevNII/team-board-back/src/config"
 	"github.com/KungurtsevNII/team-board-back/src/handlers"
+	"github.com/KungurtsevNII/team-board-back/src/repository/eventsink"
+	"github.com/KungurtsevNII/team-board-back/src/repository/markdown"
 	"github.com/KungurtsevNII/team-board-back/src/repository/postgres"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/addsprinttask"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/addworklog"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/archiveboard"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/attachsubtask"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/autowatchtasks"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/bulktasks"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/closesprint"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/countunreadnotifications"
 	"github.com/KungurtsevNII/team-board-back/src/usecase/createboard"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/createboardfromtemplate"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/createboardtemplate"
 	"github.com/KungurtsevNII/team-board-back/src/usecase/createcolumn"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/createmilestone"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/createnotifications"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/createsprint"
 	"github.com/KungurtsevNII/team-board-back/src/usecase/createtask"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/createtasklink"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/createwebhook"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/deleteattachment"
 	"github.com/KungurtsevNII/team-board-back/src/usecase/deleteboard"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/deleteboardtemplate"
 	"github.com/KungurtsevNII/team-board-back/src/usecase/deletecolumn"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/deletemilestone"
 	"github.com/KungurtsevNII/team-board-back/src/usecase/deletetask"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/deletetasklink"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/deletewebhook"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/deleteworklog"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/detachsubtask"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/downloadattachment"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/duplicateboard"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/enqueuewebhooks"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/getattachments"
 	"github.com/KungurtsevNII/team-board-back/src/usecase/getboard"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/getboardmetrics"
 	"github.com/KungurtsevNII/team-board-back/src/usecase/getboards"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/getboardtemplates"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/getemailpreference"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/getmilestone"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/getmilestoneprogress"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/getmilestones"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/getnotifications"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/getsprints"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/getstorypointscale"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/getsubtasks"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/gettags"
 	"github.com/KungurtsevNII/team-board-back/src/usecase/gettask"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/gettaskhistory"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/gettasklinks"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/gettimereport"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/gettrash"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/gettrashboards"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/getvelocity"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/getwatchers"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/getwebhookdeliveries"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/getwebhooks"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/getworklogs"
 	"github.com/KungurtsevNII/team-board-back/src/usecase/movetask"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/movetasktoboard"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/purgedeleted"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/putcolumn"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/putemailpreference"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/putmilestone"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/putsprint"
 	"github.com/KungurtsevNII/team-board-back/src/usecase/puttask"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/putworklog"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/readallnotifications"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/readnotification"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/redeliverwebhook"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/removesprinttask"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/resolvetaskkey"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/restoreboard"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/restorecolumn"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/restoretask"
 	"github.com/KungurtsevNII/team-board-back/src/usecase/searchtasks"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/setstorypointscale"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/settaskestimate"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/settaskmilestone"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/settaskstorypoints"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/startsprint"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/taskrecipients"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/unwatch"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/uploadattachment"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/watch"
 	"github.com/sytallax/prettylog"
 )
 
@@ -30,6 +106,12 @@ const (
 	envProd  = "prod"
 )
 
+// Флаги разбирает config.MustLoad вместе с -config
+var (
+	purgeOnce   = flag.Bool("purge-once", false, "run the purge of soft-deleted data once and exit")
+	purgeDryRun = flag.Bool("dry-run", false, "with -purge-once: only report what would be deleted")
+)
+
 func main() {
 	cfg := config.MustLoad()
 	log := setupLogger(cfg.Env)
@@ -42,6 +124,36 @@ func main() {
 		panic(err)
 	}
 
+	attachments, err := newAttachmentStorage(cfg.AttachmentsConfig)
+	if err != nil {
+		log.Error("failed to init attachment storage", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	purge := newPurgeWorker(purgedeleted.NewUC(rep, attachments), cfg.PurgeConfig)
+	if *purgeOnce {
+		stats, err := purge.run(context.Background(), *purgeDryRun)
+		rep.Close()
+		if err != nil {
+			log.Error("purge failed", slog.Any("error", err))
+			os.Exit(1)
+		}
+		log.Info("purge finished",
+			append(purgeStatsAttrs(stats),
+				slog.Bool("dry_run", *purgeDryRun),
+				slog.Duration("retention", cfg.PurgeConfig.Retention))...)
+		return
+	}
+
+	descriptions := markdown.New()
+
+	// подписчики доменных событий внутри процесса, события приходят из outbox
+	subscribers := eventsink.NewSubscribers()
+	subscribers.Subscribe(autowatchtasks.NewUC(rep).Handle, autowatchtasks.Events...)
+	subscribers.Subscribe(createnotifications.NewUC(rep, taskrecipients.NewResolver(rep)).Handle,
+		createnotifications.Events...)
+	subscribers.Subscribe(enqueuewebhooks.NewUC(rep).Handle, enqueuewebhooks.Events...)
+
 	handlers := handlers.NewHttpHandler(
 		&cfg.HttpConfig,
 		createcolumn.NewUC(rep),
@@ -50,17 +162,118 @@ func main() {
 		createtask.NewUC(rep),
 		getboards.NewUC(rep),
 		deleteboard.NewUC(rep),
-		gettask.NewUC(rep),
+		gettask.NewUC(rep, descriptions),
 		deletetask.NewUC(rep),
 		deletecolumn.NewUC(rep),
 		searchtasks.NewUC(rep),
-		movetask.NewUC(rep),
+		movetask.NewUC(rep, cfg.TasksConfig.RejectBlockedMoveToDone),
 		puttask.NewUC(rep),
+		gettags.NewUC(rep),
+		getsubtasks.NewUC(rep),
+		attachsubtask.NewUC(rep),
+		detachsubtask.NewUC(rep),
+		createtasklink.NewUC(rep),
+		gettasklinks.NewUC(rep),
+		deletetasklink.NewUC(rep),
+		putcolumn.NewUC(rep),
+		createmilestone.NewUC(rep),
+		getmilestones.NewUC(rep),
+		getmilestone.NewUC(rep),
+		putmilestone.NewUC(rep),
+		deletemilestone.NewUC(rep),
+		getmilestoneprogress.NewUC(rep),
+		settaskmilestone.NewUC(rep),
+		createsprint.NewUC(rep),
+		getsprints.NewUC(rep),
+		putsprint.NewUC(rep),
+		startsprint.NewUC(rep),
+		closesprint.NewUC(rep),
+		addsprinttask.NewUC(rep),
+		removesprinttask.NewUC(rep),
+		createboardtemplate.NewUC(rep),
+		getboardtemplates.NewUC(rep),
+		deleteboardtemplate.NewUC(rep),
+		createboardfromtemplate.NewUC(rep),
+		duplicateboard.NewUC(rep),
+		gettrashboards.NewUC(rep),
+		gettrash.NewUC(rep),
+		restoreboard.NewUC(rep),
+		restorecolumn.NewUC(rep),
+		restoretask.NewUC(rep),
+		archiveboard.NewUC(rep),
+		movetasktoboard.NewUC(rep),
+		resolvetaskkey.NewUC(rep, descriptions),
+		gettaskhistory.NewUC(rep),
+		bulktasks.NewUC(rep, cfg.TasksConfig.RejectBlockedMoveToDone),
+		createwebhook.NewUC(rep),
+		getwebhooks.NewUC(rep),
+		deletewebhook.NewUC(rep),
+		getwebhookdeliveries.NewUC(rep),
+		redeliverwebhook.NewUC(rep),
+		getnotifications.NewUC(rep),
+		countunreadnotifications.NewUC(rep),
+		readnotification.NewUC(rep),
+		readallnotifications.NewUC(rep),
+		getemailpreference.NewUC(rep),
+		putemailpreference.NewUC(rep),
+		watch.NewUC(rep),
+		unwatch.NewUC(rep),
+		getwatchers.NewUC(rep),
+		uploadattachment.NewUC(rep, attachments, attachmentPolicy(cfg.AttachmentsConfig)),
+		getattachments.NewUC(rep),
+		downloadattachment.NewUC(rep, attachments),
+		deleteattachment.NewUC(rep, attachments),
+		addworklog.NewUC(rep),
+		getworklogs.NewUC(rep),
+		putworklog.NewUC(rep),
+		deleteworklog.NewUC(rep),
+		settaskestimate.NewUC(rep),
+		gettimereport.NewUC(rep),
+		getstorypointscale.NewUC(rep),
+		setstorypointscale.NewUC(rep),
+		settaskstorypoints.NewUC(rep),
+		getvelocity.NewUC(rep),
+		getboardmetrics.NewUC(rep),
 	)
 
 	log.Info("repository connected", slog.String("path", cfg.PostgresConfig.Host))
 
-	httpsrv, httpErrCh := initAndStartHTTPServer(cfg, handlers)
+	httpsrv, httpErrCh := initAndStartHTTPServer(cfg, handlers, rep)
+
+	purgeCtx, stopPurge := context.WithCancel(context.Background())
+	var purgeDone <-chan struct{}
+	if cfg.PurgeConfig.Enabled {
+		purgeDone = purge.start(purgeCtx)
+	}
+
+	outboxCtx, stopOutbox := context.WithCancel(context.Background())
+	var outboxDone <-chan struct{}
+	if cfg.OutboxConfig.Enabled {
+		outbox, err := newOutboxWorker(rep, cfg.OutboxConfig, subscribers)
+		if err != nil {
+			log.Error("failed to init outbox relay", slog.Any("error", err))
+			os.Exit(1)
+		}
+		outboxDone = outbox.start(outboxCtx)
+	}
+
+	emailCtx, stopEmail := context.WithCancel(context.Background())
+	var emailDone <-chan struct{}
+	if cfg.EmailConfig.Enabled {
+		emailDone = newEmailWorker(rep, cfg.EmailConfig).start(emailCtx)
+	}
+
+	dueSoonCtx, stopDueSoon := context.WithCancel(context.Background())
+	var dueSoonDone <-chan struct{}
+	if cfg.DueSoonConfig.Enabled {
+		dueSoonDone = newDueSoonWorker(rep, cfg.DueSoonConfig).start(dueSoonCtx)
+	}
+
+	webhooksCtx, stopWebhooks := context.WithCancel(context.Background())
+	var webhooksDone <-chan struct{}
+	if cfg.WebhooksConfig.Enabled {
+		webhooksDone = newWebhookWorker(rep, cfg.WebhooksConfig).start(webhooksCtx)
+	}
 
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, syscall.SIGTERM, syscall.SIGINT)
@@ -72,6 +285,46 @@ func main() {
 	case sig := <-stop:
 		log.Info("received shutdown signal", slog.String("signal", sig.String()))
 		_ = httpsrv.srv.Close()
+		stopPurge()
+		stopWebhooks()
+		stopOutbox()
+		stopEmail()
+		stopDueSoon()
+		if purgeDone != nil {
+			select {
+			case <-purgeDone:
+			case <-time.After(purgeShutdownTimeout):
+				log.Warn("purge worker did not stop in time")
+			}
+		}
+		if webhooksDone != nil {
+			select {
+			case <-webhooksDone:
+			case <-time.After(webhookShutdownTimeout):
+				log.Warn("webhook worker did not stop in time")
+			}
+		}
+		if outboxDone != nil {
+			select {
+			case <-outboxDone:
+			case <-time.After(outboxShutdownTimeout):
+				log.Warn("outbox worker did not stop in time")
+			}
+		}
+		if emailDone != nil {
+			select {
+			case <-emailDone:
+			case <-time.After(emailShutdownTimeout):
+				log.Warn("email worker did not stop in time")
+			}
+		}
+		if dueSoonDone != nil {
+			select {
+			case <-dueSoonDone:
+			case <-time.After(dueSoonShutdownTimeout):
+				log.Warn("due-soon worker did not stop in time")
+			}
+		}
 		rep.Close()
 		log.Info("shutdown complete")
 	}
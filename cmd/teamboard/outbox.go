@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/config"
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/repository/eventsink"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/relayoutbox"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// Сколько ждать публикацию текущей пачки при остановке сервиса.
+	outboxShutdownTimeout = 10 * time.Second
+	// Забранные события скрыты от других экземпляров, пока relay их публикует
+	outboxLease = time.Minute
+)
+
+var outboxEvents = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "teamboard",
+	Subsystem: "outbox",
+	Name:      "events_total",
+	Help:      "Outbox events relayed to sinks by result.",
+}, []string{"result"})
+
+// outboxWorker публикует доменные события из outbox подписчикам.
+type outboxWorker struct {
+	uc  *relayoutbox.UC
+	cfg config.OutboxConfig
+	log *slog.Logger
+}
+
+func newOutboxWorker(repo relayoutbox.Repo, cfg config.OutboxConfig, subscribers *eventsink.Subscribers) (*outboxWorker, error) {
+	sinks := []relayoutbox.Sink{subscribers}
+	if cfg.JSONLPath != "" {
+		jsonl, err := eventsink.OpenJSONL(cfg.JSONLPath)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, jsonl)
+	}
+
+	prometheus.MustRegister(outboxEvents)
+
+	return &outboxWorker{
+		uc: relayoutbox.NewUC(repo, sinks, outboxLease, domain.OutboxRetryPolicy{
+			MaxAttempts: cfg.MaxAttempts,
+			BaseDelay:   cfg.BaseBackoff,
+			MaxDelay:    cfg.MaxBackoff,
+		}),
+		cfg: cfg,
+		log: slog.Default().With("op", "outboxWorker"),
+	}, nil
+}
+
+// start опрашивает outbox раз в PollInterval, пока есть что публиковать - без паузы.
+// Возвращенный канал закрывается, когда ctx отменен и текущая пачка опубликована.
+func (w *outboxWorker) start(ctx context.Context) <-chan struct{} {
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		cmd, err := relayoutbox.NewCommand(w.cfg.BatchSize)
+		if err != nil {
+			w.log.Error("invalid outbox config", slog.Any("error", err))
+			return
+		}
+
+		ticker := time.NewTicker(w.cfg.PollInterval)
+		defer ticker.Stop()
+
+		for {
+			for ctx.Err() == nil {
+				stats, err := w.uc.Handle(context.WithoutCancel(ctx), cmd)
+				outboxEvents.WithLabelValues("published").Add(float64(stats.Published))
+				outboxEvents.WithLabelValues("failed").Add(float64(stats.Failed))
+				outboxEvents.WithLabelValues("parked").Add(float64(stats.Parked))
+				if stats.Parked > 0 {
+					w.log.Warn("outbox events parked after max attempts", slog.Int("parked", stats.Parked))
+				}
+				if err != nil {
+					w.log.Error("outbox relay failed", slog.Any("error", err))
+					break
+				}
+				// после сбоя ждем следующего тика: упавшие события и так отложены,
+				// а отложенные за ними события агрегата вернутся в очередь
+				if stats.Published == 0 || stats.Failed > 0 {
+					break
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return done
+}
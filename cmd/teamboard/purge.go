@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/config"
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/purgedeleted"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// Пачка дописывается даже после сигнала остановки, но не дольше этого
+	purgeBatchTimeout = time.Minute
+	// Сколько ждать текущую пачку при остановке сервиса
+	purgeShutdownTimeout = purgeBatchTimeout + 5*time.Second
+)
+
+var (
+	purgeDeletedRows = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "teamboard",
+		Subsystem: "purge",
+		Name:      "deleted_rows_total",
+		Help:      "Rows removed permanently by the purge worker: soft-deleted data and expired idempotency keys.",
+	}, []string{"table"})
+	purgeBatches = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "teamboard",
+		Subsystem: "purge",
+		Name:      "batches_total",
+		Help:      "Purge batches executed.",
+	})
+	purgeRuns = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "teamboard",
+		Subsystem: "purge",
+		Name:      "runs_total",
+		Help:      "Purge runs by result.",
+	}, []string{"result"})
+	purgeLastSuccess = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "teamboard",
+		Subsystem: "purge",
+		Name:      "last_success_timestamp_seconds",
+		Help:      "Unix time of the last purge run that finished without errors.",
+	})
+)
+
+// purgeWorker периодически удаляет навсегда то, что пролежало в корзине дольше срока хранения,
+// вместе с содержимым вложений в хранилище, и истекшие ключи идемпотентности.
+type purgeWorker struct {
+	uc  *purgedeleted.UC
+	cfg config.PurgeConfig
+	log *slog.Logger
+}
+
+func newPurgeWorker(uc *purgedeleted.UC, cfg config.PurgeConfig) *purgeWorker {
+	prometheus.MustRegister(purgeDeletedRows, purgeBatches, purgeRuns, purgeLastSuccess)
+
+	return &purgeWorker{
+		uc:  uc,
+		cfg: cfg,
+		log: slog.Default().With("op", "purgeWorker"),
+	}
+}
+
+// start запускает прогоны сразу и далее раз в Interval.
+// Возвращенный канал закрывается, когда ctx отменен и текущая пачка завершена.
+func (w *purgeWorker) start(ctx context.Context) <-chan struct{} {
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(w.cfg.Interval)
+		defer ticker.Stop()
+
+		for {
+			stats, err := w.run(ctx, false)
+			if err != nil {
+				w.log.Error("purge run failed", slog.Any("error", err))
+			} else if stats.Total() > 0 {
+				w.log.Info("purge run finished", purgeStatsAttrs(stats)...)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return done
+}
+
+// run выполняет полный прогон: пачки идут, пока есть что удалять или пока не отменен ctx.
+// При dryRun ничего не удаляет и возвращает отчет о том, что было бы удалено.
+func (w *purgeWorker) run(ctx context.Context, dryRun bool) (domain.PurgeStats, error) {
+	const op = "purgeWorker.run"
+
+	cmd, err := purgedeleted.NewCommand(w.cfg.Retention, w.cfg.BatchSize, dryRun)
+	if err != nil {
+		return domain.PurgeStats{}, errors.Wrap(err, op)
+	}
+
+	if dryRun {
+		return w.uc.Handle(ctx, cmd)
+	}
+
+	var total domain.PurgeStats
+	for ctx.Err() == nil {
+		batchCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), purgeBatchTimeout)
+		stats, err := w.uc.Handle(batchCtx, cmd)
+		cancel()
+
+		purgeBatches.Inc()
+		purgeDeletedRows.WithLabelValues("tasks").Add(float64(stats.Tasks))
+		purgeDeletedRows.WithLabelValues("columns").Add(float64(stats.Columns))
+		purgeDeletedRows.WithLabelValues("boards").Add(float64(stats.Boards))
+		purgeDeletedRows.WithLabelValues("idempotency_keys").Add(float64(stats.IdempotencyKeys))
+		total.Add(stats)
+
+		if err != nil {
+			purgeRuns.WithLabelValues("error").Inc()
+			return total, errors.Wrap(err, op)
+		}
+		if stats.Total() == 0 {
+			break
+		}
+		w.log.Debug("purge batch done", purgeStatsAttrs(stats)...)
+	}
+	if ctx.Err() != nil {
+		// остановка посреди прогона: оставшееся удалится при следующем запуске
+		return total, nil
+	}
+
+	purgeRuns.WithLabelValues("ok").Inc()
+	purgeLastSuccess.SetToCurrentTime()
+
+	return total, nil
+}
+
+func purgeStatsAttrs(stats domain.PurgeStats) []any {
+	return []any{
+		slog.Int64("boards", stats.Boards),
+		slog.Int64("columns", stats.Columns),
+		slog.Int64("tasks", stats.Tasks),
+		slog.Int64("idempotency_keys", stats.IdempotencyKeys),
+	}
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/config"
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/repository/webhook"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/deliverwebhooks"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Сколько ждать отправку текущей пачки при остановке сервиса. Недоставленное
+// вернется в очередь по истечении аренды.
+const webhookShutdownTimeout = 15 * time.Second
+
+var webhookDeliveries = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "teamboard",
+	Subsystem: "webhooks",
+	Name:      "deliveries_total",
+	Help:      "Webhook delivery attempts by result.",
+}, []string{"result"})
+
+// webhookWorker отправляет события из очереди доставок подписчикам.
+type webhookWorker struct {
+	uc  *deliverwebhooks.UC
+	cfg config.WebhooksConfig
+	log *slog.Logger
+}
+
+func newWebhookWorker(repo deliverwebhooks.Repo, cfg config.WebhooksConfig) *webhookWorker {
+	prometheus.MustRegister(webhookDeliveries)
+
+	policy := domain.WebhookRetryPolicy{
+		MaxAttempts: cfg.MaxAttempts,
+		BaseDelay:   cfg.BaseBackoff,
+		MaxDelay:    cfg.MaxBackoff,
+	}
+	// пачка отправляется последовательно, аренда должна пережить ее целиком
+	lease := time.Duration(cfg.BatchSize)*cfg.Timeout + time.Minute
+
+	return &webhookWorker{
+		uc:  deliverwebhooks.NewUC(repo, webhook.New(cfg.Timeout), policy, lease),
+		cfg: cfg,
+		log: slog.Default().With("op", "webhookWorker"),
+	}
+}
+
+// start опрашивает очередь раз в PollInterval, пока есть что отправлять - без паузы.
+// Возвращенный канал закрывается, когда ctx отменен и текущая пачка отправлена.
+func (w *webhookWorker) start(ctx context.Context) <-chan struct{} {
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		cmd, err := deliverwebhooks.NewCommand(w.cfg.BatchSize)
+		if err != nil {
+			w.log.Error("invalid webhooks config", slog.Any("error", err))
+			return
+		}
+
+		ticker := time.NewTicker(w.cfg.PollInterval)
+		defer ticker.Stop()
+
+		for {
+			for ctx.Err() == nil {
+				// начатая пачка дописывается даже после сигнала остановки
+				stats, err := w.uc.Handle(context.WithoutCancel(ctx), cmd)
+				webhookDeliveries.WithLabelValues("delivered").Add(float64(stats.Delivered))
+				webhookDeliveries.WithLabelValues("retry").Add(float64(stats.Retried))
+				webhookDeliveries.WithLabelValues("dead").Add(float64(stats.Dead))
+				if err != nil {
+					w.log.Error("webhook delivery failed", slog.Any("error", err))
+					break
+				}
+				if stats.Total() == 0 {
+					break
+				}
+				w.log.Debug("webhook batch done",
+					slog.Int("delivered", stats.Delivered),
+					slog.Int("retried", stats.Retried),
+					slog.Int("dead", stats.Dead))
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return done
+}
@@ -0,0 +1,2 @@
+CREATE INDEX IF NOT EXISTS idx_tasks_tags ON tasks USING GIN (tags) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_tasks_board_id ON tasks (board_id) WHERE deleted_at IS NULL;
@@ -0,0 +1,3 @@
+ALTER TABLE tasks ADD COLUMN parent_id UUID NULL REFERENCES tasks(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_parent_id ON tasks (parent_id) WHERE deleted_at IS NULL;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS task_links;
+
+ALTER TABLE columns DROP COLUMN IF EXISTS is_done;
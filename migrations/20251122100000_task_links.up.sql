@@ -0,0 +1,13 @@
+ALTER TABLE columns ADD COLUMN is_done BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE task_links (
+    id UUID PRIMARY KEY,
+    from_task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    to_task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    type VARCHAR(20) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    CONSTRAINT unique_task_link UNIQUE (from_task_id, to_task_id, type),
+    CONSTRAINT task_link_not_self CHECK (from_task_id <> to_task_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_links_to_task_id ON task_links (to_task_id);
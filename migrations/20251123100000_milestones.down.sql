@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_tasks_milestone_id;
+
+ALTER TABLE tasks DROP COLUMN IF EXISTS milestone_id;
+
+DROP TABLE IF EXISTS milestones;
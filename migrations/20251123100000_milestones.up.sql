@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS milestones (
+    id UUID PRIMARY KEY,
+    title VARCHAR(255) NOT NULL,
+    description TEXT NULL,
+    target_date DATE NULL,
+    state VARCHAR(20) NOT NULL DEFAULT 'open',
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    deleted_at TIMESTAMPTZ NULL
+);
+
+ALTER TABLE tasks ADD COLUMN milestone_id UUID NULL REFERENCES milestones(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_milestone_id ON tasks (milestone_id) WHERE deleted_at IS NULL;
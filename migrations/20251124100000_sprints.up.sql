@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS sprints (
+    id UUID PRIMARY KEY,
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    goal TEXT NULL,
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    state VARCHAR(20) NOT NULL DEFAULT 'planned',
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    deleted_at TIMESTAMPTZ NULL,
+    CHECK (end_date >= start_date)
+);
+
+CREATE INDEX IF NOT EXISTS idx_sprints_board_id ON sprints (board_id) WHERE deleted_at IS NULL;
+
+-- на доске может быть только один активный спринт
+CREATE UNIQUE INDEX IF NOT EXISTS idx_sprints_one_active ON sprints (board_id) WHERE state = 'active' AND deleted_at IS NULL;
+
+ALTER TABLE tasks ADD COLUMN sprint_id UUID NULL REFERENCES sprints(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_sprint_id ON tasks (sprint_id) WHERE deleted_at IS NULL;
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_tasks_assignee;
+
+ALTER TABLE tasks DROP CONSTRAINT IF EXISTS tasks_priority_check;
+
+ALTER TABLE tasks DROP COLUMN IF EXISTS lane;
+ALTER TABLE tasks DROP COLUMN IF EXISTS priority;
+ALTER TABLE tasks DROP COLUMN IF EXISTS assignee;
@@ -0,0 +1,8 @@
+ALTER TABLE tasks ADD COLUMN assignee VARCHAR(100) NULL;
+ALTER TABLE tasks ADD COLUMN priority VARCHAR(20) NULL;
+ALTER TABLE tasks ADD COLUMN lane VARCHAR(100) NULL;
+
+ALTER TABLE tasks ADD CONSTRAINT tasks_priority_check
+    CHECK (priority IN ('low', 'medium', 'high', 'critical'));
+
+CREATE INDEX IF NOT EXISTS idx_tasks_assignee ON tasks (assignee) WHERE deleted_at IS NULL;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_board_templates_name;
+
+DROP TABLE IF EXISTS board_templates;
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS board_templates (
+    id UUID PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    description TEXT NULL,
+    columns JSONB NOT NULL,
+    tasks JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    deleted_at TIMESTAMPTZ NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_board_templates_name ON board_templates (name) WHERE deleted_at IS NULL;
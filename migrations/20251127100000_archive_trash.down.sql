@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_tasks_trash;
+DROP INDEX IF EXISTS idx_columns_trash;
+
+ALTER TABLE boards DROP COLUMN IF EXISTS archived_at;
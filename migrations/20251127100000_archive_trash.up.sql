@@ -0,0 +1,5 @@
+ALTER TABLE boards ADD COLUMN archived_at TIMESTAMPTZ NULL;
+
+-- корзина: мягко удаленные колонки и задачи доски
+CREATE INDEX IF NOT EXISTS idx_columns_trash ON columns (board_id, deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_tasks_trash ON tasks (board_id, deleted_at) WHERE deleted_at IS NOT NULL;
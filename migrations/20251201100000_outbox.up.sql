@@ -0,0 +1,14 @@
+CREATE TABLE outbox (
+    id UUID PRIMARY KEY,
+    event_type VARCHAR(50) NOT NULL,
+    aggregate_id UUID NOT NULL,
+    board_id UUID NOT NULL,
+    payload JSONB NOT NULL,
+    occurred_at TIMESTAMPTZ NOT NULL,
+    published_at TIMESTAMPTZ,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    locked_until TIMESTAMPTZ
+);
+
+CREATE INDEX idx_outbox_unpublished ON outbox (occurred_at) WHERE published_at IS NULL;
@@ -0,0 +1,15 @@
+CREATE TABLE notifications (
+    id UUID PRIMARY KEY,
+    user_id VARCHAR(100) NOT NULL,
+    type VARCHAR(50) NOT NULL,
+    event_id UUID NOT NULL,
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    text TEXT NOT NULL,
+    read_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL,
+    UNIQUE (event_id, user_id, type)
+);
+
+CREATE INDEX idx_notifications_user ON notifications (user_id, created_at DESC);
+CREATE INDEX idx_notifications_unread ON notifications (user_id) WHERE read_at IS NULL;
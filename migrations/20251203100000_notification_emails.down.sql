@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_notifications_not_emailed;
+ALTER TABLE notifications DROP COLUMN IF EXISTS emailed_at;
+DROP TABLE IF EXISTS notification_preferences;
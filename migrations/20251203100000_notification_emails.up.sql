@@ -0,0 +1,13 @@
+CREATE TABLE notification_preferences (
+    user_id VARCHAR(100) PRIMARY KEY,
+    email VARCHAR(255) NOT NULL DEFAULT '',
+    mode VARCHAR(20) NOT NULL,
+    last_digest_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_notification_preferences_digest ON notification_preferences (last_digest_at) WHERE mode = 'digest';
+
+ALTER TABLE notifications ADD COLUMN emailed_at TIMESTAMPTZ;
+
+CREATE INDEX idx_notifications_not_emailed ON notifications (user_id, created_at) WHERE emailed_at IS NULL;
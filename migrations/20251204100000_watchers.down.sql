@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS watchers;
+
+ALTER TABLE tasks DROP COLUMN IF EXISTS reporter;
@@ -0,0 +1,18 @@
+ALTER TABLE tasks ADD COLUMN reporter VARCHAR(100);
+
+CREATE TABLE watchers (
+    user_id VARCHAR(100) NOT NULL,
+    task_id UUID REFERENCES tasks(id) ON DELETE CASCADE,
+    board_id UUID REFERENCES boards(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL,
+    CHECK ((task_id IS NULL) <> (board_id IS NULL))
+);
+
+CREATE UNIQUE INDEX idx_watchers_task ON watchers (task_id, user_id) WHERE task_id IS NOT NULL;
+CREATE UNIQUE INDEX idx_watchers_board ON watchers (board_id, user_id) WHERE board_id IS NOT NULL;
+CREATE INDEX idx_watchers_user ON watchers (user_id);
+
+INSERT INTO watchers (user_id, task_id, created_at)
+SELECT assignee, id, NOW()
+FROM tasks
+WHERE assignee IS NOT NULL AND assignee <> '' AND deleted_at IS NULL;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS task_refs;
+DROP TABLE IF EXISTS task_mentions;
@@ -0,0 +1,20 @@
+CREATE TABLE task_mentions (
+    task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    source VARCHAR(50) NOT NULL,
+    user_id VARCHAR(100) NOT NULL,
+    position INT NOT NULL,
+    PRIMARY KEY (task_id, source, user_id)
+);
+
+CREATE INDEX idx_task_mentions_user ON task_mentions (user_id);
+
+CREATE TABLE task_refs (
+    task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    source VARCHAR(50) NOT NULL,
+    short_name VARCHAR(10) NOT NULL,
+    number BIGINT NOT NULL,
+    position INT NOT NULL,
+    PRIMARY KEY (task_id, source, short_name, number)
+);
+
+CREATE INDEX idx_task_refs_key ON task_refs (short_name, number);
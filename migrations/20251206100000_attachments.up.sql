@@ -0,0 +1,13 @@
+CREATE TABLE attachments (
+    id UUID PRIMARY KEY,
+    task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    size BIGINT NOT NULL,
+    mime_type VARCHAR(255) NOT NULL,
+    checksum CHAR(64) NOT NULL,
+    storage_key TEXT NOT NULL,
+    uploaded_by VARCHAR(100) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_attachments_task ON attachments (task_id, created_at);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS worklogs;
+
+ALTER TABLE tasks DROP COLUMN IF EXISTS remaining_estimate;
+ALTER TABLE tasks DROP COLUMN IF EXISTS original_estimate;
@@ -0,0 +1,16 @@
+ALTER TABLE tasks ADD COLUMN original_estimate INTEGER;
+ALTER TABLE tasks ADD COLUMN remaining_estimate INTEGER;
+
+CREATE TABLE worklogs (
+    id UUID PRIMARY KEY,
+    task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    user_id VARCHAR(100) NOT NULL,
+    minutes INTEGER NOT NULL CHECK (minutes > 0),
+    date DATE NOT NULL,
+    note TEXT,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_worklogs_task ON worklogs (task_id, date);
+CREATE INDEX idx_worklogs_date ON worklogs (date, user_id);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS task_transitions;
+
+ALTER TABLE tasks DROP COLUMN IF EXISTS story_points;
+ALTER TABLE boards DROP COLUMN IF EXISTS story_point_scale;
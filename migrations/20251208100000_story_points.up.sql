@@ -0,0 +1,23 @@
+ALTER TABLE boards ADD COLUMN story_point_scale INTEGER[];
+ALTER TABLE tasks ADD COLUMN story_points INTEGER;
+
+CREATE TABLE task_transitions (
+    id UUID PRIMARY KEY,
+    task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    from_column_id UUID,
+    to_column_id UUID NOT NULL,
+    occurred_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_task_transitions_task ON task_transitions (task_id, occurred_at);
+CREATE INDEX idx_task_transitions_board ON task_transitions (board_id, occurred_at);
+
+-- История перемещений до появления таблицы восстанавливается из outbox
+INSERT INTO task_transitions (id, task_id, board_id, from_column_id, to_column_id, occurred_at)
+SELECT o.id, o.aggregate_id, o.board_id,
+       (o.payload->>'from_column_id')::uuid, (o.payload->>'to_column_id')::uuid, o.occurred_at
+FROM outbox o
+WHERE o.event_type = 'task.moved'
+  AND EXISTS (SELECT 1 FROM tasks t WHERE t.id = o.aggregate_id)
+  AND EXISTS (SELECT 1 FROM boards b WHERE b.id = o.board_id);
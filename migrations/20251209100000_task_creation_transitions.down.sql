@@ -0,0 +1 @@
+DELETE FROM task_transitions WHERE from_column_id IS NULL;
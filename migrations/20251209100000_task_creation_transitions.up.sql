@@ -0,0 +1,16 @@
+-- Создание задачи - переход без исходной колонки. Для уже созданных задач
+-- колонка создания берется из первого перемещения, а если его не было - текущая.
+INSERT INTO task_transitions (id, task_id, board_id, from_column_id, to_column_id, occurred_at)
+SELECT gen_random_uuid(), t.id, COALESCE(first.board_id, t.board_id),
+       NULL, COALESCE(first.from_column_id, t.column_id), t.created_at
+FROM tasks t
+LEFT JOIN LATERAL (
+    SELECT tr.board_id, tr.from_column_id
+    FROM task_transitions tr
+    WHERE tr.task_id = t.id
+    ORDER BY tr.occurred_at
+    LIMIT 1
+) first ON true
+WHERE NOT EXISTS (
+    SELECT 1 FROM task_transitions tr WHERE tr.task_id = t.id AND tr.from_column_id IS NULL
+);
@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS idx_tasks_tags ON tasks USING GIN (tags) WHERE deleted_at IS NULL;
@@ -0,0 +1,2 @@
+-- GIN-индекс по tags не используется автодополнением: unnest(tags) ILIKE prefix% его не задействует
+DROP INDEX IF EXISTS idx_tasks_tags;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_outbox_pending_aggregate;
+DROP INDEX IF EXISTS idx_outbox_unpublished;
+CREATE INDEX idx_outbox_unpublished ON outbox (occurred_at) WHERE published_at IS NULL;
+
+ALTER TABLE outbox DROP COLUMN IF EXISTS parked_at;
@@ -0,0 +1,7 @@
+-- События, исчерпавшие попытки публикации, паркуются и больше не забираются relay
+ALTER TABLE outbox ADD COLUMN parked_at TIMESTAMPTZ;
+
+DROP INDEX idx_outbox_unpublished;
+CREATE INDEX idx_outbox_unpublished ON outbox (occurred_at) WHERE published_at IS NULL AND parked_at IS NULL;
+-- Проверка, что у агрегата нет более раннего события в работе или в ожидании повтора
+CREATE INDEX idx_outbox_pending_aggregate ON outbox (aggregate_id, occurred_at) WHERE published_at IS NULL AND parked_at IS NULL;
@@ -0,0 +1,2 @@
+-- Доставки ставятся из outbox "хотя бы один раз": повтор события не должен создавать вторую доставку
+CREATE UNIQUE INDEX idx_webhook_deliveries_event ON webhook_deliveries (webhook_id, event_id);
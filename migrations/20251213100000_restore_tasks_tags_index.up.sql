@@ -0,0 +1,2 @@
+-- GIN-индекс по tags возвращается: он нужен фильтрам задач по тегу (tags @> ARRAY[...])
+CREATE INDEX IF NOT EXISTS idx_tasks_tags ON tasks USING GIN (tags) WHERE deleted_at IS NULL;
@@ -0,0 +1 @@
+ALTER TABLE task_redirects ALTER COLUMN number TYPE INTEGER;
@@ -0,0 +1,2 @@
+-- номер в редиректе того же типа, что в task_refs: ссылки сравниваются с ним без приведения
+ALTER TABLE task_redirects ALTER COLUMN number TYPE BIGINT;
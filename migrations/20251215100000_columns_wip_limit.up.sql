@@ -0,0 +1,2 @@
+-- WIP-лимит колонки, NULL - без ограничения
+ALTER TABLE columns ADD COLUMN IF NOT EXISTS wip_limit BIGINT NULL CHECK (wip_limit > 0);
@@ -1,7 +1,9 @@
 package config
 
 import (
+	"encoding/json"
 	"flag"
+	"log/slog"
 	"os"
 	"time"
 
@@ -13,9 +15,17 @@ const (
 )
 
 type Config struct {
-	Env            string         `yaml:"env" env-default:"local"`
-	PostgresConfig PostgresConfig `yaml:"postgres"` //пока убрал env-required:"true"`
-	HttpConfig     HTTPConfig     `yaml:"http_server"`
+	Env               string            `yaml:"env" env-default:"local"`
+	PostgresConfig    PostgresConfig    `yaml:"postgres"` //пока убрал env-required:"true"`
+	HttpConfig        HTTPConfig        `yaml:"http_server"`
+	TasksConfig       TasksConfig       `yaml:"tasks"`
+	PurgeConfig       PurgeConfig       `yaml:"purge"`
+	IdempotencyConfig IdempotencyConfig `yaml:"idempotency"`
+	WebhooksConfig    WebhooksConfig    `yaml:"webhooks"`
+	OutboxConfig      OutboxConfig      `yaml:"outbox"`
+	EmailConfig       EmailConfig       `yaml:"email"`
+	DueSoonConfig     DueSoonConfig     `yaml:"due_soon"`
+	AttachmentsConfig AttachmentsConfig `yaml:"attachments"`
 }
 
 type PostgresConfig struct {
@@ -29,6 +39,122 @@ type HTTPConfig struct {
 	Timeout time.Duration `yaml:"timeout"`
 }
 
+type TasksConfig struct {
+	// Запрещать перенос задачи с незакрытыми блокерами в завершающую колонку
+	// (по умолчанию только предупреждение в ответе).
+	RejectBlockedMoveToDone bool `yaml:"reject_blocked_move_to_done" env-default:"false"`
+}
+
+// PurgeConfig - физическое удаление мягко удаленных досок, колонок и задач.
+type PurgeConfig struct {
+	Enabled bool `yaml:"enabled" env-default:"true"`
+	// Сколько удаленное лежит в корзине, прежде чем будет удалено навсегда
+	Retention time.Duration `yaml:"retention" env-default:"720h"`
+	Interval  time.Duration `yaml:"interval" env-default:"1h"`
+	BatchSize int           `yaml:"batch_size" env-default:"500"`
+}
+
+// IdempotencyConfig - повтор POST-запросов с заголовком Idempotency-Key.
+type IdempotencyConfig struct {
+	// Сколько хранится ответ на первый запрос и отдается на повторы
+	TTL time.Duration `yaml:"ttl" env-default:"24h"`
+}
+
+// WebhooksConfig - доставка событий досок внешним подписчикам.
+type WebhooksConfig struct {
+	Enabled      bool          `yaml:"enabled" env-default:"true"`
+	PollInterval time.Duration `yaml:"poll_interval" env-default:"5s"`
+	BatchSize    int           `yaml:"batch_size" env-default:"50"`
+	// Таймаут одного запроса к получателю
+	Timeout time.Duration `yaml:"timeout" env-default:"10s"`
+	// После MaxAttempts неудачных попыток доставка переходит в dead
+	MaxAttempts int           `yaml:"max_attempts" env-default:"8"`
+	BaseBackoff time.Duration `yaml:"base_backoff" env-default:"30s"`
+	MaxBackoff  time.Duration `yaml:"max_backoff" env-default:"6h"`
+}
+
+// OutboxConfig - публикация доменных событий, сохраненных в outbox.
+type OutboxConfig struct {
+	Enabled      bool          `yaml:"enabled" env-default:"true"`
+	PollInterval time.Duration `yaml:"poll_interval" env-default:"1s"`
+	BatchSize    int           `yaml:"batch_size" env-default:"100"`
+	// После MaxAttempts неудачных публикаций событие паркуется
+	MaxAttempts int           `yaml:"max_attempts" env-default:"10"`
+	BaseBackoff time.Duration `yaml:"base_backoff" env-default:"1s"`
+	MaxBackoff  time.Duration `yaml:"max_backoff" env-default:"5m"`
+	// Куда дублировать события построчно в JSON: путь к файлу или stdout.
+	// Пусто - только подписчики внутри процесса
+	JSONLPath string `yaml:"jsonl_path"`
+}
+
+// Secret - значение, которое не должно попасть в логи вместе с конфигом.
+// JSON-обработчик slog сериализует вложенные поля через encoding/json,
+// поэтому маскировка нужна и в String, и в LogValue, и в MarshalJSON.
+type Secret string
+
+func (s Secret) String() string {
+	if s == "" {
+		return ""
+	}
+	return "***"
+}
+
+func (s Secret) LogValue() slog.Value {
+	return slog.StringValue(s.String())
+}
+
+func (s Secret) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// EmailConfig - письма с уведомлениями через SMTP.
+type EmailConfig struct {
+	Enabled  bool   `yaml:"enabled" env-default:"false"`
+	Host     string `yaml:"host" env-default:"localhost"`
+	Port     int    `yaml:"port" env-default:"25"`
+	Username string `yaml:"username" env:"SMTP_USERNAME"`
+	Password Secret `yaml:"password" env:"SMTP_PASSWORD"`
+	From     string `yaml:"from" env-default:"Teamboard <noreply@teamboard.local>"`
+	// Адрес фронтенда для ссылок на задачи в письмах
+	BaseURL      string        `yaml:"base_url"`
+	Timeout      time.Duration `yaml:"timeout" env-default:"10s"`
+	PollInterval time.Duration `yaml:"poll_interval" env-default:"30s"`
+	BatchSize    int           `yaml:"batch_size" env-default:"50"`
+	// Час UTC, в который уходят дайджесты
+	DigestHour int `yaml:"digest_hour" env-default:"8"`
+}
+
+// DueSoonConfig - напоминания исполнителям о сроке цели или спринта задачи.
+type DueSoonConfig struct {
+	Enabled  bool          `yaml:"enabled" env-default:"true"`
+	Interval time.Duration `yaml:"interval" env-default:"1h"`
+}
+
+// AttachmentsConfig - файлы, прикрепленные к задачам.
+type AttachmentsConfig struct {
+	// Максимальный размер файла в байтах
+	MaxSize int64 `yaml:"max_size" env-default:"26214400"`
+	// Разрешенные MIME-типы, image/* - любой подтип. Тип определяется по содержимому
+	AllowedTypes []string `yaml:"allowed_types" env-default:"image/*,text/plain,text/csv,application/pdf,application/json,application/zip"`
+	// Где хранить содержимое: local или s3
+	Storage  string   `yaml:"storage" env-default:"local"`
+	LocalDir string   `yaml:"local_dir" env-default:"./data/attachments"`
+	S3       S3Config `yaml:"s3"`
+}
+
+// S3Config - S3-совместимое хранилище (AWS S3, MinIO и т.п.).
+type S3Config struct {
+	// Адрес со схемой, например http://localhost:9000
+	Endpoint  string `yaml:"endpoint"`
+	Region    string `yaml:"region" env-default:"us-east-1"`
+	Bucket    string `yaml:"bucket"`
+	AccessKey string `yaml:"access_key" env:"S3_ACCESS_KEY"`
+	SecretKey Secret `yaml:"secret_key" env:"S3_SECRET_KEY"`
+	// Бакет в пути, а не в имени хоста - нужно для MinIO
+	PathStyle bool          `yaml:"path_style" env-default:"true"`
+	Timeout   time.Duration `yaml:"timeout" env-default:"30s"`
+}
+
 func MustLoad() *Config {
 	configPath := fetchConfigPath()
 	if configPath == "" {
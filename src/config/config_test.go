@@ -0,0 +1,36 @@
+package config
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecretIsMaskedInLogs(t *testing.T) {
+	cfg := Config{
+		EmailConfig: EmailConfig{Username: "mailer", Password: "hunter2"},
+		AttachmentsConfig: AttachmentsConfig{
+			S3: S3Config{AccessKey: "minio", SecretKey: "s3-secret-key"},
+		},
+	}
+
+	var buf bytes.Buffer
+	log := slog.New(slog.NewJSONHandler(&buf, nil))
+	log.Info("config", slog.Any("cfg", cfg))
+	log.Info("password", slog.Any("password", cfg.EmailConfig.Password))
+
+	out := buf.String()
+	assert.NotContains(t, out, "hunter2")
+	assert.NotContains(t, out, "s3-secret-key")
+	assert.Contains(t, out, `"SecretKey":"***"`)
+	assert.Contains(t, out, `"Password":"***"`)
+	assert.Contains(t, out, `"password":"***"`)
+	assert.Contains(t, out, `"Username":"mailer"`)
+}
+
+func TestSecretEmpty(t *testing.T) {
+	assert.Equal(t, "", Secret("").String())
+	assert.Equal(t, "***", Secret("x").String())
+}
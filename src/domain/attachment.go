@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"mime"
+	"path"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const maxAttachmentNameLen = 255
+
+var (
+	ErrInvalidAttachmentName = errors.New("attachment name must be 1-255 characters")
+	ErrAttachmentTooLarge    = errors.New("attachment is too large")
+	ErrAttachmentEmpty       = errors.New("attachment is empty")
+	ErrMimeTypeNotAllowed    = errors.New("attachment type is not allowed")
+)
+
+// Attachment - файл, прикрепленный к задаче. Содержимое лежит в хранилище
+// под StorageKey, в базе только метаданные.
+type Attachment struct {
+	ID       uuid.UUID
+	TaskID   uuid.UUID
+	Name     string
+	Size     int64
+	MimeType string
+	// Checksum - SHA-256 содержимого в hex
+	Checksum   string
+	StorageKey string
+	UploadedBy string
+	CreatedAt  time.Time
+}
+
+// NewAttachment создает метаданные вложения. Из имени убирается путь,
+// который браузеры иногда присылают вместе с именем файла.
+func NewAttachment(taskID uuid.UUID, name, mimeType, uploadedBy string, size int64) (*Attachment, error) {
+	const op = "domain.NewAttachment"
+
+	name = path.Base(strings.ReplaceAll(name, `\`, "/"))
+	if name == "." || name == "/" || !utf8.ValidString(name) || utf8.RuneCountInString(name) > maxAttachmentNameLen {
+		return nil, errors.Wrap(ErrInvalidAttachmentName, op)
+	}
+	if size <= 0 {
+		return nil, errors.Wrap(ErrAttachmentEmpty, op)
+	}
+
+	id := uuid.New()
+	return &Attachment{
+		ID:         id,
+		TaskID:     taskID,
+		Name:       name,
+		Size:       size,
+		MimeType:   mimeType,
+		StorageKey: "tasks/" + taskID.String() + "/" + id.String(),
+		UploadedBy: uploadedBy,
+		CreatedAt:  time.Now().UTC(),
+	}, nil
+}
+
+// AttachmentPolicy - ограничения на загружаемые файлы.
+type AttachmentPolicy struct {
+	MaxSize int64
+	// AllowedTypes - MIME-типы без параметров, image/* разрешает любой подтип.
+	// Пустой список разрешает любые типы
+	AllowedTypes []string
+}
+
+func (p AttachmentPolicy) CheckSize(size int64) error {
+	if size > p.MaxSize {
+		return ErrAttachmentTooLarge
+	}
+	return nil
+}
+
+func (p AttachmentPolicy) CheckType(mimeType string) error {
+	if len(p.AllowedTypes) == 0 {
+		return nil
+	}
+
+	mediaType, _, err := mime.ParseMediaType(mimeType)
+	if err != nil {
+		return ErrMimeTypeNotAllowed
+	}
+	for _, allowed := range p.AllowedTypes {
+		allowed = strings.ToLower(strings.TrimSpace(allowed))
+		if allowed == mediaType {
+			return nil
+		}
+		if prefix, ok := strings.CutSuffix(allowed, "/*"); ok && strings.HasPrefix(mediaType, prefix+"/") {
+			return nil
+		}
+	}
+	return ErrMimeTypeNotAllowed
+}
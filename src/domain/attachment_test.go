@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAttachment(t *testing.T) {
+	taskID := uuid.New()
+
+	a, err := NewAttachment(taskID, `C:\Users\bob\screen shot.png`, "image/png", "bob", 10)
+	require.NoError(t, err)
+	assert.Equal(t, "screen shot.png", a.Name)
+	assert.Equal(t, "tasks/"+taskID.String()+"/"+a.ID.String(), a.StorageKey)
+
+	a, err = NewAttachment(taskID, "../../etc/passwd", "text/plain", "bob", 10)
+	require.NoError(t, err)
+	assert.Equal(t, "passwd", a.Name)
+
+	_, err = NewAttachment(taskID, "", "text/plain", "bob", 10)
+	assert.ErrorIs(t, err, ErrInvalidAttachmentName)
+	_, err = NewAttachment(taskID, strings.Repeat("a", 256), "text/plain", "bob", 10)
+	assert.ErrorIs(t, err, ErrInvalidAttachmentName)
+	_, err = NewAttachment(taskID, "empty.txt", "text/plain", "bob", 0)
+	assert.ErrorIs(t, err, ErrAttachmentEmpty)
+}
+
+func TestAttachmentPolicy(t *testing.T) {
+	p := AttachmentPolicy{MaxSize: 100, AllowedTypes: []string{"image/*", "text/plain", "application/pdf"}}
+
+	assert.NoError(t, p.CheckSize(100))
+	assert.ErrorIs(t, p.CheckSize(101), ErrAttachmentTooLarge)
+
+	assert.NoError(t, p.CheckType("image/png"))
+	assert.NoError(t, p.CheckType("text/plain; charset=utf-8"))
+	assert.NoError(t, p.CheckType("application/pdf"))
+	assert.ErrorIs(t, p.CheckType("application/x-msdownload"), ErrMimeTypeNotAllowed)
+	assert.ErrorIs(t, p.CheckType("imagefoo/png"), ErrMimeTypeNotAllowed)
+	assert.ErrorIs(t, p.CheckType("text/html; charset=utf-8"), ErrMimeTypeNotAllowed)
+
+	assert.NoError(t, AttachmentPolicy{MaxSize: 1}.CheckType("application/x-msdownload"))
+}
@@ -25,44 +25,71 @@ type Board struct {
 	CreatedAt   time.Time
 	DeletedAt   *time.Time
 	UpdatedAt   time.Time
+	ArchivedAt  *time.Time
 	Columns     []Column
 	Tasks       []Task
+	Sprint      *Sprint // заполняется, если задачи доски отфильтрованы по спринту
+	Lanes       []Swimlane
+	// StoryPointScale - своя шкала доски, nil - шкала по умолчанию
+	StoryPointScale StoryPointScale
+
+	events []Event
 }
 
 func NewBoard(name string, shortName string) (Board, error) {
+	return NewBoardWithColumns(name, shortName, nil)
+}
+
+// NewBoardWithColumns создает доску с колонками columnNames в заданном порядке.
+// Без колонок доска получает одну колонку TODO.
+func NewBoardWithColumns(name string, shortName string, columnNames []string) (Board, error) {
 	const op = "domain.NewBoard"
+
+	board, err := newEmptyBoard(name, shortName)
+	if err != nil {
+		return Board{}, errors.Wrap(err, op)
+	}
+
+	if len(columnNames) == 0 {
+		columnNames = []string{nameOfFirstColumn}
+	}
+
+	for i, colName := range columnNames {
+		column, err := NewColumn(board.ID, colName, int64(i))
+		if err != nil {
+			return Board{}, errors.Wrap(err, op)
+		}
+		board.Columns = append(board.Columns, *column)
+	}
+
+	return board, nil
+}
+
+// newEmptyBoard проверяет имена и создает доску без колонок.
+func newEmptyBoard(name string, shortName string) (Board, error) {
 	if name == "" {
-		return Board{}, errors.Wrap(ErrInvalidName, op)
+		return Board{}, ErrInvalidName
 	}
 	if len(name) > 100 {
-		return Board{}, errors.Wrap(ErrInvalidName, op)
+		return Board{}, ErrInvalidName
 	}
 
 	if shortName == "" {
-		return Board{}, errors.Wrap(ErrInvalidName, op)
+		return Board{}, ErrInvalidName
 	}
 	if !shortNameRegex.MatchString(shortName) {
-		return Board{}, errors.Wrap(ErrInvalidName, op)
+		return Board{}, ErrInvalidName
 	}
 
 	now := time.Now().UTC()
-	brdID := uuid.New()
-
-	columns := make([]Column, 0)
-	column, err := NewColumn(brdID, nameOfFirstColumn, 0)
-	if err != nil {
-		return Board{}, errors.Wrap(err, op)
-	}
-	columns = append(columns, *column)
-
 	return Board{
-		ID:        brdID,
+		ID:        uuid.New(),
 		Name:      name,
 		ShortName: shortName,
 		CreatedAt: now,
 		UpdatedAt: now,
 		DeletedAt: nil,
-		Columns: columns,
+		Columns:   make([]Column, 0),
 	}, nil
 }
 
@@ -155,3 +155,22 @@ func TestBoard_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestNewBoardWithColumns(t *testing.T) {
+	board, err := NewBoardWithColumns("Test Board", "TB", []string{"Backlog", "In progress", "Done"})
+	require.NoError(t, err)
+	require.Len(t, board.Columns, 3)
+	for i, name := range []string{"Backlog", "In progress", "Done"} {
+		assert.Equal(t, name, board.Columns[i].Name)
+		assert.Equal(t, int64(i), board.Columns[i].OrderNum)
+		assert.Equal(t, board.ID, board.Columns[i].BoardID)
+	}
+
+	board, err = NewBoardWithColumns("Test Board", "TB", nil)
+	require.NoError(t, err)
+	require.Len(t, board.Columns, 1)
+	assert.Equal(t, nameOfFirstColumn, board.Columns[0].Name)
+
+	_, err = NewBoardWithColumns("Test Board", "TB", []string{"Backlog", ""})
+	assert.ErrorIs(t, err, ErrEmptyColumnName)
+}
@@ -0,0 +1,190 @@
+package domain
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type BulkOperation string
+
+const (
+	BulkMove       BulkOperation = "move"
+	BulkAddTags    BulkOperation = "add_tags"
+	BulkRemoveTags BulkOperation = "remove_tags"
+	BulkDelete     BulkOperation = "delete"
+	BulkRestore    BulkOperation = "restore"
+	BulkSetField   BulkOperation = "set_field"
+)
+
+// BulkField - поле задачи, которое можно проставить массово.
+type BulkField string
+
+const (
+	BulkFieldAssignee BulkField = "assignee"
+	BulkFieldPriority BulkField = "priority"
+	BulkFieldLane     BulkField = "lane"
+)
+
+var (
+	ErrInvalidBulkOperation = errors.New("operation must be one of: move, add_tags, remove_tags, delete, restore, set_field")
+	ErrInvalidBulkField     = errors.New("field must be one of: assignee, priority, lane")
+	ErrBulkColumnRequired   = errors.New("column_id is required for move")
+	ErrBulkTagsRequired     = errors.New("tags are required for add_tags and remove_tags")
+	ErrTaskDeleted          = errors.New("task is deleted")
+	ErrColumnNotInBoard     = errors.New("column not found in task board")
+	// ErrBulkNoChange - операция ничего не меняет в задаче, сохранять нечего.
+	ErrBulkNoChange = errors.New("nothing to change")
+)
+
+// BulkTaskOperation - одна операция, применяемая к каждой задаче из набора.
+type BulkTaskOperation struct {
+	Operation BulkOperation
+	ColumnID  uuid.UUID
+	Tags      []string
+	Field     BulkField
+	// Value - новое значение поля для set_field, nil очищает поле
+	Value *string
+}
+
+func NewBulkTaskOperation(
+	operation string,
+	columnID *uuid.UUID,
+	tags []string,
+	field string,
+	value *string,
+) (BulkTaskOperation, error) {
+	op := BulkTaskOperation{
+		Operation: BulkOperation(operation),
+		Tags:      tags,
+		Field:     BulkField(field),
+		Value:     value,
+	}
+
+	switch op.Operation {
+	case BulkMove:
+		if columnID == nil {
+			return BulkTaskOperation{}, ErrBulkColumnRequired
+		}
+		op.ColumnID = *columnID
+	case BulkAddTags, BulkRemoveTags:
+		if len(tags) == 0 || slices.Contains(tags, "") {
+			return BulkTaskOperation{}, ErrBulkTagsRequired
+		}
+	case BulkSetField:
+		if err := op.validateField(); err != nil {
+			return BulkTaskOperation{}, err
+		}
+	case BulkDelete, BulkRestore:
+	default:
+		return BulkTaskOperation{}, ErrInvalidBulkOperation
+	}
+
+	return op, nil
+}
+
+func (op BulkTaskOperation) validateField() error {
+	switch op.Field {
+	case BulkFieldAssignee, BulkFieldLane:
+		if op.Value != nil && len(*op.Value) > maxLaneFieldLen {
+			return ErrInvalidLaneField
+		}
+	case BulkFieldPriority:
+		if op.Value != nil {
+			if _, err := ParsePriority(*op.Value); err != nil {
+				return err
+			}
+		}
+	default:
+		return ErrInvalidBulkField
+	}
+	return nil
+}
+
+// Apply применяет операцию к задаче. boardColumns - живые колонки доски задачи:
+// переносить можно только в них, восстанавливать - только задачи из них.
+// ErrBulkNoChange означает, что задача уже в нужном состоянии.
+func (op BulkTaskOperation) Apply(t *Task, boardColumns []Column) error {
+	if op.Operation == BulkRestore {
+		if t.DeletedAt == nil {
+			return ErrBulkNoChange
+		}
+		if !columnInList(boardColumns, t.ColumnID) {
+			return ErrColumnNotInBoard
+		}
+		return t.Restore()
+	}
+
+	if t.DeletedAt != nil {
+		return ErrTaskDeleted
+	}
+
+	switch op.Operation {
+	case BulkMove:
+		if !columnInList(boardColumns, op.ColumnID) {
+			return ErrColumnNotInBoard
+		}
+		if err := t.MoveToColumn(op.ColumnID); err != nil {
+			if errors.Is(err, ErrAlreadyInColumn) {
+				return ErrBulkNoChange
+			}
+			return err
+		}
+	case BulkAddTags:
+		tags := slices.Clone(t.Tags)
+		for _, tag := range op.Tags {
+			if !slices.Contains(tags, tag) {
+				tags = append(tags, tag)
+			}
+		}
+		if len(tags) == len(t.Tags) {
+			return ErrBulkNoChange
+		}
+		t.Tags = tags
+	case BulkRemoveTags:
+		tags := slices.DeleteFunc(slices.Clone(t.Tags), func(tag string) bool {
+			return slices.Contains(op.Tags, tag)
+		})
+		if len(tags) == len(t.Tags) {
+			return ErrBulkNoChange
+		}
+		t.Tags = tags
+	case BulkDelete:
+		t.Delete()
+	case BulkSetField:
+		if err := op.setField(t); err != nil {
+			return err
+		}
+	}
+
+	t.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+func (op BulkTaskOperation) setField(t *Task) error {
+	switch op.Field {
+	case BulkFieldAssignee:
+		return t.SetLaneFields(op.Value, t.Priority, t.Lane)
+	case BulkFieldLane:
+		return t.SetLaneFields(t.Assignee, t.Priority, op.Value)
+	case BulkFieldPriority:
+		var priority *Priority
+		if op.Value != nil {
+			p, err := ParsePriority(*op.Value)
+			if err != nil {
+				return err
+			}
+			priority = &p
+		}
+		return t.SetLaneFields(t.Assignee, priority, t.Lane)
+	}
+	return ErrInvalidBulkField
+}
+
+func columnInList(columns []Column, columnID uuid.UUID) bool {
+	return slices.ContainsFunc(columns, func(c Column) bool {
+		return c.ID == columnID
+	})
+}
@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBulkTaskOperation(t *testing.T) {
+	columnID := uuid.New()
+	bad := "urgent"
+
+	_, err := NewBulkTaskOperation("archive", nil, nil, "", nil)
+	assert.ErrorIs(t, err, ErrInvalidBulkOperation)
+
+	_, err = NewBulkTaskOperation("move", nil, nil, "", nil)
+	assert.ErrorIs(t, err, ErrBulkColumnRequired)
+
+	_, err = NewBulkTaskOperation("add_tags", nil, []string{""}, "", nil)
+	assert.ErrorIs(t, err, ErrBulkTagsRequired)
+
+	_, err = NewBulkTaskOperation("set_field", nil, nil, "title", nil)
+	assert.ErrorIs(t, err, ErrInvalidBulkField)
+
+	_, err = NewBulkTaskOperation("set_field", nil, nil, "priority", &bad)
+	assert.Error(t, err)
+
+	op, err := NewBulkTaskOperation("move", &columnID, nil, "", nil)
+	require.NoError(t, err)
+	assert.Equal(t, columnID, op.ColumnID)
+}
+
+func TestBulkTaskOperation_Apply(t *testing.T) {
+	todo := Column{ID: uuid.New()}
+	done := Column{ID: uuid.New()}
+	columns := []Column{todo, done}
+	newTask := func() *Task {
+		return &Task{ID: uuid.New(), ColumnID: todo.ID, Tags: []string{"bug"}}
+	}
+
+	t.Run("move", func(t *testing.T) {
+		task := newTask()
+		require.NoError(t, BulkTaskOperation{Operation: BulkMove, ColumnID: done.ID}.Apply(task, columns))
+		assert.Equal(t, done.ID, task.ColumnID)
+
+		err := BulkTaskOperation{Operation: BulkMove, ColumnID: done.ID}.Apply(task, columns)
+		assert.ErrorIs(t, err, ErrBulkNoChange)
+
+		err = BulkTaskOperation{Operation: BulkMove, ColumnID: uuid.New()}.Apply(task, columns)
+		assert.ErrorIs(t, err, ErrColumnNotInBoard)
+	})
+
+	t.Run("tags", func(t *testing.T) {
+		task := newTask()
+		require.NoError(t, BulkTaskOperation{Operation: BulkAddTags, Tags: []string{"bug", "ui"}}.Apply(task, columns))
+		assert.Equal(t, []string{"bug", "ui"}, task.Tags)
+
+		err := BulkTaskOperation{Operation: BulkAddTags, Tags: []string{"ui"}}.Apply(task, columns)
+		assert.ErrorIs(t, err, ErrBulkNoChange)
+
+		require.NoError(t, BulkTaskOperation{Operation: BulkRemoveTags, Tags: []string{"bug"}}.Apply(task, columns))
+		assert.Equal(t, []string{"ui"}, task.Tags)
+	})
+
+	t.Run("delete and restore", func(t *testing.T) {
+		task := newTask()
+		require.NoError(t, BulkTaskOperation{Operation: BulkDelete}.Apply(task, columns))
+		assert.NotNil(t, task.DeletedAt)
+
+		err := BulkTaskOperation{Operation: BulkAddTags, Tags: []string{"ui"}}.Apply(task, columns)
+		assert.ErrorIs(t, err, ErrTaskDeleted)
+
+		err = BulkTaskOperation{Operation: BulkRestore}.Apply(task, []Column{done})
+		assert.ErrorIs(t, err, ErrColumnNotInBoard)
+
+		require.NoError(t, BulkTaskOperation{Operation: BulkRestore}.Apply(task, columns))
+		assert.Nil(t, task.DeletedAt)
+
+		err = BulkTaskOperation{Operation: BulkRestore}.Apply(task, columns)
+		assert.ErrorIs(t, err, ErrBulkNoChange)
+	})
+
+	t.Run("set field keeps other lane fields", func(t *testing.T) {
+		task := newTask()
+		lane := "backend"
+		task.Lane = &lane
+		high := "high"
+
+		require.NoError(t, BulkTaskOperation{Operation: BulkSetField, Field: BulkFieldPriority, Value: &high}.Apply(task, columns))
+		require.NotNil(t, task.Priority)
+		assert.Equal(t, Priority("high"), *task.Priority)
+		assert.Equal(t, &lane, task.Lane)
+
+		require.NoError(t, BulkTaskOperation{Operation: BulkSetField, Field: BulkFieldLane}.Apply(task, columns))
+		assert.Nil(t, task.Lane)
+	})
+}
@@ -7,16 +7,24 @@ import (
 	"github.com/google/uuid"
 )
 
-var ErrEmptyColumnName = errors.New("column name can't be empty")
+var (
+	ErrEmptyColumnName = errors.New("column name can't be empty")
+	ErrInvalidWipLimit = errors.New("wip limit must be positive")
+)
 
 type Column struct {
-	ID        uuid.UUID
-	BoardID   uuid.UUID
-	OrderNum  int64
-	Name      string
+	ID       uuid.UUID
+	BoardID  uuid.UUID
+	OrderNum int64
+	Name     string
+	IsDone   bool
+	// WipLimit - ограничение на число задач в колонке, nil - без ограничения.
+	WipLimit  *int64
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	DeletedAt *time.Time
+
+	events []Event
 }
 
 func NewColumn(boardID uuid.UUID, name string, orderNum int64) (*Column, error) {
@@ -25,7 +33,7 @@ func NewColumn(boardID uuid.UUID, name string, orderNum int64) (*Column, error)
 	}
 	id := uuid.New()
 
-	return &Column{
+	column := &Column{
 		ID:        id,
 		BoardID:   boardID,
 		Name:      name,
@@ -33,10 +41,96 @@ func NewColumn(boardID uuid.UUID, name string, orderNum int64) (*Column, error)
 		CreatedAt: time.Now().UTC(),
 		UpdatedAt: time.Now().UTC(),
 		DeletedAt: nil,
-	}, nil
+	}
+	column.raise(ColumnCreated{ColumnID: id, Name: name, OrderNum: orderNum})
+	return column, nil
 }
 
 func (c *Column) Delete() {
 	now := time.Now().UTC()
 	c.DeletedAt = &now
+	c.raise(ColumnDeleted{ColumnID: c.ID})
+}
+
+func (c *Column) Update(name string, isDone bool, wipLimit *int64) error {
+	if name == "" {
+		return ErrEmptyColumnName
+	}
+	if err := validateWipLimit(wipLimit); err != nil {
+		return err
+	}
+
+	c.Name = name
+	c.IsDone = isDone
+	c.WipLimit = wipLimit
+	c.UpdatedAt = time.Now().UTC()
+	c.raise(ColumnUpdated{ColumnID: c.ID, Name: name, IsDone: isDone, WipLimit: wipLimit})
+	return nil
+}
+
+func validateWipLimit(wipLimit *int64) error {
+	if wipLimit != nil && *wipLimit <= 0 {
+		return ErrInvalidWipLimit
+	}
+	return nil
+}
+
+// Events - события, накопленные колонкой с момента последней записи в outbox.
+func (c *Column) Events() []Event {
+	return c.events
+}
+
+func (c *Column) ClearEvents() {
+	c.events = nil
+}
+
+func (c *Column) raise(payload EventPayload) {
+	c.events = append(c.events, newEvent(c.ID, c.BoardID, payload))
+}
+
+// IsDoneColumn - колонка считается завершающей, если она явно помечена IsDone,
+// либо на доске нет помеченных колонок и она последняя по порядку.
+func IsDoneColumn(columns []Column, columnID uuid.UUID) bool {
+	var last *Column
+	hasFlagged := false
+	for i := range columns {
+		if columns[i].IsDone {
+			hasFlagged = true
+			if columns[i].ID == columnID {
+				return true
+			}
+		}
+		if last == nil || columns[i].OrderNum > last.OrderNum {
+			last = &columns[i]
+		}
+	}
+
+	return !hasFlagged && last != nil && last.ID == columnID
+}
+
+// ColumnCategory - укрупненная стадия колонки для отчетов.
+type ColumnCategory string
+
+const (
+	CategoryTodo       ColumnCategory = "todo"
+	CategoryInProgress ColumnCategory = "in_progress"
+	CategoryDone       ColumnCategory = "done"
+)
+
+// CategoryOfColumn: завершающая колонка - done, первая по порядку - todo, остальные - in_progress.
+func CategoryOfColumn(columns []Column, columnID uuid.UUID) ColumnCategory {
+	if IsDoneColumn(columns, columnID) {
+		return CategoryDone
+	}
+
+	var first *Column
+	for i := range columns {
+		if first == nil || columns[i].OrderNum < first.OrderNum {
+			first = &columns[i]
+		}
+	}
+	if first != nil && first.ID == columnID {
+		return CategoryTodo
+	}
+	return CategoryInProgress
 }
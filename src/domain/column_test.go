@@ -86,3 +86,56 @@ func TestColumn_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestIsDoneColumn(t *testing.T) {
+	todo := Column{ID: uuid.New(), OrderNum: 0}
+	inProgress := Column{ID: uuid.New(), OrderNum: 1}
+	review := Column{ID: uuid.New(), OrderNum: 2}
+
+	flagged := inProgress
+	flagged.IsDone = true
+
+	testCases := []struct {
+		name     string
+		columns  []Column
+		columnID uuid.UUID
+		expected bool
+	}{
+		{
+			name:     "last column is done when nothing is flagged",
+			columns:  []Column{todo, inProgress, review},
+			columnID: review.ID,
+			expected: true,
+		},
+		{
+			name:     "not last column is not done when nothing is flagged",
+			columns:  []Column{todo, inProgress, review},
+			columnID: inProgress.ID,
+			expected: false,
+		},
+		{
+			name:     "flagged column is done",
+			columns:  []Column{todo, flagged, review},
+			columnID: flagged.ID,
+			expected: true,
+		},
+		{
+			name:     "last column is not done when another one is flagged",
+			columns:  []Column{todo, flagged, review},
+			columnID: review.ID,
+			expected: false,
+		},
+		{
+			name:     "no columns",
+			columns:  nil,
+			columnID: todo.ID,
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, IsDoneColumn(tc.columns, tc.columnID))
+		})
+	}
+}
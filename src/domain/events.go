@@ -0,0 +1,217 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type EventType string
+
+const (
+	EventTaskCreated      EventType = "task.created"
+	EventTaskUpdated      EventType = "task.updated"
+	EventTaskMoved        EventType = "task.moved"
+	EventTaskMovedToBoard EventType = "task.moved_to_board"
+	EventTaskDeleted      EventType = "task.deleted"
+	EventTaskAssigned     EventType = "task.assigned"
+	EventTaskMentioned    EventType = "task.mentioned"
+	EventTaskRestored     EventType = "task.restored"
+	EventColumnCreated    EventType = "column.created"
+	EventColumnUpdated    EventType = "column.updated"
+	EventColumnDeleted    EventType = "column.deleted"
+	EventColumnRestored   EventType = "column.restored"
+	EventBoardRestored    EventType = "board.restored"
+)
+
+var ErrUnknownEventType = errors.New("unknown event type")
+
+// Event - доменное событие. Сущности копят их в своих методах, репозиторий
+// сохраняет в outbox в той же транзакции, что и само изменение.
+type Event struct {
+	ID          uuid.UUID
+	Type        EventType
+	AggregateID uuid.UUID
+	BoardID     uuid.UUID
+	OccurredAt  time.Time
+	Payload     EventPayload
+}
+
+// EventPayload - данные конкретного события: TaskCreated, TaskMoved и т.д.
+type EventPayload interface {
+	EventType() EventType
+}
+
+type TaskCreated struct {
+	TaskID   uuid.UUID `json:"task_id"`
+	ColumnID uuid.UUID `json:"column_id"`
+	Number   int64     `json:"number"`
+	Title    string    `json:"title"`
+}
+
+type TaskUpdated struct {
+	TaskID uuid.UUID `json:"task_id"`
+	Title  string    `json:"title"`
+}
+
+type TaskMoved struct {
+	TaskID       uuid.UUID `json:"task_id"`
+	FromColumnID uuid.UUID `json:"from_column_id"`
+	ToColumnID   uuid.UUID `json:"to_column_id"`
+}
+
+// TaskMovedToBoard - задача перенесена в другую доску под новым ключом.
+// Событие принадлежит целевой доске.
+type TaskMovedToBoard struct {
+	TaskID       uuid.UUID `json:"task_id"`
+	FromBoardID  uuid.UUID `json:"from_board_id"`
+	FromKey      string    `json:"from_key"`
+	FromColumnID uuid.UUID `json:"from_column_id"`
+	ToBoardID    uuid.UUID `json:"to_board_id"`
+	ToColumnID   uuid.UUID `json:"to_column_id"`
+	ToKey        string    `json:"to_key"`
+}
+
+type TaskDeleted struct {
+	TaskID uuid.UUID `json:"task_id"`
+}
+
+type TaskAssigned struct {
+	TaskID   uuid.UUID `json:"task_id"`
+	Assignee string    `json:"assignee"`
+}
+
+// TaskMentioned - в описании задачи появились новые упоминания пользователей.
+type TaskMentioned struct {
+	TaskID uuid.UUID `json:"task_id"`
+	Users  []string  `json:"users"`
+}
+
+// TaskRestored - задача возвращена из корзины вместе с подзадачами, удаленными каскадом.
+type TaskRestored struct {
+	TaskID uuid.UUID `json:"task_id"`
+}
+
+type ColumnCreated struct {
+	ColumnID uuid.UUID `json:"column_id"`
+	Name     string    `json:"name"`
+	OrderNum int64     `json:"order_num"`
+}
+
+type ColumnUpdated struct {
+	ColumnID uuid.UUID `json:"column_id"`
+	Name     string    `json:"name"`
+	IsDone   bool      `json:"is_done"`
+	WipLimit *int64    `json:"wip_limit,omitempty"`
+}
+
+type ColumnDeleted struct {
+	ColumnID uuid.UUID `json:"column_id"`
+}
+
+type ColumnRestored struct {
+	ColumnID uuid.UUID `json:"column_id"`
+}
+
+// BoardRestored - доска возвращена из корзины вместе с колонками и задачами,
+// удаленными в том же каскаде.
+type BoardRestored struct {
+	BoardID uuid.UUID `json:"board_id"`
+}
+
+func (TaskCreated) EventType() EventType      { return EventTaskCreated }
+func (TaskUpdated) EventType() EventType      { return EventTaskUpdated }
+func (TaskMoved) EventType() EventType        { return EventTaskMoved }
+func (TaskMovedToBoard) EventType() EventType { return EventTaskMovedToBoard }
+func (TaskDeleted) EventType() EventType      { return EventTaskDeleted }
+func (TaskAssigned) EventType() EventType     { return EventTaskAssigned }
+func (TaskMentioned) EventType() EventType    { return EventTaskMentioned }
+func (TaskRestored) EventType() EventType     { return EventTaskRestored }
+func (ColumnCreated) EventType() EventType    { return EventColumnCreated }
+func (ColumnUpdated) EventType() EventType    { return EventColumnUpdated }
+func (ColumnDeleted) EventType() EventType    { return EventColumnDeleted }
+func (ColumnRestored) EventType() EventType   { return EventColumnRestored }
+func (BoardRestored) EventType() EventType    { return EventBoardRestored }
+
+func newEvent(aggregateID, boardID uuid.UUID, payload EventPayload) Event {
+	return Event{
+		ID:          uuid.New(),
+		Type:        payload.EventType(),
+		AggregateID: aggregateID,
+		BoardID:     boardID,
+		OccurredAt:  time.Now().UTC(),
+		Payload:     payload,
+	}
+}
+
+// DecodeEventPayload восстанавливает данные события, сохраненные в outbox.
+// Данные возвращаются по значению, как их поднимают сущности.
+func DecodeEventPayload(eventType EventType, data []byte) (EventPayload, error) {
+	const op = "domain.DecodeEventPayload"
+
+	var (
+		payload EventPayload
+		err     error
+	)
+	switch eventType {
+	case EventTaskCreated:
+		payload, err = decodePayload[TaskCreated](data)
+	case EventTaskUpdated:
+		payload, err = decodePayload[TaskUpdated](data)
+	case EventTaskMoved:
+		payload, err = decodePayload[TaskMoved](data)
+	case EventTaskMovedToBoard:
+		payload, err = decodePayload[TaskMovedToBoard](data)
+	case EventTaskDeleted:
+		payload, err = decodePayload[TaskDeleted](data)
+	case EventTaskAssigned:
+		payload, err = decodePayload[TaskAssigned](data)
+	case EventTaskMentioned:
+		payload, err = decodePayload[TaskMentioned](data)
+	case EventTaskRestored:
+		payload, err = decodePayload[TaskRestored](data)
+	case EventColumnCreated:
+		payload, err = decodePayload[ColumnCreated](data)
+	case EventColumnUpdated:
+		payload, err = decodePayload[ColumnUpdated](data)
+	case EventColumnDeleted:
+		payload, err = decodePayload[ColumnDeleted](data)
+	case EventColumnRestored:
+		payload, err = decodePayload[ColumnRestored](data)
+	case EventBoardRestored:
+		payload, err = decodePayload[BoardRestored](data)
+	default:
+		return nil, errors.Wrapf(ErrUnknownEventType, "%s: %s", op, eventType)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	return payload, nil
+}
+
+func decodePayload[T EventPayload](data []byte) (T, error) {
+	var payload T
+	err := json.Unmarshal(data, &payload)
+	return payload, err
+}
+
+// OutboxRetryPolicy - повторы публикации события из outbox. После MaxAttempts
+// неудачных попыток событие паркуется: relay его больше не забирает и оно
+// не задерживает следующие события того же агрегата.
+type OutboxRetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+// Retry решает судьбу события после attempts неудачных попыток:
+// ok == false - событие паркуется, иначе повтор не раньше retryAt.
+func (p OutboxRetryPolicy) Retry(attempts int, now time.Time) (retryAt time.Time, ok bool) {
+	if attempts >= p.MaxAttempts {
+		return time.Time{}, false
+	}
+	return now.Add(exponentialBackoff(p.BaseDelay, p.MaxDelay, attempts)), true
+}
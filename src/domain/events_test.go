@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskEvents(t *testing.T) {
+	boardID := uuid.New()
+	todo, done := uuid.New(), uuid.New()
+
+	task, err := NewTask(todo, boardID, 1, "Task", nil, nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, task.MoveToColumn(done))
+	task.Update(todo, boardID, 1, "Renamed", nil, nil, nil)
+	task.Delete()
+
+	events := task.Events()
+	require.Len(t, events, 5)
+
+	types := make([]EventType, 0, len(events))
+	for _, e := range events {
+		assert.Equal(t, task.ID, e.AggregateID)
+		assert.Equal(t, boardID, e.BoardID)
+		types = append(types, e.Type)
+	}
+	assert.Equal(t, []EventType{
+		EventTaskCreated, EventTaskMoved, EventTaskMoved, EventTaskUpdated, EventTaskDeleted,
+	}, types)
+	assert.Equal(t, TaskMoved{TaskID: task.ID, FromColumnID: todo, ToColumnID: done}, events[1].Payload)
+	assert.Equal(t, TaskMoved{TaskID: task.ID, FromColumnID: done, ToColumnID: todo}, events[2].Payload)
+
+	task.ClearEvents()
+	assert.Empty(t, task.Events())
+}
+
+func TestColumnEvents(t *testing.T) {
+	column, err := NewColumn(uuid.New(), "Todo", 0)
+	require.NoError(t, err)
+
+	limit := int64(3)
+	zero := int64(0)
+	require.ErrorIs(t, column.Update("", false, nil), ErrEmptyColumnName)
+	require.ErrorIs(t, column.Update("Done", true, &zero), ErrInvalidWipLimit)
+	require.NoError(t, column.Update("Done", true, &limit))
+	column.Delete()
+
+	events := column.Events()
+	require.Len(t, events, 3)
+	assert.Equal(t, ColumnCreated{ColumnID: column.ID, Name: "Todo"}, events[0].Payload)
+	assert.Equal(t, ColumnUpdated{ColumnID: column.ID, Name: "Done", IsDone: true, WipLimit: &limit}, events[1].Payload)
+	assert.Equal(t, &limit, column.WipLimit)
+	assert.Equal(t, ColumnDeleted{ColumnID: column.ID}, events[2].Payload)
+}
+
+func TestDecodeEventPayload(t *testing.T) {
+	payload := TaskMoved{TaskID: uuid.New(), FromColumnID: uuid.New(), ToColumnID: uuid.New()}
+	data, err := json.Marshal(payload)
+	require.NoError(t, err)
+
+	decoded, err := DecodeEventPayload(EventTaskMoved, data)
+	require.NoError(t, err)
+	assert.Equal(t, payload, decoded)
+
+	_, err = DecodeEventPayload("board.exploded", data)
+	assert.ErrorIs(t, err, ErrUnknownEventType)
+}
+
+func TestOutboxRetryPolicy(t *testing.T) {
+	now := time.Now().UTC()
+	policy := OutboxRetryPolicy{MaxAttempts: 5, BaseDelay: time.Second, MaxDelay: 5 * time.Second}
+
+	retryAt, ok := policy.Retry(1, now)
+	require.True(t, ok)
+	assert.Equal(t, now.Add(time.Second), retryAt)
+
+	retryAt, ok = policy.Retry(3, now)
+	require.True(t, ok)
+	assert.Equal(t, now.Add(4*time.Second), retryAt)
+
+	retryAt, ok = policy.Retry(4, now)
+	require.True(t, ok)
+	assert.Equal(t, now.Add(5*time.Second), retryAt)
+
+	_, ok = policy.Retry(5, now)
+	assert.False(t, ok)
+}
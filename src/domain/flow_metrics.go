@@ -0,0 +1,251 @@
+package domain
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// Метрики по умолчанию считаются за последние 12 недель
+const defaultFlowMetricsWeeks = 12
+
+var (
+	ErrInvalidMetricsPeriod  = errors.New("metrics period must be from <= to and at most 366 days")
+	ErrInvalidMetricsColumns = errors.New("cycle start and end columns must be on the board, start not after end")
+)
+
+// FlowMetricsFilter - доска и период по дате завершения задач, From и To включительно.
+type FlowMetricsFilter struct {
+	BoardID uuid.UUID
+	From    time.Time
+	To      time.Time
+	// StartColumnID - с какой колонки идет cycle time, nil - первая колонка после todo.
+	// EndColumnID - в какой колонке задача завершена, nil - done-колонка доски.
+	// Задача дошла до колонки, если попала в нее или в любую колонку правее.
+	StartColumnID *uuid.UUID
+	EndColumnID   *uuid.UUID
+}
+
+func NewFlowMetricsFilter(boardID uuid.UUID, from, to *time.Time, startColumnID, endColumnID *uuid.UUID) (FlowMetricsFilter, error) {
+	const op = "domain.NewFlowMetricsFilter"
+
+	f := FlowMetricsFilter{
+		BoardID:       boardID,
+		StartColumnID: startColumnID,
+		EndColumnID:   endColumnID,
+	}
+	var ok bool
+	if f.From, f.To, ok = reportPeriod(from, to, defaultFlowMetricsWeeks); !ok {
+		return FlowMetricsFilter{}, errors.Wrap(ErrInvalidMetricsPeriod, op)
+	}
+
+	return f, nil
+}
+
+// TaskFlow - путь задачи по доске, восстановленный из истории переходов.
+type TaskFlow struct {
+	TaskID uuid.UUID
+	// EnteredAt - появление задачи на доске: создание или перенос из другой доски
+	EnteredAt time.Time
+	// StartedAt - начало работы, nil - задача миновала стартовую колонку
+	StartedAt   *time.Time
+	CompletedAt time.Time
+}
+
+// LeadTime - от появления задачи на доске до завершения.
+func (f TaskFlow) LeadTime() time.Duration {
+	return f.CompletedAt.Sub(f.EnteredAt)
+}
+
+// CycleTime - от начала работы до завершения.
+func (f TaskFlow) CycleTime() (time.Duration, bool) {
+	if f.StartedAt == nil {
+		return 0, false
+	}
+	return f.CompletedAt.Sub(*f.StartedAt), true
+}
+
+// DurationStats - сводка по длительностям, перцентили по ближайшему рангу.
+type DurationStats struct {
+	Tasks   int
+	Average time.Duration
+	Min     time.Duration
+	Max     time.Duration
+	P50     time.Duration
+	P85     time.Duration
+	P95     time.Duration
+}
+
+func NewDurationStats(values []time.Duration) DurationStats {
+	if len(values) == 0 {
+		return DurationStats{}
+	}
+
+	sorted := append([]time.Duration(nil), values...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	var total time.Duration
+	for _, v := range sorted {
+		total += v
+	}
+
+	return DurationStats{
+		Tasks:   len(sorted),
+		Average: total / time.Duration(len(sorted)),
+		Min:     sorted[0],
+		Max:     sorted[len(sorted)-1],
+		P50:     percentile(sorted, 50),
+		P85:     percentile(sorted, 85),
+		P95:     percentile(sorted, 95),
+	}
+}
+
+// percentile - значение, не меньше которого p% отсортированной выборки.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// ThroughputWeek - сколько задач завершено за неделю. Крайние недели
+// считаются только по дням периода.
+type ThroughputWeek struct {
+	Label string
+	Start time.Time
+	End   time.Time
+	Tasks int
+}
+
+type FlowMetrics struct {
+	Filter FlowMetricsFilter
+	// Tasks - задачи, завершенные за период, по времени завершения
+	Tasks      []TaskFlow
+	LeadTime   DurationStats
+	CycleTime  DurationStats
+	Throughput []ThroughputWeek
+}
+
+// NewFlowMetrics проигрывает историю переходов доски. Завершением считается
+// первое попадание в конечную колонку, колонки определяются по текущему
+// порядку на доске, переходы в удаленные колонки пропускаются.
+func NewFlowMetrics(f FlowMetricsFilter, columns []Column, transitions []TaskTransition) (*FlowMetrics, error) {
+	const op = "domain.NewFlowMetrics"
+
+	reachedStart, reachedEnd, err := flowStages(f, columns)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	byTask := map[uuid.UUID][]TaskTransition{}
+	var taskIDs []uuid.UUID
+	for _, t := range transitions {
+		if _, ok := byTask[t.TaskID]; !ok {
+			taskIDs = append(taskIDs, t.TaskID)
+		}
+		byTask[t.TaskID] = append(byTask[t.TaskID], t)
+	}
+
+	from, to := f.From, f.To.AddDate(0, 0, 1)
+	m := &FlowMetrics{Filter: f, Tasks: []TaskFlow{}}
+	for _, id := range taskIDs {
+		flow, ok := replayTaskFlow(byTask[id], reachedStart, reachedEnd)
+		if !ok || flow.CompletedAt.Before(from) || !flow.CompletedAt.Before(to) {
+			continue
+		}
+		m.Tasks = append(m.Tasks, flow)
+	}
+	sort.SliceStable(m.Tasks, func(i, j int) bool {
+		return m.Tasks[i].CompletedAt.Before(m.Tasks[j].CompletedAt)
+	})
+
+	var lead, cycle []time.Duration
+	for _, flow := range m.Tasks {
+		lead = append(lead, flow.LeadTime())
+		if d, ok := flow.CycleTime(); ok {
+			cycle = append(cycle, d)
+		}
+	}
+	m.LeadTime = NewDurationStats(lead)
+	m.CycleTime = NewDurationStats(cycle)
+
+	for _, w := range isoWeeks(f.From, f.To) {
+		week := ThroughputWeek{Label: w.Label, Start: w.Start, End: w.End}
+		for _, flow := range m.Tasks {
+			if !flow.CompletedAt.Before(w.Start) && flow.CompletedAt.Before(w.End) {
+				week.Tasks++
+			}
+		}
+		m.Throughput = append(m.Throughput, week)
+	}
+
+	return m, nil
+}
+
+// flowStages строит проверки "дошла до начала работы" и "завершена" для колонок доски.
+func flowStages(f FlowMetricsFilter, columns []Column) (reachedStart, reachedEnd func(uuid.UUID) bool, err error) {
+	order := make(map[uuid.UUID]int64, len(columns))
+	for _, c := range columns {
+		order[c.ID] = c.OrderNum
+	}
+
+	reachedFrom := func(columnID *uuid.UUID) (func(uuid.UUID) bool, bool) {
+		bound, ok := order[*columnID]
+		return func(id uuid.UUID) bool {
+			o, ok := order[id]
+			return ok && o >= bound
+		}, ok
+	}
+
+	reachedStart = func(id uuid.UUID) bool {
+		_, ok := order[id]
+		return ok && CategoryOfColumn(columns, id) != CategoryTodo
+	}
+	if f.StartColumnID != nil {
+		var ok bool
+		if reachedStart, ok = reachedFrom(f.StartColumnID); !ok {
+			return nil, nil, ErrInvalidMetricsColumns
+		}
+	}
+
+	reachedEnd = func(id uuid.UUID) bool {
+		return IsDoneColumn(columns, id)
+	}
+	if f.EndColumnID != nil {
+		var ok bool
+		if reachedEnd, ok = reachedFrom(f.EndColumnID); !ok {
+			return nil, nil, ErrInvalidMetricsColumns
+		}
+	}
+
+	if f.StartColumnID != nil && f.EndColumnID != nil && order[*f.StartColumnID] > order[*f.EndColumnID] {
+		return nil, nil, ErrInvalidMetricsColumns
+	}
+
+	return reachedStart, reachedEnd, nil
+}
+
+// replayTaskFlow проходит переходы одной задачи по времени до первого завершения.
+func replayTaskFlow(transitions []TaskTransition, reachedStart, reachedEnd func(uuid.UUID) bool) (TaskFlow, bool) {
+	sort.SliceStable(transitions, func(i, j int) bool {
+		return transitions[i].OccurredAt.Before(transitions[j].OccurredAt)
+	})
+
+	flow := TaskFlow{TaskID: transitions[0].TaskID, EnteredAt: transitions[0].OccurredAt}
+	for _, t := range transitions {
+		if flow.StartedAt == nil && reachedStart(t.ToColumnID) {
+			at := t.OccurredAt
+			flow.StartedAt = &at
+		}
+		if reachedEnd(t.ToColumnID) {
+			flow.CompletedAt = t.OccurredAt
+			return flow, true
+		}
+	}
+
+	return flow, false
+}
@@ -0,0 +1,166 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// flowBoard - доска Todo -> In progress -> Review -> Done с синтетическими часами:
+// история собирается из событий задач, как ее пишет репозиторий.
+type flowBoard struct {
+	t       *testing.T
+	id      uuid.UUID
+	columns []Column
+	clock   time.Time
+	history []TaskTransition
+}
+
+func newFlowBoard(t *testing.T, start time.Time) *flowBoard {
+	b := &flowBoard{t: t, id: uuid.New(), clock: start}
+	for i, name := range []string{"Todo", "In progress", "Review", "Done"} {
+		b.columns = append(b.columns, Column{ID: uuid.New(), BoardID: b.id, Name: name, OrderNum: int64(i + 1)})
+	}
+	return b
+}
+
+func (b *flowBoard) record(task *Task) {
+	for _, e := range task.Events() {
+		e.OccurredAt = b.clock
+		b.history = append(b.history, TransitionsFromEvents([]Event{e})...)
+	}
+	task.ClearEvents()
+}
+
+func (b *flowBoard) create(column int) *Task {
+	task, err := NewTask(b.columns[column].ID, b.id, int64(len(b.history)+1), "Task", nil, nil, nil)
+	require.NoError(b.t, err)
+	b.record(task)
+	return task
+}
+
+func (b *flowBoard) move(task *Task, column int) {
+	require.NoError(b.t, task.MoveToColumn(b.columns[column].ID))
+	b.record(task)
+}
+
+func (b *flowBoard) wait(d time.Duration) {
+	b.clock = b.clock.Add(d)
+}
+
+func TestFlowMetricsReplay(t *testing.T) {
+	const day = 24 * time.Hour
+	// Понедельник 3 марта 2025
+	b := newFlowBoard(t, time.Date(2025, 3, 3, 9, 0, 0, 0, time.UTC))
+
+	// A: 1 день в Todo, 2 дня в работе, 1 день на ревью
+	a := b.create(0)
+	// B: сразу в работе, возврат с ревью в работу
+	bb := b.create(1)
+	// C: создана и брошена в Todo
+	b.create(0)
+	b.wait(day)
+	b.move(a, 1)
+	b.wait(2 * day)
+	b.move(a, 2)
+	b.move(bb, 2)
+	b.wait(day)
+	b.move(a, 3) // 7 марта: lead 4д, cycle 3д
+	b.move(bb, 1)
+	b.wait(5 * day)
+	b.move(bb, 3) // 12 марта: lead 9д, cycle 9д
+	// D: из Todo сразу в Done, потом переоткрыта и закрыта снова
+	d := b.create(0)
+	b.wait(day)
+	b.move(d, 3) // 13 марта: lead 1д, cycle 0
+	b.wait(day)
+	b.move(d, 1)
+	b.wait(day)
+	b.move(d, 3)
+
+	from, to := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC), time.Date(2025, 3, 16, 0, 0, 0, 0, time.UTC)
+
+	t.Run("done column and first column after todo", func(t *testing.T) {
+		f, err := NewFlowMetricsFilter(b.id, &from, &to, nil, nil)
+		require.NoError(t, err)
+
+		m, err := NewFlowMetrics(f, b.columns, b.history)
+		require.NoError(t, err)
+
+		require.Len(t, m.Tasks, 3)
+		assert.Equal(t, []uuid.UUID{a.ID, bb.ID, d.ID}, []uuid.UUID{m.Tasks[0].TaskID, m.Tasks[1].TaskID, m.Tasks[2].TaskID})
+
+		assert.Equal(t, 3, m.LeadTime.Tasks)
+		assert.Equal(t, day, m.LeadTime.Min)
+		assert.Equal(t, 4*day, m.LeadTime.P50)
+		assert.Equal(t, 9*day, m.LeadTime.P85)
+		assert.Equal(t, 9*day, m.LeadTime.Max)
+		assert.Equal(t, 14*day/3, m.LeadTime.Average)
+
+		assert.Equal(t, time.Duration(0), m.CycleTime.Min)
+		assert.Equal(t, 3*day, m.CycleTime.P50)
+		assert.Equal(t, 9*day, m.CycleTime.P95)
+		assert.Equal(t, 4*day, m.CycleTime.Average)
+
+		require.Len(t, m.Throughput, 2)
+		assert.Equal(t, "2025-W10", m.Throughput[0].Label)
+		assert.Equal(t, 1, m.Throughput[0].Tasks)
+		assert.Equal(t, "2025-W11", m.Throughput[1].Label)
+		assert.Equal(t, 2, m.Throughput[1].Tasks)
+	})
+
+	t.Run("custom start and end columns", func(t *testing.T) {
+		// Cycle time от ревью до ревью: задача завершена, когда дошла до Review
+		review := b.columns[2].ID
+		f, err := NewFlowMetricsFilter(b.id, &from, &to, &review, &review)
+		require.NoError(t, err)
+
+		m, err := NewFlowMetrics(f, b.columns, b.history)
+		require.NoError(t, err)
+
+		require.Len(t, m.Tasks, 3)
+		assert.Equal(t, time.Date(2025, 3, 6, 9, 0, 0, 0, time.UTC), m.Tasks[0].CompletedAt)
+		assert.Equal(t, 3*day, m.Tasks[0].LeadTime())
+		assert.Equal(t, time.Duration(0), m.CycleTime.Max)
+	})
+
+	t.Run("period cuts completions", func(t *testing.T) {
+		mar10 := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+		f, err := NewFlowMetricsFilter(b.id, &mar10, &to, nil, nil)
+		require.NoError(t, err)
+
+		m, err := NewFlowMetrics(f, b.columns, b.history)
+		require.NoError(t, err)
+		assert.Len(t, m.Tasks, 2)
+		require.Len(t, m.Throughput, 1)
+		assert.Equal(t, 2, m.Throughput[0].Tasks)
+	})
+
+	t.Run("invalid columns", func(t *testing.T) {
+		other := uuid.New()
+		_, err := NewFlowMetrics(FlowMetricsFilter{From: from, To: to, StartColumnID: &other}, b.columns, b.history)
+		assert.ErrorIs(t, err, ErrInvalidMetricsColumns)
+
+		start, end := b.columns[2].ID, b.columns[1].ID
+		_, err = NewFlowMetrics(FlowMetricsFilter{From: from, To: to, StartColumnID: &start, EndColumnID: &end}, b.columns, b.history)
+		assert.ErrorIs(t, err, ErrInvalidMetricsColumns)
+	})
+}
+
+func TestNewDurationStats(t *testing.T) {
+	assert.Equal(t, DurationStats{}, NewDurationStats(nil))
+
+	var values []time.Duration
+	for i := 20; i >= 1; i-- {
+		values = append(values, time.Duration(i)*time.Hour)
+	}
+	s := NewDurationStats(values)
+	assert.Equal(t, 20, s.Tasks)
+	assert.Equal(t, 10*time.Hour, s.P50)
+	assert.Equal(t, 17*time.Hour, s.P85)
+	assert.Equal(t, 19*time.Hour, s.P95)
+	assert.Equal(t, 630*time.Minute, s.Average)
+}
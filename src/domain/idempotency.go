@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const maxIdempotencyKeyLen = 255
+
+var ErrInvalidIdempotencyKey = errors.New("idempotency key must be 1-255 characters")
+
+// IdempotencyRecord - первый запрос с данным Idempotency-Key и ответ на него.
+// Пока запрос выполняется, StatusCode равен нулю.
+type IdempotencyRecord struct {
+	// Scope - метод, маршрут и пользователь: один ключ на разных ручках не пересекается
+	Scope       string
+	Key         string
+	RequestHash string
+	StatusCode  int
+	ContentType string
+	Body        []byte
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+}
+
+func NewIdempotencyRecord(scope, key string, requestBody []byte, ttl time.Duration) (*IdempotencyRecord, error) {
+	if key == "" || len(key) > maxIdempotencyKeyLen {
+		return nil, ErrInvalidIdempotencyKey
+	}
+
+	sum := sha256.Sum256(requestBody)
+	now := time.Now().UTC()
+	return &IdempotencyRecord{
+		Scope:       scope,
+		Key:         key,
+		RequestHash: hex.EncodeToString(sum[:]),
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(ttl),
+	}, nil
+}
+
+// Completed - ответ на первый запрос уже сохранен и его можно отдавать повторно.
+func (r *IdempotencyRecord) Completed() bool {
+	return r.StatusCode != 0
+}
+
+// SameRequest - повтор пришел с тем же телом, что и первый запрос.
+func (r *IdempotencyRecord) SameRequest(other *IdempotencyRecord) bool {
+	return r.RequestHash == other.RequestHash
+}
+
+func (r *IdempotencyRecord) Complete(statusCode int, contentType string, body []byte) {
+	r.StatusCode = statusCode
+	r.ContentType = contentType
+	r.Body = body
+}
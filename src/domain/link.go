@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type LinkType string
+
+const (
+	LinkBlocks     LinkType = "blocks"
+	LinkRelatesTo  LinkType = "relates_to"
+	LinkDuplicates LinkType = "duplicates"
+)
+
+var (
+	ErrInvalidLinkType  = errors.New("invalid link type")
+	ErrLinkToSelf       = errors.New("task can't be linked to itself")
+	ErrLinkAlreadyExist = errors.New("link already exists")
+	ErrTaskIsBlocked    = errors.New("task is blocked by unfinished tasks")
+)
+
+// linkLabels - подписи связи со стороны задачи-источника и задачи-приемника
+var linkLabels = map[LinkType][2]string{
+	LinkBlocks:     {"blocks", "is blocked by"},
+	LinkRelatesTo:  {"relates to", "relates to"},
+	LinkDuplicates: {"duplicates", "is duplicated by"},
+}
+
+// TaskLink - направленная связь FromTaskID -> ToTaskID.
+// LinkedTask заполняется при чтении: это "другая" задача относительно запрошенной.
+type TaskLink struct {
+	ID         uuid.UUID
+	FromTaskID uuid.UUID
+	ToTaskID   uuid.UUID
+	Type       LinkType
+	CreatedAt  time.Time
+	LinkedTask *Task
+}
+
+func NewTaskLink(fromTaskID, toTaskID uuid.UUID, linkType string) (*TaskLink, error) {
+	const op = "domain.NewTaskLink"
+
+	lt := LinkType(linkType)
+	if _, ok := linkLabels[lt]; !ok {
+		return nil, errors.Wrap(ErrInvalidLinkType, op)
+	}
+	if fromTaskID == toTaskID {
+		return nil, errors.Wrap(ErrLinkToSelf, op)
+	}
+
+	return &TaskLink{
+		ID:         uuid.New(),
+		FromTaskID: fromTaskID,
+		ToTaskID:   toTaskID,
+		Type:       lt,
+		CreatedAt:  time.Now().UTC(),
+	}, nil
+}
+
+// IsOutward - связь исходит из задачи taskID
+func (l *TaskLink) IsOutward(taskID uuid.UUID) bool {
+	return l.FromTaskID == taskID
+}
+
+// Label возвращает подпись связи с точки зрения задачи taskID
+func (l *TaskLink) Label(taskID uuid.UUID) string {
+	labels := linkLabels[l.Type]
+	if l.IsOutward(taskID) {
+		return labels[0]
+	}
+	return labels[1]
+}
+
+func (l *TaskLink) Involves(taskID uuid.UUID) bool {
+	return l.FromTaskID == taskID || l.ToTaskID == taskID
+}
+
+// Duplicates проверяет, что связь такого же смысла уже есть среди links.
+// relates_to симметрична, поэтому сравниваем в обе стороны.
+func (l *TaskLink) Duplicates(links []TaskLink) bool {
+	for _, other := range links {
+		if other.Type != l.Type {
+			continue
+		}
+		if other.FromTaskID == l.FromTaskID && other.ToTaskID == l.ToTaskID {
+			return true
+		}
+		if l.Type == LinkRelatesTo && other.FromTaskID == l.ToTaskID && other.ToTaskID == l.FromTaskID {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckBlockers решает судьбу переноса в завершающую колонку задачи, которую держат
+// открытые blockers: при reject перенос запрещается, иначе проходит с предупреждением.
+// Одиночный и массовый перенос задач проверяют блокеры только через нее.
+func CheckBlockers(blockers []Task, reject bool) ([]string, error) {
+	if len(blockers) == 0 {
+		return nil, nil
+	}
+
+	keys := make([]string, 0, len(blockers))
+	for _, b := range blockers {
+		keys = append(keys, b.Key())
+	}
+	blockedBy := strings.Join(keys, ", ")
+
+	if reject {
+		return nil, errors.Wrap(ErrTaskIsBlocked, blockedBy)
+	}
+	return []string{fmt.Sprintf("task is blocked by %s", blockedBy)}, nil
+}
@@ -0,0 +1,134 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTaskLink(t *testing.T) {
+	from := uuid.New()
+	to := uuid.New()
+
+	testCases := []struct {
+		name        string
+		from        uuid.UUID
+		to          uuid.UUID
+		linkType    string
+		expectError error
+	}{
+		{
+			name:     "Success: blocks link",
+			from:     from,
+			to:       to,
+			linkType: "blocks",
+		},
+		{
+			name:     "Success: duplicates link",
+			from:     from,
+			to:       to,
+			linkType: "duplicates",
+		},
+		{
+			name:        "Failure: unknown type",
+			from:        from,
+			to:          to,
+			linkType:    "depends_on",
+			expectError: ErrInvalidLinkType,
+		},
+		{
+			name:        "Failure: link to itself",
+			from:        from,
+			to:          from,
+			linkType:    "relates_to",
+			expectError: ErrLinkToSelf,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			link, err := NewTaskLink(tc.from, tc.to, tc.linkType)
+
+			if tc.expectError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tc.expectError)
+				assert.Nil(t, link)
+			} else {
+				require.NoError(t, err)
+				assert.NotEqual(t, uuid.Nil, link.ID)
+				assert.Equal(t, tc.from, link.FromTaskID)
+				assert.Equal(t, tc.to, link.ToTaskID)
+				assert.Equal(t, LinkType(tc.linkType), link.Type)
+			}
+		})
+	}
+}
+
+func TestTaskLink_Label(t *testing.T) {
+	from := uuid.New()
+	to := uuid.New()
+
+	blocks, err := NewTaskLink(from, to, "blocks")
+	require.NoError(t, err)
+	assert.Equal(t, "blocks", blocks.Label(from))
+	assert.Equal(t, "is blocked by", blocks.Label(to))
+
+	duplicates, err := NewTaskLink(from, to, "duplicates")
+	require.NoError(t, err)
+	assert.Equal(t, "duplicates", duplicates.Label(from))
+	assert.Equal(t, "is duplicated by", duplicates.Label(to))
+
+	relates, err := NewTaskLink(from, to, "relates_to")
+	require.NoError(t, err)
+	assert.Equal(t, relates.Label(from), relates.Label(to))
+}
+
+func TestTaskLink_Duplicates(t *testing.T) {
+	a, b := uuid.New(), uuid.New()
+
+	existing := []TaskLink{
+		{FromTaskID: a, ToTaskID: b, Type: LinkBlocks},
+		{FromTaskID: b, ToTaskID: a, Type: LinkRelatesTo},
+	}
+
+	testCases := []struct {
+		name     string
+		from, to uuid.UUID
+		linkType LinkType
+		expected bool
+	}{
+		{name: "same blocks link", from: a, to: b, linkType: LinkBlocks, expected: true},
+		{name: "reverse blocks link is a new link", from: b, to: a, linkType: LinkBlocks, expected: false},
+		{name: "relates_to is symmetric", from: a, to: b, linkType: LinkRelatesTo, expected: true},
+		{name: "another type", from: a, to: b, linkType: LinkDuplicates, expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			link := TaskLink{FromTaskID: tc.from, ToTaskID: tc.to, Type: tc.linkType}
+			assert.Equal(t, tc.expected, link.Duplicates(existing))
+		})
+	}
+}
+
+func TestCheckBlockers(t *testing.T) {
+	ops, team := "OPS", "TEAM"
+	blockers := []Task{
+		{ID: uuid.New(), BoardShortName: &ops, Number: 3},
+		{ID: uuid.New(), BoardShortName: &team, Number: 12},
+	}
+
+	warnings, err := CheckBlockers(nil, true)
+	require.NoError(t, err)
+	assert.Empty(t, warnings)
+
+	warnings, err = CheckBlockers(blockers, false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"task is blocked by OPS-3, TEAM-12"}, warnings)
+
+	_, err = CheckBlockers(blockers, true)
+	require.ErrorIs(t, err, ErrTaskIsBlocked)
+	assert.Contains(t, err.Error(), "OPS-3, TEAM-12")
+}
@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+)
+
+const (
+	// MaxDescriptionLen - максимальная длина описания задачи в символах.
+	MaxDescriptionLen = 20000
+	// TaskListChecklistTitle - чеклист, который синхронизируется со списком задач
+	// "- [ ]" в описании.
+	TaskListChecklistTitle = "Description"
+)
+
+// Пункт списка задач GFM: маркер списка, [ ], [x] или [X] и текст пункта.
+var taskListItemRe = regexp.MustCompile(`^ {0,3}[-*+] +\[([ xX])\] +(\S.*)$`)
+
+// RenderedText - описание, отрисованное из Markdown: безопасный HTML
+// и короткий текст без разметки для списков и превью.
+type RenderedText struct {
+	HTML    string
+	Excerpt string
+}
+
+// ParseTaskList возвращает пункты списка задач из Markdown. Строки внутри
+// блоков кода пропускаются.
+func ParseTaskList(text *string) []ChecklistItem {
+	if text == nil {
+		return nil
+	}
+
+	var items []ChecklistItem
+	inCode := false
+	for _, line := range strings.Split(*text, "\n") {
+		line = strings.TrimRight(line, "\r")
+		if fence := strings.TrimSpace(line); strings.HasPrefix(fence, "```") || strings.HasPrefix(fence, "~~~") {
+			inCode = !inCode
+			continue
+		}
+		if inCode {
+			continue
+		}
+		if m := taskListItemRe.FindStringSubmatch(line); m != nil {
+			items = append(items, NewChecklistItem(strings.TrimSpace(m[2]), m[1] != " "))
+		}
+	}
+	return items
+}
+
+// SyncTaskListChecklist переносит список задач из описания в чеклист
+// TaskListChecklistTitle: описание - источник правды, поэтому чеклист заменяется
+// целиком, а без списка задач в описании удаляется. Остальные чеклисты не меняются.
+func SyncTaskListChecklist(description *string, checklists []Checklist) []Checklist {
+	items := ParseTaskList(description)
+
+	synced := make([]Checklist, 0, len(checklists)+1)
+	replaced := false
+	for _, c := range checklists {
+		if c.Title != TaskListChecklistTitle {
+			synced = append(synced, c)
+			continue
+		}
+		if !replaced && len(items) > 0 {
+			synced = append(synced, NewChecklist(TaskListChecklistTitle, items))
+		}
+		replaced = true
+	}
+	if !replaced && len(items) > 0 {
+		synced = append(synced, NewChecklist(TaskListChecklistTitle, items))
+	}
+	return synced
+}
@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTaskList(t *testing.T) {
+	text := "Plan:\n- [ ] write tests\n* [x] fix bug  \n  + [X] deploy\n-[ ] not an item\n```\n- [ ] in code\n```\n1. [ ] ordered is ignored"
+
+	assert.Equal(t, []ChecklistItem{
+		{Title: "write tests", Completed: false},
+		{Title: "fix bug", Completed: true},
+		{Title: "deploy", Completed: true},
+	}, ParseTaskList(&text))
+	assert.Nil(t, ParseTaskList(nil))
+}
+
+func TestSyncTaskListChecklist(t *testing.T) {
+	other := NewChecklist("Release", []ChecklistItem{{Title: "tag"}})
+	stale := NewChecklist(TaskListChecklistTitle, []ChecklistItem{{Title: "old"}})
+	description := "- [x] new"
+
+	t.Run("replaces the synced checklist in place", func(t *testing.T) {
+		synced := SyncTaskListChecklist(&description, []Checklist{stale, other})
+		assert.Equal(t, []Checklist{
+			NewChecklist(TaskListChecklistTitle, []ChecklistItem{{Title: "new", Completed: true}}),
+			other,
+		}, synced)
+	})
+
+	t.Run("appends when missing", func(t *testing.T) {
+		synced := SyncTaskListChecklist(&description, []Checklist{other})
+		assert.Len(t, synced, 2)
+		assert.Equal(t, TaskListChecklistTitle, synced[1].Title)
+	})
+
+	t.Run("removes when the description has no task list", func(t *testing.T) {
+		plain := "no tasks"
+		assert.Equal(t, []Checklist{other}, SyncTaskListChecklist(&plain, []Checklist{stale, other}))
+	})
+}
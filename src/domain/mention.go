@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Упоминание - @ и имя пользователя в начале строки или после пробела/знака препинания,
+// чтобы адреса вида bob@example.com не считались упоминаниями.
+var mentionRe = regexp.MustCompile(`(?:^|[^\w@])@([\w][\w.-]{0,99})`)
+
+// ParseMentions возвращает пользователей, упомянутых в тексте, без повторов
+// и в порядке первого упоминания.
+func ParseMentions(text *string) []string {
+	if text == nil {
+		return nil
+	}
+
+	var users []string
+	seen := make(map[string]struct{})
+	for _, m := range mentionRe.FindAllStringSubmatch(*text, -1) {
+		// точка в конце - знак препинания, а не часть имени
+		user := strings.TrimRight(m[1], ".")
+		if _, ok := seen[user]; ok || user == "" {
+			continue
+		}
+		seen[user] = struct{}{}
+		users = append(users, user)
+	}
+	return users
+}
+
+// newMentions - упоминания, которых не было в прежнем тексте.
+func newMentions(before, after *string) []string {
+	old := make(map[string]struct{})
+	for _, u := range ParseMentions(before) {
+		old[u] = struct{}{}
+	}
+
+	var added []string
+	for _, u := range ParseMentions(after) {
+		if _, ok := old[u]; !ok {
+			added = append(added, u)
+		}
+	}
+	return added
+}
@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMentions(t *testing.T) {
+	text := func(s string) *string { return &s }
+
+	testCases := []struct {
+		name     string
+		text     *string
+		expected []string
+	}{
+		{name: "nil text", text: nil},
+		{name: "no mentions", text: text("just a task")},
+		{name: "several mentions", text: text("@alice please sync with @bob.smith."), expected: []string{"alice", "bob.smith"}},
+		{name: "duplicates", text: text("@alice, @bob and @alice again"), expected: []string{"alice", "bob"}},
+		{name: "email is not a mention", text: text("write to bob@example.com")},
+		{name: "mention in parentheses", text: text("(cc @carol)"), expected: []string{"carol"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, ParseMentions(tc.text))
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const (
+	maxMilestoneTitleLen = 255
+)
+
+type MilestoneState string
+
+const (
+	MilestoneOpen   MilestoneState = "open"
+	MilestoneClosed MilestoneState = "closed"
+)
+
+var (
+	ErrInvalidMilestoneTitle = errors.New("invalid milestone title")
+	ErrInvalidMilestoneState = errors.New("invalid milestone state")
+	ErrMilestoneClosed       = errors.New("milestone is closed")
+)
+
+// Milestone - цель над досками: объединяет задачи с разных досок.
+type Milestone struct {
+	ID          uuid.UUID
+	Title       string
+	Description *string
+	TargetDate  *time.Time
+	State       MilestoneState
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	DeletedAt   *time.Time
+}
+
+func NewMilestone(title string, description *string, targetDate *time.Time) (*Milestone, error) {
+	const op = "domain.NewMilestone"
+
+	if title == "" || len(title) > maxMilestoneTitleLen {
+		return nil, errors.Wrap(ErrInvalidMilestoneTitle, op)
+	}
+
+	now := time.Now().UTC()
+	return &Milestone{
+		ID:          uuid.New(),
+		Title:       title,
+		Description: description,
+		TargetDate:  targetDate,
+		State:       MilestoneOpen,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+func (m *Milestone) Update(title string, description *string, targetDate *time.Time, state string) error {
+	const op = "domain.Milestone.Update"
+
+	if title == "" || len(title) > maxMilestoneTitleLen {
+		return errors.Wrap(ErrInvalidMilestoneTitle, op)
+	}
+
+	st := MilestoneState(state)
+	if st != MilestoneOpen && st != MilestoneClosed {
+		return errors.Wrap(ErrInvalidMilestoneState, op)
+	}
+
+	m.Title = title
+	m.Description = description
+	m.TargetDate = targetDate
+	m.State = st
+	m.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+func (m *Milestone) Delete() {
+	now := time.Now().UTC()
+	m.DeletedAt = &now
+}
+
+// SetMilestone привязывает задачу к milestone, nil - отвязывает.
+// В закрытый milestone новые задачи не добавляются.
+func (t *Task) SetMilestone(m *Milestone) error {
+	const op = "domain.Task.SetMilestone"
+
+	if m == nil {
+		t.MilestoneID = nil
+		t.UpdatedAt = time.Now().UTC()
+		return nil
+	}
+	if m.State == MilestoneClosed {
+		return errors.Wrap(ErrMilestoneClosed, op)
+	}
+
+	t.MilestoneID = &m.ID
+	t.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// CategoryCounts - количество задач по стадиям колонок.
+type CategoryCounts struct {
+	Todo       int64
+	InProgress int64
+	Done       int64
+}
+
+func (c CategoryCounts) Total() int64 {
+	return c.Todo + c.InProgress + c.Done
+}
+
+func (c CategoryCounts) Percent() int64 {
+	total := c.Total()
+	if total == 0 {
+		return 0
+	}
+	return c.Done * 100 / total
+}
+
+func (c *CategoryCounts) add(category ColumnCategory, n int64) {
+	switch category {
+	case CategoryTodo:
+		c.Todo += n
+	case CategoryInProgress:
+		c.InProgress += n
+	case CategoryDone:
+		c.Done += n
+	}
+}
+
+// ColumnTaskCount - колонка доски и число задач milestone в ней.
+type ColumnTaskCount struct {
+	Column    Column
+	BoardName string
+	Count     int64
+}
+
+type BoardProgress struct {
+	BoardID   uuid.UUID
+	BoardName string
+	CategoryCounts
+}
+
+type MilestoneProgress struct {
+	MilestoneID uuid.UUID
+	Boards      []BoardProgress
+	CategoryCounts
+}
+
+// NewMilestoneProgress раскладывает задачи по стадиям колонок своих досок.
+// stats должны содержать все колонки задействованных досок, в том числе пустые:
+// по ним определяются первая и завершающая колонки.
+func NewMilestoneProgress(milestoneID uuid.UUID, stats []ColumnTaskCount) MilestoneProgress {
+	columnsByBoard := make(map[uuid.UUID][]Column)
+	boardOrder := make([]uuid.UUID, 0)
+	boardNames := make(map[uuid.UUID]string)
+	for _, s := range stats {
+		if _, ok := columnsByBoard[s.Column.BoardID]; !ok {
+			boardOrder = append(boardOrder, s.Column.BoardID)
+			boardNames[s.Column.BoardID] = s.BoardName
+		}
+		columnsByBoard[s.Column.BoardID] = append(columnsByBoard[s.Column.BoardID], s.Column)
+	}
+
+	byBoard := make(map[uuid.UUID]*BoardProgress, len(boardOrder))
+	for _, id := range boardOrder {
+		byBoard[id] = &BoardProgress{BoardID: id, BoardName: boardNames[id]}
+	}
+
+	progress := MilestoneProgress{MilestoneID: milestoneID}
+	for _, s := range stats {
+		category := CategoryOfColumn(columnsByBoard[s.Column.BoardID], s.Column.ID)
+		byBoard[s.Column.BoardID].add(category, s.Count)
+		progress.add(category, s.Count)
+	}
+
+	progress.Boards = make([]BoardProgress, 0, len(boardOrder))
+	for _, id := range boardOrder {
+		progress.Boards = append(progress.Boards, *byBoard[id])
+	}
+	return progress
+}
@@ -0,0 +1,108 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMilestone(t *testing.T) {
+	target := time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	m, err := NewMilestone("Q1 release", nil, &target)
+	require.NoError(t, err)
+	assert.Equal(t, MilestoneOpen, m.State)
+	assert.Equal(t, &target, m.TargetDate)
+
+	_, err = NewMilestone("", nil, nil)
+	assert.ErrorIs(t, err, ErrInvalidMilestoneTitle)
+
+	_, err = NewMilestone(strings.Repeat("a", 256), nil, nil)
+	assert.ErrorIs(t, err, ErrInvalidMilestoneTitle)
+}
+
+func TestMilestone_Update(t *testing.T) {
+	m, err := NewMilestone("Q1 release", nil, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, m.Update("Q1 release v2", nil, nil, "closed"))
+	assert.Equal(t, "Q1 release v2", m.Title)
+	assert.Equal(t, MilestoneClosed, m.State)
+
+	assert.ErrorIs(t, m.Update("Q1", nil, nil, "archived"), ErrInvalidMilestoneState)
+	assert.ErrorIs(t, m.Update("", nil, nil, "open"), ErrInvalidMilestoneTitle)
+}
+
+func TestTask_SetMilestone(t *testing.T) {
+	task, err := NewTask(uuid.New(), uuid.New(), 1, "task", nil, nil, nil)
+	require.NoError(t, err)
+
+	open, err := NewMilestone("open", nil, nil)
+	require.NoError(t, err)
+	closed, err := NewMilestone("closed", nil, nil)
+	require.NoError(t, err)
+	closed.State = MilestoneClosed
+
+	require.NoError(t, task.SetMilestone(open))
+	assert.Equal(t, &open.ID, task.MilestoneID)
+
+	assert.ErrorIs(t, task.SetMilestone(closed), ErrMilestoneClosed)
+	assert.Equal(t, &open.ID, task.MilestoneID)
+
+	require.NoError(t, task.SetMilestone(nil))
+	assert.Nil(t, task.MilestoneID)
+}
+
+func TestNewMilestoneProgress(t *testing.T) {
+	milestoneID := uuid.New()
+	boardA := uuid.New()
+	boardB := uuid.New()
+
+	colA := func(order int64, isDone bool) Column {
+		return Column{ID: uuid.New(), BoardID: boardA, OrderNum: order, IsDone: isDone}
+	}
+	colB := func(order int64) Column {
+		return Column{ID: uuid.New(), BoardID: boardB, OrderNum: order}
+	}
+
+	stats := []ColumnTaskCount{
+		// доска A: явно помеченная завершающая колонка не последняя
+		{Column: colA(0, false), BoardName: "A", Count: 2},
+		{Column: colA(1, true), BoardName: "A", Count: 3},
+		{Column: colA(2, false), BoardName: "A", Count: 1},
+		// доска B: завершающая - последняя, пустые колонки тоже участвуют
+		{Column: colB(0), BoardName: "B", Count: 0},
+		{Column: colB(1), BoardName: "B", Count: 4},
+		{Column: colB(2), BoardName: "B", Count: 0},
+	}
+
+	progress := NewMilestoneProgress(milestoneID, stats)
+
+	assert.Equal(t, milestoneID, progress.MilestoneID)
+	require.Len(t, progress.Boards, 2)
+
+	assert.Equal(t, BoardProgress{
+		BoardID:        boardA,
+		BoardName:      "A",
+		CategoryCounts: CategoryCounts{Todo: 2, InProgress: 1, Done: 3},
+	}, progress.Boards[0])
+	assert.Equal(t, BoardProgress{
+		BoardID:        boardB,
+		BoardName:      "B",
+		CategoryCounts: CategoryCounts{Todo: 0, InProgress: 4, Done: 0},
+	}, progress.Boards[1])
+
+	assert.Equal(t, CategoryCounts{Todo: 2, InProgress: 5, Done: 3}, progress.CategoryCounts)
+	assert.Equal(t, int64(10), progress.Total())
+	assert.Equal(t, int64(30), progress.Percent())
+}
+
+func TestNewMilestoneProgress_Empty(t *testing.T) {
+	progress := NewMilestoneProgress(uuid.New(), nil)
+	assert.Empty(t, progress.Boards)
+	assert.Equal(t, int64(0), progress.Percent())
+}
@@ -0,0 +1,179 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type NotificationType string
+
+const (
+	NotificationTaskAssigned  NotificationType = "task_assigned"
+	NotificationTaskMentioned NotificationType = "task_mentioned"
+	NotificationTaskMoved     NotificationType = "task_moved"
+	NotificationTaskUpdated   NotificationType = "task_updated"
+	NotificationTaskDeleted   NotificationType = "task_deleted"
+	NotificationTaskDueSoon   NotificationType = "task_due_soon"
+)
+
+// За сколько дней до срока исполнитель получает напоминание
+const dueSoonDays = 1
+
+// dueSoonNamespace - пространство имен для EventID напоминаний о сроке
+var dueSoonNamespace = uuid.MustParse("5d7f3c8e-2b1a-4f6e-9c0d-8a4b6e2f1d3c")
+
+// Notification - запись во входящих пользователя. EventID вместе с UserID
+// уникален: повторно доставленное событие не создаст дубликат.
+type Notification struct {
+	ID        uuid.UUID
+	UserID    string
+	Type      NotificationType
+	EventID   uuid.UUID
+	BoardID   uuid.UUID
+	TaskID    uuid.UUID
+	Text      string
+	ReadAt    *time.Time
+	CreatedAt time.Time
+}
+
+func newNotification(userID string, notificationType NotificationType, event Event, task *Task, text string) Notification {
+	return Notification{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Type:      notificationType,
+		EventID:   event.ID,
+		BoardID:   task.BoardID,
+		TaskID:    task.ID,
+		Text:      text,
+		CreatedAt: time.Now().UTC(),
+	}
+}
+
+// NotificationsForEvent применяет правила уведомлений к событию задачи:
+//   - назначение - новому исполнителю, если он еще назначен;
+//   - упоминание в описании - каждому упомянутому;
+//   - перенос (в том числе в другую доску), изменение и удаление - подписчикам задачи и доски.
+//
+// Напоминание о сроке не привязано к событию: его по расписанию строит
+// DueSoonNotifications.
+//
+// task - текущее состояние задачи, columnName - имя колонки, куда ее перенесли,
+// watchers - подписчики, найденные для события.
+func NotificationsForEvent(event Event, task *Task, columnName string, watchers []string) []Notification {
+	if task == nil {
+		return nil
+	}
+	if _, deleted := event.Payload.(TaskDeleted); deleted != (task.DeletedAt != nil) {
+		// удаленная задача уведомляет только об удалении, восстановленная - уже нет
+		return nil
+	}
+
+	var notifications []Notification
+	switch p := event.Payload.(type) {
+	case TaskAssigned:
+		if task.Assignee != nil && *task.Assignee == p.Assignee {
+			notifications = append(notifications, newNotification(p.Assignee, NotificationTaskAssigned, event, task,
+				fmt.Sprintf("You were assigned to %q", task.Title)))
+		}
+	case TaskMentioned:
+		for _, user := range p.Users {
+			notifications = append(notifications, newNotification(user, NotificationTaskMentioned, event, task,
+				fmt.Sprintf("You were mentioned in %q", task.Title)))
+		}
+	case TaskMoved:
+		if task.ColumnID == p.ToColumnID {
+			for _, user := range watchers {
+				notifications = append(notifications, newNotification(user, NotificationTaskMoved, event, task,
+					fmt.Sprintf("%q was moved to %s", task.Title, columnName)))
+			}
+		}
+	case TaskMovedToBoard:
+		if task.BoardID == p.ToBoardID {
+			for _, user := range watchers {
+				notifications = append(notifications, newNotification(user, NotificationTaskMoved, event, task,
+					fmt.Sprintf("%q was moved from %s to %s", task.Title, p.FromKey, p.ToKey)))
+			}
+		}
+	case TaskUpdated:
+		for _, user := range watchers {
+			notifications = append(notifications, newNotification(user, NotificationTaskUpdated, event, task,
+				fmt.Sprintf("%q was updated", task.Title)))
+		}
+	case TaskDeleted:
+		for _, user := range watchers {
+			notifications = append(notifications, newNotification(user, NotificationTaskDeleted, event, task,
+				fmt.Sprintf("%q was deleted", task.Title)))
+		}
+	}
+
+	return notifications
+}
+
+type DeadlineSource string
+
+const (
+	DeadlineMilestone DeadlineSource = "milestone"
+	DeadlineSprint    DeadlineSource = "sprint"
+)
+
+// TaskDeadline - срок назначенной задачи, который задает ее открытая цель
+// (дата цели) или незакрытый спринт (последний день спринта).
+type TaskDeadline struct {
+	TaskID     uuid.UUID
+	BoardID    uuid.UUID
+	Title      string
+	Assignee   string
+	DueDate    time.Time
+	Source     DeadlineSource
+	SourceName string
+}
+
+// DueSoonRange - даты сроков, о которых пора напомнить: с сегодня по завтра включительно.
+func DueSoonRange(now time.Time) (from, to time.Time) {
+	from = truncateToDate(now)
+	return from, from.AddDate(0, 0, dueSoonDays)
+}
+
+// DueSoonNotifications напоминает исполнителю о ближайшем сроке каждой задачи
+// из DueSoonRange. Просроченные сроки пропускаются. EventID выводится из задачи
+// и даты срока: повторный прогон не создаст дубликат, а перенос срока напомнит заново.
+func DueSoonNotifications(deadlines []TaskDeadline, now time.Time) []Notification {
+	from, to := DueSoonRange(now)
+
+	nearest := map[uuid.UUID]TaskDeadline{}
+	var order []uuid.UUID
+	for _, d := range deadlines {
+		due := truncateToDate(d.DueDate)
+		if due.Before(from) || due.After(to) {
+			continue
+		}
+		d.DueDate = due
+		prev, ok := nearest[d.TaskID]
+		if !ok {
+			order = append(order, d.TaskID)
+		}
+		if !ok || d.DueDate.Before(prev.DueDate) {
+			nearest[d.TaskID] = d
+		}
+	}
+
+	notifications := make([]Notification, 0, len(order))
+	for _, id := range order {
+		d := nearest[id]
+		eventID := uuid.NewSHA1(dueSoonNamespace, []byte(d.TaskID.String()+"/"+d.DueDate.Format(time.DateOnly)))
+		notifications = append(notifications, Notification{
+			ID:        uuid.New(),
+			UserID:    d.Assignee,
+			Type:      NotificationTaskDueSoon,
+			EventID:   eventID,
+			BoardID:   d.BoardID,
+			TaskID:    d.TaskID,
+			Text:      fmt.Sprintf("%q is due on %s (%s %q)", d.Title, d.DueDate.Format(time.DateOnly), d.Source, d.SourceName),
+			CreatedAt: now,
+		})
+	}
+
+	return notifications
+}
@@ -0,0 +1,95 @@
+package domain
+
+import (
+	"net/mail"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type EmailMode string
+
+const (
+	EmailInstant EmailMode = "instant"
+	EmailDigest  EmailMode = "digest"
+	EmailOff     EmailMode = "off"
+)
+
+var (
+	ErrInvalidEmailMode  = errors.New("email mode must be one of: instant, digest, off")
+	ErrInvalidEmail      = errors.New("invalid email address")
+	ErrEmailRequired     = errors.New("email is required for instant and digest modes")
+	ErrInvalidDigestHour = errors.New("digest hour must be 0-23")
+)
+
+// EmailPreference - как пользователь получает уведомления на почту.
+type EmailPreference struct {
+	UserID       string
+	Email        string
+	Mode         EmailMode
+	LastDigestAt *time.Time
+	UpdatedAt    time.Time
+}
+
+func ParseEmailMode(s string) (EmailMode, error) {
+	switch m := EmailMode(s); m {
+	case EmailInstant, EmailDigest, EmailOff:
+		return m, nil
+	}
+	return "", ErrInvalidEmailMode
+}
+
+func NewEmailPreference(userID, email string, mode EmailMode) (*EmailPreference, error) {
+	const op = "domain.NewEmailPreference"
+
+	if _, err := ParseEmailMode(string(mode)); err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	if email == "" {
+		if mode != EmailOff {
+			return nil, errors.Wrap(ErrEmailRequired, op)
+		}
+	} else {
+		addr, err := mail.ParseAddress(email)
+		if err != nil || addr.Name != "" {
+			return nil, errors.Wrap(ErrInvalidEmail, op)
+		}
+	}
+
+	return &EmailPreference{
+		UserID:    userID,
+		Email:     email,
+		Mode:      mode,
+		UpdatedAt: time.Now().UTC(),
+	}, nil
+}
+
+// NotificationEmail - письмо пользователю: одно уведомление в режиме instant
+// или все непрочитанные за сутки в режиме digest.
+type NotificationEmail struct {
+	UserID        string
+	To            string
+	Digest        bool
+	Notifications []Notification
+}
+
+// LastDigestTime - время последней по расписанию рассылки дайджеста,
+// дайджест уходит раз в сутки в hour часов UTC.
+func LastDigestTime(now time.Time, hour int) (time.Time, error) {
+	if hour < 0 || hour > 23 {
+		return time.Time{}, ErrInvalidDigestHour
+	}
+
+	now = now.UTC()
+	scheduled := time.Date(now.Year(), now.Month(), now.Day(), hour, 0, 0, 0, time.UTC)
+	if scheduled.After(now) {
+		scheduled = scheduled.AddDate(0, 0, -1)
+	}
+	return scheduled, nil
+}
+
+// DigestDue - пора ли отправить дайджест: последний ушел раньше очередного
+// времени рассылки.
+func (p *EmailPreference) DigestDue(scheduled time.Time) bool {
+	return p.Mode == EmailDigest && (p.LastDigestAt == nil || p.LastDigestAt.Before(scheduled))
+}
@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEmailPreference(t *testing.T) {
+	testCases := []struct {
+		name        string
+		email       string
+		mode        EmailMode
+		expectError error
+	}{
+		{name: "Success: instant", email: "alice@example.com", mode: EmailInstant},
+		{name: "Success: off without email", mode: EmailOff},
+		{name: "Failure: digest without email", mode: EmailDigest, expectError: ErrEmailRequired},
+		{name: "Failure: bad email", email: "alice", mode: EmailInstant, expectError: ErrInvalidEmail},
+		{name: "Failure: display name", email: "Alice <alice@example.com>", mode: EmailInstant, expectError: ErrInvalidEmail},
+		{name: "Failure: unknown mode", email: "alice@example.com", mode: "weekly", expectError: ErrInvalidEmailMode},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pref, err := NewEmailPreference("alice", tc.email, tc.mode)
+			if tc.expectError != nil {
+				assert.ErrorIs(t, err, tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.mode, pref.Mode)
+			assert.Nil(t, pref.LastDigestAt)
+		})
+	}
+}
+
+func TestLastDigestTime(t *testing.T) {
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2025, 12, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	scheduled, err := LastDigestTime(at(3, 9, 30), 8)
+	require.NoError(t, err)
+	assert.Equal(t, at(3, 8, 0), scheduled)
+
+	scheduled, err = LastDigestTime(at(3, 7, 59), 8)
+	require.NoError(t, err)
+	assert.Equal(t, at(2, 8, 0), scheduled)
+
+	_, err = LastDigestTime(at(3, 7, 59), 24)
+	assert.ErrorIs(t, err, ErrInvalidDigestHour)
+
+	sent := at(2, 8, 1)
+	pref := EmailPreference{Mode: EmailDigest, LastDigestAt: &sent}
+	assert.False(t, pref.DigestDue(at(2, 8, 0)))
+	assert.True(t, pref.DigestDue(at(3, 8, 0)))
+
+	pref.Mode = EmailInstant
+	assert.False(t, pref.DigestDue(at(3, 8, 0)))
+}
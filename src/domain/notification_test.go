@@ -0,0 +1,175 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationsForEvent(t *testing.T) {
+	alice, bob := "alice", "bob"
+	description := "@carol take a look, @dave FYI"
+	todo, done := uuid.New(), uuid.New()
+
+	task, err := NewTask(todo, uuid.New(), 1, "Fix login", &description, nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, task.SetLaneFields(&alice, nil, nil))
+	require.NoError(t, task.MoveToColumn(done))
+
+	events := task.Events()
+	require.Len(t, events, 4)
+	created, mentioned, assigned, moved := events[0], events[1], events[2], events[3]
+
+	t.Run("created task notifies nobody", func(t *testing.T) {
+		assert.Empty(t, NotificationsForEvent(created, task, "", nil))
+	})
+
+	t.Run("mention notifies every mentioned user", func(t *testing.T) {
+		ns := NotificationsForEvent(mentioned, task, "", nil)
+		require.Len(t, ns, 2)
+		assert.Equal(t, "carol", ns[0].UserID)
+		assert.Equal(t, "dave", ns[1].UserID)
+		assert.Equal(t, NotificationTaskMentioned, ns[0].Type)
+		assert.Equal(t, mentioned.ID, ns[0].EventID)
+		assert.Equal(t, task.ID, ns[0].TaskID)
+	})
+
+	t.Run("assignment notifies the assignee", func(t *testing.T) {
+		ns := NotificationsForEvent(assigned, task, "", nil)
+		require.Len(t, ns, 1)
+		assert.Equal(t, alice, ns[0].UserID)
+		assert.Equal(t, NotificationTaskAssigned, ns[0].Type)
+		assert.Equal(t, `You were assigned to "Fix login"`, ns[0].Text)
+	})
+
+	t.Run("move notifies watchers", func(t *testing.T) {
+		ns := NotificationsForEvent(moved, task, "Done", []string{alice, bob})
+		require.Len(t, ns, 2)
+		assert.Equal(t, alice, ns[0].UserID)
+		assert.Equal(t, bob, ns[1].UserID)
+		assert.Equal(t, NotificationTaskMoved, ns[1].Type)
+		assert.Equal(t, `"Fix login" was moved to Done`, ns[0].Text)
+	})
+
+	t.Run("update and delete notify watchers", func(t *testing.T) {
+		edited := *task
+		edited.ClearEvents()
+		edited.Update(edited.ColumnID, edited.BoardID, 1, "Fix login", nil, nil, nil)
+		updated := edited.Events()[0]
+
+		ns := NotificationsForEvent(updated, &edited, "", []string{bob})
+		require.Len(t, ns, 1)
+		assert.Equal(t, NotificationTaskUpdated, ns[0].Type)
+		assert.Equal(t, `"Fix login" was updated`, ns[0].Text)
+
+		edited.ClearEvents()
+		edited.Delete()
+		deletedEvent := edited.Events()[0]
+
+		ns = NotificationsForEvent(deletedEvent, &edited, "", []string{bob})
+		require.Len(t, ns, 1)
+		assert.Equal(t, NotificationTaskDeleted, ns[0].Type)
+		assert.Equal(t, `"Fix login" was deleted`, ns[0].Text)
+
+		assert.Empty(t, NotificationsForEvent(deletedEvent, task, "", []string{bob}), "restored task")
+		assert.Empty(t, NotificationsForEvent(updated, &edited, "", []string{bob}), "deleted task")
+	})
+
+	t.Run("move to another board notifies watchers", func(t *testing.T) {
+		moving := *task
+		moving.ClearEvents()
+		source := &Board{ID: moving.BoardID, ShortName: "OPS"}
+		target := &Board{ID: uuid.New(), ShortName: "TEAM"}
+		_, err := moving.MoveToBoard(source, target, uuid.New(), 42, nil, nil, nil)
+		require.NoError(t, err)
+		movedToBoard := moving.Events()[0]
+
+		ns := NotificationsForEvent(movedToBoard, &moving, "", []string{bob})
+		require.Len(t, ns, 1)
+		assert.Equal(t, NotificationTaskMoved, ns[0].Type)
+		assert.Equal(t, `"Fix login" was moved from OPS-1 to TEAM-42`, ns[0].Text)
+
+		assert.Empty(t, NotificationsForEvent(movedToBoard, task, "", []string{bob}), "moved back")
+	})
+
+	t.Run("stale events are skipped", func(t *testing.T) {
+		reassigned := *task
+		reassigned.Assignee = &bob
+		assert.Empty(t, NotificationsForEvent(assigned, &reassigned, "", nil))
+
+		movedBack := *task
+		movedBack.ColumnID = todo
+		assert.Empty(t, NotificationsForEvent(moved, &movedBack, "Done", []string{alice}))
+
+		deleted := *task
+		deleted.Delete()
+		assert.Empty(t, NotificationsForEvent(mentioned, &deleted, "", nil))
+	})
+
+	t.Run("only new mentions are raised on update", func(t *testing.T) {
+		task.ClearEvents()
+		updated := "@dave FYI, @erin please review"
+		task.Update(task.ColumnID, task.BoardID, 1, "Fix login", &updated, nil, nil)
+
+		var users []string
+		for _, e := range task.Events() {
+			if m, ok := e.Payload.(TaskMentioned); ok {
+				users = append(users, m.Users...)
+			}
+		}
+		assert.Equal(t, []string{"erin"}, users)
+	})
+
+	t.Run("reassigning the same user raises nothing", func(t *testing.T) {
+		task.ClearEvents()
+		same := alice
+		require.NoError(t, task.SetLaneFields(&same, nil, nil))
+		assert.Empty(t, task.Events())
+	})
+}
+
+func TestDueSoonNotifications(t *testing.T) {
+	now := time.Date(2025, 12, 10, 15, 30, 0, 0, time.UTC)
+	today := time.Date(2025, 12, 10, 0, 0, 0, 0, time.UTC)
+	task, other := uuid.New(), uuid.New()
+	board := uuid.New()
+
+	deadlines := []TaskDeadline{
+		{TaskID: task, BoardID: board, Title: "Fix login", Assignee: "alice", DueDate: today.AddDate(0, 0, 1), Source: DeadlineMilestone, SourceName: "v1.0"},
+		{TaskID: task, BoardID: board, Title: "Fix login", Assignee: "alice", DueDate: today, Source: DeadlineSprint, SourceName: "Sprint 5"},
+		{TaskID: other, BoardID: board, Title: "Write docs", Assignee: "bob", DueDate: today.AddDate(0, 0, -1), Source: DeadlineSprint, SourceName: "Sprint 4"},
+		{TaskID: other, BoardID: board, Title: "Write docs", Assignee: "bob", DueDate: today.AddDate(0, 0, 2), Source: DeadlineMilestone, SourceName: "v2.0"},
+	}
+
+	ns := DueSoonNotifications(deadlines, now)
+	require.Len(t, ns, 1, "overdue and distant deadlines are skipped")
+	assert.Equal(t, "alice", ns[0].UserID)
+	assert.Equal(t, NotificationTaskDueSoon, ns[0].Type)
+	assert.Equal(t, task, ns[0].TaskID)
+	assert.Equal(t, board, ns[0].BoardID)
+	assert.Equal(t, `"Fix login" is due on 2025-12-10 (sprint "Sprint 5")`, ns[0].Text)
+	assert.Equal(t, now, ns[0].CreatedAt)
+
+	t.Run("event id is stable across runs", func(t *testing.T) {
+		again := DueSoonNotifications(deadlines, now.Add(time.Hour))
+		require.Len(t, again, 1)
+		assert.Equal(t, ns[0].EventID, again[0].EventID)
+		assert.NotEqual(t, ns[0].ID, again[0].ID)
+	})
+
+	t.Run("moved deadline reminds again", func(t *testing.T) {
+		moved := []TaskDeadline{deadlines[0]}
+		again := DueSoonNotifications(moved, now)
+		require.Len(t, again, 1)
+		assert.NotEqual(t, ns[0].EventID, again[0].EventID)
+	})
+
+	t.Run("range covers today and tomorrow", func(t *testing.T) {
+		from, to := DueSoonRange(now)
+		assert.Equal(t, today, from)
+		assert.Equal(t, today.AddDate(0, 0, 1), to)
+	})
+}
@@ -0,0 +1,22 @@
+package domain
+
+// PurgeStats - сколько мягко удаленных строк удалено (или будет удалено при dry-run) навсегда.
+// Колонки и задачи, удаляемые каскадом вместе с доской, в счетчики доски не входят.
+// IdempotencyKeys - истекшие ключи идемпотентности, их чистит тот же прогон.
+type PurgeStats struct {
+	Boards          int64
+	Columns         int64
+	Tasks           int64
+	IdempotencyKeys int64
+}
+
+func (s PurgeStats) Total() int64 {
+	return s.Boards + s.Columns + s.Tasks + s.IdempotencyKeys
+}
+
+func (s *PurgeStats) Add(other PurgeStats) {
+	s.Boards += other.Boards
+	s.Columns += other.Columns
+	s.Tasks += other.Tasks
+	s.IdempotencyKeys += other.IdempotencyKeys
+}
@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/google/uuid"
+)
+
+// ReferenceSource - откуда взяты упоминания и ссылки задачи.
+type ReferenceSource string
+
+const ReferenceDescription ReferenceSource = "description"
+
+// Ссылка на задачу - # и ключ вида TEAM-42 в начале строки или после пробела/знака
+// препинания. Номер должен заканчиваться на границе слова: #TEAM-42abc не ссылка.
+var taskRefRe = regexp.MustCompile(`(?:^|[^\w#-])#([a-zA-Z0-9_-]{2,10}-\d+)\b`)
+
+// TaskRef - ссылка на задачу из текста. TaskID и Title заполнены, если задача
+// с таким ключом есть и не удалена.
+type TaskRef struct {
+	ShortName string
+	Number    int64
+	TaskID    *uuid.UUID
+	Title     *string
+}
+
+func (r TaskRef) Key() string {
+	return fmt.Sprintf("%s-%d", r.ShortName, r.Number)
+}
+
+// ParseTaskRefs возвращает ссылки на задачи из текста без повторов
+// и в порядке первого упоминания.
+func ParseTaskRefs(text *string) []TaskRef {
+	if text == nil {
+		return nil
+	}
+
+	var refs []TaskRef
+	seen := make(map[string]struct{})
+	for _, m := range taskRefRe.FindAllStringSubmatch(*text, -1) {
+		shortName, number, err := ParseTaskKey(m[1])
+		if err != nil {
+			continue
+		}
+		ref := TaskRef{ShortName: shortName, Number: number}
+		if _, ok := seen[ref.Key()]; ok {
+			continue
+		}
+		seen[ref.Key()] = struct{}{}
+		refs = append(refs, ref)
+	}
+	return refs
+}
+
+// Mentions - пользователи, упомянутые в описании задачи.
+func (t *Task) Mentions() []string {
+	return ParseMentions(t.Description)
+}
+
+// TaskRefs - ссылки на другие задачи из описания, еще не разрешенные.
+func (t *Task) TaskRefs() []TaskRef {
+	return ParseTaskRefs(t.Description)
+}
@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTaskRefs(t *testing.T) {
+	text := func(s string) *string { return &s }
+
+	testCases := []struct {
+		name     string
+		text     *string
+		expected []string
+	}{
+		{name: "nil text", text: nil},
+		{name: "no refs", text: text("just a task, #1 in queue")},
+		{name: "several refs", text: text("blocked by #TEAM-42, see #ops-7."), expected: []string{"TEAM-42", "ops-7"}},
+		{name: "short name with hyphen", text: text("(#my-team-3)"), expected: []string{"my-team-3"}},
+		{name: "duplicates", text: text("#TEAM-1 and #TEAM-1 again"), expected: []string{"TEAM-1"}},
+		{name: "suffix is not a ref", text: text("#TEAM-42abc and a#TEAM-1")},
+		{name: "url anchor is not a ref", text: text("https://example.com/page#TEAM-5")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var keys []string
+			for _, ref := range ParseTaskRefs(tc.text) {
+				keys = append(keys, ref.Key())
+			}
+			assert.Equal(t, tc.expected, keys)
+		})
+	}
+}
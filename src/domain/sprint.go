@@ -0,0 +1,174 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const (
+	maxSprintNameLen = 100
+)
+
+type SprintState string
+
+const (
+	SprintPlanned SprintState = "planned"
+	SprintActive  SprintState = "active"
+	SprintClosed  SprintState = "closed"
+)
+
+var (
+	ErrInvalidSprintName     = errors.New("invalid sprint name")
+	ErrInvalidSprintDates    = errors.New("sprint end date is before start date")
+	ErrSprintNotPlanned      = errors.New("sprint is not planned")
+	ErrSprintNotActive       = errors.New("sprint is not active")
+	ErrSprintClosed          = errors.New("sprint is closed")
+	ErrSprintInOtherBoard    = errors.New("sprint belongs to another board")
+	ErrTaskNotInSprint       = errors.New("task is not in the sprint")
+	ErrInvalidRolloverSprint = errors.New("invalid sprint to roll unfinished tasks into")
+)
+
+// Sprint - итерация на доске. Жизненный цикл: planned -> active -> closed.
+type Sprint struct {
+	ID        uuid.UUID
+	BoardID   uuid.UUID
+	Name      string
+	Goal      *string
+	StartDate time.Time
+	EndDate   time.Time
+	State     SprintState
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+}
+
+func NewSprint(boardID uuid.UUID, name string, goal *string, startDate, endDate time.Time) (*Sprint, error) {
+	const op = "domain.NewSprint"
+
+	if err := validateSprint(name, startDate, endDate); err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	now := time.Now().UTC()
+	return &Sprint{
+		ID:        uuid.New(),
+		BoardID:   boardID,
+		Name:      name,
+		Goal:      goal,
+		StartDate: startDate,
+		EndDate:   endDate,
+		State:     SprintPlanned,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+func (s *Sprint) Update(name string, goal *string, startDate, endDate time.Time) error {
+	const op = "domain.Sprint.Update"
+
+	if s.State == SprintClosed {
+		return errors.Wrap(ErrSprintClosed, op)
+	}
+	if err := validateSprint(name, startDate, endDate); err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	s.Name = name
+	s.Goal = goal
+	s.StartDate = startDate
+	s.EndDate = endDate
+	s.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+func (s *Sprint) Start() error {
+	if s.State != SprintPlanned {
+		return errors.Wrap(ErrSprintNotPlanned, "domain.Sprint.Start")
+	}
+
+	s.State = SprintActive
+	s.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// Close закрывает активный спринт. next - спринт, куда переносятся незавершенные задачи,
+// nil - задачи уходят в бэклог доски.
+func (s *Sprint) Close(next *Sprint) error {
+	const op = "domain.Sprint.Close"
+
+	if s.State != SprintActive {
+		return errors.Wrap(ErrSprintNotActive, op)
+	}
+	if next != nil && (next.ID == s.ID || next.BoardID != s.BoardID || next.State != SprintPlanned) {
+		return errors.Wrap(ErrInvalidRolloverSprint, op)
+	}
+
+	s.State = SprintClosed
+	s.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+func validateSprint(name string, startDate, endDate time.Time) error {
+	if name == "" || len(name) > maxSprintNameLen {
+		return ErrInvalidSprintName
+	}
+	if endDate.Before(startDate) {
+		return ErrInvalidSprintDates
+	}
+	return nil
+}
+
+// NextPlannedSprint - ближайший по дате начала запланированный спринт, кроме current.
+func NextPlannedSprint(sprints []Sprint, current uuid.UUID) *Sprint {
+	var next *Sprint
+	for i := range sprints {
+		s := &sprints[i]
+		if s.ID == current || s.State != SprintPlanned {
+			continue
+		}
+		if next == nil || s.StartDate.Before(next.StartDate) {
+			next = s
+		}
+	}
+	return next
+}
+
+func (t *Task) AddToSprint(s *Sprint) error {
+	const op = "domain.Task.AddToSprint"
+
+	if s.BoardID != t.BoardID {
+		return errors.Wrap(ErrSprintInOtherBoard, op)
+	}
+	if s.State == SprintClosed {
+		return errors.Wrap(ErrSprintClosed, op)
+	}
+
+	t.SprintID = &s.ID
+	t.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// RemoveFromSprint возвращает задачу в бэклог доски.
+func (t *Task) RemoveFromSprint(sprintID uuid.UUID) error {
+	if t.SprintID == nil || *t.SprintID != sprintID {
+		return errors.Wrap(ErrTaskNotInSprint, "domain.Task.RemoveFromSprint")
+	}
+
+	t.SprintID = nil
+	t.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// OnlySprintTasks оставляет на доске только задачи спринта s.
+func (b *Board) OnlySprintTasks(s *Sprint) {
+	tasks := make([]Task, 0, len(b.Tasks))
+	for _, t := range b.Tasks {
+		if t.SprintID != nil && *t.SprintID == s.ID {
+			tasks = append(tasks, t)
+		}
+	}
+	b.Tasks = tasks
+	b.Sprint = s
+}
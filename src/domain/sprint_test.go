@@ -0,0 +1,147 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sprintDates(startDay, days int) (time.Time, time.Time) {
+	start := time.Date(2026, 1, startDay, 0, 0, 0, 0, time.UTC)
+	return start, start.AddDate(0, 0, days)
+}
+
+func TestNewSprint(t *testing.T) {
+	boardID := uuid.New()
+	start, end := sprintDates(5, 14)
+
+	s, err := NewSprint(boardID, "Sprint 1", nil, start, end)
+	require.NoError(t, err)
+	assert.Equal(t, SprintPlanned, s.State)
+	assert.Equal(t, boardID, s.BoardID)
+
+	_, err = NewSprint(boardID, "", nil, start, end)
+	assert.ErrorIs(t, err, ErrInvalidSprintName)
+
+	_, err = NewSprint(boardID, "Sprint 1", nil, end, start)
+	assert.ErrorIs(t, err, ErrInvalidSprintDates)
+
+	// однодневный спринт допустим
+	_, err = NewSprint(boardID, "Sprint 1", nil, start, start)
+	assert.NoError(t, err)
+}
+
+func TestSprint_Lifecycle(t *testing.T) {
+	boardID := uuid.New()
+	start, end := sprintDates(5, 14)
+
+	s, err := NewSprint(boardID, "Sprint 1", nil, start, end)
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, s.Close(nil), ErrSprintNotActive)
+
+	require.NoError(t, s.Start())
+	assert.Equal(t, SprintActive, s.State)
+	assert.ErrorIs(t, s.Start(), ErrSprintNotPlanned)
+
+	require.NoError(t, s.Close(nil))
+	assert.Equal(t, SprintClosed, s.State)
+
+	assert.ErrorIs(t, s.Update("Sprint 1", nil, start, end), ErrSprintClosed)
+}
+
+func TestSprint_CloseRollover(t *testing.T) {
+	boardID := uuid.New()
+	start, end := sprintDates(5, 14)
+
+	newActive := func() *Sprint {
+		s, err := NewSprint(boardID, "current", nil, start, end)
+		require.NoError(t, err)
+		require.NoError(t, s.Start())
+		return s
+	}
+
+	next, err := NewSprint(boardID, "next", nil, end, end.AddDate(0, 0, 14))
+	require.NoError(t, err)
+
+	otherBoard, err := NewSprint(uuid.New(), "other", nil, end, end.AddDate(0, 0, 14))
+	require.NoError(t, err)
+
+	closedNext, err := NewSprint(boardID, "closed", nil, end, end.AddDate(0, 0, 14))
+	require.NoError(t, err)
+	closedNext.State = SprintClosed
+
+	assert.NoError(t, newActive().Close(next))
+	assert.ErrorIs(t, newActive().Close(otherBoard), ErrInvalidRolloverSprint)
+	assert.ErrorIs(t, newActive().Close(closedNext), ErrInvalidRolloverSprint)
+
+	current := newActive()
+	assert.ErrorIs(t, current.Close(current), ErrInvalidRolloverSprint)
+}
+
+func TestNextPlannedSprint(t *testing.T) {
+	current := uuid.New()
+	s1, e1 := sprintDates(19, 14)
+	s2, e2 := sprintDates(5, 14)
+
+	sprints := []Sprint{
+		{ID: current, State: SprintActive, StartDate: s2, EndDate: e2},
+		{ID: uuid.New(), State: SprintPlanned, StartDate: s1, EndDate: e1},
+		{ID: uuid.New(), State: SprintClosed, StartDate: s2, EndDate: e2},
+		{ID: uuid.New(), State: SprintPlanned, StartDate: s1.AddDate(0, 0, 14), EndDate: e1.AddDate(0, 0, 14)},
+	}
+
+	next := NextPlannedSprint(sprints, current)
+	require.NotNil(t, next)
+	assert.Equal(t, sprints[1].ID, next.ID)
+
+	assert.Nil(t, NextPlannedSprint(sprints[:1], current))
+}
+
+func TestTask_SprintMembership(t *testing.T) {
+	boardID := uuid.New()
+	start, end := sprintDates(5, 14)
+
+	task, err := NewTask(uuid.New(), boardID, 1, "task", nil, nil, nil)
+	require.NoError(t, err)
+
+	sprint, err := NewSprint(boardID, "Sprint 1", nil, start, end)
+	require.NoError(t, err)
+
+	other, err := NewSprint(uuid.New(), "Other", nil, start, end)
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, task.AddToSprint(other), ErrSprintInOtherBoard)
+	assert.ErrorIs(t, task.RemoveFromSprint(sprint.ID), ErrTaskNotInSprint)
+
+	require.NoError(t, task.AddToSprint(sprint))
+	assert.Equal(t, &sprint.ID, task.SprintID)
+
+	require.NoError(t, task.RemoveFromSprint(sprint.ID))
+	assert.Nil(t, task.SprintID)
+
+	sprint.State = SprintClosed
+	assert.ErrorIs(t, task.AddToSprint(sprint), ErrSprintClosed)
+}
+
+func TestBoard_OnlySprintTasks(t *testing.T) {
+	sprint := &Sprint{ID: uuid.New()}
+	other := uuid.New()
+
+	board := Board{Tasks: []Task{
+		{Number: 1, SprintID: &sprint.ID},
+		{Number: 2},
+		{Number: 3, SprintID: &other},
+		{Number: 4, SprintID: &sprint.ID},
+	}}
+
+	board.OnlySprintTasks(sprint)
+
+	require.Len(t, board.Tasks, 2)
+	assert.Equal(t, int64(1), board.Tasks[0].Number)
+	assert.Equal(t, int64(4), board.Tasks[1].Number)
+	assert.Equal(t, sprint, board.Sprint)
+}
@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	maxStoryPointScaleLen = 20
+	MaxStoryPoints        = 1000
+)
+
+var (
+	ErrInvalidStoryPointScale  = errors.New("story point scale must have 1-20 distinct values between 0 and 1000")
+	ErrUnknownStoryPointPreset = errors.New("unknown story point scale preset")
+	ErrInvalidStoryPoints      = errors.New("story points are not in the board scale")
+)
+
+// StoryPointScale - допустимые значения story points на доске, по возрастанию.
+type StoryPointScale []int
+
+// Готовые шкалы. Доска без своей шкалы использует Фибоначчи.
+var storyPointPresets = map[string]StoryPointScale{
+	"fibonacci":     {0, 1, 2, 3, 5, 8, 13, 21, 34, 55, 89},
+	"linear":        {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+	"powers_of_two": {0, 1, 2, 4, 8, 16, 32, 64},
+}
+
+const DefaultStoryPointPreset = "fibonacci"
+
+func DefaultStoryPointScale() StoryPointScale {
+	return StoryPointPreset(DefaultStoryPointPreset)
+}
+
+// StoryPointPreset возвращает копию готовой шкалы или nil, если такой нет.
+func StoryPointPreset(name string) StoryPointScale {
+	preset, ok := storyPointPresets[name]
+	if !ok {
+		return nil
+	}
+	return append(StoryPointScale(nil), preset...)
+}
+
+// NewStoryPointScale собирает шкалу из готовой по имени или из своих значений.
+// Значения сортируются, повторы недопустимы.
+func NewStoryPointScale(preset string, values []int) (StoryPointScale, error) {
+	const op = "domain.NewStoryPointScale"
+
+	if preset != "" {
+		if len(values) > 0 {
+			return nil, errors.Wrap(ErrInvalidStoryPointScale, op)
+		}
+		scale := StoryPointPreset(preset)
+		if scale == nil {
+			return nil, errors.Wrap(ErrUnknownStoryPointPreset, op)
+		}
+		return scale, nil
+	}
+
+	if len(values) == 0 || len(values) > maxStoryPointScaleLen {
+		return nil, errors.Wrap(ErrInvalidStoryPointScale, op)
+	}
+
+	scale := append(StoryPointScale(nil), values...)
+	sort.Ints(scale)
+	for i, v := range scale {
+		if v < 0 || v > MaxStoryPoints || (i > 0 && scale[i-1] == v) {
+			return nil, errors.Wrap(ErrInvalidStoryPointScale, op)
+		}
+	}
+
+	return scale, nil
+}
+
+func (s StoryPointScale) Contains(points int) bool {
+	i := sort.SearchInts(s, points)
+	return i < len(s) && s[i] == points
+}
+
+// SetStoryPoints задает оценку в очках, nil - оценки нет.
+func (t *Task) SetStoryPoints(points *int, scale StoryPointScale) error {
+	const op = "domain.Task.SetStoryPoints"
+
+	if points != nil && !scale.Contains(*points) {
+		return errors.Wrap(ErrInvalidStoryPoints, op)
+	}
+
+	t.StoryPoints = points
+	return nil
+}
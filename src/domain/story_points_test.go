@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStoryPointScale(t *testing.T) {
+	scale, err := NewStoryPointScale("", []int{8, 1, 3, 0})
+	require.NoError(t, err)
+	assert.Equal(t, StoryPointScale{0, 1, 3, 8}, scale)
+
+	scale, err = NewStoryPointScale("powers_of_two", nil)
+	require.NoError(t, err)
+	assert.Equal(t, StoryPointScale{0, 1, 2, 4, 8, 16, 32, 64}, scale)
+
+	// Готовая шкала отдается копией
+	scale[0] = 100
+	assert.Equal(t, 0, StoryPointPreset("powers_of_two")[0])
+
+	_, err = NewStoryPointScale("tshirt", nil)
+	assert.ErrorIs(t, err, ErrUnknownStoryPointPreset)
+	_, err = NewStoryPointScale("linear", []int{1})
+	assert.ErrorIs(t, err, ErrInvalidStoryPointScale)
+	_, err = NewStoryPointScale("", nil)
+	assert.ErrorIs(t, err, ErrInvalidStoryPointScale)
+	_, err = NewStoryPointScale("", []int{1, 2, 2})
+	assert.ErrorIs(t, err, ErrInvalidStoryPointScale)
+	_, err = NewStoryPointScale("", []int{-1, 2})
+	assert.ErrorIs(t, err, ErrInvalidStoryPointScale)
+	_, err = NewStoryPointScale("", []int{MaxStoryPoints + 1})
+	assert.ErrorIs(t, err, ErrInvalidStoryPointScale)
+}
+
+func TestSetStoryPoints(t *testing.T) {
+	task := &Task{}
+	intPtr := func(v int) *int { return &v }
+
+	require.NoError(t, task.SetStoryPoints(intPtr(5), DefaultStoryPointScale()))
+	assert.Equal(t, intPtr(5), task.StoryPoints)
+
+	err := task.SetStoryPoints(intPtr(4), DefaultStoryPointScale())
+	assert.ErrorIs(t, err, ErrInvalidStoryPoints)
+	assert.Equal(t, intPtr(5), task.StoryPoints)
+
+	require.NoError(t, task.SetStoryPoints(nil, DefaultStoryPointScale()))
+	assert.Nil(t, task.StoryPoints)
+}
@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const (
+	maxTaskDepth = 4 // корневая задача + 3 уровня подзадач
+)
+
+var (
+	ErrParentIsSelf       = errors.New("task can't be its own parent")
+	ErrParentInOtherBoard = errors.New("parent task belongs to another board")
+	ErrParentCycle        = errors.New("parent task is a descendant of the task")
+	ErrTaskDepthExceeded  = errors.New("task hierarchy is too deep")
+	ErrTaskIsNotChild     = errors.New("task is not a child of the parent")
+)
+
+// SubtaskProgress - свернутый прогресс по дочерним задачам.
+// Done - дочерние задачи в завершающей колонке своей доски (см. IsDoneColumn).
+type SubtaskProgress struct {
+	Total int64
+	Done  int64
+}
+
+func (p SubtaskProgress) Percent() int64 {
+	if p.Total == 0 {
+		return 0
+	}
+	return p.Done * 100 / p.Total
+}
+
+// AttachToParent делает задачу дочерней для parent.
+// parentAncestors - предки parent (от ближайшего к корню),
+// subtreeHeight - высота поддерева задачи (0, если у нее нет детей).
+func (t *Task) AttachToParent(parent *Task, parentAncestors []uuid.UUID, subtreeHeight int) error {
+	const op = "domain.Task.AttachToParent"
+
+	if parent.ID == t.ID {
+		return errors.Wrap(ErrParentIsSelf, op)
+	}
+	if parent.BoardID != t.BoardID {
+		return errors.Wrap(ErrParentInOtherBoard, op)
+	}
+	if slices.Contains(parentAncestors, t.ID) {
+		return errors.Wrap(ErrParentCycle, op)
+	}
+
+	// глубина корня = 1
+	newDepth := len(parentAncestors) + 2
+	if newDepth+subtreeHeight > maxTaskDepth {
+		return errors.Wrap(ErrTaskDepthExceeded, op)
+	}
+
+	t.ParentID = &parent.ID
+	t.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+func (t *Task) DetachFromParent(parentID uuid.UUID) error {
+	if t.ParentID == nil || *t.ParentID != parentID {
+		return ErrTaskIsNotChild
+	}
+
+	t.ParentID = nil
+	t.UpdatedAt = time.Now().UTC()
+	return nil
+}
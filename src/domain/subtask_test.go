@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTask_AttachToParent(t *testing.T) {
+	boardID := uuid.New()
+
+	newTask := func(boardID uuid.UUID) *Task {
+		task, err := NewTask(uuid.New(), boardID, 1, "task", nil, nil, nil)
+		require.NoError(t, err)
+		return task
+	}
+
+	child := newTask(boardID)
+	parent := newTask(boardID)
+
+	testCases := []struct {
+		name            string
+		task            *Task
+		parent          *Task
+		parentAncestors []uuid.UUID
+		subtreeHeight   int
+		expectError     error
+	}{
+		{
+			name:   "Success: attaches task to a root parent",
+			task:   newTask(boardID),
+			parent: parent,
+		},
+		{
+			name:            "Success: fills the hierarchy up to max depth",
+			task:            newTask(boardID),
+			parent:          parent,
+			parentAncestors: []uuid.UUID{uuid.New()},
+			subtreeHeight:   1,
+		},
+		{
+			name:        "Failure: task is its own parent",
+			task:        child,
+			parent:      child,
+			expectError: ErrParentIsSelf,
+		},
+		{
+			name:        "Failure: parent in another board",
+			task:        newTask(boardID),
+			parent:      newTask(uuid.New()),
+			expectError: ErrParentInOtherBoard,
+		},
+		{
+			name:            "Failure: parent is a descendant of the task",
+			task:            child,
+			parent:          parent,
+			parentAncestors: []uuid.UUID{uuid.New(), child.ID},
+			expectError:     ErrParentCycle,
+		},
+		{
+			name:            "Failure: hierarchy too deep",
+			task:            newTask(boardID),
+			parent:          parent,
+			parentAncestors: []uuid.UUID{uuid.New(), uuid.New()},
+			subtreeHeight:   1,
+			expectError:     ErrTaskDepthExceeded,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			originalParentID := tc.task.ParentID
+
+			err := tc.task.AttachToParent(tc.parent, tc.parentAncestors, tc.subtreeHeight)
+
+			if tc.expectError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tc.expectError)
+				assert.Equal(t, originalParentID, tc.task.ParentID, "ParentID should not change on error")
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, tc.task.ParentID)
+				assert.Equal(t, tc.parent.ID, *tc.task.ParentID)
+			}
+		})
+	}
+}
+
+func TestTask_DetachFromParent(t *testing.T) {
+	parentID := uuid.New()
+
+	task, err := NewTask(uuid.New(), uuid.New(), 1, "child", nil, nil, nil)
+	require.NoError(t, err)
+	task.ParentID = &parentID
+
+	err = task.DetachFromParent(uuid.New())
+	require.ErrorIs(t, err, ErrTaskIsNotChild)
+	require.NotNil(t, task.ParentID)
+
+	err = task.DetachFromParent(parentID)
+	require.NoError(t, err)
+	assert.Nil(t, task.ParentID)
+
+	err = task.DetachFromParent(parentID)
+	assert.ErrorIs(t, err, ErrTaskIsNotChild)
+}
+
+func TestSubtaskProgress_Percent(t *testing.T) {
+	assert.Equal(t, int64(0), SubtaskProgress{}.Percent())
+	assert.Equal(t, int64(33), SubtaskProgress{Total: 3, Done: 1}.Percent())
+	assert.Equal(t, int64(100), SubtaskProgress{Total: 2, Done: 2}.Percent())
+}
@@ -0,0 +1,210 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const (
+	maxLaneFieldLen = 100
+)
+
+type Priority string
+
+const (
+	PriorityLow      Priority = "low"
+	PriorityMedium   Priority = "medium"
+	PriorityHigh     Priority = "high"
+	PriorityCritical Priority = "critical"
+)
+
+// priorityRank - порядок дорожек при группировке по приоритету: сначала самые важные
+var priorityRank = map[Priority]int{
+	PriorityCritical: 0,
+	PriorityHigh:     1,
+	PriorityMedium:   2,
+	PriorityLow:      3,
+}
+
+type GroupBy string
+
+const (
+	GroupByAssignee GroupBy = "assignee"
+	GroupByPriority GroupBy = "priority"
+	GroupByTag      GroupBy = "tag"
+	GroupByParent   GroupBy = "parent"
+	GroupByLane     GroupBy = "lane"
+)
+
+var (
+	ErrInvalidPriority  = errors.New("invalid priority")
+	ErrInvalidGroupBy   = errors.New("invalid group by")
+	ErrInvalidLaneField = errors.New("assignee and lane must be at most 100 characters")
+)
+
+// Swimlane - строка матрицы "дорожка x колонка".
+// Пустой Key - дорожка задач без значения группировки, она всегда последняя.
+type Swimlane struct {
+	Key   string
+	Title string
+	Total int64
+	Cells []SwimlaneCell
+}
+
+// SwimlaneCell - ячейка дорожки. Count - полное число задач в ячейке,
+// TaskIDs может быть обрезан лимитом на ячейку.
+type SwimlaneCell struct {
+	ColumnID uuid.UUID
+	Count    int64
+	TaskIDs  []uuid.UUID
+}
+
+func ParsePriority(s string) (Priority, error) {
+	p := Priority(s)
+	if _, ok := priorityRank[p]; !ok {
+		return "", errors.Wrap(ErrInvalidPriority, "domain.ParsePriority")
+	}
+	return p, nil
+}
+
+func ParseGroupBy(s string) (GroupBy, error) {
+	g := GroupBy(s)
+	switch g {
+	case GroupByAssignee, GroupByPriority, GroupByTag, GroupByParent, GroupByLane:
+		return g, nil
+	}
+	return "", errors.Wrap(ErrInvalidGroupBy, "domain.ParseGroupBy")
+}
+
+// SetLaneFields задает поля, по которым задачи группируются в дорожки.
+func (t *Task) SetLaneFields(assignee *string, priority *Priority, lane *string) error {
+	const op = "domain.Task.SetLaneFields"
+
+	if assignee != nil && len(*assignee) > maxLaneFieldLen {
+		return errors.Wrap(ErrInvalidLaneField, op)
+	}
+	if lane != nil && len(*lane) > maxLaneFieldLen {
+		return errors.Wrap(ErrInvalidLaneField, op)
+	}
+	if priority != nil {
+		if _, err := ParsePriority(string(*priority)); err != nil {
+			return errors.Wrap(err, op)
+		}
+	}
+
+	if assignee != nil && *assignee != "" && (t.Assignee == nil || *t.Assignee != *assignee) {
+		t.raise(TaskAssigned{TaskID: t.ID, Assignee: *assignee})
+	}
+	t.Assignee = assignee
+	t.Priority = priority
+	t.Lane = lane
+	return nil
+}
+
+// laneKeys возвращает ключи дорожек задачи. По тегам задача попадает в дорожку каждого своего тега.
+func (t *Task) laneKeys(groupBy GroupBy) []string {
+	switch groupBy {
+	case GroupByAssignee:
+		return optionalKey(t.Assignee)
+	case GroupByPriority:
+		if t.Priority != nil {
+			return []string{string(*t.Priority)}
+		}
+	case GroupByLane:
+		return optionalKey(t.Lane)
+	case GroupByParent:
+		if t.ParentID != nil {
+			return []string{t.ParentID.String()}
+		}
+	case GroupByTag:
+		if len(t.Tags) > 0 {
+			return t.Tags
+		}
+	}
+	return []string{""}
+}
+
+func optionalKey(s *string) []string {
+	if s == nil || *s == "" {
+		return []string{""}
+	}
+	return []string{*s}
+}
+
+// Swimlanes раскладывает задачи доски в матрицу "дорожка x колонка".
+// cellLimit ограничивает число ID задач в ячейке (0 - без ограничения), счетчики не обрезаются.
+func (b *Board) Swimlanes(groupBy GroupBy, cellLimit int) []Swimlane {
+	columnIdx := make(map[uuid.UUID]int, len(b.Columns))
+	for i, c := range b.Columns {
+		columnIdx[c.ID] = i
+	}
+
+	lanes := make(map[string]*Swimlane)
+	for _, t := range b.Tasks {
+		ci, ok := columnIdx[t.ColumnID]
+		if !ok {
+			continue
+		}
+		for _, key := range t.laneKeys(groupBy) {
+			lane, ok := lanes[key]
+			if !ok {
+				lane = b.newSwimlane(groupBy, key)
+				lanes[key] = lane
+			}
+			cell := &lane.Cells[ci]
+			cell.Count++
+			lane.Total++
+			if cellLimit <= 0 || len(cell.TaskIDs) < cellLimit {
+				cell.TaskIDs = append(cell.TaskIDs, t.ID)
+			}
+		}
+	}
+
+	result := make([]Swimlane, 0, len(lanes))
+	for _, lane := range lanes {
+		result = append(result, *lane)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return laneLess(groupBy, result[i], result[j])
+	})
+	return result
+}
+
+func (b *Board) newSwimlane(groupBy GroupBy, key string) *Swimlane {
+	cells := make([]SwimlaneCell, len(b.Columns))
+	for i, c := range b.Columns {
+		cells[i] = SwimlaneCell{ColumnID: c.ID, TaskIDs: make([]uuid.UUID, 0)}
+	}
+
+	title := key
+	if groupBy == GroupByParent && key != "" {
+		for _, t := range b.Tasks {
+			if t.ID.String() == key {
+				title = t.Title
+				break
+			}
+		}
+	}
+
+	return &Swimlane{Key: key, Title: title, Cells: cells}
+}
+
+func laneLess(groupBy GroupBy, a, b Swimlane) bool {
+	if a.Key == "" {
+		return false
+	}
+	if b.Key == "" {
+		return true
+	}
+	if groupBy == GroupByPriority {
+		return priorityRank[Priority(a.Key)] < priorityRank[Priority(b.Key)]
+	}
+	at, bt := strings.ToLower(a.Title), strings.ToLower(b.Title)
+	if at != bt {
+		return at < bt
+	}
+	return a.Key < b.Key
+}
@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTask_SetLaneFields(t *testing.T) {
+	task, err := NewTask(uuid.New(), uuid.New(), 1, "task", nil, nil, nil)
+	require.NoError(t, err)
+
+	assignee := "ivan"
+	high := PriorityHigh
+	require.NoError(t, task.SetLaneFields(&assignee, &high, nil))
+	assert.Equal(t, &assignee, task.Assignee)
+	assert.Equal(t, &high, task.Priority)
+
+	unknown := Priority("urgent")
+	assert.ErrorIs(t, task.SetLaneFields(nil, &unknown, nil), ErrInvalidPriority)
+
+	long := strings.Repeat("a", 101)
+	assert.ErrorIs(t, task.SetLaneFields(nil, nil, &long), ErrInvalidLaneField)
+	assert.Equal(t, &assignee, task.Assignee)
+}
+
+func TestParseGroupBy(t *testing.T) {
+	g, err := ParseGroupBy("tag")
+	require.NoError(t, err)
+	assert.Equal(t, GroupByTag, g)
+
+	_, err = ParseGroupBy("column")
+	assert.ErrorIs(t, err, ErrInvalidGroupBy)
+}
+
+func TestBoard_Swimlanes(t *testing.T) {
+	todo := Column{ID: uuid.New()}
+	done := Column{ID: uuid.New()}
+
+	high, low := PriorityHigh, PriorityLow
+	ivan, anna := "ivan", "anna"
+
+	parent := Task{ID: uuid.New(), ColumnID: todo.ID, Title: "Epic", Tags: []string{"api"}}
+	t1 := Task{ID: uuid.New(), ColumnID: todo.ID, Assignee: &ivan, Priority: &low, Tags: []string{"api", "db"}, ParentID: &parent.ID}
+	t2 := Task{ID: uuid.New(), ColumnID: done.ID, Assignee: &ivan, Priority: &high, ParentID: &parent.ID}
+	t3 := Task{ID: uuid.New(), ColumnID: done.ID, Assignee: &anna}
+
+	board := Board{
+		Columns: []Column{todo, done},
+		Tasks:   []Task{parent, t1, t2, t3},
+	}
+
+	t.Run("by assignee", func(t *testing.T) {
+		lanes := board.Swimlanes(GroupByAssignee, 0)
+		require.Len(t, lanes, 3)
+		assert.Equal(t, []string{"anna", "ivan", ""}, laneKeysOf(lanes))
+
+		ivanLane := lanes[1]
+		assert.Equal(t, int64(2), ivanLane.Total)
+		assert.Equal(t, todo.ID, ivanLane.Cells[0].ColumnID)
+		assert.Equal(t, []uuid.UUID{t1.ID}, ivanLane.Cells[0].TaskIDs)
+		assert.Equal(t, []uuid.UUID{t2.ID}, ivanLane.Cells[1].TaskIDs)
+	})
+
+	t.Run("by priority ordered by importance", func(t *testing.T) {
+		lanes := board.Swimlanes(GroupByPriority, 0)
+		assert.Equal(t, []string{"high", "low", ""}, laneKeysOf(lanes))
+	})
+
+	t.Run("by tag puts task into every tag lane", func(t *testing.T) {
+		lanes := board.Swimlanes(GroupByTag, 0)
+		require.Equal(t, []string{"api", "db", ""}, laneKeysOf(lanes))
+		assert.Equal(t, int64(2), lanes[0].Cells[0].Count)
+		assert.Equal(t, int64(1), lanes[1].Total)
+	})
+
+	t.Run("by parent uses parent title", func(t *testing.T) {
+		lanes := board.Swimlanes(GroupByParent, 0)
+		require.Len(t, lanes, 2)
+		assert.Equal(t, parent.ID.String(), lanes[0].Key)
+		assert.Equal(t, "Epic", lanes[0].Title)
+		assert.Equal(t, int64(2), lanes[0].Total)
+	})
+
+	t.Run("cell limit truncates ids but not counts", func(t *testing.T) {
+		lanes := board.Swimlanes(GroupByTag, 1)
+		assert.Equal(t, int64(2), lanes[0].Cells[0].Count)
+		assert.Len(t, lanes[0].Cells[0].TaskIDs, 1)
+	})
+}
+
+func laneKeysOf(lanes []Swimlane) []string {
+	keys := make([]string, 0, len(lanes))
+	for _, l := range lanes {
+		keys = append(keys, l.Key)
+	}
+	return keys
+}
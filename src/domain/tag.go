@@ -0,0 +1,7 @@
+package domain
+
+// TagUsage - тег и количество живых задач, в которых он используется
+type TagUsage struct {
+	Name  string
+	Count int64
+}
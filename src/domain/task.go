@@ -1,6 +1,9 @@
 package domain
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -19,11 +22,32 @@ type Task struct {
 	Number      int64
 	Title       string
 	Description *string
+	// DescriptionRendered - описание в HTML и превью, заполняется при чтении задачи
+	DescriptionRendered *RenderedText
 	Tags        []string
 	Checklists  []Checklist
+	ParentID    *uuid.UUID
+	Subtasks    *SubtaskProgress
+	Links       []TaskLink
+	// References - разрешенные ссылки #KEY-N из описания, загружаются отдельно
+	References  []TaskRef
+	MilestoneID *uuid.UUID
+	SprintID    *uuid.UUID
+	Assignee    *string
+	Priority    *Priority
+	Lane        *string
+	// Reporter - пользователь, создавший задачу
+	Reporter    *string
+	// OriginalEstimate и RemainingEstimate - оценки в минутах
+	OriginalEstimate  *int
+	RemainingEstimate *int
+	// StoryPoints - оценка в очках по шкале доски, см. StoryPointScale
+	StoryPoints *int
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 	DeletedAt   *time.Time
+
+	events []Event
 }
 
 func NewTask(
@@ -37,7 +61,7 @@ func NewTask(
 ) (*Task, error) {
 	id := uuid.New()
 
-	return &Task{
+	task := &Task{
 		ID:          id,
 		ColumnID:    columnID,
 		BoardID:     boardID,
@@ -49,7 +73,12 @@ func NewTask(
 		CreatedAt:   time.Now().UTC(),
 		UpdatedAt:   time.Now().UTC(),
 		DeletedAt:   nil,
-	}, nil
+	}
+	task.raise(TaskCreated{TaskID: id, ColumnID: columnID, Number: number, Title: title})
+	if users := ParseMentions(description); len(users) > 0 {
+		task.raise(TaskMentioned{TaskID: id, Users: users})
+	}
+	return task, nil
 }
 
 func (t *Task)Update(
@@ -61,6 +90,11 @@ func (t *Task)Update(
 	tags []string,
 	checklists []Checklist,
 ){
+	if t.ColumnID != columnID {
+		t.raise(TaskMoved{TaskID: t.ID, FromColumnID: t.ColumnID, ToColumnID: columnID})
+	}
+	mentioned := newMentions(t.Description, description)
+
 	t.ColumnID = columnID
 	t.BoardID = boardID
 	t.Number = number
@@ -69,11 +103,16 @@ func (t *Task)Update(
 	t.Tags = tags
 	t.Checklists = checklists
 	t.UpdatedAt = time.Now().UTC()
+	t.raise(TaskUpdated{TaskID: t.ID, Title: title})
+	if len(mentioned) > 0 {
+		t.raise(TaskMentioned{TaskID: t.ID, Users: mentioned})
+	}
 }
 
 func (c *Task) Delete() {
 	now := time.Now().UTC()
 	c.DeletedAt = &now
+	c.raise(TaskDeleted{TaskID: c.ID})
 }
 
 func (t *Task) MoveToColumn(columnID uuid.UUID) error {
@@ -81,7 +120,52 @@ func (t *Task) MoveToColumn(columnID uuid.UUID) error {
 		return ErrAlreadyInColumn
 	}
 
+	t.raise(TaskMoved{TaskID: t.ID, FromColumnID: t.ColumnID, ToColumnID: columnID})
 	t.ColumnID = columnID
 	t.UpdatedAt = time.Now().UTC()
 	return nil
 }
+
+// Events - события, накопленные задачей с момента последней записи в outbox.
+func (t *Task) Events() []Event {
+	return t.events
+}
+
+func (t *Task) ClearEvents() {
+	t.events = nil
+}
+
+func (t *Task) raise(payload EventPayload) {
+	t.events = append(t.events, newEvent(t.ID, t.BoardID, payload))
+}
+
+// Key - человекочитаемый ключ задачи вида TEAM-12.
+// Пустой, если короткое имя доски не загружено.
+func (t *Task) Key() string {
+	if t.BoardShortName == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s-%d", *t.BoardShortName, t.Number)
+}
+
+var ErrInvalidTaskKey = errors.New("task key must look like SHORTNAME-NUMBER")
+
+// ParseTaskKey разбирает ключ вида TEAM-12 на короткое имя доски и номер задачи.
+// Короткое имя само может содержать дефис, поэтому номер ищется после последнего.
+func ParseTaskKey(key string) (string, int64, error) {
+	i := strings.LastIndex(key, "-")
+	if i <= 0 || i == len(key)-1 {
+		return "", 0, ErrInvalidTaskKey
+	}
+
+	shortName := key[:i]
+	if !shortNameRegex.MatchString(shortName) {
+		return "", 0, ErrInvalidTaskKey
+	}
+	number, err := strconv.ParseInt(key[i+1:], 10, 64)
+	if err != nil || number < 0 {
+		return "", 0, ErrInvalidTaskKey
+	}
+
+	return shortName, number, nil
+}
@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TaskHistoryKind string
+
+const (
+	TaskHistoryMovedToBoard TaskHistoryKind = "moved_to_board"
+)
+
+// TaskHistoryEntry - событие в истории задачи. Состав Data зависит от Kind.
+type TaskHistoryEntry struct {
+	ID        uuid.UUID
+	TaskID    uuid.UUID
+	Kind      TaskHistoryKind
+	Data      map[string]any
+	CreatedAt time.Time
+}
+
+func NewTaskHistoryEntry(taskID uuid.UUID, kind TaskHistoryKind, data map[string]any) TaskHistoryEntry {
+	if data == nil {
+		data = map[string]any{}
+	}
+	return TaskHistoryEntry{
+		ID:        uuid.New(),
+		TaskID:    taskID,
+		Kind:      kind,
+		Data:      data,
+		CreatedAt: time.Now().UTC(),
+	}
+}
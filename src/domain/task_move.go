@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+var ErrTaskAlreadyInBoard = errors.New("task already in target board")
+
+// BoardMove описывает перенос задачи в другую доску.
+type BoardMove struct {
+	FromBoardID  uuid.UUID
+	FromNumber   int64
+	FromKey      string
+	FromColumnID uuid.UUID
+	ToBoardID    uuid.UUID
+	ToColumnID   uuid.UUID
+	ToNumber     int64
+	ToKey        string
+	RemappedTags map[string]string
+	DroppedTags  []string
+}
+
+// MoveToBoard переносит задачу в колонку columnID доски target под номером number.
+// Теги из tagMapping заменяются (пустое значение - удалить тег), остальные теги
+// остаются, только если они уже есть в целевой доске (targetTags).
+// Родитель и спринт принадлежат старой доске, поэтому сбрасываются.
+// Оценка в очках должна быть в шкале целевой доски targetScale.
+func (t *Task) MoveToBoard(
+	source *Board,
+	target *Board,
+	columnID uuid.UUID,
+	number int64,
+	targetTags []string,
+	tagMapping map[string]string,
+	targetScale StoryPointScale,
+) (BoardMove, error) {
+	const op = "domain.Task.MoveToBoard"
+
+	if t.BoardID == target.ID {
+		return BoardMove{}, errors.Wrap(ErrTaskAlreadyInBoard, op)
+	}
+	if t.StoryPoints != nil && !targetScale.Contains(*t.StoryPoints) {
+		return BoardMove{}, errors.Wrap(ErrInvalidStoryPoints, op)
+	}
+
+	move := BoardMove{
+		FromBoardID:  t.BoardID,
+		FromNumber:   t.Number,
+		FromKey:      fmt.Sprintf("%s-%d", source.ShortName, t.Number),
+		FromColumnID: t.ColumnID,
+		ToBoardID:    target.ID,
+		ToColumnID:   columnID,
+		ToNumber:     number,
+		ToKey:        fmt.Sprintf("%s-%d", target.ShortName, number),
+		RemappedTags: map[string]string{},
+		DroppedTags:  []string{},
+	}
+
+	tags := make([]string, 0, len(t.Tags))
+	for _, tag := range t.Tags {
+		newTag := tag
+		if mapped, ok := tagMapping[tag]; ok {
+			newTag = mapped
+			if mapped != "" && mapped != tag {
+				move.RemappedTags[tag] = mapped
+			}
+		} else if !slices.Contains(targetTags, tag) {
+			newTag = ""
+		}
+
+		if newTag == "" {
+			move.DroppedTags = append(move.DroppedTags, tag)
+			continue
+		}
+		if !slices.Contains(tags, newTag) {
+			tags = append(tags, newTag)
+		}
+	}
+
+	t.BoardID = target.ID
+	t.BoardShortName = &target.ShortName
+	t.BoardName = &target.Name
+	t.ColumnID = columnID
+	t.Number = number
+	t.Tags = tags
+	t.ParentID = nil
+	t.SprintID = nil
+	t.UpdatedAt = time.Now().UTC()
+	t.raise(TaskMovedToBoard{
+		TaskID:       t.ID,
+		FromBoardID:  move.FromBoardID,
+		FromKey:      move.FromKey,
+		FromColumnID: move.FromColumnID,
+		ToBoardID:    move.ToBoardID,
+		ToColumnID:   move.ToColumnID,
+		ToKey:        move.ToKey,
+	})
+
+	return move, nil
+}
+
+// HistoryEntry - запись о переносе для истории задачи.
+func (m BoardMove) HistoryEntry(taskID uuid.UUID) TaskHistoryEntry {
+	return NewTaskHistoryEntry(taskID, TaskHistoryMovedToBoard, map[string]any{
+		"from_board_id": m.FromBoardID.String(),
+		"from_key":      m.FromKey,
+		"to_board_id":   m.ToBoardID.String(),
+		"to_column_id":  m.ToColumnID.String(),
+		"to_key":        m.ToKey,
+		"remapped_tags": m.RemappedTags,
+		"dropped_tags":  m.DroppedTags,
+	})
+}
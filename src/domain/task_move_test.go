@@ -0,0 +1,142 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTask_MoveToBoard(t *testing.T) {
+	source := &Board{ID: uuid.New(), ShortName: "OPS", Name: "Ops"}
+	target := &Board{ID: uuid.New(), ShortName: "TEAM", Name: "Team"}
+	columnID := uuid.New()
+	parentID := uuid.New()
+	sprintID := uuid.New()
+
+	newTask := func() *Task {
+		return &Task{
+			ID:       uuid.New(),
+			BoardID:  source.ID,
+			ColumnID: uuid.New(),
+			Number:   7,
+			Tags:     []string{"bug", "infra", "urgent", "legacy"},
+			ParentID: &parentID,
+			SprintID: &sprintID,
+		}
+	}
+
+	t.Run("теги переназначаются, отсутствующие в целевой доске отбрасываются", func(t *testing.T) {
+		task := newTask()
+
+		move, err := task.MoveToBoard(source, target, columnID, 42,
+			[]string{"bug", "platform"},
+			map[string]string{"infra": "platform", "legacy": ""},
+			DefaultStoryPointScale(),
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, target.ID, task.BoardID)
+		assert.Equal(t, columnID, task.ColumnID)
+		assert.Equal(t, int64(42), task.Number)
+		assert.Equal(t, []string{"bug", "platform"}, task.Tags)
+		assert.Nil(t, task.ParentID)
+		assert.Nil(t, task.SprintID)
+		assert.Equal(t, "TEAM-42", task.Key())
+
+		assert.Equal(t, "OPS-7", move.FromKey)
+		assert.Equal(t, "TEAM-42", move.ToKey)
+		assert.Equal(t, int64(7), move.FromNumber)
+		assert.Equal(t, map[string]string{"infra": "platform"}, move.RemappedTags)
+		assert.Equal(t, []string{"urgent", "legacy"}, move.DroppedTags)
+
+		events := task.Events()
+		require.Len(t, events, 1)
+		assert.Equal(t, EventTaskMovedToBoard, events[0].Type)
+		assert.Equal(t, target.ID, events[0].BoardID)
+		assert.Equal(t, TaskMovedToBoard{
+			TaskID: task.ID, FromBoardID: source.ID, FromKey: "OPS-7", FromColumnID: move.FromColumnID,
+			ToBoardID: target.ID, ToColumnID: columnID, ToKey: "TEAM-42",
+		}, events[0].Payload)
+
+		transitions := TransitionsFromEvents(events)
+		require.Len(t, transitions, 1)
+		assert.Equal(t, target.ID, transitions[0].BoardID)
+		assert.Equal(t, move.FromColumnID, *transitions[0].FromColumnID)
+		assert.Equal(t, columnID, transitions[0].ToColumnID)
+	})
+
+	t.Run("переназначение в уже имеющийся тег не дублирует его", func(t *testing.T) {
+		task := newTask()
+		task.Tags = []string{"bug", "defect"}
+
+		_, err := task.MoveToBoard(source, target, columnID, 1, []string{"bug"}, map[string]string{"defect": "bug"}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"bug"}, task.Tags)
+	})
+
+	t.Run("перенос в ту же доску", func(t *testing.T) {
+		task := newTask()
+
+		_, err := task.MoveToBoard(source, source, columnID, 1, nil, nil, nil)
+		assert.ErrorIs(t, err, ErrTaskAlreadyInBoard)
+		assert.Equal(t, int64(7), task.Number)
+		assert.Empty(t, task.Events())
+	})
+
+	t.Run("оценка вне шкалы целевой доски", func(t *testing.T) {
+		task := newTask()
+		points := 4
+		task.StoryPoints = &points
+
+		_, err := task.MoveToBoard(source, target, columnID, 1, nil, nil, DefaultStoryPointScale())
+		assert.ErrorIs(t, err, ErrInvalidStoryPoints)
+		assert.Equal(t, source.ID, task.BoardID)
+		assert.Empty(t, task.Events())
+
+		_, err = task.MoveToBoard(source, target, columnID, 1, nil, nil, StoryPointScale{1, 2, 4, 8})
+		assert.NoError(t, err)
+	})
+}
+
+func TestBoardMove_HistoryEntry(t *testing.T) {
+	taskID := uuid.New()
+	move := BoardMove{FromKey: "OPS-7", ToKey: "TEAM-42", DroppedTags: []string{"legacy"}}
+
+	entry := move.HistoryEntry(taskID)
+	assert.Equal(t, taskID, entry.TaskID)
+	assert.Equal(t, TaskHistoryMovedToBoard, entry.Kind)
+	assert.Equal(t, "OPS-7", entry.Data["from_key"])
+	assert.Equal(t, "TEAM-42", entry.Data["to_key"])
+}
+
+func TestParseTaskKey(t *testing.T) {
+	tests := []struct {
+		key       string
+		shortName string
+		number    int64
+		wantErr   bool
+	}{
+		{key: "TEAM-42", shortName: "TEAM", number: 42},
+		{key: "my-team-3", shortName: "my-team", number: 3},
+		{key: "TEAM", wantErr: true},
+		{key: "TEAM-", wantErr: true},
+		{key: "-12", wantErr: true},
+		{key: "TEAM-abc", wantErr: true},
+		{key: "T!-1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			shortName, number, err := ParseTaskKey(tt.key)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidTaskKey)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.shortName, shortName)
+			assert.Equal(t, tt.number, number)
+		})
+	}
+}
@@ -0,0 +1,252 @@
+package domain
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const (
+	maxTemplateNameLen = 100
+)
+
+var (
+	ErrInvalidTemplateName = errors.New("template name must be between 1 and 100 characters")
+	ErrInvalidTemplate     = errors.New("template task references unknown column")
+)
+
+// BoardTemplate - снимок структуры доски, из которого можно создать новую доску.
+// Задачи в шаблоне необязательны и хранятся без исполнителей, спринтов и вех.
+type BoardTemplate struct {
+	ID          uuid.UUID
+	Name        string
+	Description *string
+	Columns     []TemplateColumn
+	Tasks       []TemplateTask
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	DeletedAt   *time.Time
+}
+
+type TemplateColumn struct {
+	Name     string
+	IsDone   bool
+	WipLimit *int64
+}
+
+// TemplateTask ссылается на колонку и родителя по индексам в шаблоне.
+type TemplateTask struct {
+	Column      int
+	Parent      *int
+	Title       string
+	Description *string
+	Tags        []string
+	Checklists  []Checklist
+	Priority    *Priority
+	Lane        *string
+	// OriginalEstimate и RemainingEstimate - оценки в минутах, как у задачи
+	OriginalEstimate  *int
+	RemainingEstimate *int
+}
+
+// NewBoardTemplate снимает шаблон с доски. Колонки берутся в порядке OrderNum, задачи - в порядке номеров.
+func NewBoardTemplate(name string, description *string, board *Board, withTasks bool) (*BoardTemplate, error) {
+	const op = "domain.NewBoardTemplate"
+
+	if name == "" || len(name) > maxTemplateNameLen {
+		return nil, errors.Wrap(ErrInvalidTemplateName, op)
+	}
+	if len(board.Columns) == 0 {
+		return nil, errors.Wrap(ErrColumnsIsEmpty, op)
+	}
+
+	columns := sortedColumns(board.Columns)
+	columnIdx := make(map[uuid.UUID]int, len(columns))
+	tplColumns := make([]TemplateColumn, 0, len(columns))
+	for i, c := range columns {
+		columnIdx[c.ID] = i
+		tplColumns = append(tplColumns, TemplateColumn{Name: c.Name, IsDone: c.IsDone, WipLimit: c.WipLimit})
+	}
+
+	tplTasks := make([]TemplateTask, 0)
+	if withTasks {
+		tasks := sortedTasks(board.Tasks)
+		taskIdx := make(map[uuid.UUID]int, len(tasks))
+		for i, t := range tasks {
+			taskIdx[t.ID] = i
+		}
+		for _, t := range tasks {
+			tt := TemplateTask{
+				Column:      columnIdx[t.ColumnID],
+				Title:       t.Title,
+				Description: t.Description,
+				Tags:        t.Tags,
+				Checklists:  t.Checklists,
+				Priority:    t.Priority,
+				Lane:        t.Lane,
+
+				OriginalEstimate:  t.OriginalEstimate,
+				RemainingEstimate: t.RemainingEstimate,
+			}
+			if t.ParentID != nil {
+				if pi, ok := taskIdx[*t.ParentID]; ok {
+					tt.Parent = &pi
+				}
+			}
+			tplTasks = append(tplTasks, tt)
+		}
+	}
+
+	now := time.Now().UTC()
+	return &BoardTemplate{
+		ID:          uuid.New(),
+		Name:        name,
+		Description: description,
+		Columns:     tplColumns,
+		Tasks:       tplTasks,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+func (t *BoardTemplate) Delete() {
+	now := time.Now().UTC()
+	t.DeletedAt = &now
+	t.UpdatedAt = now
+}
+
+// NewBoard создает доску по шаблону. Задачи нумеруются заново с 1.
+func (t *BoardTemplate) NewBoard(name string, shortName string) (Board, error) {
+	const op = "domain.BoardTemplate.NewBoard"
+
+	if len(t.Columns) == 0 {
+		return Board{}, errors.Wrap(ErrColumnsIsEmpty, op)
+	}
+
+	board, err := newEmptyBoard(name, shortName)
+	if err != nil {
+		return Board{}, errors.Wrap(err, op)
+	}
+
+	for i, tc := range t.Columns {
+		col, err := NewColumn(board.ID, tc.Name, int64(i))
+		if err != nil {
+			return Board{}, errors.Wrap(err, op)
+		}
+		col.IsDone = tc.IsDone
+		col.WipLimit = tc.WipLimit
+		board.Columns = append(board.Columns, *col)
+	}
+
+	board.Tasks = make([]Task, 0, len(t.Tasks))
+	for i, tt := range t.Tasks {
+		if tt.Column < 0 || tt.Column >= len(board.Columns) {
+			return Board{}, errors.Wrap(ErrInvalidTemplate, op)
+		}
+		task, err := NewTask(board.Columns[tt.Column].ID, board.ID, int64(i+1), tt.Title, tt.Description, tt.Tags, tt.Checklists)
+		if err != nil {
+			return Board{}, errors.Wrap(err, op)
+		}
+		task.Priority = tt.Priority
+		task.Lane = tt.Lane
+		if err := task.SetEstimates(tt.OriginalEstimate, tt.RemainingEstimate); err != nil {
+			return Board{}, errors.Wrap(err, op)
+		}
+		board.Tasks = append(board.Tasks, *task)
+	}
+	// Родители проставляются вторым проходом: ID задач известны только после создания.
+	for i, tt := range t.Tasks {
+		if tt.Parent != nil && *tt.Parent >= 0 && *tt.Parent < len(board.Tasks) {
+			pid := board.Tasks[*tt.Parent].ID
+			board.Tasks[i].ParentID = &pid
+		}
+	}
+
+	return board, nil
+}
+
+// Duplicate глубоко копирует доску под новым коротким именем: шкалу story points,
+// колонки с WIP-лимитами, задачи с подзадачами, тегами, чек-листами, оценками,
+// авторами и исполнителями. Задачи нумеруются заново с 1 в порядке исходных номеров.
+// Оценка вне шкалы доски дает ErrInvalidStoryPoints. Спринты и связи между задачами не копируются.
+func (b *Board) Duplicate(name string, shortName string) (Board, error) {
+	const op = "domain.Board.Duplicate"
+
+	if len(b.Columns) == 0 {
+		return Board{}, errors.Wrap(ErrColumnsIsEmpty, op)
+	}
+
+	board, err := newEmptyBoard(name, shortName)
+	if err != nil {
+		return Board{}, errors.Wrap(err, op)
+	}
+
+	board.StoryPointScale = b.StoryPointScale
+	scale := board.StoryPointScale
+	if scale == nil {
+		scale = DefaultStoryPointScale()
+	}
+
+	columnIDs := make(map[uuid.UUID]uuid.UUID, len(b.Columns))
+	for i, c := range sortedColumns(b.Columns) {
+		col, err := NewColumn(board.ID, c.Name, int64(i))
+		if err != nil {
+			return Board{}, errors.Wrap(err, op)
+		}
+		col.IsDone = c.IsDone
+		col.WipLimit = c.WipLimit
+		columnIDs[c.ID] = col.ID
+		board.Columns = append(board.Columns, *col)
+	}
+
+	tasks := sortedTasks(b.Tasks)
+	taskIDs := make(map[uuid.UUID]uuid.UUID, len(tasks))
+	board.Tasks = make([]Task, 0, len(tasks))
+	for i, t := range tasks {
+		task, err := NewTask(columnIDs[t.ColumnID], board.ID, int64(i+1), t.Title, t.Description, t.Tags, t.Checklists)
+		if err != nil {
+			return Board{}, errors.Wrap(err, op)
+		}
+		task.MilestoneID = t.MilestoneID
+		task.Assignee = t.Assignee
+		task.Reporter = t.Reporter
+		task.Priority = t.Priority
+		task.Lane = t.Lane
+		if err := task.SetEstimates(t.OriginalEstimate, t.RemainingEstimate); err != nil {
+			return Board{}, errors.Wrap(err, op)
+		}
+		if err := task.SetStoryPoints(t.StoryPoints, scale); err != nil {
+			return Board{}, errors.Wrap(err, op)
+		}
+		taskIDs[t.ID] = task.ID
+		board.Tasks = append(board.Tasks, *task)
+	}
+	for i, t := range tasks {
+		if t.ParentID != nil {
+			if pid, ok := taskIDs[*t.ParentID]; ok {
+				board.Tasks[i].ParentID = &pid
+			}
+		}
+	}
+
+	return board, nil
+}
+
+func sortedColumns(columns []Column) []Column {
+	sorted := slices.Clone(columns)
+	slices.SortStableFunc(sorted, func(a, b Column) int {
+		return cmp.Compare(a.OrderNum, b.OrderNum)
+	})
+	return sorted
+}
+
+func sortedTasks(tasks []Task) []Task {
+	sorted := slices.Clone(tasks)
+	slices.SortStableFunc(sorted, func(a, b Task) int {
+		return cmp.Compare(a.Number, b.Number)
+	})
+	return sorted
+}
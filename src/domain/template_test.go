@@ -0,0 +1,140 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func templateSourceBoard() *Board {
+	boardID := uuid.New()
+	todo := Column{ID: uuid.New(), BoardID: boardID, Name: "TODO", OrderNum: 0}
+	wipLimit := int64(3)
+	done := Column{ID: uuid.New(), BoardID: boardID, Name: "Done", OrderNum: 5, IsDone: true, WipLimit: &wipLimit}
+	assignee := "ivan"
+
+	original, remaining := 480, 120
+	parent := Task{ID: uuid.New(), BoardID: boardID, ColumnID: todo.ID, Number: 7, Title: "Epic", Tags: []string{"api"},
+		OriginalEstimate: &original, RemainingEstimate: &remaining}
+	points := 8
+	child := Task{ID: uuid.New(), BoardID: boardID, ColumnID: done.ID, Number: 12, Title: "Child", ParentID: &parent.ID,
+		Assignee: &assignee, Reporter: &assignee, StoryPoints: &points}
+
+	return &Board{
+		ID:      boardID,
+		Columns: []Column{done, todo},
+		Tasks:   []Task{child, parent},
+	}
+}
+
+func TestNewBoardTemplate(t *testing.T) {
+	src := templateSourceBoard()
+
+	tpl, err := NewBoardTemplate("Scrum", nil, src, true)
+	require.NoError(t, err)
+	wipLimit := int64(3)
+	assert.Equal(t, []TemplateColumn{{Name: "TODO"}, {Name: "Done", IsDone: true, WipLimit: &wipLimit}}, tpl.Columns)
+	require.Len(t, tpl.Tasks, 2)
+	assert.Equal(t, "Epic", tpl.Tasks[0].Title)
+	assert.Equal(t, 480, *tpl.Tasks[0].OriginalEstimate)
+	assert.Equal(t, 120, *tpl.Tasks[0].RemainingEstimate)
+	assert.Equal(t, 1, tpl.Tasks[1].Column)
+	require.NotNil(t, tpl.Tasks[1].Parent)
+	assert.Equal(t, 0, *tpl.Tasks[1].Parent)
+
+	tpl, err = NewBoardTemplate("Scrum", nil, src, false)
+	require.NoError(t, err)
+	assert.Empty(t, tpl.Tasks)
+
+	_, err = NewBoardTemplate("", nil, src, false)
+	assert.ErrorIs(t, err, ErrInvalidTemplateName)
+}
+
+func TestBoardTemplate_NewBoard(t *testing.T) {
+	tpl, err := NewBoardTemplate("Scrum", nil, templateSourceBoard(), true)
+	require.NoError(t, err)
+
+	board, err := tpl.NewBoard("New board", "NEW")
+	require.NoError(t, err)
+	require.Len(t, board.Columns, 2)
+	assert.Equal(t, int64(0), board.Columns[0].OrderNum)
+	assert.Equal(t, int64(1), board.Columns[1].OrderNum)
+	assert.True(t, board.Columns[1].IsDone)
+	require.NotNil(t, board.Columns[1].WipLimit)
+	assert.Equal(t, int64(3), *board.Columns[1].WipLimit)
+
+	require.Len(t, board.Tasks, 2)
+	assert.Equal(t, int64(1), board.Tasks[0].Number)
+	assert.Equal(t, int64(2), board.Tasks[1].Number)
+	assert.Equal(t, board.Columns[1].ID, board.Tasks[1].ColumnID)
+	assert.Equal(t, &board.Tasks[0].ID, board.Tasks[1].ParentID)
+	assert.Nil(t, board.Tasks[1].Assignee)
+	assert.Equal(t, 480, *board.Tasks[0].OriginalEstimate)
+	assert.Equal(t, 120, *board.Tasks[0].RemainingEstimate)
+	assert.Nil(t, board.Tasks[1].OriginalEstimate)
+
+	child := board.Tasks[1]
+	require.Len(t, child.Events(), 1)
+	assert.Equal(t, board.ID, child.Events()[0].BoardID)
+	assert.Equal(t, TaskCreated{TaskID: child.ID, ColumnID: child.ColumnID, Number: 2, Title: "Child"}, child.Events()[0].Payload)
+
+	_, err = tpl.NewBoard("New board", "!")
+	assert.ErrorIs(t, err, ErrInvalidName)
+
+	invalid := -1
+	tpl.Tasks[0].OriginalEstimate = &invalid
+	_, err = tpl.NewBoard("New board", "NEW")
+	assert.ErrorIs(t, err, ErrInvalidEstimate)
+
+	tpl.Tasks[0].OriginalEstimate = nil
+	tpl.Tasks[0].Column = 9
+	_, err = tpl.NewBoard("New board", "NEW")
+	assert.ErrorIs(t, err, ErrInvalidTemplate)
+}
+
+func TestBoard_Duplicate(t *testing.T) {
+	src := templateSourceBoard()
+
+	board, err := src.Duplicate("Copy", "COPY")
+	require.NoError(t, err)
+	assert.NotEqual(t, src.ID, board.ID)
+	require.Len(t, board.Columns, 2)
+	assert.Equal(t, "TODO", board.Columns[0].Name)
+	assert.Equal(t, board.ID, board.Columns[0].BoardID)
+	assert.Equal(t, src.Columns[0].WipLimit, board.Columns[1].WipLimit)
+	require.Len(t, board.Columns[0].Events(), 1)
+	assert.IsType(t, ColumnCreated{}, board.Columns[0].Events()[0].Payload)
+
+	require.Len(t, board.Tasks, 2)
+	parent, child := board.Tasks[0], board.Tasks[1]
+	assert.Equal(t, int64(1), parent.Number)
+	assert.Equal(t, int64(2), child.Number)
+	assert.NotEqual(t, src.Tasks[1].ID, parent.ID)
+	assert.Equal(t, &parent.ID, child.ParentID)
+	assert.Equal(t, board.Columns[1].ID, child.ColumnID)
+	assert.Equal(t, "ivan", *child.Assignee)
+	assert.Equal(t, 480, *parent.OriginalEstimate)
+	assert.Equal(t, 120, *parent.RemainingEstimate)
+	assert.Equal(t, 8, *child.StoryPoints)
+	assert.Equal(t, "ivan", *child.Reporter)
+
+	require.Len(t, child.Events(), 1)
+	assert.Equal(t, TaskCreated{TaskID: child.ID, ColumnID: child.ColumnID, Number: 2, Title: "Child"}, child.Events()[0].Payload)
+}
+
+func TestBoard_Duplicate_StoryPointScale(t *testing.T) {
+	src := templateSourceBoard()
+	src.StoryPointScale = StoryPointPreset("linear")
+
+	board, err := src.Duplicate("Copy", "COPY")
+	require.NoError(t, err)
+	assert.Equal(t, src.StoryPointScale, board.StoryPointScale)
+
+	three := 3
+	src.StoryPointScale = StoryPointPreset("powers_of_two")
+	src.Tasks[0].StoryPoints = &three
+	_, err = src.Duplicate("Copy", "COPY")
+	assert.ErrorIs(t, err, ErrInvalidStoryPoints)
+}
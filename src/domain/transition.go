@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TaskTransition - переход задачи между колонками. Пишется вместе с событиями
+// задачи и при переносе в другую доску, служит историей для отчетов.
+type TaskTransition struct {
+	// ID совпадает с ID события
+	ID           uuid.UUID
+	TaskID       uuid.UUID
+	BoardID      uuid.UUID
+	FromColumnID *uuid.UUID
+	ToColumnID   uuid.UUID
+	OccurredAt   time.Time
+}
+
+// TransitionsFromEvents выбирает переходы из событий TaskMoved, TaskMovedToBoard и TaskCreated.
+// Создание задачи - переход без исходной колонки.
+func TransitionsFromEvents(events []Event) []TaskTransition {
+	var transitions []TaskTransition
+	for _, e := range events {
+		t := TaskTransition{ID: e.ID, BoardID: e.BoardID, OccurredAt: e.OccurredAt}
+		switch p := e.Payload.(type) {
+		case TaskCreated:
+			t.TaskID, t.ToColumnID = p.TaskID, p.ColumnID
+		case TaskMoved:
+			from := p.FromColumnID
+			t.TaskID, t.FromColumnID, t.ToColumnID = p.TaskID, &from, p.ToColumnID
+		case TaskMovedToBoard:
+			// в новой доске задача появляется так же, как при создании, но с исходной колонкой
+			from := p.FromColumnID
+			t.TaskID, t.FromColumnID, t.ToColumnID = p.TaskID, &from, p.ToColumnID
+		default:
+			continue
+		}
+		transitions = append(transitions, t)
+	}
+	return transitions
+}
@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrBoardNotDeleted  = errors.New("board is not deleted")
+	ErrColumnNotDeleted = errors.New("column is not deleted")
+	ErrTaskNotDeleted   = errors.New("task is not deleted")
+	ErrBoardArchived    = errors.New("board is already archived")
+	ErrBoardNotArchived = errors.New("board is not archived")
+)
+
+// Trash - корзина доски: мягко удаленные колонки и задачи.
+// Сама доска тоже может быть удалена, тогда в корзине все, что удалено вместе с ней.
+type Trash struct {
+	Board   Board
+	Columns []Column
+	Tasks   []Task
+}
+
+// Restore возвращает доску из корзины. Колонки и задачи, удаленные
+// вместе с доской (с тем же DeletedAt), восстанавливает репозиторий.
+func (b *Board) Restore() error {
+	if b.DeletedAt == nil {
+		return errors.Wrap(ErrBoardNotDeleted, "domain.Board.Restore")
+	}
+	b.DeletedAt = nil
+	b.UpdatedAt = time.Now().UTC()
+	b.raise(BoardRestored{BoardID: b.ID})
+	return nil
+}
+
+// Events - события, накопленные доской с момента последней записи в outbox.
+func (b *Board) Events() []Event {
+	return b.events
+}
+
+func (b *Board) ClearEvents() {
+	b.events = nil
+}
+
+func (b *Board) raise(payload EventPayload) {
+	b.events = append(b.events, newEvent(b.ID, b.ID, payload))
+}
+
+// Archive скрывает завершенную доску из списка досок, доска остается доступной для чтения.
+func (b *Board) Archive() error {
+	if b.ArchivedAt != nil {
+		return errors.Wrap(ErrBoardArchived, "domain.Board.Archive")
+	}
+	now := time.Now().UTC()
+	b.ArchivedAt = &now
+	b.UpdatedAt = now
+	return nil
+}
+
+func (b *Board) Unarchive() error {
+	if b.ArchivedAt == nil {
+		return errors.Wrap(ErrBoardNotArchived, "domain.Board.Unarchive")
+	}
+	b.ArchivedAt = nil
+	b.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+func (c *Column) Restore() error {
+	if c.DeletedAt == nil {
+		return errors.Wrap(ErrColumnNotDeleted, "domain.Column.Restore")
+	}
+	c.DeletedAt = nil
+	c.UpdatedAt = time.Now().UTC()
+	c.raise(ColumnRestored{ColumnID: c.ID})
+	return nil
+}
+
+// Restore возвращает задачу из корзины. Подзадачи, удаленные каскадом
+// вместе с ней (с тем же DeletedAt), восстанавливает репозиторий.
+func (t *Task) Restore() error {
+	if t.DeletedAt == nil {
+		return errors.Wrap(ErrTaskNotDeleted, "domain.Task.Restore")
+	}
+	t.DeletedAt = nil
+	t.UpdatedAt = time.Now().UTC()
+	t.raise(TaskRestored{TaskID: t.ID})
+	return nil
+}
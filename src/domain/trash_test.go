@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBoard_Restore(t *testing.T) {
+	board, err := NewBoard("Test Board", "TB")
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, board.Restore(), ErrBoardNotDeleted)
+
+	board.Delete()
+	require.NoError(t, board.Restore())
+	assert.Nil(t, board.DeletedAt)
+	require.Len(t, board.Events(), 1)
+	assert.Equal(t, BoardRestored{BoardID: board.ID}, board.Events()[0].Payload)
+	assert.Equal(t, board.ID, board.Events()[0].BoardID)
+}
+
+func TestBoard_Archive(t *testing.T) {
+	board, err := NewBoard("Test Board", "TB")
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, board.Unarchive(), ErrBoardNotArchived)
+
+	require.NoError(t, board.Archive())
+	assert.NotNil(t, board.ArchivedAt)
+	assert.ErrorIs(t, board.Archive(), ErrBoardArchived)
+
+	require.NoError(t, board.Unarchive())
+	assert.Nil(t, board.ArchivedAt)
+}
+
+func TestColumnAndTask_Restore(t *testing.T) {
+	column, err := NewColumn(uuid.New(), "TODO", 0)
+	require.NoError(t, err)
+	assert.ErrorIs(t, column.Restore(), ErrColumnNotDeleted)
+	column.Delete()
+	require.NoError(t, column.Restore())
+	assert.Nil(t, column.DeletedAt)
+	events := column.Events()
+	assert.Equal(t, ColumnRestored{ColumnID: column.ID}, events[len(events)-1].Payload)
+
+	task, err := NewTask(column.ID, column.BoardID, 1, "task", nil, nil, nil)
+	require.NoError(t, err)
+	assert.ErrorIs(t, task.Restore(), ErrTaskNotDeleted)
+	task.Delete()
+	require.NoError(t, task.Restore())
+	assert.Nil(t, task.DeletedAt)
+	events = task.Events()
+	assert.Equal(t, TaskRestored{TaskID: task.ID}, events[len(events)-1].Payload)
+}
@@ -0,0 +1,225 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type VelocityGrouping string
+
+const (
+	VelocityByWeek   VelocityGrouping = "week"
+	VelocityBySprint VelocityGrouping = "sprint"
+)
+
+const (
+	// Периоды по умолчанию: 12 недель или спринты за полгода
+	defaultVelocityWeeks       = 12
+	defaultVelocitySprintWeeks = 26
+)
+
+var (
+	ErrInvalidVelocityGrouping = errors.New("velocity must be grouped by week or sprint")
+	ErrInvalidVelocityPeriod   = errors.New("velocity period must be from <= to and at most 366 days")
+)
+
+// TaskCompletion - первое попадание задачи в done-колонку доски.
+// Повторные возвраты в работу и закрытия не учитываются.
+type TaskCompletion struct {
+	TaskID      uuid.UUID
+	StoryPoints *int
+	CompletedAt time.Time
+}
+
+// VelocityFilter - доска, группировка и период. From и To - даты включительно.
+type VelocityFilter struct {
+	BoardID uuid.UUID
+	GroupBy VelocityGrouping
+	From    time.Time
+	To      time.Time
+}
+
+// NewVelocityFilter проверяет период. Без дат берутся последние 12 недель
+// для недельной группировки и полгода для спринтов, по сегодняшний день.
+func NewVelocityFilter(boardID uuid.UUID, groupBy VelocityGrouping, from, to *time.Time) (VelocityFilter, error) {
+	const op = "domain.NewVelocityFilter"
+
+	if groupBy == "" {
+		groupBy = VelocityByWeek
+	}
+	if groupBy != VelocityByWeek && groupBy != VelocityBySprint {
+		return VelocityFilter{}, errors.Wrap(ErrInvalidVelocityGrouping, op)
+	}
+
+	weeks := defaultVelocityWeeks
+	if groupBy == VelocityBySprint {
+		weeks = defaultVelocitySprintWeeks
+	}
+
+	f := VelocityFilter{BoardID: boardID, GroupBy: groupBy}
+	var ok bool
+	if f.From, f.To, ok = reportPeriod(from, to, weeks); !ok {
+		return VelocityFilter{}, errors.Wrap(ErrInvalidVelocityPeriod, op)
+	}
+
+	return f, nil
+}
+
+// reportPeriod приводит границы отчета к датам. Без to - по сегодняшний день,
+// без from - последние weeks недель. ok == false, если период пустой или длиннее года.
+func reportPeriod(from, to *time.Time, weeks int) (start, end time.Time, ok bool) {
+	end = truncateToDate(time.Now().UTC())
+	if to != nil {
+		end = truncateToDate(*to)
+	}
+
+	start = end.AddDate(0, 0, 1-7*weeks)
+	if from != nil {
+		start = truncateToDate(*from)
+	}
+
+	ok = !start.After(end) && end.Sub(start) < MaxTimeReportDays*24*time.Hour
+	return start, end, ok
+}
+
+// VelocityPeriod - неделя или спринт. Start включительно, End - нет.
+type VelocityPeriod struct {
+	Label    string
+	SprintID *uuid.UUID
+	Start    time.Time
+	End      time.Time
+	// Points - сумма story points завершенных задач, Tasks - их число,
+	// Unestimated - сколько из них без оценки
+	Points      int
+	Tasks       int
+	Unestimated int
+}
+
+func (p VelocityPeriod) contains(t time.Time) bool {
+	return !t.Before(p.Start) && t.Before(p.End)
+}
+
+type VelocityReport struct {
+	Filter  VelocityFilter
+	Periods []VelocityPeriod
+	// AveragePoints - среднее по всем периодам отчета
+	AveragePoints float64
+}
+
+// VelocityPeriods нарезает период фильтра на недели с понедельника или
+// выбирает начатые спринты, пересекающие его.
+func VelocityPeriods(f VelocityFilter, sprints []Sprint) []VelocityPeriod {
+	if f.GroupBy == VelocityBySprint {
+		return sprintPeriods(f, sprints)
+	}
+	return weekPeriods(f)
+}
+
+func weekPeriods(f VelocityFilter) []VelocityPeriod {
+	var periods []VelocityPeriod
+	for _, w := range isoWeeks(f.From, f.To) {
+		periods = append(periods, VelocityPeriod{Label: w.Label, Start: w.Start, End: w.End})
+	}
+	return periods
+}
+
+// isoWeek - неделя с понедельника, Start включительно, End - нет.
+type isoWeek struct {
+	Label string
+	Start time.Time
+	End   time.Time
+}
+
+// isoWeeks нарезает даты from-to включительно на недели ISO, крайние недели - целиком.
+func isoWeeks(from, to time.Time) []isoWeek {
+	start := from.AddDate(0, 0, -((int(from.Weekday()) + 6) % 7))
+
+	var weeks []isoWeek
+	for ; !start.After(to); start = start.AddDate(0, 0, 7) {
+		year, week := start.ISOWeek()
+		weeks = append(weeks, isoWeek{
+			Label: fmt.Sprintf("%d-W%02d", year, week),
+			Start: start,
+			End:   start.AddDate(0, 0, 7),
+		})
+	}
+	return weeks
+}
+
+func sprintPeriods(f VelocityFilter, sprints []Sprint) []VelocityPeriod {
+	sorted := append([]Sprint(nil), sprints...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].StartDate.Before(sorted[j].StartDate)
+	})
+
+	var periods []VelocityPeriod
+	for _, s := range sorted {
+		if s.State == SprintPlanned || s.DeletedAt != nil {
+			continue
+		}
+		start := truncateToDate(s.StartDate)
+		end := truncateToDate(s.EndDate).AddDate(0, 0, 1)
+		if start.After(f.To) || !end.After(f.From) {
+			continue
+		}
+		id := s.ID
+		periods = append(periods, VelocityPeriod{
+			Label:    s.Name,
+			SprintID: &id,
+			Start:    start,
+			End:      end,
+		})
+	}
+	return periods
+}
+
+// CompletionRange - границы выборки завершений для периодов.
+func CompletionRange(periods []VelocityPeriod) (start, end time.Time, ok bool) {
+	for i, p := range periods {
+		if i == 0 || p.Start.Before(start) {
+			start = p.Start
+		}
+		if i == 0 || p.End.After(end) {
+			end = p.End
+		}
+	}
+	return start, end, len(periods) > 0
+}
+
+// NewVelocityReport раскладывает завершения задач по периодам. Story points
+// берутся текущие, задача попадает в период, где она впервые стала done.
+func NewVelocityReport(f VelocityFilter, periods []VelocityPeriod, completions []TaskCompletion) *VelocityReport {
+	r := &VelocityReport{Filter: f, Periods: periods}
+	if r.Periods == nil {
+		r.Periods = []VelocityPeriod{}
+	}
+
+	for _, c := range completions {
+		for i := range r.Periods {
+			p := &r.Periods[i]
+			if !p.contains(c.CompletedAt) {
+				continue
+			}
+			p.Tasks++
+			if c.StoryPoints == nil {
+				p.Unestimated++
+			} else {
+				p.Points += *c.StoryPoints
+			}
+		}
+	}
+
+	if len(r.Periods) > 0 {
+		total := 0
+		for _, p := range r.Periods {
+			total += p.Points
+		}
+		r.AveragePoints = float64(total) / float64(len(r.Periods))
+	}
+
+	return r
+}
@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransitionsFromEvents(t *testing.T) {
+	todo, done := uuid.New(), uuid.New()
+	task := &Task{ID: uuid.New(), BoardID: uuid.New(), ColumnID: todo}
+	require.NoError(t, task.MoveToColumn(done))
+	task.raise(TaskDeleted{TaskID: task.ID})
+
+	transitions := TransitionsFromEvents(task.Events())
+	require.Len(t, transitions, 1)
+	assert.Equal(t, task.Events()[0].ID, transitions[0].ID)
+	assert.Equal(t, task.BoardID, transitions[0].BoardID)
+	assert.Equal(t, &todo, transitions[0].FromColumnID)
+	assert.Equal(t, done, transitions[0].ToColumnID)
+}
+
+func TestNewVelocityFilter(t *testing.T) {
+	boardID := uuid.New()
+	day := func(d int) *time.Time {
+		v := time.Date(2025, 3, d, 15, 0, 0, 0, time.UTC)
+		return &v
+	}
+
+	f, err := NewVelocityFilter(boardID, "", nil, day(31))
+	require.NoError(t, err)
+	assert.Equal(t, VelocityByWeek, f.GroupBy)
+	assert.Equal(t, time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC), f.To)
+	assert.Equal(t, time.Date(2025, 1, 7, 0, 0, 0, 0, time.UTC), f.From)
+
+	_, err = NewVelocityFilter(boardID, "month", nil, nil)
+	assert.ErrorIs(t, err, ErrInvalidVelocityGrouping)
+	_, err = NewVelocityFilter(boardID, VelocityByWeek, day(10), day(9))
+	assert.ErrorIs(t, err, ErrInvalidVelocityPeriod)
+	from := day(1).AddDate(-2, 0, 0)
+	_, err = NewVelocityFilter(boardID, VelocityByWeek, &from, day(1))
+	assert.ErrorIs(t, err, ErrInvalidVelocityPeriod)
+}
+
+func TestVelocityByWeek(t *testing.T) {
+	intPtr := func(v int) *int { return &v }
+	at := func(d, h int) time.Time { return time.Date(2025, 3, d, h, 0, 0, 0, time.UTC) }
+
+	// Среда 5 марта - воскресенье 16 марта: недели W10 и W11
+	f := VelocityFilter{GroupBy: VelocityByWeek, From: at(5, 0), To: at(16, 0)}
+	periods := VelocityPeriods(f, nil)
+	require.Len(t, periods, 2)
+	assert.Equal(t, "2025-W10", periods[0].Label)
+	assert.Equal(t, at(3, 0), periods[0].Start)
+	assert.Equal(t, at(17, 0), periods[1].End)
+
+	r := NewVelocityReport(f, periods, []TaskCompletion{
+		{TaskID: uuid.New(), StoryPoints: intPtr(3), CompletedAt: at(3, 9)},
+		{TaskID: uuid.New(), StoryPoints: intPtr(5), CompletedAt: at(9, 23)},
+		{TaskID: uuid.New(), CompletedAt: at(10, 0)},
+		{TaskID: uuid.New(), StoryPoints: intPtr(8), CompletedAt: at(17, 0)},
+	})
+	assert.Equal(t, 8, r.Periods[0].Points)
+	assert.Equal(t, 2, r.Periods[0].Tasks)
+	assert.Equal(t, 0, r.Periods[1].Points)
+	assert.Equal(t, 1, r.Periods[1].Tasks)
+	assert.Equal(t, 1, r.Periods[1].Unestimated)
+	assert.Equal(t, 4.0, r.AveragePoints)
+}
+
+func TestVelocityBySprint(t *testing.T) {
+	intPtr := func(v int) *int { return &v }
+	at := func(d int) time.Time { return time.Date(2025, 3, d, 0, 0, 0, 0, time.UTC) }
+	now := time.Now()
+
+	second := Sprint{ID: uuid.New(), Name: "Sprint 2", StartDate: at(15), EndDate: at(28), State: SprintActive}
+	first := Sprint{ID: uuid.New(), Name: "Sprint 1", StartDate: at(1), EndDate: at(14), State: SprintClosed}
+	planned := Sprint{ID: uuid.New(), Name: "Sprint 3", StartDate: at(29), EndDate: at(31), State: SprintPlanned}
+	deleted := Sprint{ID: uuid.New(), Name: "Old", StartDate: at(1), EndDate: at(14), State: SprintClosed, DeletedAt: &now}
+
+	f := VelocityFilter{GroupBy: VelocityBySprint, From: at(10), To: at(31)}
+	periods := VelocityPeriods(f, []Sprint{second, planned, deleted, first})
+	require.Len(t, periods, 2)
+	assert.Equal(t, "Sprint 1", periods[0].Label)
+	assert.Equal(t, &first.ID, periods[0].SprintID)
+	assert.Equal(t, at(15), periods[0].End)
+
+	start, end, ok := CompletionRange(periods)
+	require.True(t, ok)
+	assert.Equal(t, at(1), start)
+	assert.Equal(t, at(29), end)
+
+	r := NewVelocityReport(f, periods, []TaskCompletion{
+		{TaskID: uuid.New(), StoryPoints: intPtr(13), CompletedAt: at(14).Add(23 * time.Hour)},
+		{TaskID: uuid.New(), StoryPoints: intPtr(2), CompletedAt: at(15)},
+	})
+	assert.Equal(t, 13, r.Periods[0].Points)
+	assert.Equal(t, 2, r.Periods[1].Points)
+	assert.Equal(t, 7.5, r.AveragePoints)
+
+	empty := NewVelocityReport(f, nil, nil)
+	assert.NotNil(t, empty.Periods)
+	assert.Zero(t, empty.AveragePoints)
+}
@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const maxWatcherIDLen = 100
+
+var ErrInvalidWatcher = errors.New("watcher user id must be 1-100 characters")
+
+// Watcher - подписка пользователя на задачу или на всю доску.
+// Заполнен ровно один из TaskID и BoardID.
+type Watcher struct {
+	UserID    string
+	TaskID    *uuid.UUID
+	BoardID   *uuid.UUID
+	CreatedAt time.Time
+}
+
+func NewTaskWatcher(userID string, taskID uuid.UUID) (*Watcher, error) {
+	if userID == "" || len(userID) > maxWatcherIDLen {
+		return nil, errors.Wrap(ErrInvalidWatcher, "domain.NewTaskWatcher")
+	}
+	return &Watcher{UserID: userID, TaskID: &taskID, CreatedAt: time.Now().UTC()}, nil
+}
+
+func NewBoardWatcher(userID string, boardID uuid.UUID) (*Watcher, error) {
+	if userID == "" || len(userID) > maxWatcherIDLen {
+		return nil, errors.Wrap(ErrInvalidWatcher, "domain.NewBoardWatcher")
+	}
+	return &Watcher{UserID: userID, BoardID: &boardID, CreatedAt: time.Now().UTC()}, nil
+}
+
+// AutoWatchers - кто подписывается на задачу без явного запроса:
+// автор и исполнитель.
+func (t *Task) AutoWatchers() []Watcher {
+	var watchers []Watcher
+	for _, user := range []*string{t.Reporter, t.Assignee} {
+		if user == nil {
+			continue
+		}
+		if w, err := NewTaskWatcher(*user, t.ID); err == nil {
+			watchers = append(watchers, *w)
+		}
+	}
+	return watchers
+}
+
+// WatcherUserIDs возвращает отсортированных пользователей без повторов:
+// подписанный и на задачу, и на доску получит одно уведомление.
+func WatcherUserIDs(watchers []Watcher) []string {
+	seen := make(map[string]struct{}, len(watchers))
+	users := make([]string, 0, len(watchers))
+	for _, w := range watchers {
+		if _, ok := seen[w.UserID]; ok {
+			continue
+		}
+		seen[w.UserID] = struct{}{}
+		users = append(users, w.UserID)
+	}
+	sort.Strings(users)
+	return users
+}
@@ -0,0 +1,335 @@
+package domain
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const (
+	maxWebhookURLLen    = 2048
+	minWebhookSecretLen = 16
+	maxWebhookSecretLen = 255
+	webhookSecretBytes  = 32
+	// Ответ получателя обрезается до этой длины в журнале доставок
+	maxWebhookErrorLen = 1024
+)
+
+type WebhookEventType string
+
+const (
+	WebhookTaskCreated      WebhookEventType = "task.created"
+	WebhookTaskUpdated      WebhookEventType = "task.updated"
+	WebhookTaskMoved        WebhookEventType = "task.moved"
+	WebhookTaskMovedToBoard WebhookEventType = "task.moved_to_board"
+	WebhookTaskDeleted      WebhookEventType = "task.deleted"
+	WebhookTaskRestored     WebhookEventType = "task.restored"
+	WebhookColumnCreated    WebhookEventType = "column.created"
+	WebhookColumnUpdated    WebhookEventType = "column.updated"
+	WebhookColumnDeleted    WebhookEventType = "column.deleted"
+	WebhookColumnRestored   WebhookEventType = "column.restored"
+	WebhookBoardRestored    WebhookEventType = "board.restored"
+)
+
+var WebhookEventTypes = []WebhookEventType{
+	WebhookTaskCreated,
+	WebhookTaskUpdated,
+	WebhookTaskMoved,
+	WebhookTaskMovedToBoard,
+	WebhookTaskDeleted,
+	WebhookTaskRestored,
+	WebhookColumnCreated,
+	WebhookColumnUpdated,
+	WebhookColumnDeleted,
+	WebhookColumnRestored,
+	WebhookBoardRestored,
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "delivered"
+	// WebhookDeliveryDead - попытки исчерпаны, доставка повторяется только вручную
+	WebhookDeliveryDead WebhookDeliveryStatus = "dead"
+)
+
+var (
+	ErrInvalidWebhookURL      = errors.New("webhook url must be an absolute http(s) url")
+	ErrWebhookHostNotAllowed  = errors.New("webhook url must point to a public host")
+	ErrInvalidWebhookEvents   = errors.New("webhook events must be a non-empty list of known event types")
+	ErrInvalidWebhookSecret   = errors.New("webhook secret must be 16-255 characters")
+	ErrInvalidDeliveryStatus  = errors.New("invalid webhook delivery status")
+	ErrWebhookDeliveryPending = errors.New("webhook delivery is already pending")
+)
+
+// Webhook - подписка внешнего сервиса на события доски.
+type Webhook struct {
+	ID        uuid.UUID
+	BoardID   uuid.UUID
+	URL       string
+	Events    []WebhookEventType
+	Secret    string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewWebhook создает подписку. Пустой secret генерируется случайно.
+func NewWebhook(boardID uuid.UUID, rawURL string, events []string, secret string) (*Webhook, error) {
+	const op = "domain.NewWebhook"
+
+	u, err := url.Parse(rawURL)
+	if err != nil || len(rawURL) > maxWebhookURLLen || u.Host == "" ||
+		(u.Scheme != "http" && u.Scheme != "https") {
+		return nil, errors.Wrap(ErrInvalidWebhookURL, op)
+	}
+	if !publicWebhookHost(u.Hostname()) {
+		return nil, errors.Wrap(ErrWebhookHostNotAllowed, op)
+	}
+
+	if len(events) == 0 {
+		return nil, errors.Wrap(ErrInvalidWebhookEvents, op)
+	}
+	types := make([]WebhookEventType, 0, len(events))
+	for _, e := range events {
+		et := WebhookEventType(e)
+		if !slices.Contains(WebhookEventTypes, et) {
+			return nil, errors.Wrapf(ErrInvalidWebhookEvents, "%s: unknown event %q", op, e)
+		}
+		if !slices.Contains(types, et) {
+			types = append(types, et)
+		}
+	}
+
+	if secret == "" {
+		secret, err = generateWebhookSecret()
+		if err != nil {
+			return nil, errors.Wrap(err, op)
+		}
+	}
+	if len(secret) < minWebhookSecretLen || len(secret) > maxWebhookSecretLen {
+		return nil, errors.Wrap(ErrInvalidWebhookSecret, op)
+	}
+
+	now := time.Now().UTC()
+	return &Webhook{
+		ID:        uuid.New(),
+		BoardID:   boardID,
+		URL:       rawURL,
+		Events:    types,
+		Secret:    secret,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+// publicWebhookHost отсекает адреса внутри сети сервиса, куда подписка не должна
+// достучаться (SSRF). Имена проверяются здесь только на localhost, адреса, в которые
+// они разрешаются, проверяет клиент доставки при соединении.
+func publicWebhookHost(host string) bool {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return false
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		return PublicWebhookIP(ip)
+	}
+	return true
+}
+
+// PublicWebhookIP - можно ли слать доставки на этот адрес: не loopback,
+// не частная сеть, не link-local (в том числе метаданные облака 169.254.169.254).
+func PublicWebhookIP(ip net.IP) bool {
+	return !ip.IsLoopback() && !ip.IsPrivate() && !ip.IsUnspecified() &&
+		!ip.IsLinkLocalUnicast() && !ip.IsLinkLocalMulticast() && !ip.IsInterfaceLocalMulticast() &&
+		!ip.IsMulticast()
+}
+
+func (w *Webhook) Subscribed(event WebhookEventType) bool {
+	return slices.Contains(w.Events, event)
+}
+
+func generateWebhookSecret() (string, error) {
+	b := make([]byte, webhookSecretBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// SignWebhookPayload - подпись тела запроса, которую получатель сверяет
+// с заголовком X-Teamboard-Signature.
+func SignWebhookPayload(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// WebhookEvent - изменение на доске, о котором сообщается подписчикам.
+// Data - данные доменного события.
+type WebhookEvent struct {
+	ID         uuid.UUID
+	Type       WebhookEventType
+	BoardID    uuid.UUID
+	OccurredAt time.Time
+	Data       any
+}
+
+// WebhookEventFromDomain строит событие для подписчиков из события outbox.
+// ID берется из outbox, поэтому повторная публикация события узнается по нему.
+// ok == false - события этого типа подписчикам не рассылаются.
+func WebhookEventFromDomain(event Event) (WebhookEvent, bool) {
+	eventType := WebhookEventType(event.Type)
+	if !slices.Contains(WebhookEventTypes, eventType) {
+		return WebhookEvent{}, false
+	}
+	return WebhookEvent{
+		ID:         event.ID,
+		Type:       eventType,
+		BoardID:    event.BoardID,
+		OccurredAt: event.OccurredAt,
+		Data:       event.Payload,
+	}, true
+}
+
+type webhookPayload struct {
+	ID         uuid.UUID        `json:"id"`
+	Event      WebhookEventType `json:"event"`
+	BoardID    uuid.UUID        `json:"board_id"`
+	OccurredAt time.Time        `json:"occurred_at"`
+	Data       any              `json:"data"`
+}
+
+// Payload - тело запроса к получателю.
+func (e WebhookEvent) Payload() ([]byte, error) {
+	return json.Marshal(webhookPayload{
+		ID:         e.ID,
+		Event:      e.Type,
+		BoardID:    e.BoardID,
+		OccurredAt: e.OccurredAt,
+		Data:       e.Data,
+	})
+}
+
+// WebhookRetryPolicy - экспоненциальная задержка между попытками доставки.
+type WebhookRetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+// Backoff - задержка перед следующей попыткой после attempt неудачных.
+func (p WebhookRetryPolicy) Backoff(attempt int) time.Duration {
+	return exponentialBackoff(p.BaseDelay, p.MaxDelay, attempt)
+}
+
+// exponentialBackoff удваивает base с каждой попыткой после первой, но не больше maxDelay.
+func exponentialBackoff(base, maxDelay time.Duration, attempt int) time.Duration {
+	delay := base
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if delay >= maxDelay {
+			return maxDelay
+		}
+	}
+	return min(delay, maxDelay)
+}
+
+// WebhookDelivery - доставка одного события одному подписчику.
+type WebhookDelivery struct {
+	ID             uuid.UUID
+	WebhookID      uuid.UUID
+	EventID        uuid.UUID
+	EventType      WebhookEventType
+	Payload        []byte
+	Status         WebhookDeliveryStatus
+	Attempts       int
+	NextAttemptAt  *time.Time
+	LastStatusCode *int
+	LastError      *string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DeliveredAt    *time.Time
+}
+
+func NewWebhookDelivery(webhookID uuid.UUID, event WebhookEvent, payload []byte) WebhookDelivery {
+	now := time.Now().UTC()
+	return WebhookDelivery{
+		ID:            uuid.New(),
+		WebhookID:     webhookID,
+		EventID:       event.ID,
+		EventType:     event.Type,
+		Payload:       payload,
+		Status:        WebhookDeliveryPending,
+		NextAttemptAt: &now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+}
+
+func ParseWebhookDeliveryStatus(s string) (WebhookDeliveryStatus, error) {
+	status := WebhookDeliveryStatus(s)
+	switch status {
+	case WebhookDeliveryPending, WebhookDeliveryDelivered, WebhookDeliveryDead:
+		return status, nil
+	}
+	return "", ErrInvalidDeliveryStatus
+}
+
+func (d *WebhookDelivery) MarkDelivered(statusCode int, now time.Time) {
+	d.Attempts++
+	d.Status = WebhookDeliveryDelivered
+	d.LastStatusCode = &statusCode
+	d.LastError = nil
+	d.NextAttemptAt = nil
+	d.DeliveredAt = &now
+	d.UpdatedAt = now
+}
+
+// MarkFailed фиксирует неудачную попытку: планирует следующую по политике
+// или переводит доставку в dead, если попытки исчерпаны. statusCode == 0 -
+// ответа не было (сетевая ошибка, таймаут).
+func (d *WebhookDelivery) MarkFailed(statusCode int, reason string, policy WebhookRetryPolicy, now time.Time) {
+	d.Attempts++
+	d.LastStatusCode = nil
+	if statusCode != 0 {
+		d.LastStatusCode = &statusCode
+	}
+	if len(reason) > maxWebhookErrorLen {
+		reason = reason[:maxWebhookErrorLen]
+	}
+	d.LastError = &reason
+	d.UpdatedAt = now
+
+	if d.Attempts >= policy.MaxAttempts {
+		d.Status = WebhookDeliveryDead
+		d.NextAttemptAt = nil
+		return
+	}
+	next := now.Add(policy.Backoff(d.Attempts))
+	d.NextAttemptAt = &next
+}
+
+// Redeliver ставит доставку в очередь заново с обнуленным счетчиком попыток.
+func (d *WebhookDelivery) Redeliver() error {
+	if d.Status == WebhookDeliveryPending {
+		return errors.Wrap(ErrWebhookDeliveryPending, "domain.WebhookDelivery.Redeliver")
+	}
+	now := time.Now().UTC()
+	d.Status = WebhookDeliveryPending
+	d.Attempts = 0
+	d.NextAttemptAt = &now
+	d.DeliveredAt = nil
+	d.UpdatedAt = now
+	return nil
+}
@@ -0,0 +1,108 @@
+package domain
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWebhook(t *testing.T) {
+	boardID := uuid.New()
+
+	testCases := []struct {
+		name        string
+		url         string
+		events      []string
+		secret      string
+		expectError error
+	}{
+		{name: "Success: generated secret", url: "https://ci.example.com/hook", events: []string{"task.moved"}},
+		{name: "Success: own secret", url: "http://bot.example.com:8080/x", events: []string{"task.created"}, secret: "0123456789abcdef"},
+		{name: "Failure: relative url", url: "/hook", events: []string{"task.moved"}, expectError: ErrInvalidWebhookURL},
+		{name: "Failure: ftp url", url: "ftp://example.com", events: []string{"task.moved"}, expectError: ErrInvalidWebhookURL},
+		{name: "Failure: no events", url: "https://example.com", expectError: ErrInvalidWebhookEvents},
+		{name: "Failure: unknown event", url: "https://example.com", events: []string{"board.exploded"}, expectError: ErrInvalidWebhookEvents},
+		{name: "Success: public ip", url: "https://203.0.113.10/hook", events: []string{"task.moved"}},
+		{name: "Failure: localhost", url: "http://localhost:8080/hook", events: []string{"task.moved"}, expectError: ErrWebhookHostNotAllowed},
+		{name: "Failure: loopback ip", url: "http://127.0.0.1/hook", events: []string{"task.moved"}, expectError: ErrWebhookHostNotAllowed},
+		{name: "Failure: loopback ipv6", url: "http://[::1]:9000/hook", events: []string{"task.moved"}, expectError: ErrWebhookHostNotAllowed},
+		{name: "Failure: private network", url: "https://10.1.2.3/hook", events: []string{"task.moved"}, expectError: ErrWebhookHostNotAllowed},
+		{name: "Failure: cloud metadata", url: "http://169.254.169.254/latest/meta-data", events: []string{"task.moved"}, expectError: ErrWebhookHostNotAllowed},
+		{name: "Failure: unspecified", url: "http://0.0.0.0/hook", events: []string{"task.moved"}, expectError: ErrWebhookHostNotAllowed},
+		{name: "Failure: short secret", url: "https://example.com", events: []string{"task.moved"}, secret: "short", expectError: ErrInvalidWebhookSecret},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w, err := NewWebhook(boardID, tc.url, tc.events, tc.secret)
+			if tc.expectError != nil {
+				assert.ErrorIs(t, err, tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+			assert.GreaterOrEqual(t, len(w.Secret), minWebhookSecretLen)
+			assert.True(t, w.Subscribed(WebhookEventType(tc.events[0])))
+			assert.False(t, w.Subscribed(WebhookColumnDeleted))
+		})
+	}
+}
+
+func TestSignWebhookPayload(t *testing.T) {
+	payload := []byte(`{"event":"task.moved"}`)
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write(payload)
+
+	assert.Equal(t, "sha256="+hex.EncodeToString(mac.Sum(nil)), SignWebhookPayload("secret", payload))
+}
+
+func TestWebhookRetryPolicy_Backoff(t *testing.T) {
+	p := WebhookRetryPolicy{MaxAttempts: 5, BaseDelay: time.Second, MaxDelay: 10 * time.Second}
+
+	assert.Equal(t, time.Second, p.Backoff(1))
+	assert.Equal(t, 2*time.Second, p.Backoff(2))
+	assert.Equal(t, 8*time.Second, p.Backoff(4))
+	assert.Equal(t, 10*time.Second, p.Backoff(5))
+	assert.Equal(t, 10*time.Second, p.Backoff(40))
+}
+
+func TestWebhookDelivery_Lifecycle(t *testing.T) {
+	policy := WebhookRetryPolicy{MaxAttempts: 2, BaseDelay: time.Minute, MaxDelay: time.Hour}
+	task, err := NewTask(uuid.New(), uuid.New(), 1, "Fix login", nil, nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, task.MoveToColumn(uuid.New()))
+	event, ok := WebhookEventFromDomain(task.Events()[1])
+	require.True(t, ok)
+	payload, err := event.Payload()
+	require.NoError(t, err)
+
+	d := NewWebhookDelivery(uuid.New(), event, payload)
+	assert.Equal(t, WebhookDeliveryPending, d.Status)
+	assert.ErrorIs(t, d.Redeliver(), ErrWebhookDeliveryPending)
+
+	now := time.Now().UTC()
+	d.MarkFailed(500, "boom", policy, now)
+	assert.Equal(t, WebhookDeliveryPending, d.Status)
+	require.NotNil(t, d.NextAttemptAt)
+	assert.Equal(t, now.Add(time.Minute), *d.NextAttemptAt)
+	assert.Equal(t, 500, *d.LastStatusCode)
+
+	d.MarkFailed(0, "timeout", policy, now)
+	assert.Equal(t, WebhookDeliveryDead, d.Status)
+	assert.Nil(t, d.NextAttemptAt)
+	assert.Nil(t, d.LastStatusCode)
+
+	require.NoError(t, d.Redeliver())
+	assert.Equal(t, WebhookDeliveryPending, d.Status)
+	assert.Zero(t, d.Attempts)
+
+	d.MarkDelivered(204, now)
+	assert.Equal(t, WebhookDeliveryDelivered, d.Status)
+	assert.Nil(t, d.LastError)
+	assert.NotNil(t, d.DeliveredAt)
+}
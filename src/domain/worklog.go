@@ -0,0 +1,184 @@
+package domain
+
+import (
+	"sort"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const (
+	// Одна запись - не больше суток
+	MaxWorklogMinutes = 24 * 60
+	// Оценка задачи - не больше 10000 часов
+	MaxEstimateMinutes = 10000 * 60
+	// Отчет строится максимум за год
+	MaxTimeReportDays = 366
+
+	maxWorklogNoteLen = 1000
+	maxWorklogUserLen = 100
+)
+
+var (
+	ErrInvalidWorklogMinutes = errors.New("worklog minutes must be between 1 and 1440")
+	ErrInvalidWorklogDate    = errors.New("worklog date must not be in the future")
+	ErrInvalidWorklogNote    = errors.New("worklog note must be at most 1000 characters")
+	ErrInvalidWorklogUser    = errors.New("worklog user must be 1-100 characters")
+	ErrInvalidEstimate       = errors.New("estimate must be between 0 and 600000 minutes")
+	ErrInvalidReportPeriod   = errors.New("report period must be from <= to and at most 366 days")
+)
+
+// Worklog - списанное на задачу время. Minutes вычитаются из оставшейся
+// оценки задачи, если она задана.
+type Worklog struct {
+	ID      uuid.UUID
+	TaskID  uuid.UUID
+	UserID  string
+	Minutes int
+	// Date - день работы, без времени и часового пояса
+	Date      time.Time
+	Note      *string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewWorklog(taskID uuid.UUID, userID string, minutes int, date time.Time, note *string) (*Worklog, error) {
+	const op = "domain.NewWorklog"
+
+	if userID == "" || len(userID) > maxWorklogUserLen {
+		return nil, errors.Wrap(ErrInvalidWorklogUser, op)
+	}
+
+	now := time.Now().UTC()
+	w := &Worklog{
+		ID:        uuid.New(),
+		TaskID:    taskID,
+		UserID:    userID,
+		CreatedAt: now,
+	}
+	if err := w.Update(minutes, date, note); err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	w.UpdatedAt = now
+
+	return w, nil
+}
+
+// Update меняет запись. Дата может быть завтрашней по UTC, чтобы
+// пользователи восточнее UTC могли списать время за свой сегодняшний день.
+func (w *Worklog) Update(minutes int, date time.Time, note *string) error {
+	if minutes < 1 || minutes > MaxWorklogMinutes {
+		return ErrInvalidWorklogMinutes
+	}
+	date = truncateToDate(date)
+	if date.After(truncateToDate(time.Now().UTC()).AddDate(0, 0, 1)) {
+		return ErrInvalidWorklogDate
+	}
+	if note != nil && utf8.RuneCountInString(*note) > maxWorklogNoteLen {
+		return ErrInvalidWorklogNote
+	}
+
+	w.Minutes = minutes
+	w.Date = date
+	w.Note = note
+	w.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// SetEstimates задает оценки в минутах, nil - оценки нет.
+func (t *Task) SetEstimates(original, remaining *int) error {
+	const op = "domain.Task.SetEstimates"
+
+	for _, e := range []*int{original, remaining} {
+		if e != nil && (*e < 0 || *e > MaxEstimateMinutes) {
+			return errors.Wrap(ErrInvalidEstimate, op)
+		}
+	}
+
+	t.OriginalEstimate = original
+	t.RemainingEstimate = remaining
+	return nil
+}
+
+func truncateToDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// TimeReportFilter - границы отчета по времени, From и To включительно.
+type TimeReportFilter struct {
+	BoardID *uuid.UUID
+	UserID  *string
+	From    time.Time
+	To      time.Time
+}
+
+func NewTimeReportFilter(boardID *uuid.UUID, userID *string, from, to time.Time) (TimeReportFilter, error) {
+	const op = "domain.NewTimeReportFilter"
+
+	from, to = truncateToDate(from), truncateToDate(to)
+	if to.Before(from) || to.Sub(from) >= MaxTimeReportDays*24*time.Hour {
+		return TimeReportFilter{}, errors.Wrap(ErrInvalidReportPeriod, op)
+	}
+
+	return TimeReportFilter{BoardID: boardID, UserID: userID, From: from, To: to}, nil
+}
+
+// TimeReportEntry - запись о времени вместе с задачей, на которую она списана.
+type TimeReportEntry struct {
+	Worklog
+	BoardID   uuid.UUID
+	TaskKey   string
+	TaskTitle string
+}
+
+// TimeTotal - сумма минут по пользователю, задаче (ключ KEY-N) или дню (YYYY-MM-DD).
+type TimeTotal struct {
+	Key     string
+	Minutes int
+}
+
+type TimeReport struct {
+	Filter       TimeReportFilter
+	Entries      []TimeReportEntry
+	TotalMinutes int
+	ByUser       []TimeTotal
+	ByTask       []TimeTotal
+	ByDay        []TimeTotal
+}
+
+// NewTimeReport считает итоги по записям. Итоги по пользователям и задачам
+// идут по убыванию времени, по дням - по дате.
+func NewTimeReport(filter TimeReportFilter, entries []TimeReportEntry) *TimeReport {
+	byUser := make(map[string]int)
+	byTask := make(map[string]int)
+	byDay := make(map[string]int)
+
+	report := &TimeReport{Filter: filter, Entries: entries}
+	for _, e := range entries {
+		report.TotalMinutes += e.Minutes
+		byUser[e.UserID] += e.Minutes
+		byTask[e.TaskKey] += e.Minutes
+		byDay[e.Date.Format(time.DateOnly)] += e.Minutes
+	}
+
+	report.ByUser = sortedTotals(byUser, true)
+	report.ByTask = sortedTotals(byTask, true)
+	report.ByDay = sortedTotals(byDay, false)
+	return report
+}
+
+func sortedTotals(m map[string]int, byMinutes bool) []TimeTotal {
+	totals := make([]TimeTotal, 0, len(m))
+	for k, v := range m {
+		totals = append(totals, TimeTotal{Key: k, Minutes: v})
+	}
+	sort.Slice(totals, func(i, j int) bool {
+		if byMinutes && totals[i].Minutes != totals[j].Minutes {
+			return totals[i].Minutes > totals[j].Minutes
+		}
+		return totals[i].Key < totals[j].Key
+	})
+	return totals
+}
@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWorklog(t *testing.T) {
+	taskID := uuid.New()
+	day := time.Date(2025, 3, 10, 18, 45, 0, 0, time.UTC)
+
+	w, err := NewWorklog(taskID, "alice", 90, day, nil)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC), w.Date)
+	assert.Equal(t, 90, w.Minutes)
+
+	_, err = NewWorklog(taskID, "", 90, day, nil)
+	assert.ErrorIs(t, err, ErrInvalidWorklogUser)
+	_, err = NewWorklog(taskID, "alice", 0, day, nil)
+	assert.ErrorIs(t, err, ErrInvalidWorklogMinutes)
+	_, err = NewWorklog(taskID, "alice", MaxWorklogMinutes+1, day, nil)
+	assert.ErrorIs(t, err, ErrInvalidWorklogMinutes)
+	_, err = NewWorklog(taskID, "alice", 30, time.Now().AddDate(0, 0, 2), nil)
+	assert.ErrorIs(t, err, ErrInvalidWorklogDate)
+	_, err = NewWorklog(taskID, "alice", 30, time.Now().AddDate(0, 0, 1), nil)
+	assert.NoError(t, err)
+
+	note := strings.Repeat("я", maxWorklogNoteLen+1)
+	_, err = NewWorklog(taskID, "alice", 30, day, &note)
+	assert.ErrorIs(t, err, ErrInvalidWorklogNote)
+}
+
+func TestSetEstimates(t *testing.T) {
+	task := &Task{}
+	intPtr := func(v int) *int { return &v }
+
+	require.NoError(t, task.SetEstimates(intPtr(480), intPtr(120)))
+	assert.Equal(t, 480, *task.OriginalEstimate)
+	assert.Equal(t, 120, *task.RemainingEstimate)
+
+	require.NoError(t, task.SetEstimates(nil, intPtr(0)))
+	assert.Nil(t, task.OriginalEstimate)
+
+	assert.ErrorIs(t, task.SetEstimates(intPtr(-1), nil), ErrInvalidEstimate)
+	assert.ErrorIs(t, task.SetEstimates(nil, intPtr(MaxEstimateMinutes+1)), ErrInvalidEstimate)
+}
+
+func TestNewTimeReport(t *testing.T) {
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	filter, err := NewTimeReportFilter(nil, nil, from, from.AddDate(0, 0, 30))
+	require.NoError(t, err)
+
+	entry := func(user, key string, day, minutes int) TimeReportEntry {
+		return TimeReportEntry{
+			Worklog: Worklog{UserID: user, Minutes: minutes, Date: from.AddDate(0, 0, day)},
+			TaskKey: key,
+		}
+	}
+	report := NewTimeReport(filter, []TimeReportEntry{
+		entry("bob", "TEAM-1", 0, 60),
+		entry("alice", "TEAM-2", 0, 30),
+		entry("alice", "TEAM-1", 2, 45),
+	})
+
+	assert.Equal(t, 135, report.TotalMinutes)
+	assert.Equal(t, []TimeTotal{{"TEAM-1", 105}, {"TEAM-2", 30}}, report.ByTask)
+	assert.Equal(t, []TimeTotal{{"alice", 75}, {"bob", 60}}, report.ByUser)
+	assert.Equal(t, []TimeTotal{{"2025-03-01", 90}, {"2025-03-03", 45}}, report.ByDay)
+
+	_, err = NewTimeReportFilter(nil, nil, from, from.AddDate(0, 0, -1))
+	assert.ErrorIs(t, err, ErrInvalidReportPeriod)
+	_, err = NewTimeReportFilter(nil, nil, from, from.AddDate(0, 0, MaxTimeReportDays))
+	assert.ErrorIs(t, err, ErrInvalidReportPeriod)
+	_, err = NewTimeReportFilter(nil, nil, from, from.AddDate(0, 0, MaxTimeReportDays-1))
+	assert.NoError(t, err)
+}
@@ -0,0 +1,275 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"mime"
+	"net/http"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/deleteattachment"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/downloadattachment"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/getattachments"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/uploadattachment"
+	"github.com/gin-gonic/gin"
+)
+
+type (
+	AttachmentResponse struct {
+		ID         string    `json:"id"`
+		TaskID     string    `json:"task_id"`
+		Name       string    `json:"name"`
+		Size       int64     `json:"size"`
+		MimeType   string    `json:"mime_type"`
+		Checksum   string    `json:"checksum"`
+		UploadedBy string    `json:"uploaded_by"`
+		CreatedAt  time.Time `json:"created_at"`
+	}
+
+	GetAttachmentsResponse struct {
+		Attachments []AttachmentResponse `json:"attachments"`
+	}
+
+	UploadAttachmentUseCase interface {
+		Handle(ctx context.Context, cmd uploadattachment.Command) (*domain.Attachment, error)
+	}
+
+	GetAttachmentsUseCase interface {
+		Handle(ctx context.Context, query getattachments.Query) ([]domain.Attachment, error)
+	}
+
+	DownloadAttachmentUseCase interface {
+		Handle(ctx context.Context, query downloadattachment.Query) (*downloadattachment.Result, error)
+	}
+
+	DeleteAttachmentUseCase interface {
+		Handle(ctx context.Context, cmd deleteattachment.Command) error
+	}
+)
+
+func attachmentDomainToDto(a domain.Attachment) AttachmentResponse {
+	return AttachmentResponse{
+		ID:         a.ID.String(),
+		TaskID:     a.TaskID.String(),
+		Name:       a.Name,
+		Size:       a.Size,
+		MimeType:   a.MimeType,
+		Checksum:   a.Checksum,
+		UploadedBy: a.UploadedBy,
+		CreatedAt:  a.CreatedAt,
+	}
+}
+
+// @Summary Загрузить вложение
+// @Description Файл передается в поле file формы multipart/form-data. Тип определяется по содержимому,
+// @Description размер и допустимые типы ограничены настройками сервиса.
+// @Schemes
+// @Tags Attachments
+// @Accept multipart/form-data
+// @Produce json
+// @Param User-ID header string true "ID пользователя"
+// @Param task_id path string true "ID задачи"
+// @Param file formData file true "Файл"
+// @Success 201 {object} AttachmentResponse
+// @Failure     400,404,408,413,415,500,503  {object}  ErrorResponse
+// @Router /v1/tasks/{task_id}/attachments [POST]
+func (h *HttpHandler) UploadAttachment(c *gin.Context) {
+	const op = "handlers.UploadAttachment"
+	log := slog.Default()
+	log.With("op", op)
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		log.Warn("failed to read file", slog.String("err", err.Error()))
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			NewErrorResponse(c, http.StatusRequestEntityTooLarge, "file is too large")
+			return
+		}
+		NewErrorResponse(c, http.StatusBadRequest, "file is required")
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		log.Error("failed to open file", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		return
+	}
+	defer file.Close()
+
+	cmd, err := uploadattachment.NewCommand(c.GetHeader("User-ID"), c.Param("task_id"),
+		fileHeader.Filename, fileHeader.Size, file)
+	if err != nil {
+		log.Warn("failed to create command", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	attachment, err := h.uploadAttachmentUC.Handle(c, cmd)
+	if err != nil {
+		log.Error("failed to upload attachment",
+			slog.String("err", err.Error()),
+			slog.String("task_id", cmd.TaskID.String()))
+
+		switch {
+		case errors.Is(err, uploadattachment.ErrTaskNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "task not found")
+		case errors.Is(err, uploadattachment.ErrFileTooLarge):
+			NewErrorResponse(c, http.StatusRequestEntityTooLarge, "file is too large")
+		case errors.Is(err, uploadattachment.ErrTypeNotAllowed):
+			NewErrorResponse(c, http.StatusUnsupportedMediaType, "file type is not allowed")
+		case errors.Is(err, uploadattachment.ErrInvalidName),
+			errors.Is(err, uploadattachment.ErrEmptyFile):
+			NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, attachmentDomainToDto(*attachment))
+}
+
+// @Summary Получить вложения задачи
+// @Schemes
+// @Tags Attachments
+// @Produce json
+// @Param task_id path string true "ID задачи"
+// @Success 200 {object} GetAttachmentsResponse
+// @Failure     400,404,408,500,503  {object}  ErrorResponse
+// @Router /v1/tasks/{task_id}/attachments [GET]
+func (h *HttpHandler) GetAttachments(c *gin.Context) {
+	const op = "handlers.GetAttachments"
+	log := slog.Default()
+	log.With("op", op)
+
+	query, err := getattachments.NewQuery(c.Param("task_id"))
+	if err != nil {
+		log.Warn("failed to create query", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	attachments, err := h.getAttachmentsUC.Handle(c, query)
+	if err != nil {
+		log.Error("failed to get attachments", slog.String("err", err.Error()))
+
+		switch {
+		case errors.Is(err, getattachments.ErrTaskNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "task not found")
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	resp := GetAttachmentsResponse{Attachments: make([]AttachmentResponse, 0, len(attachments))}
+	for _, a := range attachments {
+		resp.Attachments = append(resp.Attachments, attachmentDomainToDto(a))
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Скачать вложение
+// @Description Поддерживает заголовки Range и If-None-Match, ETag - контрольная сумма SHA-256.
+// @Schemes
+// @Tags Attachments
+// @Produce octet-stream
+// @Param task_id path string true "ID задачи"
+// @Param attachment_id path string true "ID вложения"
+// @Param Range header string false "Диапазон байт, например bytes=0-1023"
+// @Success 200 {file} file
+// @Success 206 {file} file
+// @Failure     400,404,408,416,500,503  {object}  ErrorResponse
+// @Router /v1/tasks/{task_id}/attachments/{attachment_id} [GET]
+func (h *HttpHandler) DownloadAttachment(c *gin.Context) {
+	const op = "handlers.DownloadAttachment"
+	log := slog.Default()
+	log.With("op", op)
+
+	query, err := downloadattachment.NewQuery(c.Param("task_id"), c.Param("attachment_id"))
+	if err != nil {
+		log.Warn("failed to create query", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	res, err := h.downloadAttachmentUC.Handle(c, query)
+	if err != nil {
+		log.Error("failed to download attachment", slog.String("err", err.Error()))
+
+		switch {
+		case errors.Is(err, downloadattachment.ErrAttachmentNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "attachment not found")
+		case errors.Is(err, downloadattachment.ErrContentNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "attachment content not found")
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+	defer res.Content.Close()
+
+	a := res.Attachment
+	c.Header("Content-Type", a.MimeType)
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": a.Name}))
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.Header("ETag", `"`+a.Checksum+`"`)
+
+	http.ServeContent(c.Writer, c.Request, a.Name, a.CreatedAt, res.Content)
+}
+
+// @Summary Удалить вложение
+// @Schemes
+// @Tags Attachments
+// @Param task_id path string true "ID задачи"
+// @Param attachment_id path string true "ID вложения"
+// @Success 204
+// @Failure     400,404,408,500,503  {object}  ErrorResponse
+// @Router /v1/tasks/{task_id}/attachments/{attachment_id} [DELETE]
+func (h *HttpHandler) DeleteAttachment(c *gin.Context) {
+	const op = "handlers.DeleteAttachment"
+	log := slog.Default()
+	log.With("op", op)
+
+	cmd, err := deleteattachment.NewCommand(c.Param("task_id"), c.Param("attachment_id"))
+	if err != nil {
+		log.Warn("failed to create command", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := h.deleteAttachmentUC.Handle(c, cmd); err != nil {
+		log.Error("failed to delete attachment", slog.String("err", err.Error()))
+
+		switch {
+		case errors.Is(err, deleteattachment.ErrAttachmentNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "attachment not found")
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/getboardmetrics"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type (
+	// DurationStatsDto - длительности в часах, перцентили по ближайшему рангу.
+	DurationStatsDto struct {
+		Tasks        int     `json:"tasks"`
+		AverageHours float64 `json:"average_hours"`
+		MinHours     float64 `json:"min_hours"`
+		MaxHours     float64 `json:"max_hours"`
+		P50Hours     float64 `json:"p50_hours"`
+		P85Hours     float64 `json:"p85_hours"`
+		P95Hours     float64 `json:"p95_hours"`
+	}
+
+	ThroughputWeekDto struct {
+		Week  string `json:"week"`
+		From  string `json:"from"`
+		To    string `json:"to"`
+		Tasks int    `json:"tasks"`
+	}
+
+	BoardMetricsResponse struct {
+		BoardID uuid.UUID `json:"board_id"`
+		From    string    `json:"from"`
+		To      string    `json:"to"`
+		// Колонки cycle time, null - по умолчанию
+		StartColumnID  *uuid.UUID          `json:"start_column_id"`
+		EndColumnID    *uuid.UUID          `json:"end_column_id"`
+		CompletedTasks int                 `json:"completed_tasks"`
+		LeadTime       DurationStatsDto    `json:"lead_time"`
+		CycleTime      DurationStatsDto    `json:"cycle_time"`
+		Throughput     []ThroughputWeekDto `json:"throughput"`
+	}
+
+	GetBoardMetricsUseCase interface {
+		Handle(ctx context.Context, query getboardmetrics.Query) (*domain.FlowMetrics, error)
+	}
+)
+
+func hours(d time.Duration) float64 {
+	return math.Round(d.Hours()*100) / 100
+}
+
+func durationStatsToDto(s domain.DurationStats) DurationStatsDto {
+	return DurationStatsDto{
+		Tasks:        s.Tasks,
+		AverageHours: hours(s.Average),
+		MinHours:     hours(s.Min),
+		MaxHours:     hours(s.Max),
+		P50Hours:     hours(s.P50),
+		P85Hours:     hours(s.P85),
+		P95Hours:     hours(s.P95),
+	}
+}
+
+func flowMetricsDomainToResponse(m *domain.FlowMetrics) BoardMetricsResponse {
+	throughput := make([]ThroughputWeekDto, 0, len(m.Throughput))
+	for _, w := range m.Throughput {
+		throughput = append(throughput, ThroughputWeekDto{
+			Week:  w.Label,
+			From:  w.Start.Format(time.DateOnly),
+			To:    w.End.AddDate(0, 0, -1).Format(time.DateOnly),
+			Tasks: w.Tasks,
+		})
+	}
+
+	return BoardMetricsResponse{
+		BoardID:        m.Filter.BoardID,
+		From:           m.Filter.From.Format(time.DateOnly),
+		To:             m.Filter.To.Format(time.DateOnly),
+		StartColumnID:  m.Filter.StartColumnID,
+		EndColumnID:    m.Filter.EndColumnID,
+		CompletedTasks: len(m.Tasks),
+		LeadTime:       durationStatsToDto(m.LeadTime),
+		CycleTime:      durationStatsToDto(m.CycleTime),
+		Throughput:     throughput,
+	}
+}
+
+// @Summary Метрики потока доски
+// @Description Lead time (от появления задачи на доске до завершения), cycle time (от начальной
+// @Description до конечной колонки) и throughput по неделям для задач, завершенных за период.
+// @Description Считается по истории переходов: завершение - первое попадание в конечную колонку
+// @Description или правее. По умолчанию cycle time идет от первой колонки после todo до done-колонки,
+// @Description период - последние 12 недель, не больше 366 дней.
+// @Schemes
+// @Tags Boards
+// @Produce json
+// @Param id path string true "ID доски"
+// @Param from query string false "Начало периода, YYYY-MM-DD"
+// @Param to query string false "Конец периода, YYYY-MM-DD"
+// @Param start_column_id query string false "ID колонки начала cycle time"
+// @Param end_column_id query string false "ID колонки завершения"
+// @Success 200 {object} BoardMetricsResponse
+// @Failure     400,404,408,500,503  {object}  ErrorResponse
+// @Router /v1/boards/{id}/metrics [GET]
+func (h *HttpHandler) GetBoardMetrics(c *gin.Context) {
+	const op = "handlers.GetBoardMetrics"
+	log := slog.Default()
+	log.With("op", op)
+
+	query, err := getboardmetrics.NewQuery(
+		c.Param("id"), c.Query("from"), c.Query("to"), c.Query("start_column_id"), c.Query("end_column_id"),
+	)
+	if err != nil {
+		log.Warn("failed to create query", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	metrics, err := h.getBoardMetricsUC.Handle(c, query)
+	if err != nil {
+		log.Error("failed to get board metrics", slog.String("err", err.Error()))
+
+		switch {
+		case errors.Is(err, getboardmetrics.ErrBoardNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "board not found")
+		case errors.Is(err, getboardmetrics.ErrInvalidColumns):
+			NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, flowMetricsDomainToResponse(metrics))
+}
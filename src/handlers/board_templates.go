@@ -0,0 +1,353 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/createboardfromtemplate"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/createboardtemplate"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/deleteboardtemplate"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/duplicateboard"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type (
+	CreateBoardTemplateRequest struct {
+		BoardID      string  `json:"board_id" binding:"required"`
+		Name         string  `json:"name"`
+		Description  *string `json:"description"`
+		IncludeTasks bool    `json:"include_tasks"`
+	}
+
+	BoardTemplateResponse struct {
+		ID          uuid.UUID                `json:"id"`
+		Name        string                   `json:"name"`
+		Description *string                  `json:"description"`
+		Columns     []BoardTemplateColumnDto `json:"columns"`
+		TasksCount  int                      `json:"tasks_count"`
+		CreatedAt   time.Time                `json:"created_at"`
+		UpdatedAt   time.Time                `json:"updated_at"`
+	}
+
+	BoardTemplateColumnDto struct {
+		Name     string `json:"name"`
+		IsDone   bool   `json:"is_done"`
+		WipLimit *int64 `json:"wip_limit"`
+	}
+
+	GetBoardTemplatesResponse struct {
+		Templates []BoardTemplateResponse `json:"templates"`
+	}
+
+	NewBoardRequest struct {
+		Name      string `json:"name"`
+		ShortName string `json:"short_name"`
+	}
+
+	// NewBoardResponse - доска, созданная по шаблону или копированием
+	NewBoardResponse struct {
+		ID         uuid.UUID        `json:"id"`
+		Name       string           `json:"name"`
+		ShortName  string           `json:"short_name"`
+		Columns    []GetBoardColumn `json:"columns"`
+		TasksCount int              `json:"tasks_count"`
+		CreatedAt  time.Time        `json:"created_at"`
+	}
+
+	CreateBoardTemplateUseCase interface {
+		Handle(ctx context.Context, cmd createboardtemplate.Command) (*domain.BoardTemplate, error)
+	}
+
+	GetBoardTemplatesUseCase interface {
+		Handle(ctx context.Context) ([]domain.BoardTemplate, error)
+	}
+
+	DeleteBoardTemplateUseCase interface {
+		Handle(ctx context.Context, cmd deleteboardtemplate.Command) error
+	}
+
+	CreateBoardFromTemplateUseCase interface {
+		Handle(ctx context.Context, cmd createboardfromtemplate.Command) (*domain.Board, error)
+	}
+
+	DuplicateBoardUseCase interface {
+		Handle(ctx context.Context, cmd duplicateboard.Command) (*domain.Board, error)
+	}
+)
+
+// @Summary Сохранение доски как шаблона
+// @Description Сохраняются колонки доски и, если include_tasks, ее задачи без исполнителей, спринтов и вех.
+// @Schemes
+// @Tags BoardTemplates
+// @Accept json
+// @Produce json
+// @Param createBoardTemplateRequest body CreateBoardTemplateRequest true "request на создание шаблона"
+// @Success 201 {object}  BoardTemplateResponse
+// @Failure     400,404,408,409,500,503  {object}  ErrorResponse
+// @Router /v1/board-templates [POST]
+func (h *HttpHandler) CreateBoardTemplate(c *gin.Context) {
+	const op = "handlers.CreateBoardTemplate"
+	log := slog.Default()
+	log.With("op", op)
+
+	var req CreateBoardTemplateRequest
+	if err := c.BindJSON(&req); err != nil {
+		log.Warn("failed to bind request", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, "bad body")
+		return
+	}
+
+	cmd, err := createboardtemplate.NewCommand(req.BoardID, req.Name, req.Description, req.IncludeTasks)
+	if err != nil {
+		log.Warn("failed to create command", "error", err)
+		switch {
+		case errors.Is(err, createboardtemplate.ErrInvalidUUID):
+			NewErrorResponse(c, http.StatusBadRequest, "invalid board id")
+		default:
+			NewErrorResponse(c, http.StatusBadRequest, "template name must be between 1 and 100 characters")
+		}
+		return
+	}
+
+	tpl, err := h.createBoardTemplateUC.Handle(c.Request.Context(), cmd)
+	if err != nil {
+		log.Error("failed to create board template", "error", err)
+		switch {
+		case errors.Is(err, createboardtemplate.ErrBoardNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "board not found")
+		case errors.Is(err, createboardtemplate.ErrTemplateExists):
+			NewErrorResponse(c, http.StatusConflict, createboardtemplate.ErrTemplateExists.Error())
+		case errors.Is(err, createboardtemplate.ErrValidationFailed):
+			NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		case errors.Is(err, createboardtemplate.ErrCreateBoardTemplateUnknown):
+			NewErrorResponse(c, http.StatusInternalServerError, "failed to create board template")
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, boardTemplateDomainToResponse(tpl))
+}
+
+// @Summary Получение шаблонов досок
+// @Schemes
+// @Tags BoardTemplates
+// @Accept json
+// @Produce json
+// @Success 200 {object}  GetBoardTemplatesResponse
+// @Failure     408,500,503  {object}  ErrorResponse
+// @Router /v1/board-templates [GET]
+func (h *HttpHandler) GetBoardTemplates(c *gin.Context) {
+	const op = "handlers.GetBoardTemplates"
+	log := slog.Default()
+	log.With("op", op)
+
+	templates, err := h.getBoardTemplatesUC.Handle(c.Request.Context())
+	if err != nil {
+		log.Error("failed to get board templates", "error", err)
+		switch {
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "failed to get board templates")
+		}
+		return
+	}
+
+	resp := GetBoardTemplatesResponse{Templates: make([]BoardTemplateResponse, 0, len(templates))}
+	for _, tpl := range templates {
+		resp.Templates = append(resp.Templates, boardTemplateDomainToResponse(&tpl))
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Удаление шаблона доски
+// @Schemes
+// @Tags BoardTemplates
+// @Accept json
+// @Produce json
+// @Param template_id path string true "ID шаблона"
+// @Success 204
+// @Failure     400,404,408,500,503  {object}  ErrorResponse
+// @Router /v1/board-templates/{template_id} [DELETE]
+func (h *HttpHandler) DeleteBoardTemplate(c *gin.Context) {
+	const op = "handlers.DeleteBoardTemplate"
+	log := slog.Default()
+	log.With("op", op)
+
+	cmd, err := deleteboardtemplate.NewCommand(c.Param("template_id"))
+	if err != nil {
+		log.Warn("failed to create command", "error", err)
+		NewErrorResponse(c, http.StatusBadRequest, "invalid template id")
+		return
+	}
+
+	err = h.deleteBoardTemplateUC.Handle(c.Request.Context(), cmd)
+	if err != nil {
+		log.Error("failed to delete board template", "error", err)
+		switch {
+		case errors.Is(err, deleteboardtemplate.ErrTemplateNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "board template not found")
+		case errors.Is(err, deleteboardtemplate.ErrDeleteBoardTemplateUnknown):
+			NewErrorResponse(c, http.StatusInternalServerError, "failed to delete board template")
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+// @Summary Создание доски по шаблону
+// @Schemes
+// @Tags BoardTemplates
+// @Accept json
+// @Produce json
+// @Param template_id path string true "ID шаблона"
+// @Param newBoardRequest body NewBoardRequest true "имя и короткое имя новой доски"
+// @Success 201 {object}  NewBoardResponse
+// @Failure     400,404,408,409,500,503  {object}  ErrorResponse
+// @Router /v1/board-templates/{template_id}/boards [POST]
+func (h *HttpHandler) CreateBoardFromTemplate(c *gin.Context) {
+	const op = "handlers.CreateBoardFromTemplate"
+	log := slog.Default()
+	log.With("op", op)
+
+	var req NewBoardRequest
+	if err := c.BindJSON(&req); err != nil {
+		log.Warn("failed to bind request", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, "bad body")
+		return
+	}
+
+	cmd, err := createboardfromtemplate.NewCommand(c.Param("template_id"), req.Name, req.ShortName)
+	if err != nil {
+		log.Warn("failed to create command", "error", err)
+		NewErrorResponse(c, http.StatusBadRequest, "invalid template id")
+		return
+	}
+
+	board, err := h.createBoardFromTemplateUC.Handle(c.Request.Context(), cmd)
+	if err != nil {
+		log.Error("failed to create board from template", "error", err)
+		switch {
+		case errors.Is(err, createboardfromtemplate.ErrTemplateNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "board template not found")
+		case errors.Is(err, createboardfromtemplate.ErrBoardIsExists):
+			NewErrorResponse(c, http.StatusConflict, createboardfromtemplate.ErrBoardIsExists.Error())
+		case errors.Is(err, createboardfromtemplate.ErrValidationFailed):
+			NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		case errors.Is(err, createboardfromtemplate.ErrCreateBoardFromTemplateUnknown):
+			NewErrorResponse(c, http.StatusInternalServerError, "failed to create board from template")
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, newBoardDomainToResponse(board))
+}
+
+// @Summary Копирование доски
+// @Description Доска копируется одной транзакцией вместе с колонками и задачами, задачи нумеруются заново.
+// @Schemes
+// @Tags Boards
+// @Accept json
+// @Produce json
+// @Param id path string true "ID доски"
+// @Param newBoardRequest body NewBoardRequest true "имя и короткое имя копии"
+// @Success 201 {object}  NewBoardResponse
+// @Failure     400,404,408,409,500,503  {object}  ErrorResponse
+// @Router /v1/boards/{id}/duplicate [POST]
+func (h *HttpHandler) DuplicateBoard(c *gin.Context) {
+	const op = "handlers.DuplicateBoard"
+	log := slog.Default()
+	log.With("op", op)
+
+	var req NewBoardRequest
+	if err := c.BindJSON(&req); err != nil {
+		log.Warn("failed to bind request", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, "bad body")
+		return
+	}
+
+	cmd, err := duplicateboard.NewCommand(c.Param("id"), req.Name, req.ShortName)
+	if err != nil {
+		log.Warn("failed to create command", "error", err)
+		NewErrorResponse(c, http.StatusBadRequest, "invalid board id")
+		return
+	}
+
+	board, err := h.duplicateBoardUC.Handle(c.Request.Context(), cmd)
+	if err != nil {
+		log.Error("failed to duplicate board", "error", err)
+		switch {
+		case errors.Is(err, duplicateboard.ErrBoardNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "board not found")
+		case errors.Is(err, duplicateboard.ErrBoardIsExists):
+			NewErrorResponse(c, http.StatusConflict, duplicateboard.ErrBoardIsExists.Error())
+		case errors.Is(err, duplicateboard.ErrValidationFailed):
+			NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		case errors.Is(err, duplicateboard.ErrDuplicateBoardUnknown):
+			NewErrorResponse(c, http.StatusInternalServerError, "failed to duplicate board")
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, newBoardDomainToResponse(board))
+}
+
+func boardTemplateDomainToResponse(tpl *domain.BoardTemplate) BoardTemplateResponse {
+	columns := make([]BoardTemplateColumnDto, 0, len(tpl.Columns))
+	for _, c := range tpl.Columns {
+		columns = append(columns, BoardTemplateColumnDto{Name: c.Name, IsDone: c.IsDone, WipLimit: c.WipLimit})
+	}
+	return BoardTemplateResponse{
+		ID:          tpl.ID,
+		Name:        tpl.Name,
+		Description: tpl.Description,
+		Columns:     columns,
+		TasksCount:  len(tpl.Tasks),
+		CreatedAt:   tpl.CreatedAt,
+		UpdatedAt:   tpl.UpdatedAt,
+	}
+}
+
+func newBoardDomainToResponse(board *domain.Board) NewBoardResponse {
+	return NewBoardResponse{
+		ID:         board.ID,
+		Name:       board.Name,
+		ShortName:  board.ShortName,
+		Columns:    dtoColumnsToResp(board.Columns),
+		TasksCount: len(board.Tasks),
+		CreatedAt:  board.CreatedAt,
+	}
+}
@@ -0,0 +1,128 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/KungurtsevNII/team-board-back/src/usecase/bulktasks"
+	"github.com/gin-gonic/gin"
+)
+
+type (
+	BulkTasksRequest struct {
+		TaskIDs []string `json:"task_ids" binding:"required"`
+		// Operation - move, add_tags, remove_tags, delete, restore или set_field
+		Operation string `json:"operation" binding:"required"`
+		// ColumnID - целевая колонка для move
+		ColumnID *string `json:"column_id"`
+		// Tags - теги для add_tags и remove_tags
+		Tags []string `json:"tags"`
+		// Field - assignee, priority или lane для set_field
+		Field string `json:"field"`
+		// Value - новое значение для set_field, null очищает поле
+		Value *string `json:"value"`
+		// AllOrNothing - при ошибке хотя бы по одной задаче ничего не сохранять
+		AllOrNothing bool `json:"all_or_nothing"`
+	}
+
+	BulkTaskResultResponse struct {
+		TaskID   string   `json:"task_id"`
+		Status   string   `json:"status"`
+		Error    string   `json:"error,omitempty"`
+		Warnings []string `json:"warnings,omitempty"`
+	}
+
+	BulkTasksResponse struct {
+		Updated    int                      `json:"updated"`
+		Unchanged  int                      `json:"unchanged"`
+		Failed     int                      `json:"failed"`
+		RolledBack int                      `json:"rolled_back"`
+		Results    []BulkTaskResultResponse `json:"results"`
+	}
+
+	BulkTasksUseCase interface {
+		Handle(ctx context.Context, cmd bulktasks.Command) (*bulktasks.Result, error)
+	}
+)
+
+// @Summary Массовая операция над задачами
+// @Description Применяет одну операцию к набору задач в одной транзакции и возвращает результат по каждой задаче.
+// @Description При all_or_nothing ошибка по любой задаче отменяет все изменения (409).
+// @Schemes
+// @Tags Tasks
+// @Accept json
+// @Produce json
+// @Param bulkTasksRequest body BulkTasksRequest true "Задачи и операция"
+// @Success 200 {object} BulkTasksResponse "Результат по задачам"
+// @Failure 409 {object} BulkTasksResponse "Изменения отменены в режиме all_or_nothing"
+// @Failure     400,408,500,503  {object}  ErrorResponse
+// @Router /v1/tasks/bulk [POST]
+func (h *HttpHandler) BulkTasks(c *gin.Context) {
+	const op = "handlers.BulkTasks"
+	log := slog.Default()
+	log.With("op", op)
+
+	var req BulkTasksRequest
+	if err := c.BindJSON(&req); err != nil {
+		log.Warn("failed to bind request", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, "bad body")
+		return
+	}
+
+	cmd, err := bulktasks.NewCommand(
+		req.TaskIDs, req.Operation, req.ColumnID, req.Tags, req.Field, req.Value, req.AllOrNothing)
+	if err != nil {
+		log.Warn("failed to create command", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	res, err := h.bulkTasksUC.Handle(c, cmd)
+	if err != nil {
+		log.Error("failed to apply bulk operation",
+			slog.String("err", err.Error()),
+			slog.String("operation", req.Operation),
+			slog.Int("tasks", len(cmd.TaskIDs)))
+
+		switch {
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	status := http.StatusOK
+	if !res.Succeeded() {
+		status = http.StatusConflict
+	}
+	c.JSON(status, bulkResultToResponse(res))
+}
+
+func bulkResultToResponse(res *bulktasks.Result) BulkTasksResponse {
+	results := make([]BulkTaskResultResponse, 0, len(res.Tasks))
+	for _, t := range res.Tasks {
+		r := BulkTaskResultResponse{
+			TaskID:   t.TaskID.String(),
+			Status:   string(t.Status),
+			Warnings: t.Warnings,
+		}
+		if t.Err != nil {
+			r.Error = t.Err.Error()
+		}
+		results = append(results, r)
+	}
+
+	return BulkTasksResponse{
+		Updated:    res.Updated,
+		Unchanged:  res.Unchanged,
+		Failed:     res.Failed,
+		RolledBack: res.RolledBack,
+		Results:    results,
+	}
+}
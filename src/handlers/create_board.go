@@ -17,16 +17,19 @@ type (
 	CreateBoardReqest struct {
 		Name      string `json:"name"`
 		ShortName string `json:"short_name"`
+		// Columns - имена начальных колонок по порядку, по умолчанию одна колонка TODO
+		Columns []string `json:"columns"`
 	}
 
 	CreateBoardResponce struct {
-		ID        string               `json:"id"`
-		Name      string               `json:"name"`
-		ShortName string               `json:"short_name"`
-		Ccr       CreateColumnResponse `json:"column"`
-		CreatedAt time.Time            `json:"created_at"`
-		UpdatedAt time.Time            `json:"updated_at"`
-		DeletedAt *time.Time           `json:"deleted_at"`
+		ID        string                 `json:"id"`
+		Name      string                 `json:"name"`
+		ShortName string                 `json:"short_name"`
+		Ccr       CreateColumnResponse   `json:"column"`
+		Columns   []CreateColumnResponse `json:"columns"`
+		CreatedAt time.Time              `json:"created_at"`
+		UpdatedAt time.Time              `json:"updated_at"`
+		DeletedAt *time.Time             `json:"deleted_at"`
 	}
 
 	CreateBoardUseCase interface {
@@ -40,8 +43,9 @@ type (
 // @Accept json
 // @Produce json
 // @Param createBoardRequest body CreateBoardReqest true "request на создание доски"
+// @Param Idempotency-Key header string false "Ключ идемпотентности: повтор с тем же ключом вернет первый ответ"
 // @Success 201 {object}  CreateBoardResponce
-// @Failure     400,408,409,500,503  {object}  ErrorResponse
+// @Failure     400,408,409,422,500,503  {object}  ErrorResponse
 // @Router /v1/boards [POST]
 func (h *HttpHandler) CreateBoard(c *gin.Context) {
 	const op = "handlers.CreateBoard"
@@ -53,7 +57,7 @@ func (h *HttpHandler) CreateBoard(c *gin.Context) {
 		NewErrorResponse(c, http.StatusBadRequest, "invalid request")
 		return
 	}
-	cmd, err := createboard.NewCommand(req.Name, req.ShortName)
+	cmd, err := createboard.NewCommand(req.Name, req.ShortName, req.Columns)
 	if err != nil {
 		log.Warn("failed to create command",
 			slog.String("err", err.Error()),
@@ -103,6 +107,19 @@ func (h *HttpHandler) CreateBoard(c *gin.Context) {
 		return
 	}
 
+	columns := make([]CreateColumnResponse, 0, len(board.Columns))
+	for _, col := range board.Columns {
+		columns = append(columns, CreateColumnResponse{
+			ID:        col.ID.String(),
+			BoardID:   col.BoardID.String(),
+			Name:      col.Name,
+			OrderNum:  col.OrderNum,
+			CreatedAt: col.CreatedAt,
+			UpdatedAt: col.UpdatedAt,
+			DeletedAt: col.DeletedAt,
+		})
+	}
+
 	resp := CreateBoardResponce{
 		ID:        board.ID.String(),
 		Name:      board.Name,
@@ -116,6 +133,7 @@ func (h *HttpHandler) CreateBoard(c *gin.Context) {
 			UpdatedAt: column.UpdatedAt,
 			DeletedAt: column.DeletedAt,
 		},
+		Columns:   columns,
 		CreatedAt: board.CreatedAt,
 		UpdatedAt: board.UpdatedAt,
 		DeletedAt: board.DeletedAt,
@@ -15,7 +15,8 @@ import (
 
 type (
 	CreateColumnRequest struct {
-		Name string `json:"name"`
+		Name   string `json:"name"`
+		IsDone bool   `json:"is_done"`
 	}
 
 	CreateColumnResponse struct {
@@ -23,6 +24,8 @@ type (
 		BoardID   string     `json:"board_id"`
 		OrderNum  int64      `json:"order_num"`
 		Name      string     `json:"name"`
+		IsDone    bool       `json:"is_done"`
+		WipLimit  *int64     `json:"wip_limit"`
 		CreatedAt time.Time  `json:"created_at"`
 		UpdatedAt time.Time  `json:"updated_at"`
 		DeletedAt *time.Time `json:"deleted_at"`
@@ -60,7 +63,7 @@ func (h *HttpHandler) CreateColumn(c *gin.Context) {
 		return
 	}
 
-	cmd, err := createcolumn.NewCommand(BoardID, req.Name)
+	cmd, err := createcolumn.NewCommand(BoardID, req.Name, req.IsDone)
 	if err != nil {
 		log.Warn("failed to create command",
 			slog.String("err", err.Error()),
@@ -109,6 +112,8 @@ func (h *HttpHandler) CreateColumn(c *gin.Context) {
 		BoardID:   dmn.BoardID.String(),
 		OrderNum:  dmn.OrderNum,
 		Name:      dmn.Name,
+		IsDone:    dmn.IsDone,
+		WipLimit:  dmn.WipLimit,
 		CreatedAt: dmn.CreatedAt,
 		UpdatedAt: dmn.UpdatedAt,
 		DeletedAt: dmn.DeletedAt,
@@ -21,6 +21,8 @@ type (
 		Description *string        `json:"description"`
 		Tags        []string       `json:"tags"`
 		Checklists  []ChecklistDto `json:"checklists"`
+		// SyncChecklists - чеклист "Description" собирается из "- [ ]" в описании
+		SyncChecklists bool `json:"sync_checklists"`
 	}
 
 	CreateTaskResponse struct {
@@ -32,6 +34,8 @@ type (
 		Description *string        `json:"description"`
 		Tags        []string       `json:"tags"`
 		Checklists  []ChecklistDto `json:"checklists"`
+		Mentions    []string       `json:"mentions"`
+		References  []TaskRefDto   `json:"references"`
 		CreatedAt   time.Time      `json:"created_at"`
 		UpdatedAt   time.Time      `json:"updated_at"`
 		DeletedAt   *time.Time     `json:"deleted_at"`
@@ -60,8 +64,10 @@ type (
 // @Accept json
 // @Produce json
 // @Param createTaskRequest body CreateTaskRequest true "request на создание таски"
+// @Param Idempotency-Key header string false "Ключ идемпотентности: повтор с тем же ключом вернет первый ответ"
+// @Param User-ID header string false "ID автора: становится reporter и подписчиком задачи"
 // @Success 201 {object}  CreateTaskResponse
-// @Failure     400,404,408,500,503  {object}  ErrorResponse
+// @Failure     400,404,408,409,422,500,503  {object}  ErrorResponse
 // @Router /v1/tasks [POST]
 func (h *HttpHandler) CreateTask(c *gin.Context) {
 	const op = "handlers.CreateTask"
@@ -97,6 +103,8 @@ func (h *HttpHandler) CreateTask(c *gin.Context) {
 		req.Description,
 		req.Tags,
 		checkListsDmn,
+		c.GetHeader("User-ID"),
+		req.SyncChecklists,
 	)
 
 	if err != nil {
@@ -159,6 +167,8 @@ func (h *HttpHandler) CreateTask(c *gin.Context) {
 		Description: dmn.Description,
 		Tags:        dmn.Tags,
 		Checklists:  checklistResp,
+		Mentions:    mentionsToDto(dmn),
+		References:  taskRefsDomainToDto(dmn.References),
 		CreatedAt:   dmn.CreatedAt,
 		UpdatedAt:   dmn.UpdatedAt,
 		DeletedAt:   dmn.DeletedAt,
@@ -22,6 +22,7 @@ type (
 // @Accept json
 // @Produce json
 // @Param column_id path string true "ID колонки"
+// @Param move_tasks_to query string false "ID колонки той же доски, куда перенести задачи перед удалением"
 // @Success 204
 // @Failure     400,404,408,409,500,503  {object}  ErrorResponse
 // @Router /v1/columns/{column_id} [DELETE]
@@ -32,7 +33,7 @@ func (h *HttpHandler) DeleteColumn(c *gin.Context) {
 
 	colID := c.Param("column_id")
 
-	cmd, err := deletecolumn.NewCommand(colID)
+	cmd, err := deletecolumn.NewCommand(colID, c.Query("move_tasks_to"))
 	if err != nil {
 		log.Warn("failed to create command", "error", err)
 		NewErrorResponse(c, http.StatusBadRequest, "failed to create command")
@@ -52,6 +53,10 @@ func (h *HttpHandler) DeleteColumn(c *gin.Context) {
 			NewErrorResponse(c, http.StatusInternalServerError, "failed to checking if column is empty")
 		case errors.Is(err, deletecolumn.ErrColumnNotEmpty):
 			NewErrorResponse(c, http.StatusConflict, "column is not empty") //Не уверен что статус 409, но вроде подходит
+		case errors.Is(err, deletecolumn.ErrLastColumn):
+			NewErrorResponse(c, http.StatusConflict, deletecolumn.ErrLastColumn.Error())
+		case errors.Is(err, deletecolumn.ErrTargetColumnNotInBoard):
+			NewErrorResponse(c, http.StatusBadRequest, deletecolumn.ErrTargetColumnNotInBoard.Error())
 		case errors.Is(err, context.Canceled):
 			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
 		case errors.Is(err, context.DeadlineExceeded):
@@ -22,6 +22,7 @@ type (
 // @Accept json
 // @Produce json
 // @Param task_id path string true "ID задачи"
+// @Param children query string false "Что делать с дочерними задачами: orphan (по умолчанию) или cascade"
 // @Success 204
 // @Failure     400,404,408,500,503  {object}  ErrorResponse
 // @Router /v1/tasks/{task_id} [DELETE]
@@ -32,7 +33,7 @@ func (h *HttpHandler) DeleteTask(c *gin.Context) {
 
 	taskID := c.Param("task_id")
 
-	cmd, err := deletetask.NewCommand(taskID)
+	cmd, err := deletetask.NewCommand(taskID, c.Query("children"))
 	if err != nil {
 		log.Warn("failed to create command", "error", err)
 		NewErrorResponse(c, http.StatusBadRequest, "failed to create command")
@@ -5,6 +5,8 @@ import (
 	"errors"
 	"log/slog"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/KungurtsevNII/team-board-back/src/domain"
 	"github.com/KungurtsevNII/team-board-back/src/usecase/getboard"
@@ -13,13 +15,30 @@ import (
 )
 
 type (
-
 	GetBoardBoard struct {
-		ID        uuid.UUID        `json:"id"`
-		Name      string           `json:"name"`
-		ShortName string           `json:"short_name"`
-		Columns   []GetBoardColumn `json:"columns"`
-		Tasks     []GetBoardTask   `json:"tasks"`
+		ID         uuid.UUID        `json:"id"`
+		Name       string           `json:"name"`
+		ShortName  string           `json:"short_name"`
+		ArchivedAt *time.Time       `json:"archived_at,omitempty"`
+		Columns    []GetBoardColumn `json:"columns"`
+		Tasks      []GetBoardTask   `json:"tasks"`
+		Sprint     *SprintResponse  `json:"sprint,omitempty"`
+		GroupBy    string           `json:"group_by,omitempty"`
+		Lanes      []GetBoardLane   `json:"lanes,omitempty"`
+	}
+
+	// GetBoardLane - дорожка доски. Ячейки идут в порядке колонок и ссылаются на задачи из tasks.
+	GetBoardLane struct {
+		Key   string             `json:"key"`
+		Title string             `json:"title"`
+		Total int64              `json:"total"`
+		Cells []GetBoardLaneCell `json:"cells"`
+	}
+
+	GetBoardLaneCell struct {
+		ColumnID uuid.UUID   `json:"column_id"`
+		Count    int64       `json:"count"`
+		TaskIDs  []uuid.UUID `json:"task_ids"`
 	}
 
 	GetBoardColumn struct {
@@ -27,14 +46,22 @@ type (
 		BoardID  uuid.UUID `json:"board_id"`
 		OrderNum int64     `json:"order_num"`
 		Name     string    `json:"name"`
+		IsDone   bool      `json:"is_done"`
+		WipLimit *int64    `json:"wip_limit"`
 	}
 
 	GetBoardTask struct {
-		ID       uuid.UUID `json:"id"`
-		ColumnID uuid.UUID `json:"column_id"`
-		BoardID  uuid.UUID `json:"board_id"`
-		Number   int64     `json:"number"`
-		Title    string    `json:"title"`
+		ID       uuid.UUID  `json:"id"`
+		ColumnID uuid.UUID  `json:"column_id"`
+		BoardID  uuid.UUID  `json:"board_id"`
+		Number   int64      `json:"number"`
+		Title    string     `json:"title"`
+		SprintID *uuid.UUID `json:"sprint_id"`
+		Tags     []string   `json:"tags"`
+		ParentID *uuid.UUID `json:"parent_id"`
+		Assignee *string    `json:"assignee"`
+		Priority *string    `json:"priority"`
+		Lane     *string    `json:"lane"`
 	}
 
 	GetBoardUseCase interface {
@@ -48,6 +75,9 @@ type (
 // @Accept json
 // @Produce json
 // @Param id path string true "User-id in uuid-format"
+// @Param sprint query string false "active - только задачи активного спринта"
+// @Param group_by query string false "группировка в дорожки: assignee, priority, tag, parent, lane"
+// @Param cell_limit query int false "сколько ID задач отдавать в ячейке дорожки, 0 - все"
 // @Success 200 {object}  GetBoardsResponse
 // @Failure     400,404,408,500,503  {object}  ErrorResponse
 // @Router /v1/boards/{id} [GET]
@@ -58,12 +88,36 @@ func (h *HttpHandler) GetBoard(c *gin.Context) {
 	log.Info(c.Request.URL.Path)
 
 	id := c.Param("id")
-	cmd, err := getboard.NewQuery(id)
+	cellLimit := 0
+	if v := c.Query("cell_limit"); v != "" {
+		var err error
+		cellLimit, err = strconv.Atoi(v)
+		if err != nil {
+			log.Warn("failed to parse cell_limit", "err", err)
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": getboard.ErrInvalidCellLimit.Error(),
+			})
+			return
+		}
+	}
+
+	cmd, err := getboard.NewQuery(id, c.Query("sprint"), c.Query("group_by"), cellLimit)
 	if err != nil {
 		log.Warn("failed to create command", "err", err)
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "failed to create command",
-		})
+		switch {
+		case errors.Is(err, getboard.ErrInvalidGroupBy):
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": getboard.ErrInvalidGroupBy.Error(),
+			})
+		case errors.Is(err, getboard.ErrInvalidCellLimit):
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": getboard.ErrInvalidCellLimit.Error(),
+			})
+		default:
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "failed to create command",
+			})
+		}
 		return
 	}
 
@@ -79,6 +133,10 @@ func (h *HttpHandler) GetBoard(c *gin.Context) {
 			c.JSON(http.StatusNotFound, gin.H{
 				"error": getboard.ErrBoardNotFound.Error(),
 			})
+		case errors.Is(err, getboard.ErrNoActiveSprint):
+			c.JSON(http.StatusNotFound, gin.H{
+				"error": getboard.ErrNoActiveSprint.Error(),
+			})
 		case errors.Is(err, getboard.ErrBoardIsNotExists):
 			c.JSON(http.StatusConflict, gin.H{
 				"error": getboard.ErrBoardNotFound.Error(),
@@ -93,11 +151,20 @@ func (h *HttpHandler) GetBoard(c *gin.Context) {
 	tasks := dtoTasksToResp(board.Tasks)
 
 	resp := GetBoardBoard{
-		ID:        board.ID,
-		Name:      board.Name,
-		ShortName: board.ShortName,
-		Columns:   columns,
-		Tasks:     tasks,
+		ID:         board.ID,
+		Name:       board.Name,
+		ShortName:  board.ShortName,
+		ArchivedAt: board.ArchivedAt,
+		Columns:    columns,
+		Tasks:      tasks,
+	}
+	if board.Sprint != nil {
+		sprint := sprintDomainToResponse(board.Sprint)
+		resp.Sprint = &sprint
+	}
+	if cmd.GroupBy != "" {
+		resp.GroupBy = string(cmd.GroupBy)
+		resp.Lanes = lanesDomainToResp(board.Lanes)
 	}
 
 	c.JSON(http.StatusOK, gin.H{
@@ -113,6 +180,8 @@ func dtoColumnsToResp(dtoCol []domain.Column) []GetBoardColumn {
 			BoardID:  col.BoardID,
 			OrderNum: col.OrderNum,
 			Name:     col.Name,
+			IsDone:   col.IsDone,
+			WipLimit: col.WipLimit,
 		}
 	}
 	return columns
@@ -127,7 +196,34 @@ func dtoTasksToResp(dtoTasks []domain.Task) []GetBoardTask {
 			BoardID:  task.BoardID,
 			Number:   task.Number,
 			Title:    task.Title,
+			SprintID: task.SprintID,
+			Tags:     task.Tags,
+			ParentID: task.ParentID,
+			Assignee: task.Assignee,
+			Priority: (*string)(task.Priority),
+			Lane:     task.Lane,
 		}
 	}
 	return tasks
 }
+
+func lanesDomainToResp(lanes []domain.Swimlane) []GetBoardLane {
+	resp := make([]GetBoardLane, 0, len(lanes))
+	for _, lane := range lanes {
+		cells := make([]GetBoardLaneCell, 0, len(lane.Cells))
+		for _, cell := range lane.Cells {
+			cells = append(cells, GetBoardLaneCell{
+				ColumnID: cell.ColumnID,
+				Count:    cell.Count,
+				TaskIDs:  cell.TaskIDs,
+			})
+		}
+		resp = append(resp, GetBoardLane{
+			Key:   lane.Key,
+			Title: lane.Title,
+			Total: lane.Total,
+			Cells: cells,
+		})
+	}
+	return resp
+}
@@ -15,10 +15,11 @@ import (
 
 type (
 	Board struct {
-		ID        uuid.UUID `db:"id" json:"id"`
-		Name      string    `db:"name" json:"name"`
-		ShortName string    `db:"short_name" json:"short_name"`
-		UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+		ID         uuid.UUID  `db:"id" json:"id"`
+		Name       string     `db:"name" json:"name"`
+		ShortName  string     `db:"short_name" json:"short_name"`
+		UpdatedAt  time.Time  `db:"updated_at" json:"updated_at"`
+		ArchivedAt *time.Time `db:"archived_at" json:"archived_at,omitempty"`
 	}
 
 	GetBoardsResponse struct {
@@ -36,6 +37,7 @@ type (
 // @Accept json
 // @Produce json
 // @Param User-id header string true "User-id in uuid-format"
+// @Param include_archived query bool false "true - вместе с архивными досками"
 // @Success 200 {object}  GetBoardsResponse
 // @Failure     400,404,408,500,503  {object}  ErrorResponse
 // @Router /v1/boards [GET]
@@ -47,7 +49,9 @@ func (h *HttpHandler) GetBoards(c *gin.Context) {
 	//TODO принимать offset и limtit из query параметров
 	userID := c.GetHeader("User-ID")
 
-	cmd, err := getboards.NewQuery(userID)
+	includeArchived := c.Query("include_archived") == "true"
+
+	cmd, err := getboards.NewQuery(userID, includeArchived)
 	if err != nil {
 		log.Warn("failed to create query",
 			slog.String("err", err.Error()),
@@ -75,10 +79,11 @@ func (h *HttpHandler) GetBoards(c *gin.Context) {
 	boardsResp := make([]Board, len(boards))
 	for i, board := range boards {
 		boardsResp[i] = Board{
-			ID:        board.ID,
-			Name:      board.Name,
-			ShortName: board.ShortName,
-			UpdatedAt: board.UpdatedAt,
+			ID:         board.ID,
+			Name:       board.Name,
+			ShortName:  board.ShortName,
+			UpdatedAt:  board.UpdatedAt,
+			ArchivedAt: board.ArchivedAt,
 		}
 	}
 
@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/gettags"
+	"github.com/gin-gonic/gin"
+)
+
+type (
+	GetTagsRequest struct {
+		Prefix string `form:"prefix"`
+		Limit  uint   `form:"limit"`
+	}
+
+	TagUsageDto struct {
+		Name  string `json:"name"`
+		Count int64  `json:"count"`
+	}
+
+	GetTagsResponse struct {
+		Tags []TagUsageDto `json:"tags"`
+	}
+
+	GetTagsUseCase interface {
+		Handle(ctx context.Context, q gettags.Query) ([]domain.TagUsage, error)
+	}
+)
+
+// @Summary Теги доски с количеством использований (для автодополнения)
+// @Schemes
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Param id path string true "ID доски"
+// @Param prefix query string false "Префикс тега"
+// @Param limit query int false "Максимум тегов в ответе (по умолчанию 20, не больше 100)"
+// @Success 200 {object}  GetTagsResponse
+// @Failure     400,404,408,500,503  {object}  ErrorResponse
+// @Router /v1/boards/{id}/tags [GET]
+func (h *HttpHandler) GetBoardTags(c *gin.Context) {
+	const op = "handlers.GetBoardTags"
+	log := slog.Default()
+	log.With("op", op)
+
+	var req GetTagsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		log.Warn("failed to bind query", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, "bad query")
+		return
+	}
+
+	qry, err := gettags.NewBoardQuery(c.Param("id"), req.Prefix, req.Limit)
+	if err != nil {
+		log.Warn("failed to create query", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, "invalid board id")
+		return
+	}
+
+	h.handleGetTags(c, qry)
+}
+
+// @Summary Теги всех досок пользователя с количеством использований
+// @Schemes
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Param User-id header string true "User-id in uuid-format"
+// @Param prefix query string false "Префикс тега"
+// @Param limit query int false "Максимум тегов в ответе (по умолчанию 20, не больше 100)"
+// @Success 200 {object}  GetTagsResponse
+// @Failure     400,408,500,503  {object}  ErrorResponse
+// @Router /v1/tags [GET]
+func (h *HttpHandler) GetTags(c *gin.Context) {
+	const op = "handlers.GetTags"
+	log := slog.Default()
+	log.With("op", op)
+
+	var req GetTagsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		log.Warn("failed to bind query", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, "bad query")
+		return
+	}
+
+	userID := c.GetHeader("User-ID")
+	qry, err := gettags.NewUserQuery(userID, req.Prefix, req.Limit)
+	if err != nil {
+		log.Warn("failed to create query",
+			slog.String("err", err.Error()),
+			slog.String("User-id", userID))
+		NewErrorResponse(c, http.StatusBadRequest, "invalid user id")
+		return
+	}
+
+	h.handleGetTags(c, qry)
+}
+
+func (h *HttpHandler) handleGetTags(c *gin.Context, qry gettags.Query) {
+	tags, err := h.getTagsUC.Handle(c.Request.Context(), qry)
+	if err != nil {
+		slog.Default().Error("failed to get tags", slog.String("err", err.Error()))
+		switch {
+		case errors.Is(err, gettags.ErrBoardNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "board not found")
+		case errors.Is(err, gettags.ErrGetTagsUnknown):
+			NewErrorResponse(c, http.StatusInternalServerError, "failed to get tags")
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	resp := GetTagsResponse{Tags: make([]TagUsageDto, 0, len(tags))}
+	for _, tag := range tags {
+		resp.Tags = append(resp.Tags, TagUsageDto{
+			Name:  tag.Name,
+			Count: tag.Count,
+		})
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/KungurtsevNII/team-board-back/src/domain"
 	"github.com/KungurtsevNII/team-board-back/src/usecase/gettask"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 )
 
 type (
@@ -22,11 +23,34 @@ type (
 		Description *string        `json:"description"`
 		Tags        []string       `json:"tags"`
 		Checklists  []ChecklistDto `json:"checklists"`
+		ParentID    *string        `json:"parent_id"`
+		Subtasks    ProgressDto    `json:"subtasks"`
+		Links       []TaskLinkDto  `json:"links"`
+		MilestoneID *uuid.UUID     `json:"milestone_id"`
+		Assignee    *string        `json:"assignee"`
+		Priority    *string        `json:"priority"`
+		Lane        *string        `json:"lane"`
+		Reporter    *string        `json:"reporter"`
+		// Оценки в минутах
+		OriginalEstimateMinutes  *int `json:"original_estimate_minutes"`
+		RemainingEstimateMinutes *int `json:"remaining_estimate_minutes"`
+		StoryPoints              *int `json:"story_points"`
+		// DescriptionHTML - описание из Markdown, очищенное от опасной разметки
+		DescriptionHTML    *string `json:"description_html"`
+		DescriptionExcerpt *string `json:"description_excerpt"`
+		Mentions    []string       `json:"mentions"`
+		References  []TaskRefDto   `json:"references"`
 		CreatedAt   time.Time      `json:"created_at"`
 		UpdatedAt   time.Time      `json:"updated_at"`
 		DeletedAt   *time.Time     `json:"deleted_at"`
 	}
 
+	ProgressDto struct {
+		Total   int64 `json:"total"`
+		Done    int64 `json:"done"`
+		Percent int64 `json:"percent"`
+	}
+
 	GetTaskUseCase interface {
 		Handle(ctx context.Context, query gettask.GetTaskQuery) (*domain.Task, error)
 	}
@@ -97,6 +121,24 @@ func taskDomainToGetTaskResponse(task *domain.Task) *GetTaskResponse {
 			Items: checklistItemsResp,
 		})
 	}
+	var parentID *string
+	if task.ParentID != nil {
+		id := task.ParentID.String()
+		parentID = &id
+	}
+	var subtasks ProgressDto
+	if task.Subtasks != nil {
+		subtasks = ProgressDto{
+			Total:   task.Subtasks.Total,
+			Done:    task.Subtasks.Done,
+			Percent: task.Subtasks.Percent(),
+		}
+	}
+	var descriptionHTML, descriptionExcerpt *string
+	if task.DescriptionRendered != nil {
+		descriptionHTML = &task.DescriptionRendered.HTML
+		descriptionExcerpt = &task.DescriptionRendered.Excerpt
+	}
 	return &GetTaskResponse{
 		ID:          task.ID.String(),
 		ColumnID:    task.ColumnID.String(),
@@ -106,8 +148,47 @@ func taskDomainToGetTaskResponse(task *domain.Task) *GetTaskResponse {
 		Description: task.Description,
 		Tags:        task.Tags,
 		Checklists:  checklistResp,
+		ParentID:    parentID,
+		Subtasks:    subtasks,
+		Links:       taskLinksDomainToDto(task.Links, task.ID),
+		MilestoneID: task.MilestoneID,
+		Assignee:    task.Assignee,
+		Priority:    (*string)(task.Priority),
+		Lane:        task.Lane,
+		Reporter:    task.Reporter,
+		OriginalEstimateMinutes:  task.OriginalEstimate,
+		RemainingEstimateMinutes: task.RemainingEstimate,
+		StoryPoints:              task.StoryPoints,
+		DescriptionHTML:    descriptionHTML,
+		DescriptionExcerpt: descriptionExcerpt,
+		Mentions:    mentionsToDto(task),
+		References:  taskRefsDomainToDto(task.References),
 		CreatedAt:   task.CreatedAt,
 		UpdatedAt:   task.UpdatedAt,
 		DeletedAt:   task.DeletedAt,
 	}
-}
\ No newline at end of file
+}
+
+// TaskRefDto - ссылка #KEY-N из описания. TaskID и Title пустые, если задача
+// с таким ключом не найдена.
+type TaskRefDto struct {
+	Key    string     `json:"key"`
+	TaskID *uuid.UUID `json:"task_id"`
+	Title  *string    `json:"title"`
+}
+
+func taskRefsDomainToDto(refs []domain.TaskRef) []TaskRefDto {
+	resp := make([]TaskRefDto, 0, len(refs))
+	for _, ref := range refs {
+		resp = append(resp, TaskRefDto{Key: ref.Key(), TaskID: ref.TaskID, Title: ref.Title})
+	}
+	return resp
+}
+
+func mentionsToDto(task *domain.Task) []string {
+	mentions := task.Mentions()
+	if mentions == nil {
+		return []string{}
+	}
+	return mentions
+}
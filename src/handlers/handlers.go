@@ -22,6 +22,72 @@ type HttpHandler struct {
 	searchTasksUC      SearchTasksUseCase
 	moveTaskUC     MoveTaskUseCase
 	putTaskUC PutTaskUseCase
+	getTagsUC      GetTagsUseCase
+	getSubtasksUC   GetSubtasksUseCase
+	attachSubtaskUC AttachSubtaskUseCase
+	detachSubtaskUC DetachSubtaskUseCase
+	createTaskLinkUC CreateTaskLinkUseCase
+	getTaskLinksUC   GetTaskLinksUseCase
+	deleteTaskLinkUC DeleteTaskLinkUseCase
+	putColumnUC      PutColumnUseCase
+	createMilestoneUC CreateMilestoneUseCase
+	getMilestonesUC GetMilestonesUseCase
+	getMilestoneUC GetMilestoneUseCase
+	putMilestoneUC PutMilestoneUseCase
+	deleteMilestoneUC DeleteMilestoneUseCase
+	getMilestoneProgressUC GetMilestoneProgressUseCase
+	setTaskMilestoneUC SetTaskMilestoneUseCase
+	createSprintUC CreateSprintUseCase
+	getSprintsUC GetSprintsUseCase
+	putSprintUC PutSprintUseCase
+	startSprintUC StartSprintUseCase
+	closeSprintUC CloseSprintUseCase
+	addSprintTaskUC AddSprintTaskUseCase
+	removeSprintTaskUC RemoveSprintTaskUseCase
+	createBoardTemplateUC CreateBoardTemplateUseCase
+	getBoardTemplatesUC GetBoardTemplatesUseCase
+	deleteBoardTemplateUC DeleteBoardTemplateUseCase
+	createBoardFromTemplateUC CreateBoardFromTemplateUseCase
+	duplicateBoardUC DuplicateBoardUseCase
+	getTrashBoardsUC GetTrashBoardsUseCase
+	getTrashUC GetTrashUseCase
+	restoreBoardUC RestoreBoardUseCase
+	restoreColumnUC RestoreColumnUseCase
+	restoreTaskUC RestoreTaskUseCase
+	archiveBoardUC ArchiveBoardUseCase
+	moveTaskToBoardUC MoveTaskToBoardUseCase
+	resolveTaskKeyUC ResolveTaskKeyUseCase
+	getTaskHistoryUC GetTaskHistoryUseCase
+	bulkTasksUC BulkTasksUseCase
+	createWebhookUC CreateWebhookUseCase
+	getWebhooksUC GetWebhooksUseCase
+	deleteWebhookUC DeleteWebhookUseCase
+	getWebhookDeliveriesUC GetWebhookDeliveriesUseCase
+	redeliverWebhookUC RedeliverWebhookUseCase
+	getNotificationsUC GetNotificationsUseCase
+	countUnreadNotificationsUC CountUnreadNotificationsUseCase
+	readNotificationUC ReadNotificationUseCase
+	readAllNotificationsUC ReadAllNotificationsUseCase
+	getEmailPreferenceUC GetEmailPreferenceUseCase
+	putEmailPreferenceUC PutEmailPreferenceUseCase
+	watchUC WatchUseCase
+	unwatchUC UnwatchUseCase
+	getWatchersUC GetWatchersUseCase
+	uploadAttachmentUC UploadAttachmentUseCase
+	getAttachmentsUC GetAttachmentsUseCase
+	downloadAttachmentUC DownloadAttachmentUseCase
+	deleteAttachmentUC DeleteAttachmentUseCase
+	addWorklogUC AddWorklogUseCase
+	getWorklogsUC GetWorklogsUseCase
+	putWorklogUC PutWorklogUseCase
+	deleteWorklogUC DeleteWorklogUseCase
+	setTaskEstimateUC SetTaskEstimateUseCase
+	getTimeReportUC GetTimeReportUseCase
+	getStoryPointScaleUC GetStoryPointScaleUseCase
+	setStoryPointScaleUC SetStoryPointScaleUseCase
+	setTaskStoryPointsUC SetTaskStoryPointsUseCase
+	getVelocityUC GetVelocityUseCase
+	getBoardMetricsUC GetBoardMetricsUseCase
 }
 
 func NewHttpHandler(
@@ -39,6 +105,72 @@ func NewHttpHandler(
 	searchTasksUC SearchTasksUseCase,
 	moveTaskUC MoveTaskUseCase,
 	putTaskUC PutTaskUseCase,
+	getTagsUC GetTagsUseCase,
+	getSubtasksUC GetSubtasksUseCase,
+	attachSubtaskUC AttachSubtaskUseCase,
+	detachSubtaskUC DetachSubtaskUseCase,
+	createTaskLinkUC CreateTaskLinkUseCase,
+	getTaskLinksUC GetTaskLinksUseCase,
+	deleteTaskLinkUC DeleteTaskLinkUseCase,
+	putColumnUC PutColumnUseCase,
+	createMilestoneUC CreateMilestoneUseCase,
+	getMilestonesUC GetMilestonesUseCase,
+	getMilestoneUC GetMilestoneUseCase,
+	putMilestoneUC PutMilestoneUseCase,
+	deleteMilestoneUC DeleteMilestoneUseCase,
+	getMilestoneProgressUC GetMilestoneProgressUseCase,
+	setTaskMilestoneUC SetTaskMilestoneUseCase,
+	createSprintUC CreateSprintUseCase,
+	getSprintsUC GetSprintsUseCase,
+	putSprintUC PutSprintUseCase,
+	startSprintUC StartSprintUseCase,
+	closeSprintUC CloseSprintUseCase,
+	addSprintTaskUC AddSprintTaskUseCase,
+	removeSprintTaskUC RemoveSprintTaskUseCase,
+	createBoardTemplateUC CreateBoardTemplateUseCase,
+	getBoardTemplatesUC GetBoardTemplatesUseCase,
+	deleteBoardTemplateUC DeleteBoardTemplateUseCase,
+	createBoardFromTemplateUC CreateBoardFromTemplateUseCase,
+	duplicateBoardUC DuplicateBoardUseCase,
+	getTrashBoardsUC GetTrashBoardsUseCase,
+	getTrashUC GetTrashUseCase,
+	restoreBoardUC RestoreBoardUseCase,
+	restoreColumnUC RestoreColumnUseCase,
+	restoreTaskUC RestoreTaskUseCase,
+	archiveBoardUC ArchiveBoardUseCase,
+	moveTaskToBoardUC MoveTaskToBoardUseCase,
+	resolveTaskKeyUC ResolveTaskKeyUseCase,
+	getTaskHistoryUC GetTaskHistoryUseCase,
+	bulkTasksUC BulkTasksUseCase,
+	createWebhookUC CreateWebhookUseCase,
+	getWebhooksUC GetWebhooksUseCase,
+	deleteWebhookUC DeleteWebhookUseCase,
+	getWebhookDeliveriesUC GetWebhookDeliveriesUseCase,
+	redeliverWebhookUC RedeliverWebhookUseCase,
+	getNotificationsUC GetNotificationsUseCase,
+	countUnreadNotificationsUC CountUnreadNotificationsUseCase,
+	readNotificationUC ReadNotificationUseCase,
+	readAllNotificationsUC ReadAllNotificationsUseCase,
+	getEmailPreferenceUC GetEmailPreferenceUseCase,
+	putEmailPreferenceUC PutEmailPreferenceUseCase,
+	watchUC WatchUseCase,
+	unwatchUC UnwatchUseCase,
+	getWatchersUC GetWatchersUseCase,
+	uploadAttachmentUC UploadAttachmentUseCase,
+	getAttachmentsUC GetAttachmentsUseCase,
+	downloadAttachmentUC DownloadAttachmentUseCase,
+	deleteAttachmentUC DeleteAttachmentUseCase,
+	addWorklogUC AddWorklogUseCase,
+	getWorklogsUC GetWorklogsUseCase,
+	putWorklogUC PutWorklogUseCase,
+	deleteWorklogUC DeleteWorklogUseCase,
+	setTaskEstimateUC SetTaskEstimateUseCase,
+	getTimeReportUC GetTimeReportUseCase,
+	getStoryPointScaleUC GetStoryPointScaleUseCase,
+	setStoryPointScaleUC SetStoryPointScaleUseCase,
+	setTaskStoryPointsUC SetTaskStoryPointsUseCase,
+	getVelocityUC GetVelocityUseCase,
+	getBoardMetricsUC GetBoardMetricsUseCase,
 ) *HttpHandler {
 	return &HttpHandler{
 		cfg:            cfg,
@@ -54,6 +186,72 @@ func NewHttpHandler(
 		searchTasksUC:  searchTasksUC,
 		moveTaskUC:     moveTaskUC,
 		putTaskUC: putTaskUC,
+		getTagsUC:      getTagsUC,
+		getSubtasksUC:   getSubtasksUC,
+		attachSubtaskUC: attachSubtaskUC,
+		detachSubtaskUC: detachSubtaskUC,
+		createTaskLinkUC: createTaskLinkUC,
+		getTaskLinksUC:   getTaskLinksUC,
+		deleteTaskLinkUC: deleteTaskLinkUC,
+		putColumnUC:      putColumnUC,
+		createMilestoneUC: createMilestoneUC,
+		getMilestonesUC: getMilestonesUC,
+		getMilestoneUC: getMilestoneUC,
+		putMilestoneUC: putMilestoneUC,
+		deleteMilestoneUC: deleteMilestoneUC,
+		getMilestoneProgressUC: getMilestoneProgressUC,
+		setTaskMilestoneUC: setTaskMilestoneUC,
+		createSprintUC: createSprintUC,
+		getSprintsUC: getSprintsUC,
+		putSprintUC: putSprintUC,
+		startSprintUC: startSprintUC,
+		closeSprintUC: closeSprintUC,
+		addSprintTaskUC: addSprintTaskUC,
+		removeSprintTaskUC: removeSprintTaskUC,
+		createBoardTemplateUC: createBoardTemplateUC,
+		getBoardTemplatesUC: getBoardTemplatesUC,
+		deleteBoardTemplateUC: deleteBoardTemplateUC,
+		createBoardFromTemplateUC: createBoardFromTemplateUC,
+		duplicateBoardUC: duplicateBoardUC,
+		getTrashBoardsUC: getTrashBoardsUC,
+		getTrashUC: getTrashUC,
+		restoreBoardUC: restoreBoardUC,
+		restoreColumnUC: restoreColumnUC,
+		restoreTaskUC: restoreTaskUC,
+		archiveBoardUC: archiveBoardUC,
+		moveTaskToBoardUC: moveTaskToBoardUC,
+		resolveTaskKeyUC: resolveTaskKeyUC,
+		getTaskHistoryUC: getTaskHistoryUC,
+		bulkTasksUC: bulkTasksUC,
+		createWebhookUC: createWebhookUC,
+		getWebhooksUC: getWebhooksUC,
+		deleteWebhookUC: deleteWebhookUC,
+		getWebhookDeliveriesUC: getWebhookDeliveriesUC,
+		redeliverWebhookUC: redeliverWebhookUC,
+		getNotificationsUC: getNotificationsUC,
+		countUnreadNotificationsUC: countUnreadNotificationsUC,
+		readNotificationUC: readNotificationUC,
+		readAllNotificationsUC: readAllNotificationsUC,
+		getEmailPreferenceUC: getEmailPreferenceUC,
+		putEmailPreferenceUC: putEmailPreferenceUC,
+		watchUC: watchUC,
+		unwatchUC: unwatchUC,
+		getWatchersUC: getWatchersUC,
+		uploadAttachmentUC: uploadAttachmentUC,
+		getAttachmentsUC: getAttachmentsUC,
+		downloadAttachmentUC: downloadAttachmentUC,
+		deleteAttachmentUC: deleteAttachmentUC,
+		addWorklogUC: addWorklogUC,
+		getWorklogsUC: getWorklogsUC,
+		putWorklogUC: putWorklogUC,
+		deleteWorklogUC: deleteWorklogUC,
+		setTaskEstimateUC: setTaskEstimateUC,
+		getTimeReportUC: getTimeReportUC,
+		getStoryPointScaleUC: getStoryPointScaleUC,
+		setStoryPointScaleUC: setStoryPointScaleUC,
+		setTaskStoryPointsUC: setTaskStoryPointsUC,
+		getVelocityUC: getVelocityUC,
+		getBoardMetricsUC: getBoardMetricsUC,
 	}
 }
 
@@ -0,0 +1,493 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/createmilestone"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/deletemilestone"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/getmilestone"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/getmilestoneprogress"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/getmilestones"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/putmilestone"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/settaskmilestone"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type (
+	CreateMilestoneRequest struct {
+		Title       string  `json:"title"`
+		Description *string `json:"description"`
+		TargetDate  *string `json:"target_date" example:"2026-03-31"`
+	}
+
+	PutMilestoneRequest struct {
+		Title       string  `json:"title"`
+		Description *string `json:"description"`
+		TargetDate  *string `json:"target_date" example:"2026-03-31"`
+		State       string  `json:"state" example:"open"`
+	}
+
+	MilestoneResponse struct {
+		ID          uuid.UUID  `json:"id"`
+		Title       string     `json:"title"`
+		Description *string    `json:"description"`
+		TargetDate  *string    `json:"target_date"`
+		State       string     `json:"state"`
+		CreatedAt   time.Time  `json:"created_at"`
+		UpdatedAt   time.Time  `json:"updated_at"`
+		DeletedAt   *time.Time `json:"deleted_at"`
+	}
+
+	GetMilestonesResponse struct {
+		Milestones []MilestoneResponse `json:"milestones"`
+	}
+
+	CategoryCountsDto struct {
+		Todo       int64 `json:"todo"`
+		InProgress int64 `json:"in_progress"`
+		Done       int64 `json:"done"`
+		Total      int64 `json:"total"`
+		Percent    int64 `json:"percent"`
+	}
+
+	BoardProgressDto struct {
+		BoardID   uuid.UUID `json:"board_id"`
+		BoardName string    `json:"board_name"`
+		CategoryCountsDto
+	}
+
+	MilestoneProgressResponse struct {
+		MilestoneID uuid.UUID          `json:"milestone_id"`
+		Boards      []BoardProgressDto `json:"boards"`
+		CategoryCountsDto
+	}
+
+	SetTaskMilestoneRequest struct {
+		MilestoneID *string `json:"milestone_id"`
+	}
+
+	SetTaskMilestoneResponse struct {
+		ID          uuid.UUID  `json:"id"`
+		MilestoneID *uuid.UUID `json:"milestone_id"`
+	}
+
+	CreateMilestoneUseCase interface {
+		Handle(ctx context.Context, cmd createmilestone.Command) (*domain.Milestone, error)
+	}
+
+	GetMilestoneUseCase interface {
+		Handle(ctx context.Context, q getmilestone.Query) (*domain.Milestone, error)
+	}
+
+	GetMilestonesUseCase interface {
+		Handle(ctx context.Context, q getmilestones.Query) ([]domain.Milestone, error)
+	}
+
+	PutMilestoneUseCase interface {
+		Handle(ctx context.Context, cmd putmilestone.Command) (*domain.Milestone, error)
+	}
+
+	DeleteMilestoneUseCase interface {
+		Handle(ctx context.Context, cmd deletemilestone.Command) error
+	}
+
+	GetMilestoneProgressUseCase interface {
+		Handle(ctx context.Context, q getmilestoneprogress.Query) (*domain.MilestoneProgress, error)
+	}
+
+	SetTaskMilestoneUseCase interface {
+		Handle(ctx context.Context, cmd settaskmilestone.Command) (*domain.Task, error)
+	}
+)
+
+// @Summary Создание milestone
+// @Schemes
+// @Tags Milestones
+// @Accept json
+// @Produce json
+// @Param createMilestoneRequest body CreateMilestoneRequest true "request на создание milestone"
+// @Success 201 {object}  MilestoneResponse
+// @Failure     400,408,500,503  {object}  ErrorResponse
+// @Router /v1/milestones [POST]
+func (h *HttpHandler) CreateMilestone(c *gin.Context) {
+	const op = "handlers.CreateMilestone"
+	log := slog.Default()
+	log.With("op", op)
+
+	var req CreateMilestoneRequest
+	if err := c.BindJSON(&req); err != nil {
+		log.Warn("failed to bind request", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, "bad body")
+		return
+	}
+
+	cmd, err := createmilestone.NewCommand(req.Title, req.Description, req.TargetDate)
+	if err != nil {
+		log.Warn("failed to create command", "error", err)
+		switch {
+		case errors.Is(err, createmilestone.ErrInvalidTargetDate):
+			NewErrorResponse(c, http.StatusBadRequest, "invalid target date, expected YYYY-MM-DD")
+		default:
+			NewErrorResponse(c, http.StatusBadRequest, "validation failed")
+		}
+		return
+	}
+
+	dmn, err := h.createMilestoneUC.Handle(c.Request.Context(), cmd)
+	if err != nil {
+		log.Error("failed to create milestone", "error", err)
+		switch {
+		case errors.Is(err, createmilestone.ErrValidationFailed):
+			NewErrorResponse(c, http.StatusBadRequest, "validation failed")
+		case errors.Is(err, createmilestone.ErrCreateMilestoneUnknown):
+			NewErrorResponse(c, http.StatusInternalServerError, "failed to create milestone")
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, milestoneDomainToResponse(dmn))
+}
+
+// @Summary Получение списка milestones
+// @Schemes
+// @Tags Milestones
+// @Accept json
+// @Produce json
+// @Param state query string false "Фильтр по состоянию: open, closed"
+// @Success 200 {object}  GetMilestonesResponse
+// @Failure     400,408,500,503  {object}  ErrorResponse
+// @Router /v1/milestones [GET]
+func (h *HttpHandler) GetMilestones(c *gin.Context) {
+	const op = "handlers.GetMilestones"
+	log := slog.Default()
+	log.With("op", op)
+
+	qry, err := getmilestones.NewQuery(c.Query("state"))
+	if err != nil {
+		log.Warn("failed to create query", "error", err)
+		NewErrorResponse(c, http.StatusBadRequest, "invalid milestone state")
+		return
+	}
+
+	milestones, err := h.getMilestonesUC.Handle(c.Request.Context(), qry)
+	if err != nil {
+		log.Error("failed to get milestones", "error", err)
+		switch {
+		case errors.Is(err, getmilestones.ErrGetMilestonesUnknown):
+			NewErrorResponse(c, http.StatusInternalServerError, "failed to get milestones")
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	resp := GetMilestonesResponse{Milestones: make([]MilestoneResponse, 0, len(milestones))}
+	for _, m := range milestones {
+		resp.Milestones = append(resp.Milestones, milestoneDomainToResponse(&m))
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Получение milestone по ID
+// @Schemes
+// @Tags Milestones
+// @Accept json
+// @Produce json
+// @Param milestone_id path string true "ID milestone"
+// @Success 200 {object}  MilestoneResponse
+// @Failure     400,404,408,500,503  {object}  ErrorResponse
+// @Router /v1/milestones/{milestone_id} [GET]
+func (h *HttpHandler) GetMilestone(c *gin.Context) {
+	const op = "handlers.GetMilestone"
+	log := slog.Default()
+	log.With("op", op)
+
+	qry, err := getmilestone.NewQuery(c.Param("milestone_id"))
+	if err != nil {
+		log.Warn("failed to create query", "error", err)
+		NewErrorResponse(c, http.StatusBadRequest, "invalid milestone id")
+		return
+	}
+
+	dmn, err := h.getMilestoneUC.Handle(c.Request.Context(), qry)
+	if err != nil {
+		log.Error("failed to get milestone", "error", err)
+		switch {
+		case errors.Is(err, getmilestone.ErrMilestoneNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "milestone not found")
+		case errors.Is(err, getmilestone.ErrGetMilestoneUnknown):
+			NewErrorResponse(c, http.StatusInternalServerError, "failed to get milestone")
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, milestoneDomainToResponse(dmn))
+}
+
+// @Summary Изменение milestone
+// @Schemes
+// @Tags Milestones
+// @Accept json
+// @Produce json
+// @Param milestone_id path string true "ID milestone"
+// @Param putMilestoneRequest body PutMilestoneRequest true "request на изменение milestone"
+// @Success 200 {object}  MilestoneResponse
+// @Failure     400,404,408,500,503  {object}  ErrorResponse
+// @Router /v1/milestones/{milestone_id} [PUT]
+func (h *HttpHandler) PutMilestone(c *gin.Context) {
+	const op = "handlers.PutMilestone"
+	log := slog.Default()
+	log.With("op", op)
+
+	var req PutMilestoneRequest
+	if err := c.BindJSON(&req); err != nil {
+		log.Warn("failed to bind request", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, "bad body")
+		return
+	}
+
+	cmd, err := putmilestone.NewCommand(c.Param("milestone_id"), req.Title, req.Description, req.TargetDate, req.State)
+	if err != nil {
+		log.Warn("failed to create command", "error", err)
+		switch {
+		case errors.Is(err, putmilestone.ErrInvalidUUID):
+			NewErrorResponse(c, http.StatusBadRequest, "invalid milestone id")
+		case errors.Is(err, putmilestone.ErrInvalidTargetDate):
+			NewErrorResponse(c, http.StatusBadRequest, "invalid target date, expected YYYY-MM-DD")
+		default:
+			NewErrorResponse(c, http.StatusBadRequest, "validation failed")
+		}
+		return
+	}
+
+	dmn, err := h.putMilestoneUC.Handle(c.Request.Context(), cmd)
+	if err != nil {
+		log.Error("failed to put milestone", "error", err)
+		switch {
+		case errors.Is(err, putmilestone.ErrMilestoneNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "milestone not found")
+		case errors.Is(err, putmilestone.ErrValidationFailed):
+			NewErrorResponse(c, http.StatusBadRequest, "validation failed")
+		case errors.Is(err, putmilestone.ErrPutMilestoneUnknown):
+			NewErrorResponse(c, http.StatusInternalServerError, "failed to update milestone")
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, milestoneDomainToResponse(dmn))
+}
+
+// @Summary Удаление milestone
+// @Description Задачи отвязываются от удаленного milestone.
+// @Schemes
+// @Tags Milestones
+// @Accept json
+// @Produce json
+// @Param milestone_id path string true "ID milestone"
+// @Success 204
+// @Failure     400,404,408,500,503  {object}  ErrorResponse
+// @Router /v1/milestones/{milestone_id} [DELETE]
+func (h *HttpHandler) DeleteMilestone(c *gin.Context) {
+	const op = "handlers.DeleteMilestone"
+	log := slog.Default()
+	log.With("op", op)
+
+	cmd, err := deletemilestone.NewCommand(c.Param("milestone_id"))
+	if err != nil {
+		log.Warn("failed to create command", "error", err)
+		NewErrorResponse(c, http.StatusBadRequest, "invalid milestone id")
+		return
+	}
+
+	err = h.deleteMilestoneUC.Handle(c.Request.Context(), cmd)
+	if err != nil {
+		log.Error("failed to delete milestone", "error", err)
+		switch {
+		case errors.Is(err, deletemilestone.ErrMilestoneNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "milestone not found")
+		case errors.Is(err, deletemilestone.ErrDeleteMilestoneUnknown):
+			NewErrorResponse(c, http.StatusInternalServerError, "failed to delete milestone")
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+// @Summary Прогресс milestone
+// @Description Количество задач milestone по стадиям колонок (todo, in_progress, done) в разрезе досок.
+// @Schemes
+// @Tags Milestones
+// @Accept json
+// @Produce json
+// @Param milestone_id path string true "ID milestone"
+// @Success 200 {object}  MilestoneProgressResponse
+// @Failure     400,404,408,500,503  {object}  ErrorResponse
+// @Router /v1/milestones/{milestone_id}/progress [GET]
+func (h *HttpHandler) GetMilestoneProgress(c *gin.Context) {
+	const op = "handlers.GetMilestoneProgress"
+	log := slog.Default()
+	log.With("op", op)
+
+	qry, err := getmilestoneprogress.NewQuery(c.Param("milestone_id"))
+	if err != nil {
+		log.Warn("failed to create query", "error", err)
+		NewErrorResponse(c, http.StatusBadRequest, "invalid milestone id")
+		return
+	}
+
+	progress, err := h.getMilestoneProgressUC.Handle(c.Request.Context(), qry)
+	if err != nil {
+		log.Error("failed to get milestone progress", "error", err)
+		switch {
+		case errors.Is(err, getmilestoneprogress.ErrMilestoneNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "milestone not found")
+		case errors.Is(err, getmilestoneprogress.ErrGetProgressUnknown):
+			NewErrorResponse(c, http.StatusInternalServerError, "failed to get milestone progress")
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	resp := MilestoneProgressResponse{
+		MilestoneID:       progress.MilestoneID,
+		Boards:            make([]BoardProgressDto, 0, len(progress.Boards)),
+		CategoryCountsDto: categoryCountsToDto(progress.CategoryCounts),
+	}
+	for _, b := range progress.Boards {
+		resp.Boards = append(resp.Boards, BoardProgressDto{
+			BoardID:           b.BoardID,
+			BoardName:         b.BoardName,
+			CategoryCountsDto: categoryCountsToDto(b.CategoryCounts),
+		})
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Привязка задачи к milestone
+// @Description milestone_id = null отвязывает задачу.
+// @Schemes
+// @Tags Tasks
+// @Accept json
+// @Produce json
+// @Param task_id path string true "ID задачи"
+// @Param setTaskMilestoneRequest body SetTaskMilestoneRequest true "request на привязку к milestone"
+// @Success 200 {object}  SetTaskMilestoneResponse
+// @Failure     400,404,408,409,500,503  {object}  ErrorResponse
+// @Router /v1/tasks/{task_id}/milestone [PUT]
+func (h *HttpHandler) SetTaskMilestone(c *gin.Context) {
+	const op = "handlers.SetTaskMilestone"
+	log := slog.Default()
+	log.With("op", op)
+
+	var req SetTaskMilestoneRequest
+	if err := c.BindJSON(&req); err != nil {
+		log.Warn("failed to bind request", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, "bad body")
+		return
+	}
+
+	cmd, err := settaskmilestone.NewCommand(c.Param("task_id"), req.MilestoneID)
+	if err != nil {
+		log.Warn("failed to create command", "error", err)
+		NewErrorResponse(c, http.StatusBadRequest, "invalid task or milestone id")
+		return
+	}
+
+	task, err := h.setTaskMilestoneUC.Handle(c.Request.Context(), cmd)
+	if err != nil {
+		log.Error("failed to set task milestone", "error", err)
+		switch {
+		case errors.Is(err, settaskmilestone.ErrTaskNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "task not found")
+		case errors.Is(err, settaskmilestone.ErrMilestoneNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "milestone not found")
+		case errors.Is(err, settaskmilestone.ErrMilestoneClosed):
+			NewErrorResponse(c, http.StatusConflict, "milestone is closed")
+		case errors.Is(err, settaskmilestone.ErrSetTaskMilestoneUnknown):
+			NewErrorResponse(c, http.StatusInternalServerError, "failed to set task milestone")
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, SetTaskMilestoneResponse{
+		ID:          task.ID,
+		MilestoneID: task.MilestoneID,
+	})
+}
+
+func milestoneDomainToResponse(m *domain.Milestone) MilestoneResponse {
+	var targetDate *string
+	if m.TargetDate != nil {
+		td := m.TargetDate.Format(time.DateOnly)
+		targetDate = &td
+	}
+
+	return MilestoneResponse{
+		ID:          m.ID,
+		Title:       m.Title,
+		Description: m.Description,
+		TargetDate:  targetDate,
+		State:       string(m.State),
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+		DeletedAt:   m.DeletedAt,
+	}
+}
+
+func categoryCountsToDto(c domain.CategoryCounts) CategoryCountsDto {
+	return CategoryCountsDto{
+		Todo:       c.Todo,
+		InProgress: c.InProgress,
+		Done:       c.Done,
+		Total:      c.Total(),
+		Percent:    c.Percent(),
+	}
+}
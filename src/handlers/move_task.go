@@ -29,13 +29,14 @@ type (
 		CreatedAt   time.Time      `json:"created_at"`
 		UpdatedAt   time.Time      `json:"updated_at"`
 		DeletedAt   *time.Time     `json:"deleted_at"`
+		Warnings    []string       `json:"warnings,omitempty"`
 	}
 
 	MoveTaskUseCase interface {
 		Handle(
 			ctx context.Context,
 			cmd movetask.MoveTaskCommand,
-		) (task *domain.Task, err error)
+		) (res *movetask.Result, err error)
 	}
 )
 
@@ -47,7 +48,7 @@ type (
 // @Param task_id path string true "ID задачи"
 // @Param moveTaskRequest body MoveTaskRequest true "request на перемещение задачи"
 // @Success 200 {object}  MoveTaskResponse "Полная информация об обновленной задаче"
-// @Failure     400,404,408,500,503  {object}  ErrorResponse
+// @Failure     400,404,408,409,500,503  {object}  ErrorResponse
 // @Router /v1/tasks/{task_id}/move [PUT]
 func (h *HttpHandler) MoveTask(c *gin.Context) {
 	const op = "handlers.MoveTask"
@@ -81,7 +82,7 @@ func (h *HttpHandler) MoveTask(c *gin.Context) {
 		return
 	}
 
-	dmn, err := h.moveTaskUC.Handle(c, cmd)
+	res, err := h.moveTaskUC.Handle(c, cmd)
 	if err != nil {
 		log.Error("failed to move task",
 			slog.String("err", err.Error()),
@@ -93,6 +94,8 @@ func (h *HttpHandler) MoveTask(c *gin.Context) {
 			NewErrorResponse(c, http.StatusNotFound, "task not found")
 		case errors.Is(err, movetask.ErrColumnNotInBoard):
 			NewErrorResponse(c, http.StatusBadRequest, "column does not belong to the task's board")
+		case errors.Is(err, movetask.ErrTaskIsBlocked):
+			NewErrorResponse(c, http.StatusConflict, err.Error())
 		case errors.Is(err, context.Canceled):
 			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
 		case errors.Is(err, context.DeadlineExceeded):
@@ -103,7 +106,8 @@ func (h *HttpHandler) MoveTask(c *gin.Context) {
 		return
 	}
 
-	resp := taskDomainToMoveTaskResponse(dmn)
+	resp := taskDomainToMoveTaskResponse(res.Task)
+	resp.Warnings = res.Warnings
 	c.JSON(http.StatusOK, resp)
 }
 
@@ -0,0 +1,369 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/countunreadnotifications"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/getemailpreference"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/getnotifications"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/putemailpreference"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/readallnotifications"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/readnotification"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type (
+	NotificationResponse struct {
+		ID        uuid.UUID  `json:"id"`
+		Type      string     `json:"type"`
+		BoardID   uuid.UUID  `json:"board_id"`
+		TaskID    uuid.UUID  `json:"task_id"`
+		Text      string     `json:"text"`
+		ReadAt    *time.Time `json:"read_at"`
+		CreatedAt time.Time  `json:"created_at"`
+	}
+
+	GetNotificationsResponse struct {
+		Notifications []NotificationResponse `json:"notifications"`
+		UnreadCount   int64                  `json:"unread_count"`
+	}
+
+	UnreadNotificationsResponse struct {
+		UnreadCount int64 `json:"unread_count"`
+	}
+
+	EmailPreferenceRequest struct {
+		// Email - адрес для писем, обязателен для режимов instant и digest
+		Email string `json:"email"`
+		// Mode - instant, digest или off
+		Mode string `json:"mode" binding:"required"`
+	}
+
+	EmailPreferenceResponse struct {
+		Email        string     `json:"email"`
+		Mode         string     `json:"mode"`
+		LastDigestAt *time.Time `json:"last_digest_at"`
+	}
+
+	ReadAllNotificationsResponse struct {
+		Updated int64 `json:"updated"`
+	}
+
+	GetNotificationsUseCase interface {
+		Handle(ctx context.Context, query getnotifications.Query) (getnotifications.Result, error)
+	}
+
+	CountUnreadNotificationsUseCase interface {
+		Handle(ctx context.Context, query countunreadnotifications.Query) (int64, error)
+	}
+
+	ReadNotificationUseCase interface {
+		Handle(ctx context.Context, cmd readnotification.Command) error
+	}
+
+	ReadAllNotificationsUseCase interface {
+		Handle(ctx context.Context, cmd readallnotifications.Command) (int64, error)
+	}
+
+	GetEmailPreferenceUseCase interface {
+		Handle(ctx context.Context, query getemailpreference.Query) (*domain.EmailPreference, error)
+	}
+
+	PutEmailPreferenceUseCase interface {
+		Handle(ctx context.Context, cmd putemailpreference.Command) (*domain.EmailPreference, error)
+	}
+)
+
+// @Summary Входящие уведомления пользователя
+// @Description Назначения, упоминания и переносы задач пользователя. Новые первыми.
+// @Schemes
+// @Tags Notifications
+// @Produce json
+// @Param User-ID header string true "ID пользователя"
+// @Param unread query bool false "true - только непрочитанные"
+// @Param limit query int false "Количество записей (1-200, по умолчанию 50)"
+// @Param offset query int false "Смещение"
+// @Success 200 {object} GetNotificationsResponse
+// @Failure     400,408,500,503  {object}  ErrorResponse
+// @Router /v1/notifications [GET]
+func (h *HttpHandler) GetNotifications(c *gin.Context) {
+	const op = "handlers.GetNotifications"
+	log := slog.Default()
+	log.With("op", op)
+
+	query, err := getnotifications.NewQuery(
+		c.GetHeader("User-ID"), c.Query("unread") == "true", c.Query("limit"), c.Query("offset"))
+	if err != nil {
+		log.Warn("failed to create query", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	result, err := h.getNotificationsUC.Handle(c, query)
+	if err != nil {
+		log.Error("failed to get notifications",
+			slog.String("err", err.Error()),
+			slog.String("User-id", query.UserID))
+
+		switch {
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	resp := GetNotificationsResponse{
+		Notifications: make([]NotificationResponse, 0, len(result.Notifications)),
+		UnreadCount:   result.UnreadCount,
+	}
+	for i := range result.Notifications {
+		resp.Notifications = append(resp.Notifications, notificationToResponse(&result.Notifications[i]))
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Количество непрочитанных уведомлений
+// @Schemes
+// @Tags Notifications
+// @Produce json
+// @Param User-ID header string true "ID пользователя"
+// @Success 200 {object} UnreadNotificationsResponse
+// @Failure     400,408,500,503  {object}  ErrorResponse
+// @Router /v1/notifications/unread-count [GET]
+func (h *HttpHandler) CountUnreadNotifications(c *gin.Context) {
+	const op = "handlers.CountUnreadNotifications"
+	log := slog.Default()
+	log.With("op", op)
+
+	query, err := countunreadnotifications.NewQuery(c.GetHeader("User-ID"))
+	if err != nil {
+		log.Warn("failed to create query", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	count, err := h.countUnreadNotificationsUC.Handle(c, query)
+	if err != nil {
+		log.Error("failed to count unread notifications",
+			slog.String("err", err.Error()),
+			slog.String("User-id", query.UserID))
+
+		switch {
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, UnreadNotificationsResponse{UnreadCount: count})
+}
+
+// @Summary Отметить уведомление прочитанным
+// @Schemes
+// @Tags Notifications
+// @Param User-ID header string true "ID пользователя"
+// @Param notification_id path string true "ID уведомления"
+// @Success 204
+// @Failure     400,404,408,500,503  {object}  ErrorResponse
+// @Router /v1/notifications/{notification_id}/read [POST]
+func (h *HttpHandler) ReadNotification(c *gin.Context) {
+	const op = "handlers.ReadNotification"
+	log := slog.Default()
+	log.With("op", op)
+
+	cmd, err := readnotification.NewCommand(c.GetHeader("User-ID"), c.Param("notification_id"))
+	if err != nil {
+		log.Warn("failed to create command", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err = h.readNotificationUC.Handle(c, cmd)
+	if err != nil {
+		log.Error("failed to mark notification read",
+			slog.String("err", err.Error()),
+			slog.String("notification_id", cmd.NotificationID.String()))
+
+		switch {
+		case errors.Is(err, readnotification.ErrNotificationNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "notification not found")
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary Отметить все уведомления прочитанными
+// @Schemes
+// @Tags Notifications
+// @Produce json
+// @Param User-ID header string true "ID пользователя"
+// @Success 200 {object} ReadAllNotificationsResponse
+// @Failure     400,408,500,503  {object}  ErrorResponse
+// @Router /v1/notifications/read-all [POST]
+func (h *HttpHandler) ReadAllNotifications(c *gin.Context) {
+	const op = "handlers.ReadAllNotifications"
+	log := slog.Default()
+	log.With("op", op)
+
+	cmd, err := readallnotifications.NewCommand(c.GetHeader("User-ID"))
+	if err != nil {
+		log.Warn("failed to create command", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	updated, err := h.readAllNotificationsUC.Handle(c, cmd)
+	if err != nil {
+		log.Error("failed to mark notifications read",
+			slog.String("err", err.Error()),
+			slog.String("User-id", cmd.UserID))
+
+		switch {
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, ReadAllNotificationsResponse{Updated: updated})
+}
+
+// @Summary Настройки уведомлений на почту
+// @Description Пока настройки не заданы, режим off.
+// @Schemes
+// @Tags Notifications
+// @Produce json
+// @Param User-ID header string true "ID пользователя"
+// @Success 200 {object} EmailPreferenceResponse
+// @Failure     400,408,500,503  {object}  ErrorResponse
+// @Router /v1/notifications/preferences [GET]
+func (h *HttpHandler) GetEmailPreference(c *gin.Context) {
+	const op = "handlers.GetEmailPreference"
+	log := slog.Default()
+	log.With("op", op)
+
+	query, err := getemailpreference.NewQuery(c.GetHeader("User-ID"))
+	if err != nil {
+		log.Warn("failed to create query", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	pref, err := h.getEmailPreferenceUC.Handle(c, query)
+	if err != nil {
+		log.Error("failed to get email preference",
+			slog.String("err", err.Error()),
+			slog.String("User-id", query.UserID))
+
+		switch {
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, emailPreferenceToResponse(pref))
+}
+
+// @Summary Изменить настройки уведомлений на почту
+// @Description instant - письмо на каждое уведомление, digest - раз в сутки все непрочитанные, off - без писем.
+// @Schemes
+// @Tags Notifications
+// @Accept json
+// @Produce json
+// @Param User-ID header string true "ID пользователя"
+// @Param emailPreferenceRequest body EmailPreferenceRequest true "Адрес и режим"
+// @Success 200 {object} EmailPreferenceResponse
+// @Failure     400,408,500,503  {object}  ErrorResponse
+// @Router /v1/notifications/preferences [PUT]
+func (h *HttpHandler) PutEmailPreference(c *gin.Context) {
+	const op = "handlers.PutEmailPreference"
+	log := slog.Default()
+	log.With("op", op)
+
+	var req EmailPreferenceRequest
+	if err := c.BindJSON(&req); err != nil {
+		log.Warn("failed to bind request", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, "bad body")
+		return
+	}
+
+	cmd, err := putemailpreference.NewCommand(c.GetHeader("User-ID"), req.Email, req.Mode)
+	if err != nil {
+		log.Warn("failed to create command", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	pref, err := h.putEmailPreferenceUC.Handle(c, cmd)
+	if err != nil {
+		log.Error("failed to save email preference",
+			slog.String("err", err.Error()),
+			slog.String("User-id", cmd.UserID))
+
+		switch {
+		case errors.Is(err, putemailpreference.ErrValidationFailed):
+			NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, emailPreferenceToResponse(pref))
+}
+
+func emailPreferenceToResponse(p *domain.EmailPreference) EmailPreferenceResponse {
+	return EmailPreferenceResponse{
+		Email:        p.Email,
+		Mode:         string(p.Mode),
+		LastDigestAt: p.LastDigestAt,
+	}
+}
+
+func notificationToResponse(n *domain.Notification) NotificationResponse {
+	return NotificationResponse{
+		ID:        n.ID,
+		Type:      string(n.Type),
+		BoardID:   n.BoardID,
+		TaskID:    n.TaskID,
+		Text:      n.Text,
+		ReadAt:    n.ReadAt,
+		CreatedAt: n.CreatedAt,
+	}
+}
@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/putcolumn"
+	"github.com/gin-gonic/gin"
+)
+
+type (
+	PutColumnRequest struct {
+		Name     string `json:"name"`
+		IsDone   bool   `json:"is_done"`
+		WipLimit *int64 `json:"wip_limit"`
+	}
+
+	PutColumnUseCase interface {
+		Handle(ctx context.Context, cmd putcolumn.Command) (*domain.Column, error)
+	}
+)
+
+// @Summary Обновление колонки
+// @Description Колонка с is_done считается завершающей: перенос в нее задач с незакрытыми блокерами дает предупреждение.
+// @Schemes
+// @Tags Columns
+// @Accept json
+// @Produce json
+// @Param column_id path string true "ID колонки"
+// @Param putColumnRequest body PutColumnRequest true "request на обновление колонки"
+// @Success 200 {object}  CreateColumnResponse
+// @Failure     400,404,408,500,503  {object}  ErrorResponse
+// @Router /v1/columns/{column_id} [PUT]
+func (h *HttpHandler) PutColumn(c *gin.Context) {
+	const op = "handlers.PutColumn"
+	log := slog.Default()
+	log.With("op", op)
+
+	var req PutColumnRequest
+	if err := c.BindJSON(&req); err != nil {
+		log.Warn("failed to bind request", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, "bad body")
+		return
+	}
+
+	cmd, err := putcolumn.NewCommand(c.Param("column_id"), req.Name, req.IsDone, req.WipLimit)
+	if err != nil {
+		log.Warn("failed to create command", "error", err)
+		switch {
+		case errors.Is(err, putcolumn.ErrValidationFailed):
+			NewErrorResponse(c, http.StatusBadRequest, "validation failed")
+		case errors.Is(err, putcolumn.ErrInvalidUUID):
+			NewErrorResponse(c, http.StatusBadRequest, "invalid column id")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	dmn, err := h.putColumnUC.Handle(c.Request.Context(), cmd)
+	if err != nil {
+		log.Error("failed to put column", "error", err)
+		switch {
+		case errors.Is(err, putcolumn.ErrColumnNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "column not found")
+		case errors.Is(err, putcolumn.ErrValidationFailed):
+			NewErrorResponse(c, http.StatusBadRequest, "validation failed")
+		case errors.Is(err, putcolumn.ErrPutColumnUnknown):
+			NewErrorResponse(c, http.StatusInternalServerError, "failed to update column")
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, CreateColumnResponse{
+		ID:        dmn.ID.String(),
+		BoardID:   dmn.BoardID.String(),
+		OrderNum:  dmn.OrderNum,
+		Name:      dmn.Name,
+		IsDone:    dmn.IsDone,
+		WipLimit:  dmn.WipLimit,
+		CreatedAt: dmn.CreatedAt,
+		UpdatedAt: dmn.UpdatedAt,
+		DeletedAt: dmn.DeletedAt,
+	})
+}
@@ -22,6 +22,11 @@ type (
 		Description *string        `json:"description"`
 		Tags        []string       `json:"tags"`
 		Checklists  []ChecklistDto `json:"checklists"`
+		Assignee    *string        `json:"assignee"`
+		Priority    *string        `json:"priority"`
+		Lane        *string        `json:"lane"`
+		Mentions    []string       `json:"mentions"`
+		References  []TaskRefDto   `json:"references"`
 		CreatedAt   time.Time      `json:"created_at"`
 		UpdatedAt   time.Time      `json:"updated_at"`
 	}
@@ -29,11 +34,17 @@ type (
 	PutTaskRequest struct {
 		ColumnID    string         `json:"column_id"`
 		BoardID     string         `json:"board_id"`
+		// Number менять нельзя: 0 или текущий номер задачи
 		Number      int64          `json:"number"`
 		Title       string         `json:"title"`
 		Description *string        `json:"description"`
 		Tags        []string       `json:"tags"`
 		Checklists  []ChecklistDto `json:"checklists"`
+		Assignee    *string        `json:"assignee"`
+		Priority    *string        `json:"priority" example:"high"`
+		Lane        *string        `json:"lane"`
+		// SyncChecklists - чеклист "Description" собирается из "- [ ]" в описании
+		SyncChecklists bool `json:"sync_checklists"`
 	}
 
 	PutTaskUseCase interface {
@@ -48,7 +59,7 @@ type (
 // @Param task_id path string true "ID задачи"
 // @Param putTaskRequest body PutTaskRequest true "put task request"
 // @Success 200 {object}  PutTaskResponse
-// @Failure     400,404,408,500,503  {object}  ErrorResponse
+// @Failure     400,404,408,409,500,503  {object}  ErrorResponse
 // @Router /v1/tasks/{task_id} [PUT]
 func (h *HttpHandler) PutTask(c *gin.Context) {
 	const op = "handlers.GetTask"
@@ -73,6 +84,10 @@ func (h *HttpHandler) PutTask(c *gin.Context) {
 		req.Description, 
 		req.Tags, 
 		putChecklistsRequestToDomain(req.Checklists),
+		req.Assignee,
+		req.Priority,
+		req.Lane,
+		req.SyncChecklists,
 	)
 	if err != nil {
 		log.Warn("failed to create command", "error", err)
@@ -88,8 +103,14 @@ func (h *HttpHandler) PutTask(c *gin.Context) {
 			NewErrorResponse(c, http.StatusNotFound, "task not found")
 		case errors.Is(err, puttask.ErrPutTaskUnknown):
 			NewErrorResponse(c, http.StatusInternalServerError, "failed to put task")
+		case errors.Is(err, puttask.ErrValidationFailed):
+			NewErrorResponse(c, http.StatusBadRequest, err.Error())
 		case errors.Is(err, puttask.ErrColumnNotFound):
 			NewErrorResponse(c, http.StatusNotFound, "column not found")
+		case errors.Is(err, puttask.ErrBoardChangeNotAllowed):
+			NewErrorResponse(c, http.StatusConflict, puttask.ErrBoardChangeNotAllowed.Error())
+		case errors.Is(err, puttask.ErrNumberChangeNotAllowed):
+			NewErrorResponse(c, http.StatusConflict, puttask.ErrNumberChangeNotAllowed.Error())
 		case errors.Is(err, context.Canceled):
 			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
 		case errors.Is(err, context.DeadlineExceeded):
@@ -147,6 +168,11 @@ func taskDomainToPutTaskResponse(task *domain.Task) *PutTaskResponse {
 		Description: task.Description,
 		Tags:        task.Tags,
 		Checklists:  checklistResp,
+		Assignee:    task.Assignee,
+		Priority:    (*string)(task.Priority),
+		Lane:        task.Lane,
+		Mentions:    mentionsToDto(task),
+		References:  taskRefsDomainToDto(task.References),
 		CreatedAt:   task.CreatedAt,
 		UpdatedAt:   task.UpdatedAt,
 	}
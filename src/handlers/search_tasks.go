@@ -18,7 +18,10 @@ type (
 		Limit   uint `json:"limit"`
 		Offset  uint `json:"offset"`
 		Filters struct {
-			Tags []string `json:"tags"`
+			Tags        []string `json:"tags"`
+			MilestoneID string   `json:"milestone_id"`
+			// MentioningMe - только задачи, где упомянут пользователь из User-ID
+			MentioningMe bool `json:"mentioning_me"`
 		} `json:"filters"`
 	}
 
@@ -38,12 +41,14 @@ type (
 	}
 )
 
-// @Summary Поиск задач по тегам и названию
+// @Summary Поиск задач по тегам, названию и milestone
+// @Description С filters.mentioning_me ищет задачи, в описании которых упомянут пользователь из User-ID.
 // @Schemes
 // @Tags Tasks
 // @Accept json
 // @Produce json
 // @Param searchTasksRequest body SearchTasksRequest true "request для поиска тасок"
+// @Param User-ID header string false "ID пользователя, обязателен для mentioning_me"
 // @Success 200 {object}  []SearchTaskResponse
 // @Failure     400,408,500,503  {object}  ErrorResponse
 // @Router /v1/tasks/search [POST]
@@ -60,7 +65,16 @@ func (h *HttpHandler) SearchTasks(c *gin.Context) {
 		return
 	}
 
-	qry, err := searchtasks.NewQuery(req.Filters.Tags, req.Query, req.Limit, req.Offset)
+	var mentioned string
+	if req.Filters.MentioningMe {
+		mentioned = c.GetHeader("User-ID")
+		if mentioned == "" {
+			NewErrorResponse(c, http.StatusBadRequest, "User-ID header is required for mentioning_me")
+			return
+		}
+	}
+
+	qry, err := searchtasks.NewQuery(req.Filters.Tags, req.Query, req.Filters.MilestoneID, mentioned, req.Limit, req.Offset)
 	if err != nil {
 		log.Warn("failed to create command", "error", err)
 		NewErrorResponse(c, http.StatusBadRequest, "failed to create command")
@@ -0,0 +1,492 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/addsprinttask"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/closesprint"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/createsprint"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/getsprints"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/putsprint"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/removesprinttask"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/startsprint"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type (
+	SprintRequest struct {
+		Name      string  `json:"name"`
+		Goal      *string `json:"goal"`
+		StartDate string  `json:"start_date" example:"2026-01-05"`
+		EndDate   string  `json:"end_date" example:"2026-01-18"`
+	}
+
+	SprintResponse struct {
+		ID        uuid.UUID `json:"id"`
+		BoardID   uuid.UUID `json:"board_id"`
+		Name      string    `json:"name"`
+		Goal      *string   `json:"goal"`
+		StartDate string    `json:"start_date"`
+		EndDate   string    `json:"end_date"`
+		State     string    `json:"state"`
+		CreatedAt time.Time `json:"created_at"`
+		UpdatedAt time.Time `json:"updated_at"`
+	}
+
+	GetSprintsResponse struct {
+		Sprints []SprintResponse `json:"sprints"`
+	}
+
+	CloseSprintRequest struct {
+		// "backlog", ID запланированного спринта или пусто - ближайший запланированный
+		MoveUnfinishedTo string `json:"move_unfinished_to"`
+	}
+
+	CloseSprintResponse struct {
+		Sprint     SprintResponse  `json:"sprint"`
+		NextSprint *SprintResponse `json:"next_sprint"`
+		MovedTasks int64           `json:"moved_tasks"`
+	}
+
+	AddSprintTaskRequest struct {
+		TaskID string `json:"task_id" binding:"required"`
+	}
+
+	SprintTaskResponse struct {
+		ID       uuid.UUID  `json:"id"`
+		SprintID *uuid.UUID `json:"sprint_id"`
+	}
+
+	CreateSprintUseCase interface {
+		Handle(ctx context.Context, cmd createsprint.Command) (*domain.Sprint, error)
+	}
+
+	GetSprintsUseCase interface {
+		Handle(ctx context.Context, q getsprints.Query) ([]domain.Sprint, error)
+	}
+
+	PutSprintUseCase interface {
+		Handle(ctx context.Context, cmd putsprint.Command) (*domain.Sprint, error)
+	}
+
+	StartSprintUseCase interface {
+		Handle(ctx context.Context, cmd startsprint.Command) (*domain.Sprint, error)
+	}
+
+	CloseSprintUseCase interface {
+		Handle(ctx context.Context, cmd closesprint.Command) (*closesprint.Result, error)
+	}
+
+	AddSprintTaskUseCase interface {
+		Handle(ctx context.Context, cmd addsprinttask.Command) (*domain.Task, error)
+	}
+
+	RemoveSprintTaskUseCase interface {
+		Handle(ctx context.Context, cmd removesprinttask.Command) error
+	}
+)
+
+// @Summary Создание спринта на доске
+// @Schemes
+// @Tags Sprints
+// @Accept json
+// @Produce json
+// @Param id path string true "ID доски"
+// @Param sprintRequest body SprintRequest true "request на создание спринта"
+// @Success 201 {object}  SprintResponse
+// @Failure     400,404,408,500,503  {object}  ErrorResponse
+// @Router /v1/boards/{id}/sprints [POST]
+func (h *HttpHandler) CreateSprint(c *gin.Context) {
+	const op = "handlers.CreateSprint"
+	log := slog.Default()
+	log.With("op", op)
+
+	var req SprintRequest
+	if err := c.BindJSON(&req); err != nil {
+		log.Warn("failed to bind request", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, "bad body")
+		return
+	}
+
+	cmd, err := createsprint.NewCommand(c.Param("id"), req.Name, req.Goal, req.StartDate, req.EndDate)
+	if err != nil {
+		log.Warn("failed to create command", "error", err)
+		switch {
+		case errors.Is(err, createsprint.ErrInvalidUUID):
+			NewErrorResponse(c, http.StatusBadRequest, "invalid board id")
+		case errors.Is(err, createsprint.ErrInvalidDate):
+			NewErrorResponse(c, http.StatusBadRequest, "invalid date, expected YYYY-MM-DD")
+		default:
+			NewErrorResponse(c, http.StatusBadRequest, "validation failed")
+		}
+		return
+	}
+
+	dmn, err := h.createSprintUC.Handle(c.Request.Context(), cmd)
+	if err != nil {
+		log.Error("failed to create sprint", "error", err)
+		switch {
+		case errors.Is(err, createsprint.ErrBoardNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "board not found")
+		case errors.Is(err, createsprint.ErrValidationFailed):
+			NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		case errors.Is(err, createsprint.ErrCreateSprintUnknown):
+			NewErrorResponse(c, http.StatusInternalServerError, "failed to create sprint")
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, sprintDomainToResponse(dmn))
+}
+
+// @Summary Получение спринтов доски
+// @Schemes
+// @Tags Sprints
+// @Accept json
+// @Produce json
+// @Param id path string true "ID доски"
+// @Success 200 {object}  GetSprintsResponse
+// @Failure     400,404,408,500,503  {object}  ErrorResponse
+// @Router /v1/boards/{id}/sprints [GET]
+func (h *HttpHandler) GetSprints(c *gin.Context) {
+	const op = "handlers.GetSprints"
+	log := slog.Default()
+	log.With("op", op)
+
+	qry, err := getsprints.NewQuery(c.Param("id"))
+	if err != nil {
+		log.Warn("failed to create query", "error", err)
+		NewErrorResponse(c, http.StatusBadRequest, "invalid board id")
+		return
+	}
+
+	sprints, err := h.getSprintsUC.Handle(c.Request.Context(), qry)
+	if err != nil {
+		log.Error("failed to get sprints", "error", err)
+		switch {
+		case errors.Is(err, getsprints.ErrBoardNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "board not found")
+		case errors.Is(err, getsprints.ErrGetSprintsUnknown):
+			NewErrorResponse(c, http.StatusInternalServerError, "failed to get sprints")
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	resp := GetSprintsResponse{Sprints: make([]SprintResponse, 0, len(sprints))}
+	for _, s := range sprints {
+		resp.Sprints = append(resp.Sprints, sprintDomainToResponse(&s))
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Изменение спринта
+// @Schemes
+// @Tags Sprints
+// @Accept json
+// @Produce json
+// @Param sprint_id path string true "ID спринта"
+// @Param sprintRequest body SprintRequest true "request на изменение спринта"
+// @Success 200 {object}  SprintResponse
+// @Failure     400,404,408,409,500,503  {object}  ErrorResponse
+// @Router /v1/sprints/{sprint_id} [PUT]
+func (h *HttpHandler) PutSprint(c *gin.Context) {
+	const op = "handlers.PutSprint"
+	log := slog.Default()
+	log.With("op", op)
+
+	var req SprintRequest
+	if err := c.BindJSON(&req); err != nil {
+		log.Warn("failed to bind request", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, "bad body")
+		return
+	}
+
+	cmd, err := putsprint.NewCommand(c.Param("sprint_id"), req.Name, req.Goal, req.StartDate, req.EndDate)
+	if err != nil {
+		log.Warn("failed to create command", "error", err)
+		switch {
+		case errors.Is(err, putsprint.ErrInvalidUUID):
+			NewErrorResponse(c, http.StatusBadRequest, "invalid sprint id")
+		case errors.Is(err, putsprint.ErrInvalidDate):
+			NewErrorResponse(c, http.StatusBadRequest, "invalid date, expected YYYY-MM-DD")
+		default:
+			NewErrorResponse(c, http.StatusBadRequest, "validation failed")
+		}
+		return
+	}
+
+	dmn, err := h.putSprintUC.Handle(c.Request.Context(), cmd)
+	if err != nil {
+		log.Error("failed to put sprint", "error", err)
+		switch {
+		case errors.Is(err, putsprint.ErrSprintNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "sprint not found")
+		case errors.Is(err, putsprint.ErrSprintClosed):
+			NewErrorResponse(c, http.StatusConflict, "sprint is closed")
+		case errors.Is(err, putsprint.ErrValidationFailed):
+			NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		case errors.Is(err, putsprint.ErrPutSprintUnknown):
+			NewErrorResponse(c, http.StatusInternalServerError, "failed to update sprint")
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, sprintDomainToResponse(dmn))
+}
+
+// @Summary Старт спринта
+// @Description На доске может быть только один активный спринт.
+// @Schemes
+// @Tags Sprints
+// @Accept json
+// @Produce json
+// @Param sprint_id path string true "ID спринта"
+// @Success 200 {object}  SprintResponse
+// @Failure     400,404,408,409,500,503  {object}  ErrorResponse
+// @Router /v1/sprints/{sprint_id}/start [POST]
+func (h *HttpHandler) StartSprint(c *gin.Context) {
+	const op = "handlers.StartSprint"
+	log := slog.Default()
+	log.With("op", op)
+
+	cmd, err := startsprint.NewCommand(c.Param("sprint_id"))
+	if err != nil {
+		log.Warn("failed to create command", "error", err)
+		NewErrorResponse(c, http.StatusBadRequest, "invalid sprint id")
+		return
+	}
+
+	dmn, err := h.startSprintUC.Handle(c.Request.Context(), cmd)
+	if err != nil {
+		log.Error("failed to start sprint", "error", err)
+		switch {
+		case errors.Is(err, startsprint.ErrSprintNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "sprint not found")
+		case errors.Is(err, startsprint.ErrSprintNotPlanned):
+			NewErrorResponse(c, http.StatusConflict, "sprint is not planned")
+		case errors.Is(err, startsprint.ErrActiveSprintExists):
+			NewErrorResponse(c, http.StatusConflict, "board already has an active sprint")
+		case errors.Is(err, startsprint.ErrStartSprintUnknown):
+			NewErrorResponse(c, http.StatusInternalServerError, "failed to start sprint")
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, sprintDomainToResponse(dmn))
+}
+
+// @Summary Закрытие спринта
+// @Description Незавершенные задачи (не в завершающей колонке) переносятся в следующий спринт или в бэклог.
+// @Schemes
+// @Tags Sprints
+// @Accept json
+// @Produce json
+// @Param sprint_id path string true "ID спринта"
+// @Param closeSprintRequest body CloseSprintRequest false "куда перенести незавершенные задачи"
+// @Success 200 {object}  CloseSprintResponse
+// @Failure     400,404,408,409,500,503  {object}  ErrorResponse
+// @Router /v1/sprints/{sprint_id}/close [POST]
+func (h *HttpHandler) CloseSprint(c *gin.Context) {
+	const op = "handlers.CloseSprint"
+	log := slog.Default()
+	log.With("op", op)
+
+	var req CloseSprintRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.BindJSON(&req); err != nil {
+			log.Warn("failed to bind request", slog.String("err", err.Error()))
+			NewErrorResponse(c, http.StatusBadRequest, "bad body")
+			return
+		}
+	}
+
+	cmd, err := closesprint.NewCommand(c.Param("sprint_id"), req.MoveUnfinishedTo)
+	if err != nil {
+		log.Warn("failed to create command", "error", err)
+		NewErrorResponse(c, http.StatusBadRequest, "invalid sprint id")
+		return
+	}
+
+	res, err := h.closeSprintUC.Handle(c.Request.Context(), cmd)
+	if err != nil {
+		log.Error("failed to close sprint", "error", err)
+		switch {
+		case errors.Is(err, closesprint.ErrSprintNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "sprint not found")
+		case errors.Is(err, closesprint.ErrNextSprintNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "next sprint not found")
+		case errors.Is(err, closesprint.ErrSprintNotActive):
+			NewErrorResponse(c, http.StatusConflict, "sprint is not active")
+		case errors.Is(err, closesprint.ErrInvalidRolloverTarget):
+			NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		case errors.Is(err, closesprint.ErrCloseSprintUnknown):
+			NewErrorResponse(c, http.StatusInternalServerError, "failed to close sprint")
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	resp := CloseSprintResponse{
+		Sprint:     sprintDomainToResponse(res.Sprint),
+		MovedTasks: res.MovedTasks,
+	}
+	if res.NextSprint != nil {
+		next := sprintDomainToResponse(res.NextSprint)
+		resp.NextSprint = &next
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Добавление задачи в спринт
+// @Schemes
+// @Tags Sprints
+// @Accept json
+// @Produce json
+// @Param sprint_id path string true "ID спринта"
+// @Param addSprintTaskRequest body AddSprintTaskRequest true "request на добавление задачи"
+// @Success 200 {object}  SprintTaskResponse
+// @Failure     400,404,408,409,500,503  {object}  ErrorResponse
+// @Router /v1/sprints/{sprint_id}/tasks [POST]
+func (h *HttpHandler) AddSprintTask(c *gin.Context) {
+	const op = "handlers.AddSprintTask"
+	log := slog.Default()
+	log.With("op", op)
+
+	var req AddSprintTaskRequest
+	if err := c.BindJSON(&req); err != nil {
+		log.Warn("failed to bind request", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, "bad body")
+		return
+	}
+
+	cmd, err := addsprinttask.NewCommand(c.Param("sprint_id"), req.TaskID)
+	if err != nil {
+		log.Warn("failed to create command", "error", err)
+		NewErrorResponse(c, http.StatusBadRequest, "invalid sprint or task id")
+		return
+	}
+
+	task, err := h.addSprintTaskUC.Handle(c.Request.Context(), cmd)
+	if err != nil {
+		log.Error("failed to add task to sprint", "error", err)
+		switch {
+		case errors.Is(err, addsprinttask.ErrSprintNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "sprint not found")
+		case errors.Is(err, addsprinttask.ErrTaskNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "task not found")
+		case errors.Is(err, addsprinttask.ErrInvalidSprint):
+			NewErrorResponse(c, http.StatusConflict, err.Error())
+		case errors.Is(err, addsprinttask.ErrAddSprintTaskUnknown):
+			NewErrorResponse(c, http.StatusInternalServerError, "failed to add task to sprint")
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, SprintTaskResponse{
+		ID:       task.ID,
+		SprintID: task.SprintID,
+	})
+}
+
+// @Summary Удаление задачи из спринта
+// @Description Задача возвращается в бэклог доски.
+// @Schemes
+// @Tags Sprints
+// @Accept json
+// @Produce json
+// @Param sprint_id path string true "ID спринта"
+// @Param task_id path string true "ID задачи"
+// @Success 204
+// @Failure     400,404,408,500,503  {object}  ErrorResponse
+// @Router /v1/sprints/{sprint_id}/tasks/{task_id} [DELETE]
+func (h *HttpHandler) RemoveSprintTask(c *gin.Context) {
+	const op = "handlers.RemoveSprintTask"
+	log := slog.Default()
+	log.With("op", op)
+
+	cmd, err := removesprinttask.NewCommand(c.Param("sprint_id"), c.Param("task_id"))
+	if err != nil {
+		log.Warn("failed to create command", "error", err)
+		NewErrorResponse(c, http.StatusBadRequest, "invalid sprint or task id")
+		return
+	}
+
+	err = h.removeSprintTaskUC.Handle(c.Request.Context(), cmd)
+	if err != nil {
+		log.Error("failed to remove task from sprint", "error", err)
+		switch {
+		case errors.Is(err, removesprinttask.ErrTaskNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "task not found")
+		case errors.Is(err, removesprinttask.ErrTaskNotInSprint):
+			NewErrorResponse(c, http.StatusNotFound, "task is not in the sprint")
+		case errors.Is(err, removesprinttask.ErrRemoveSprintTaskUnknown):
+			NewErrorResponse(c, http.StatusInternalServerError, "failed to remove task from sprint")
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func sprintDomainToResponse(s *domain.Sprint) SprintResponse {
+	return SprintResponse{
+		ID:        s.ID,
+		BoardID:   s.BoardID,
+		Name:      s.Name,
+		Goal:      s.Goal,
+		StartDate: s.StartDate.Format(time.DateOnly),
+		EndDate:   s.EndDate.Format(time.DateOnly),
+		State:     string(s.State),
+		CreatedAt: s.CreatedAt,
+		UpdatedAt: s.UpdatedAt,
+	}
+}
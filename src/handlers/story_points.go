@@ -0,0 +1,290 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/getstorypointscale"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/getvelocity"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/setstorypointscale"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/settaskstorypoints"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type (
+	// StoryPointScaleRequest - готовая шкала (fibonacci, linear, powers_of_two)
+	// или свои значения, но не оба сразу.
+	StoryPointScaleRequest struct {
+		Preset string `json:"preset"`
+		Values []int  `json:"values"`
+	}
+
+	StoryPointScaleResponse struct {
+		BoardID uuid.UUID `json:"board_id"`
+		Values  []int     `json:"values"`
+	}
+
+	// SetTaskStoryPointsRequest - null убирает оценку.
+	SetTaskStoryPointsRequest struct {
+		StoryPoints *int `json:"story_points"`
+	}
+
+	TaskStoryPointsDto struct {
+		TaskID      uuid.UUID `json:"task_id"`
+		StoryPoints *int      `json:"story_points"`
+	}
+
+	VelocityPeriodDto struct {
+		Label       string     `json:"label"`
+		SprintID    *uuid.UUID `json:"sprint_id"`
+		From        string     `json:"from"`
+		To          string     `json:"to"`
+		Points      int        `json:"points"`
+		Tasks       int        `json:"tasks"`
+		Unestimated int        `json:"unestimated_tasks"`
+	}
+
+	VelocityResponse struct {
+		BoardID       uuid.UUID           `json:"board_id"`
+		By            string              `json:"by"`
+		From          string              `json:"from"`
+		To            string              `json:"to"`
+		AveragePoints float64             `json:"average_points"`
+		Periods       []VelocityPeriodDto `json:"periods"`
+	}
+
+	GetStoryPointScaleUseCase interface {
+		Handle(ctx context.Context, query getstorypointscale.Query) (domain.StoryPointScale, error)
+	}
+
+	SetStoryPointScaleUseCase interface {
+		Handle(ctx context.Context, cmd setstorypointscale.Command) (domain.StoryPointScale, error)
+	}
+
+	SetTaskStoryPointsUseCase interface {
+		Handle(ctx context.Context, cmd settaskstorypoints.Command) (*domain.Task, error)
+	}
+
+	GetVelocityUseCase interface {
+		Handle(ctx context.Context, query getvelocity.Query) (*domain.VelocityReport, error)
+	}
+)
+
+func velocityDomainToResponse(r *domain.VelocityReport) VelocityResponse {
+	periods := make([]VelocityPeriodDto, 0, len(r.Periods))
+	for _, p := range r.Periods {
+		periods = append(periods, VelocityPeriodDto{
+			Label:       p.Label,
+			SprintID:    p.SprintID,
+			From:        p.Start.Format(time.DateOnly),
+			To:          p.End.AddDate(0, 0, -1).Format(time.DateOnly),
+			Points:      p.Points,
+			Tasks:       p.Tasks,
+			Unestimated: p.Unestimated,
+		})
+	}
+
+	return VelocityResponse{
+		BoardID:       r.Filter.BoardID,
+		By:            string(r.Filter.GroupBy),
+		From:          r.Filter.From.Format(time.DateOnly),
+		To:            r.Filter.To.Format(time.DateOnly),
+		AveragePoints: r.AveragePoints,
+		Periods:       periods,
+	}
+}
+
+// @Summary Шкала story points доски
+// @Description Без своей шкалы доска использует Фибоначчи: 0, 1, 2, 3, 5, 8, 13, 21, 34, 55, 89.
+// @Schemes
+// @Tags Boards
+// @Produce json
+// @Param id path string true "ID доски"
+// @Success 200 {object} StoryPointScaleResponse
+// @Failure     400,404,408,500,503  {object}  ErrorResponse
+// @Router /v1/boards/{id}/story-points [GET]
+func (h *HttpHandler) GetStoryPointScale(c *gin.Context) {
+	const op = "handlers.GetStoryPointScale"
+	log := slog.Default()
+	log.With("op", op)
+
+	query, err := getstorypointscale.NewQuery(c.Param("id"))
+	if err != nil {
+		log.Warn("failed to create query", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	scale, err := h.getStoryPointScaleUC.Handle(c, query)
+	if err != nil {
+		log.Error("failed to get story point scale", slog.String("err", err.Error()))
+
+		switch {
+		case errors.Is(err, getstorypointscale.ErrBoardNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "board not found")
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, StoryPointScaleResponse{BoardID: query.BoardID, Values: scale})
+}
+
+// @Summary Задать шкалу story points доски
+// @Description Готовая шкала по имени или свои значения: 1-20 разных чисел от 0 до 1000.
+// @Description Оценки задач вне новой шкалы сохраняются, пока задачу не переоценят.
+// @Schemes
+// @Tags Boards
+// @Accept json
+// @Produce json
+// @Param id path string true "ID доски"
+// @Param storyPointScaleRequest body StoryPointScaleRequest true "шкала"
+// @Success 200 {object} StoryPointScaleResponse
+// @Failure     400,404,408,500,503  {object}  ErrorResponse
+// @Router /v1/boards/{id}/story-points [PUT]
+func (h *HttpHandler) SetStoryPointScale(c *gin.Context) {
+	const op = "handlers.SetStoryPointScale"
+	log := slog.Default()
+	log.With("op", op)
+
+	var req StoryPointScaleRequest
+	if err := c.BindJSON(&req); err != nil {
+		log.Warn("failed to bind request", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, "bad body")
+		return
+	}
+
+	cmd, err := setstorypointscale.NewCommand(c.Param("id"), req.Preset, req.Values)
+	if err != nil {
+		log.Warn("failed to create command", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	scale, err := h.setStoryPointScaleUC.Handle(c, cmd)
+	if err != nil {
+		log.Error("failed to set story point scale", slog.String("err", err.Error()))
+
+		switch {
+		case errors.Is(err, setstorypointscale.ErrBoardNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "board not found")
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, StoryPointScaleResponse{BoardID: cmd.BoardID, Values: scale})
+}
+
+// @Summary Story points задачи
+// @Description Значение должно входить в шкалу доски задачи. null убирает оценку.
+// @Schemes
+// @Tags Tasks
+// @Accept json
+// @Produce json
+// @Param task_id path string true "ID задачи"
+// @Param setTaskStoryPointsRequest body SetTaskStoryPointsRequest true "оценка"
+// @Success 200 {object} TaskStoryPointsDto
+// @Failure     400,404,408,500,503  {object}  ErrorResponse
+// @Router /v1/tasks/{task_id}/story-points [PUT]
+func (h *HttpHandler) SetTaskStoryPoints(c *gin.Context) {
+	const op = "handlers.SetTaskStoryPoints"
+	log := slog.Default()
+	log.With("op", op)
+
+	var req SetTaskStoryPointsRequest
+	if err := c.BindJSON(&req); err != nil {
+		log.Warn("failed to bind request", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, "bad body")
+		return
+	}
+
+	cmd, err := settaskstorypoints.NewCommand(c.Param("task_id"), req.StoryPoints)
+	if err != nil {
+		log.Warn("failed to create command", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	task, err := h.setTaskStoryPointsUC.Handle(c, cmd)
+	if err != nil {
+		log.Error("failed to set task story points", slog.String("err", err.Error()))
+
+		switch {
+		case errors.Is(err, settaskstorypoints.ErrTaskNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "task not found")
+		case errors.Is(err, settaskstorypoints.ErrInvalidStoryPoints):
+			NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, TaskStoryPointsDto{TaskID: task.ID, StoryPoints: task.StoryPoints})
+}
+
+// @Summary Velocity доски
+// @Description Сумма story points задач, завершенных за неделю или спринт. Задача засчитывается
+// @Description по истории перемещений - в период, где она впервые попала в done-колонку доски.
+// @Description Без дат берутся последние 12 недель или спринты за полгода, период не больше 366 дней.
+// @Schemes
+// @Tags Boards
+// @Produce json
+// @Param id path string true "ID доски"
+// @Param by query string false "Группировка: week или sprint" default(week)
+// @Param from query string false "Начало периода, YYYY-MM-DD"
+// @Param to query string false "Конец периода, YYYY-MM-DD"
+// @Success 200 {object} VelocityResponse
+// @Failure     400,404,408,500,503  {object}  ErrorResponse
+// @Router /v1/boards/{id}/velocity [GET]
+func (h *HttpHandler) GetVelocity(c *gin.Context) {
+	const op = "handlers.GetVelocity"
+	log := slog.Default()
+	log.With("op", op)
+
+	query, err := getvelocity.NewQuery(c.Param("id"), c.Query("by"), c.Query("from"), c.Query("to"))
+	if err != nil {
+		log.Warn("failed to create query", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	report, err := h.getVelocityUC.Handle(c, query)
+	if err != nil {
+		log.Error("failed to get velocity", slog.String("err", err.Error()))
+
+		switch {
+		case errors.Is(err, getvelocity.ErrBoardNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "board not found")
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, velocityDomainToResponse(report))
+}
@@ -0,0 +1,205 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/attachsubtask"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/detachsubtask"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/getsubtasks"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type (
+	AttachSubtaskRequest struct {
+		ChildID string `json:"child_id" binding:"required"`
+	}
+
+	SubtaskResponse struct {
+		ID       uuid.UUID `json:"id"`
+		ColumnID uuid.UUID `json:"column_id"`
+		BoardID  uuid.UUID `json:"board_id"`
+		ParentID uuid.UUID `json:"parent_id"`
+		Number   int64     `json:"number"`
+		Title    string    `json:"title"`
+	}
+
+	GetSubtasksResponse struct {
+		Subtasks []SubtaskResponse `json:"subtasks"`
+	}
+
+	GetSubtasksUseCase interface {
+		Handle(ctx context.Context, q getsubtasks.Query) ([]domain.Task, error)
+	}
+
+	AttachSubtaskUseCase interface {
+		Handle(ctx context.Context, cmd attachsubtask.Command) (*domain.Task, error)
+	}
+
+	DetachSubtaskUseCase interface {
+		Handle(ctx context.Context, cmd detachsubtask.Command) error
+	}
+)
+
+// @Summary Получение дочерних задач
+// @Schemes
+// @Tags Tasks
+// @Accept json
+// @Produce json
+// @Param task_id path string true "ID родительской задачи"
+// @Success 200 {object}  GetSubtasksResponse
+// @Failure     400,404,408,500,503  {object}  ErrorResponse
+// @Router /v1/tasks/{task_id}/children [GET]
+func (h *HttpHandler) GetSubtasks(c *gin.Context) {
+	const op = "handlers.GetSubtasks"
+	log := slog.Default()
+	log.With("op", op)
+
+	qry, err := getsubtasks.NewQuery(c.Param("task_id"))
+	if err != nil {
+		log.Warn("failed to create query", "error", err)
+		NewErrorResponse(c, http.StatusBadRequest, "invalid task id")
+		return
+	}
+
+	children, err := h.getSubtasksUC.Handle(c.Request.Context(), qry)
+	if err != nil {
+		log.Error("failed to get subtasks", "error", err)
+		switch {
+		case errors.Is(err, getsubtasks.ErrTaskNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "task not found")
+		case errors.Is(err, getsubtasks.ErrGetSubtasksUnknown):
+			NewErrorResponse(c, http.StatusInternalServerError, "failed to get subtasks")
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	resp := GetSubtasksResponse{Subtasks: make([]SubtaskResponse, 0, len(children))}
+	for _, child := range children {
+		resp.Subtasks = append(resp.Subtasks, taskDomainToSubtaskResponse(&child))
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Привязка дочерней задачи
+// @Schemes
+// @Tags Tasks
+// @Accept json
+// @Produce json
+// @Param task_id path string true "ID родительской задачи"
+// @Param attachSubtaskRequest body AttachSubtaskRequest true "request на привязку дочерней задачи"
+// @Success 200 {object}  SubtaskResponse
+// @Failure     400,404,408,409,500,503  {object}  ErrorResponse
+// @Router /v1/tasks/{task_id}/children [POST]
+func (h *HttpHandler) AttachSubtask(c *gin.Context) {
+	const op = "handlers.AttachSubtask"
+	log := slog.Default()
+	log.With("op", op)
+
+	var req AttachSubtaskRequest
+	if err := c.BindJSON(&req); err != nil {
+		log.Warn("failed to bind request", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, "bad body")
+		return
+	}
+
+	cmd, err := attachsubtask.NewCommand(c.Param("task_id"), req.ChildID)
+	if err != nil {
+		log.Warn("failed to create command", "error", err)
+		NewErrorResponse(c, http.StatusBadRequest, "invalid task id")
+		return
+	}
+
+	child, err := h.attachSubtaskUC.Handle(c.Request.Context(), cmd)
+	if err != nil {
+		log.Error("failed to attach subtask", "error", err)
+		switch {
+		case errors.Is(err, attachsubtask.ErrParentNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "parent task not found")
+		case errors.Is(err, attachsubtask.ErrTaskNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "task not found")
+		case errors.Is(err, attachsubtask.ErrInvalidHierarchy):
+			NewErrorResponse(c, http.StatusConflict, err.Error())
+		case errors.Is(err, attachsubtask.ErrAttachSubtaskUnknown):
+			NewErrorResponse(c, http.StatusInternalServerError, "failed to attach subtask")
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, taskDomainToSubtaskResponse(child))
+}
+
+// @Summary Отвязка дочерней задачи
+// @Schemes
+// @Tags Tasks
+// @Accept json
+// @Produce json
+// @Param task_id path string true "ID родительской задачи"
+// @Param child_id path string true "ID дочерней задачи"
+// @Success 204
+// @Failure     400,404,408,409,500,503  {object}  ErrorResponse
+// @Router /v1/tasks/{task_id}/children/{child_id} [DELETE]
+func (h *HttpHandler) DetachSubtask(c *gin.Context) {
+	const op = "handlers.DetachSubtask"
+	log := slog.Default()
+	log.With("op", op)
+
+	cmd, err := detachsubtask.NewCommand(c.Param("task_id"), c.Param("child_id"))
+	if err != nil {
+		log.Warn("failed to create command", "error", err)
+		NewErrorResponse(c, http.StatusBadRequest, "invalid task id")
+		return
+	}
+
+	if err := h.detachSubtaskUC.Handle(c.Request.Context(), cmd); err != nil {
+		log.Error("failed to detach subtask", "error", err)
+		switch {
+		case errors.Is(err, detachsubtask.ErrTaskNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "task not found")
+		case errors.Is(err, detachsubtask.ErrTaskIsNotChild):
+			NewErrorResponse(c, http.StatusConflict, "task is not a child of the parent")
+		case errors.Is(err, detachsubtask.ErrDetachSubtaskUnknown):
+			NewErrorResponse(c, http.StatusInternalServerError, "failed to detach subtask")
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func taskDomainToSubtaskResponse(task *domain.Task) SubtaskResponse {
+	resp := SubtaskResponse{
+		ID:       task.ID,
+		ColumnID: task.ColumnID,
+		BoardID:  task.BoardID,
+		Number:   task.Number,
+		Title:    task.Title,
+	}
+	if task.ParentID != nil {
+		resp.ParentID = *task.ParentID
+	}
+	return resp
+}
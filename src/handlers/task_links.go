@@ -0,0 +1,237 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/createtasklink"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/deletetasklink"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/gettasklinks"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	linkDirectionOutward = "outward"
+	linkDirectionInward  = "inward"
+)
+
+type (
+	CreateTaskLinkRequest struct {
+		TaskID string `json:"task_id" binding:"required"`
+		Type   string `json:"type" binding:"required"`
+	}
+
+	TaskLinkDto struct {
+		ID        uuid.UUID     `json:"id"`
+		Type      string        `json:"type"`
+		Direction string        `json:"direction"`
+		Label     string        `json:"label"`
+		Task      LinkedTaskDto `json:"task"`
+		CreatedAt time.Time     `json:"created_at"`
+	}
+
+	LinkedTaskDto struct {
+		ID      uuid.UUID `json:"id"`
+		BoardID uuid.UUID `json:"board_id"`
+		Key     string    `json:"key"`
+		Number  int64     `json:"number"`
+		Title   string    `json:"title"`
+	}
+
+	GetTaskLinksResponse struct {
+		Links []TaskLinkDto `json:"links"`
+	}
+
+	CreateTaskLinkUseCase interface {
+		Handle(ctx context.Context, cmd createtasklink.Command) (*domain.TaskLink, error)
+	}
+
+	GetTaskLinksUseCase interface {
+		Handle(ctx context.Context, q gettasklinks.Query) ([]domain.TaskLink, error)
+	}
+
+	DeleteTaskLinkUseCase interface {
+		Handle(ctx context.Context, cmd deletetasklink.Command) error
+	}
+)
+
+// @Summary Создание связи между задачами
+// @Description Типы связей: blocks, relates_to, duplicates. Связь направлена от задачи из пути к task_id из тела.
+// @Schemes
+// @Tags Tasks
+// @Accept json
+// @Produce json
+// @Param task_id path string true "ID задачи"
+// @Param createTaskLinkRequest body CreateTaskLinkRequest true "request на создание связи"
+// @Success 201 {object}  TaskLinkDto
+// @Failure     400,404,408,409,500,503  {object}  ErrorResponse
+// @Router /v1/tasks/{task_id}/links [POST]
+func (h *HttpHandler) CreateTaskLink(c *gin.Context) {
+	const op = "handlers.CreateTaskLink"
+	log := slog.Default()
+	log.With("op", op)
+
+	var req CreateTaskLinkRequest
+	if err := c.BindJSON(&req); err != nil {
+		log.Warn("failed to bind request", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, "bad body")
+		return
+	}
+
+	cmd, err := createtasklink.NewCommand(c.Param("task_id"), req.TaskID, req.Type)
+	if err != nil {
+		log.Warn("failed to create command", "error", err)
+		NewErrorResponse(c, http.StatusBadRequest, "invalid task id")
+		return
+	}
+
+	link, err := h.createTaskLinkUC.Handle(c.Request.Context(), cmd)
+	if err != nil {
+		log.Error("failed to create task link", "error", err)
+		switch {
+		case errors.Is(err, createtasklink.ErrTaskNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "task not found")
+		case errors.Is(err, createtasklink.ErrLinkedTaskNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "linked task not found")
+		case errors.Is(err, createtasklink.ErrValidationFailed):
+			NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		case errors.Is(err, createtasklink.ErrLinkAlreadyExists):
+			NewErrorResponse(c, http.StatusConflict, "link already exists")
+		case errors.Is(err, createtasklink.ErrCreateTaskLinkUnknown):
+			NewErrorResponse(c, http.StatusInternalServerError, "failed to create task link")
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, taskLinkDomainToDto(link, cmd.TaskID))
+}
+
+// @Summary Получение связей задачи
+// @Schemes
+// @Tags Tasks
+// @Accept json
+// @Produce json
+// @Param task_id path string true "ID задачи"
+// @Success 200 {object}  GetTaskLinksResponse
+// @Failure     400,404,408,500,503  {object}  ErrorResponse
+// @Router /v1/tasks/{task_id}/links [GET]
+func (h *HttpHandler) GetTaskLinks(c *gin.Context) {
+	const op = "handlers.GetTaskLinks"
+	log := slog.Default()
+	log.With("op", op)
+
+	qry, err := gettasklinks.NewQuery(c.Param("task_id"))
+	if err != nil {
+		log.Warn("failed to create query", "error", err)
+		NewErrorResponse(c, http.StatusBadRequest, "invalid task id")
+		return
+	}
+
+	links, err := h.getTaskLinksUC.Handle(c.Request.Context(), qry)
+	if err != nil {
+		log.Error("failed to get task links", "error", err)
+		switch {
+		case errors.Is(err, gettasklinks.ErrTaskNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "task not found")
+		case errors.Is(err, gettasklinks.ErrGetTaskLinksUnknown):
+			NewErrorResponse(c, http.StatusInternalServerError, "failed to get task links")
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, GetTaskLinksResponse{Links: taskLinksDomainToDto(links, qry.TaskID)})
+}
+
+// @Summary Удаление связи между задачами
+// @Schemes
+// @Tags Tasks
+// @Accept json
+// @Produce json
+// @Param task_id path string true "ID задачи"
+// @Param link_id path string true "ID связи"
+// @Success 204
+// @Failure     400,404,408,500,503  {object}  ErrorResponse
+// @Router /v1/tasks/{task_id}/links/{link_id} [DELETE]
+func (h *HttpHandler) DeleteTaskLink(c *gin.Context) {
+	const op = "handlers.DeleteTaskLink"
+	log := slog.Default()
+	log.With("op", op)
+
+	cmd, err := deletetasklink.NewCommand(c.Param("task_id"), c.Param("link_id"))
+	if err != nil {
+		log.Warn("failed to create command", "error", err)
+		NewErrorResponse(c, http.StatusBadRequest, "invalid task or link id")
+		return
+	}
+
+	err = h.deleteTaskLinkUC.Handle(c.Request.Context(), cmd)
+	if err != nil {
+		log.Error("failed to delete task link", "error", err)
+		switch {
+		case errors.Is(err, deletetasklink.ErrLinkNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "task link not found")
+		case errors.Is(err, deletetasklink.ErrDeleteTaskLinkUnknown):
+			NewErrorResponse(c, http.StatusInternalServerError, "failed to delete task link")
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+// taskLinkDomainToDto описывает связь с точки зрения задачи taskID
+func taskLinkDomainToDto(link *domain.TaskLink, taskID uuid.UUID) TaskLinkDto {
+	direction := linkDirectionInward
+	if link.IsOutward(taskID) {
+		direction = linkDirectionOutward
+	}
+
+	dto := TaskLinkDto{
+		ID:        link.ID,
+		Type:      string(link.Type),
+		Direction: direction,
+		Label:     link.Label(taskID),
+		CreatedAt: link.CreatedAt,
+	}
+	if link.LinkedTask != nil {
+		dto.Task = LinkedTaskDto{
+			ID:      link.LinkedTask.ID,
+			BoardID: link.LinkedTask.BoardID,
+			Key:     link.LinkedTask.Key(),
+			Number:  link.LinkedTask.Number,
+			Title:   link.LinkedTask.Title,
+		}
+	}
+	return dto
+}
+
+func taskLinksDomainToDto(links []domain.TaskLink, taskID uuid.UUID) []TaskLinkDto {
+	resp := make([]TaskLinkDto, 0, len(links))
+	for _, link := range links {
+		resp = append(resp, taskLinkDomainToDto(&link, taskID))
+	}
+	return resp
+}
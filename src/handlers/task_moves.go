@@ -0,0 +1,219 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/gettaskhistory"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/movetasktoboard"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/resolvetaskkey"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type (
+	MoveTaskToBoardRequest struct {
+		BoardID  string `json:"board_id" binding:"required"`
+		ColumnID string `json:"column_id" binding:"required"`
+		// Замена тегов: старый -> новый, "" - удалить. Теги без замены, которых нет в целевой доске, удаляются.
+		TagMapping map[string]string `json:"tag_mapping"`
+	}
+
+	MoveTaskToBoardResponse struct {
+		Task         *GetTaskResponse  `json:"task"`
+		FromKey      string            `json:"from_key"`
+		ToKey        string            `json:"to_key"`
+		RemappedTags map[string]string `json:"remapped_tags"`
+		DroppedTags  []string          `json:"dropped_tags"`
+	}
+
+	ResolveTaskKeyResponse struct {
+		Task *GetTaskResponse `json:"task"`
+		// Актуальный ключ задачи; отличается от запрошенного, если задачу перенесли
+		Key        string `json:"key"`
+		Redirected bool   `json:"redirected"`
+	}
+
+	TaskHistoryEntryDto struct {
+		ID        uuid.UUID      `json:"id"`
+		Kind      string         `json:"kind"`
+		Data      map[string]any `json:"data"`
+		CreatedAt time.Time      `json:"created_at"`
+	}
+
+	GetTaskHistoryResponse struct {
+		Entries []TaskHistoryEntryDto `json:"entries"`
+	}
+
+	MoveTaskToBoardUseCase interface {
+		Handle(ctx context.Context, cmd movetasktoboard.Command) (*domain.Task, domain.BoardMove, error)
+	}
+
+	ResolveTaskKeyUseCase interface {
+		Handle(ctx context.Context, q resolvetaskkey.Query) (*resolvetaskkey.Result, error)
+	}
+
+	GetTaskHistoryUseCase interface {
+		Handle(ctx context.Context, q gettaskhistory.Query) ([]domain.TaskHistoryEntry, error)
+	}
+)
+
+// MoveTaskToBoard godoc
+// @Summary Перенос задачи в другую доску
+// @Description Задача получает следующий номер в целевой доске, старый ключ открывается через редирект
+// @Tags Tasks
+// @Accept json
+// @Produce json
+// @Param task_id path string true "ID задачи"
+// @Param request body MoveTaskToBoardRequest true "Целевая доска и колонка"
+// @Success 200 {object} MoveTaskToBoardResponse
+// @Failure 400,404,408,409,500,503 {object} ErrorResponse
+// @Router /v1/tasks/{task_id}/move-to-board [post]
+func (h *HttpHandler) MoveTaskToBoard(c *gin.Context) {
+	const op = "handlers.MoveTaskToBoard"
+	log := slog.Default()
+	log.With("op", op)
+
+	var req MoveTaskToBoardRequest
+	if err := c.BindJSON(&req); err != nil {
+		log.Warn("failed to bind request", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, "bad body")
+		return
+	}
+
+	cmd, err := movetasktoboard.NewCommand(c.Param("task_id"), req.BoardID, req.ColumnID, req.TagMapping)
+	if err != nil {
+		log.Warn("failed to create command", "error", err)
+		NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	task, move, err := h.moveTaskToBoardUC.Handle(c.Request.Context(), cmd)
+	if err != nil {
+		log.Error("failed to move task to board", "error", err)
+		switch {
+		case errors.Is(err, movetasktoboard.ErrTaskNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "task not found")
+		case errors.Is(err, movetasktoboard.ErrBoardNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "board not found")
+		case errors.Is(err, movetasktoboard.ErrColumnNotInBoard):
+			NewErrorResponse(c, http.StatusNotFound, movetasktoboard.ErrColumnNotInBoard.Error())
+		case errors.Is(err, movetasktoboard.ErrTaskAlreadyInBoard):
+			NewErrorResponse(c, http.StatusConflict, movetasktoboard.ErrTaskAlreadyInBoard.Error())
+		case errors.Is(err, movetasktoboard.ErrStoryPointsNotInScale):
+			NewErrorResponse(c, http.StatusBadRequest, movetasktoboard.ErrStoryPointsNotInScale.Error())
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, MoveTaskToBoardResponse{
+		Task:         taskDomainToGetTaskResponse(task),
+		FromKey:      move.FromKey,
+		ToKey:        move.ToKey,
+		RemappedTags: move.RemappedTags,
+		DroppedTags:  move.DroppedTags,
+	})
+}
+
+// ResolveTaskKey godoc
+// @Summary Задача по ключу
+// @Description Ищет задачу по ключу вида TEAM-42, в том числе по старому ключу перенесенной задачи
+// @Tags Tasks
+// @Produce json
+// @Param key path string true "Ключ задачи, например TEAM-42"
+// @Success 200 {object} ResolveTaskKeyResponse
+// @Failure 400,404,408,500,503 {object} ErrorResponse
+// @Router /v1/task-keys/{key} [get]
+func (h *HttpHandler) ResolveTaskKey(c *gin.Context) {
+	const op = "handlers.ResolveTaskKey"
+	log := slog.Default()
+	log.With("op", op)
+
+	q, err := resolvetaskkey.NewQuery(c.Param("key"))
+	if err != nil {
+		log.Warn("failed to create query", "error", err)
+		NewErrorResponse(c, http.StatusBadRequest, domain.ErrInvalidTaskKey.Error())
+		return
+	}
+
+	res, err := h.resolveTaskKeyUC.Handle(c.Request.Context(), q)
+	if err != nil {
+		log.Error("failed to resolve task key", "error", err)
+		switch {
+		case errors.Is(err, resolvetaskkey.ErrTaskNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "task not found")
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, ResolveTaskKeyResponse{
+		Task:       taskDomainToGetTaskResponse(res.Task),
+		Key:        res.Task.Key(),
+		Redirected: res.Redirected,
+	})
+}
+
+// GetTaskHistory godoc
+// @Summary История задачи
+// @Tags Tasks
+// @Produce json
+// @Param task_id path string true "ID задачи"
+// @Success 200 {object} GetTaskHistoryResponse
+// @Failure 400,404,408,500,503 {object} ErrorResponse
+// @Router /v1/tasks/{task_id}/history [get]
+func (h *HttpHandler) GetTaskHistory(c *gin.Context) {
+	const op = "handlers.GetTaskHistory"
+	log := slog.Default()
+	log.With("op", op)
+
+	q, err := gettaskhistory.NewQuery(c.Param("task_id"))
+	if err != nil {
+		log.Warn("failed to create query", "error", err)
+		NewErrorResponse(c, http.StatusBadRequest, "invalid task id")
+		return
+	}
+
+	entries, err := h.getTaskHistoryUC.Handle(c.Request.Context(), q)
+	if err != nil {
+		log.Error("failed to get task history", "error", err)
+		switch {
+		case errors.Is(err, gettaskhistory.ErrTaskNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "task not found")
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	resp := GetTaskHistoryResponse{Entries: make([]TaskHistoryEntryDto, 0, len(entries))}
+	for _, e := range entries {
+		resp.Entries = append(resp.Entries, TaskHistoryEntryDto{
+			ID:        e.ID,
+			Kind:      string(e.Kind),
+			Data:      e.Data,
+			CreatedAt: e.CreatedAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,182 @@
+package handlers
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/gettimereport"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type (
+	TimeReportEntryDto struct {
+		WorklogResponse
+		BoardID   uuid.UUID `json:"board_id"`
+		TaskKey   string    `json:"task_key"`
+		TaskTitle string    `json:"task_title"`
+	}
+
+	TimeTotalDto struct {
+		Key     string `json:"key"`
+		Minutes int    `json:"minutes"`
+	}
+
+	TimeReportResponse struct {
+		From         string               `json:"from"`
+		To           string               `json:"to"`
+		BoardID      *uuid.UUID           `json:"board_id"`
+		UserID       *string              `json:"user_id"`
+		TotalMinutes int                  `json:"total_minutes"`
+		ByUser       []TimeTotalDto       `json:"by_user"`
+		ByTask       []TimeTotalDto       `json:"by_task"`
+		ByDay        []TimeTotalDto       `json:"by_day"`
+		Entries      []TimeReportEntryDto `json:"entries"`
+	}
+
+	GetTimeReportUseCase interface {
+		Handle(ctx context.Context, query gettimereport.Query) (*domain.TimeReport, error)
+	}
+)
+
+// @Summary Отчет по списанному времени
+// @Description Записи за период с итогами по пользователям, задачам и дням. Период - даты YYYY-MM-DD
+// @Description включительно, не больше 366 дней. format=csv отдает записи файлом CSV со строкой итога.
+// @Schemes
+// @Tags Worklogs
+// @Produce json
+// @Produce text/csv
+// @Param from query string true "Начало периода, YYYY-MM-DD"
+// @Param to query string true "Конец периода, YYYY-MM-DD"
+// @Param board_id query string false "ID доски"
+// @Param user_id query string false "ID пользователя"
+// @Param format query string false "json (по умолчанию) или csv"
+// @Success 200 {object} TimeReportResponse
+// @Failure     400,404,408,500,503  {object}  ErrorResponse
+// @Router /v1/reports/time [GET]
+func (h *HttpHandler) GetTimeReport(c *gin.Context) {
+	const op = "handlers.GetTimeReport"
+	log := slog.Default()
+	log.With("op", op)
+
+	format := c.DefaultQuery("format", "json")
+	if format != "json" && format != "csv" {
+		NewErrorResponse(c, http.StatusBadRequest, "format must be json or csv")
+		return
+	}
+
+	query, err := gettimereport.NewQuery(c.Query("board_id"), c.Query("user_id"), c.Query("from"), c.Query("to"))
+	if err != nil {
+		log.Warn("failed to create query", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	report, err := h.getTimeReportUC.Handle(c, query)
+	if err != nil {
+		log.Error("failed to get time report", slog.String("err", err.Error()))
+
+		switch {
+		case errors.Is(err, gettimereport.ErrBoardNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "board not found")
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	if format == "csv" {
+		filename := "time-report-" + report.Filter.From.Format(time.DateOnly) + "-" + report.Filter.To.Format(time.DateOnly) + ".csv"
+		c.Header("Content-Type", "text/csv; charset=utf-8")
+		c.Header("Content-Disposition", `attachment; filename="`+filename+`"`)
+		c.Status(http.StatusOK)
+		if err := writeTimeReportCSV(c.Writer, report); err != nil {
+			log.Error("failed to write csv", slog.String("err", err.Error()))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, timeReportDomainToResponse(report))
+}
+
+func timeReportDomainToResponse(report *domain.TimeReport) TimeReportResponse {
+	resp := TimeReportResponse{
+		From:         report.Filter.From.Format(time.DateOnly),
+		To:           report.Filter.To.Format(time.DateOnly),
+		BoardID:      report.Filter.BoardID,
+		UserID:       report.Filter.UserID,
+		TotalMinutes: report.TotalMinutes,
+		ByUser:       timeTotalsToDto(report.ByUser),
+		ByTask:       timeTotalsToDto(report.ByTask),
+		ByDay:        timeTotalsToDto(report.ByDay),
+		Entries:      make([]TimeReportEntryDto, 0, len(report.Entries)),
+	}
+	for _, e := range report.Entries {
+		resp.Entries = append(resp.Entries, TimeReportEntryDto{
+			WorklogResponse: worklogDomainToResponse(e.Worklog),
+			BoardID:         e.BoardID,
+			TaskKey:         e.TaskKey,
+			TaskTitle:       e.TaskTitle,
+		})
+	}
+	return resp
+}
+
+func timeTotalsToDto(totals []domain.TimeTotal) []TimeTotalDto {
+	resp := make([]TimeTotalDto, 0, len(totals))
+	for _, t := range totals {
+		resp = append(resp, TimeTotalDto{Key: t.Key, Minutes: t.Minutes})
+	}
+	return resp
+}
+
+// writeTimeReportCSV пишет по строке на запись и строку с итогом.
+func writeTimeReportCSV(w io.Writer, report *domain.TimeReport) error {
+	cw := csv.NewWriter(w)
+
+	_ = cw.Write([]string{"date", "user_id", "task", "title", "minutes", "hours", "note"})
+	for _, e := range report.Entries {
+		var note string
+		if e.Note != nil {
+			note = *e.Note
+		}
+		_ = cw.Write([]string{
+			e.Date.Format(time.DateOnly),
+			csvText(e.UserID),
+			e.TaskKey,
+			csvText(e.TaskTitle),
+			strconv.Itoa(e.Minutes),
+			formatHours(e.Minutes),
+			csvText(note),
+		})
+	}
+	_ = cw.Write([]string{"total", "", "", "", strconv.Itoa(report.TotalMinutes), formatHours(report.TotalMinutes), ""})
+
+	cw.Flush()
+	return cw.Error()
+}
+
+func formatHours(minutes int) string {
+	return strconv.FormatFloat(float64(minutes)/60, 'f', 2, 64)
+}
+
+// csvText не дает табличным редакторам принять пользовательский текст
+// за формулу.
+func csvText(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
@@ -0,0 +1,399 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/archiveboard"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/gettrash"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/restoreboard"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/restorecolumn"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/restoretask"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type (
+	TrashBoardResponse struct {
+		ID         uuid.UUID  `json:"id"`
+		Name       string     `json:"name"`
+		ShortName  string     `json:"short_name"`
+		ArchivedAt *time.Time `json:"archived_at,omitempty"`
+		DeletedAt  *time.Time `json:"deleted_at"`
+		UpdatedAt  time.Time  `json:"updated_at"`
+	}
+
+	GetTrashBoardsResponse struct {
+		Boards []TrashBoardResponse `json:"boards"`
+	}
+
+	TrashColumnResponse struct {
+		ID        uuid.UUID  `json:"id"`
+		Name      string     `json:"name"`
+		OrderNum  int64      `json:"order_num"`
+		DeletedAt *time.Time `json:"deleted_at"`
+	}
+
+	TrashTaskResponse struct {
+		ID        uuid.UUID  `json:"id"`
+		ColumnID  uuid.UUID  `json:"column_id"`
+		Number    int64      `json:"number"`
+		Title     string     `json:"title"`
+		ParentID  *uuid.UUID `json:"parent_id"`
+		DeletedAt *time.Time `json:"deleted_at"`
+	}
+
+	GetTrashResponse struct {
+		Board   TrashBoardResponse    `json:"board"`
+		Columns []TrashColumnResponse `json:"columns"`
+		Tasks   []TrashTaskResponse   `json:"tasks"`
+	}
+
+	RestoreTaskResponse struct {
+		Task     TrashTaskResponse `json:"task"`
+		Restored int64             `json:"restored"`
+	}
+
+	GetTrashBoardsUseCase interface {
+		Handle(ctx context.Context) ([]domain.Board, error)
+	}
+
+	GetTrashUseCase interface {
+		Handle(ctx context.Context, q gettrash.Query) (*domain.Trash, error)
+	}
+
+	RestoreBoardUseCase interface {
+		Handle(ctx context.Context, cmd restoreboard.Command) (*domain.Board, error)
+	}
+
+	RestoreColumnUseCase interface {
+		Handle(ctx context.Context, cmd restorecolumn.Command) (*domain.Column, error)
+	}
+
+	RestoreTaskUseCase interface {
+		Handle(ctx context.Context, cmd restoretask.Command) (*domain.Task, int64, error)
+	}
+
+	ArchiveBoardUseCase interface {
+		Handle(ctx context.Context, cmd archiveboard.Command) (*domain.Board, error)
+	}
+)
+
+// GetTrashBoards godoc
+// @Summary Удаленные доски
+// @Description Список мягко удаленных досок, последние удаленные сначала
+// @Tags Trash
+// @Produce json
+// @Success 200 {object} GetTrashBoardsResponse
+// @Failure 408,500,503 {object} ErrorResponse
+// @Router /v1/trash [get]
+func (h *HttpHandler) GetTrashBoards(c *gin.Context) {
+	const op = "handlers.GetTrashBoards"
+	log := slog.Default()
+	log.With("op", op)
+
+	boards, err := h.getTrashBoardsUC.Handle(c.Request.Context())
+	if err != nil {
+		log.Error("failed to get deleted boards", "error", err)
+		switch {
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	resp := GetTrashBoardsResponse{Boards: make([]TrashBoardResponse, 0, len(boards))}
+	for i := range boards {
+		resp.Boards = append(resp.Boards, trashBoardDomainToResponse(&boards[i]))
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetTrash godoc
+// @Summary Корзина доски
+// @Description Удаленные колонки и задачи доски, последние удаленные сначала
+// @Tags Trash
+// @Produce json
+// @Param id path string true "ID доски"
+// @Success 200 {object} GetTrashResponse
+// @Failure 400,404,408,500,503 {object} ErrorResponse
+// @Router /v1/boards/{id}/trash [get]
+func (h *HttpHandler) GetTrash(c *gin.Context) {
+	const op = "handlers.GetTrash"
+	log := slog.Default()
+	log.With("op", op)
+
+	q, err := gettrash.NewQuery(c.Param("id"))
+	if err != nil {
+		log.Warn("failed to create query", "error", err)
+		NewErrorResponse(c, http.StatusBadRequest, "invalid board id")
+		return
+	}
+
+	trash, err := h.getTrashUC.Handle(c.Request.Context(), q)
+	if err != nil {
+		log.Error("failed to get trash", "error", err)
+		switch {
+		case errors.Is(err, gettrash.ErrBoardNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "board not found")
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	resp := GetTrashResponse{
+		Board:   trashBoardDomainToResponse(&trash.Board),
+		Columns: make([]TrashColumnResponse, 0, len(trash.Columns)),
+		Tasks:   make([]TrashTaskResponse, 0, len(trash.Tasks)),
+	}
+	for _, col := range trash.Columns {
+		resp.Columns = append(resp.Columns, TrashColumnResponse{
+			ID:        col.ID,
+			Name:      col.Name,
+			OrderNum:  col.OrderNum,
+			DeletedAt: col.DeletedAt,
+		})
+	}
+	for i := range trash.Tasks {
+		resp.Tasks = append(resp.Tasks, trashTaskDomainToResponse(&trash.Tasks[i]))
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// RestoreBoard godoc
+// @Summary Восстановление доски
+// @Description Возвращает доску из корзины вместе с колонками и задачами, удаленными вместе с ней
+// @Tags Trash
+// @Produce json
+// @Param id path string true "ID доски"
+// @Success 200 {object} TrashBoardResponse
+// @Failure 400,404,408,409,500,503 {object} ErrorResponse
+// @Router /v1/boards/{id}/restore [post]
+func (h *HttpHandler) RestoreBoard(c *gin.Context) {
+	const op = "handlers.RestoreBoard"
+	log := slog.Default()
+	log.With("op", op)
+
+	cmd, err := restoreboard.NewCommand(c.Param("id"))
+	if err != nil {
+		log.Warn("failed to create command", "error", err)
+		NewErrorResponse(c, http.StatusBadRequest, "invalid board id")
+		return
+	}
+
+	board, err := h.restoreBoardUC.Handle(c.Request.Context(), cmd)
+	if err != nil {
+		log.Error("failed to restore board", "error", err)
+		switch {
+		case errors.Is(err, restoreboard.ErrBoardNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "board not found")
+		case errors.Is(err, restoreboard.ErrBoardNotDeleted):
+			NewErrorResponse(c, http.StatusConflict, restoreboard.ErrBoardNotDeleted.Error())
+		case errors.Is(err, restoreboard.ErrBoardIsExists):
+			NewErrorResponse(c, http.StatusConflict, restoreboard.ErrBoardIsExists.Error())
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, trashBoardDomainToResponse(board))
+}
+
+// RestoreColumn godoc
+// @Summary Восстановление колонки
+// @Tags Trash
+// @Produce json
+// @Param column_id path string true "ID колонки"
+// @Success 200 {object} TrashColumnResponse
+// @Failure 400,404,408,409,500,503 {object} ErrorResponse
+// @Router /v1/columns/{column_id}/restore [post]
+func (h *HttpHandler) RestoreColumn(c *gin.Context) {
+	const op = "handlers.RestoreColumn"
+	log := slog.Default()
+	log.With("op", op)
+
+	cmd, err := restorecolumn.NewCommand(c.Param("column_id"))
+	if err != nil {
+		log.Warn("failed to create command", "error", err)
+		NewErrorResponse(c, http.StatusBadRequest, "invalid column id")
+		return
+	}
+
+	column, err := h.restoreColumnUC.Handle(c.Request.Context(), cmd)
+	if err != nil {
+		log.Error("failed to restore column", "error", err)
+		switch {
+		case errors.Is(err, restorecolumn.ErrColumnNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "column not found")
+		case errors.Is(err, restorecolumn.ErrColumnNotDeleted):
+			NewErrorResponse(c, http.StatusConflict, restorecolumn.ErrColumnNotDeleted.Error())
+		case errors.Is(err, restorecolumn.ErrBoardDeleted):
+			NewErrorResponse(c, http.StatusConflict, restorecolumn.ErrBoardDeleted.Error())
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, TrashColumnResponse{
+		ID:        column.ID,
+		Name:      column.Name,
+		OrderNum:  column.OrderNum,
+		DeletedAt: column.DeletedAt,
+	})
+}
+
+// RestoreTask godoc
+// @Summary Восстановление задачи
+// @Description Возвращает задачу из корзины вместе с подзадачами, удаленными каскадом
+// @Tags Trash
+// @Produce json
+// @Param task_id path string true "ID задачи"
+// @Success 200 {object} RestoreTaskResponse
+// @Failure 400,404,408,409,500,503 {object} ErrorResponse
+// @Router /v1/tasks/{task_id}/restore [post]
+func (h *HttpHandler) RestoreTask(c *gin.Context) {
+	const op = "handlers.RestoreTask"
+	log := slog.Default()
+	log.With("op", op)
+
+	cmd, err := restoretask.NewCommand(c.Param("task_id"))
+	if err != nil {
+		log.Warn("failed to create command", "error", err)
+		NewErrorResponse(c, http.StatusBadRequest, "invalid task id")
+		return
+	}
+
+	task, restored, err := h.restoreTaskUC.Handle(c.Request.Context(), cmd)
+	if err != nil {
+		log.Error("failed to restore task", "error", err)
+		switch {
+		case errors.Is(err, restoretask.ErrTaskNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "task not found")
+		case errors.Is(err, restoretask.ErrTaskNotDeleted):
+			NewErrorResponse(c, http.StatusConflict, restoretask.ErrTaskNotDeleted.Error())
+		case errors.Is(err, restoretask.ErrBoardDeleted):
+			NewErrorResponse(c, http.StatusConflict, restoretask.ErrBoardDeleted.Error())
+		case errors.Is(err, restoretask.ErrColumnDeleted):
+			NewErrorResponse(c, http.StatusConflict, restoretask.ErrColumnDeleted.Error())
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, RestoreTaskResponse{
+		Task:     trashTaskDomainToResponse(task),
+		Restored: restored,
+	})
+}
+
+// ArchiveBoard godoc
+// @Summary Архивирование доски
+// @Description Архивная доска доступна для чтения, но не попадает в список досок без include_archived=true
+// @Tags Boards
+// @Produce json
+// @Param id path string true "ID доски"
+// @Success 200 {object} TrashBoardResponse
+// @Failure 400,404,408,409,500,503 {object} ErrorResponse
+// @Router /v1/boards/{id}/archive [post]
+func (h *HttpHandler) ArchiveBoard(c *gin.Context) {
+	h.setBoardArchived(c, true)
+}
+
+// UnarchiveBoard godoc
+// @Summary Возврат доски из архива
+// @Tags Boards
+// @Produce json
+// @Param id path string true "ID доски"
+// @Success 200 {object} TrashBoardResponse
+// @Failure 400,404,408,409,500,503 {object} ErrorResponse
+// @Router /v1/boards/{id}/unarchive [post]
+func (h *HttpHandler) UnarchiveBoard(c *gin.Context) {
+	h.setBoardArchived(c, false)
+}
+
+func (h *HttpHandler) setBoardArchived(c *gin.Context, archive bool) {
+	const op = "handlers.ArchiveBoard"
+	log := slog.Default()
+	log.With("op", op)
+
+	cmd, err := archiveboard.NewCommand(c.Param("id"), archive)
+	if err != nil {
+		log.Warn("failed to create command", "error", err)
+		NewErrorResponse(c, http.StatusBadRequest, "invalid board id")
+		return
+	}
+
+	board, err := h.archiveBoardUC.Handle(c.Request.Context(), cmd)
+	if err != nil {
+		log.Error("failed to archive board", "error", err, "archive", archive)
+		switch {
+		case errors.Is(err, archiveboard.ErrBoardNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "board not found")
+		case errors.Is(err, archiveboard.ErrBoardArchived):
+			NewErrorResponse(c, http.StatusConflict, archiveboard.ErrBoardArchived.Error())
+		case errors.Is(err, archiveboard.ErrBoardNotArchived):
+			NewErrorResponse(c, http.StatusConflict, archiveboard.ErrBoardNotArchived.Error())
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, trashBoardDomainToResponse(board))
+}
+
+func trashBoardDomainToResponse(board *domain.Board) TrashBoardResponse {
+	return TrashBoardResponse{
+		ID:         board.ID,
+		Name:       board.Name,
+		ShortName:  board.ShortName,
+		ArchivedAt: board.ArchivedAt,
+		DeletedAt:  board.DeletedAt,
+		UpdatedAt:  board.UpdatedAt,
+	}
+}
+
+func trashTaskDomainToResponse(task *domain.Task) TrashTaskResponse {
+	return TrashTaskResponse{
+		ID:        task.ID,
+		ColumnID:  task.ColumnID,
+		Number:    task.Number,
+		Title:     task.Title,
+		ParentID:  task.ParentID,
+		DeletedAt: task.DeletedAt,
+	}
+}
@@ -0,0 +1,254 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/getwatchers"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/unwatch"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/watch"
+	"github.com/gin-gonic/gin"
+)
+
+type (
+	WatcherResponse struct {
+		UserID    string    `json:"user_id"`
+		CreatedAt time.Time `json:"created_at"`
+	}
+
+	GetWatchersResponse struct {
+		Watchers []WatcherResponse `json:"watchers"`
+	}
+
+	WatchUseCase interface {
+		Handle(ctx context.Context, cmd watch.Command) error
+	}
+
+	UnwatchUseCase interface {
+		Handle(ctx context.Context, cmd unwatch.Command) error
+	}
+
+	GetWatchersUseCase interface {
+		Handle(ctx context.Context, query getwatchers.Query) ([]domain.Watcher, error)
+	}
+)
+
+// @Summary Подписаться на задачу
+// @Description Подписчик получает уведомления о переносе, изменении и удалении задачи.
+// @Schemes
+// @Tags Watchers
+// @Param User-ID header string true "ID пользователя"
+// @Param task_id path string true "ID задачи"
+// @Success 204
+// @Failure     400,404,408,500,503  {object}  ErrorResponse
+// @Router /v1/tasks/{task_id}/watchers [POST]
+func (h *HttpHandler) WatchTask(c *gin.Context) {
+	const op = "handlers.WatchTask"
+	log := slog.Default()
+	log.With("op", op)
+
+	cmd, err := watch.NewTaskCommand(c.GetHeader("User-ID"), c.Param("task_id"))
+	if err != nil {
+		log.Warn("failed to create command", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	h.watch(c, cmd)
+}
+
+// @Summary Подписаться на доску
+// @Description Подписчик доски получает уведомления обо всех ее задачах.
+// @Schemes
+// @Tags Watchers
+// @Param User-ID header string true "ID пользователя"
+// @Param id path string true "ID доски"
+// @Success 204
+// @Failure     400,404,408,500,503  {object}  ErrorResponse
+// @Router /v1/boards/{id}/watchers [POST]
+func (h *HttpHandler) WatchBoard(c *gin.Context) {
+	const op = "handlers.WatchBoard"
+	log := slog.Default()
+	log.With("op", op)
+
+	cmd, err := watch.NewBoardCommand(c.GetHeader("User-ID"), c.Param("id"))
+	if err != nil {
+		log.Warn("failed to create command", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	h.watch(c, cmd)
+}
+
+func (h *HttpHandler) watch(c *gin.Context, cmd watch.Command) {
+	err := h.watchUC.Handle(c, cmd)
+	if err != nil {
+		slog.Default().Error("failed to watch",
+			slog.String("err", err.Error()),
+			slog.String("User-id", cmd.Watcher.UserID))
+
+		switch {
+		case errors.Is(err, watch.ErrTaskNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "task not found")
+		case errors.Is(err, watch.ErrBoardNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "board not found")
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary Отписаться от задачи
+// @Schemes
+// @Tags Watchers
+// @Param User-ID header string true "ID пользователя"
+// @Param task_id path string true "ID задачи"
+// @Success 204
+// @Failure     400,408,500,503  {object}  ErrorResponse
+// @Router /v1/tasks/{task_id}/watchers [DELETE]
+func (h *HttpHandler) UnwatchTask(c *gin.Context) {
+	const op = "handlers.UnwatchTask"
+	log := slog.Default()
+	log.With("op", op)
+
+	cmd, err := unwatch.NewTaskCommand(c.GetHeader("User-ID"), c.Param("task_id"))
+	if err != nil {
+		log.Warn("failed to create command", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	h.unwatch(c, cmd)
+}
+
+// @Summary Отписаться от доски
+// @Description Подписки на отдельные задачи доски остаются.
+// @Schemes
+// @Tags Watchers
+// @Param User-ID header string true "ID пользователя"
+// @Param id path string true "ID доски"
+// @Success 204
+// @Failure     400,408,500,503  {object}  ErrorResponse
+// @Router /v1/boards/{id}/watchers [DELETE]
+func (h *HttpHandler) UnwatchBoard(c *gin.Context) {
+	const op = "handlers.UnwatchBoard"
+	log := slog.Default()
+	log.With("op", op)
+
+	cmd, err := unwatch.NewBoardCommand(c.GetHeader("User-ID"), c.Param("id"))
+	if err != nil {
+		log.Warn("failed to create command", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	h.unwatch(c, cmd)
+}
+
+func (h *HttpHandler) unwatch(c *gin.Context, cmd unwatch.Command) {
+	err := h.unwatchUC.Handle(c, cmd)
+	if err != nil {
+		slog.Default().Error("failed to unwatch",
+			slog.String("err", err.Error()),
+			slog.String("User-id", cmd.Watcher.UserID))
+
+		switch {
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary Подписчики задачи
+// @Description Только явные подписчики задачи, включая автора и исполнителя. Подписчики доски не входят.
+// @Schemes
+// @Tags Watchers
+// @Produce json
+// @Param task_id path string true "ID задачи"
+// @Success 200 {object} GetWatchersResponse
+// @Failure     400,404,408,500,503  {object}  ErrorResponse
+// @Router /v1/tasks/{task_id}/watchers [GET]
+func (h *HttpHandler) GetTaskWatchers(c *gin.Context) {
+	const op = "handlers.GetTaskWatchers"
+	log := slog.Default()
+	log.With("op", op)
+
+	query, err := getwatchers.NewTaskQuery(c.Param("task_id"))
+	if err != nil {
+		log.Warn("failed to create query", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	h.getWatchers(c, query)
+}
+
+// @Summary Подписчики доски
+// @Schemes
+// @Tags Watchers
+// @Produce json
+// @Param id path string true "ID доски"
+// @Success 200 {object} GetWatchersResponse
+// @Failure     400,404,408,500,503  {object}  ErrorResponse
+// @Router /v1/boards/{id}/watchers [GET]
+func (h *HttpHandler) GetBoardWatchers(c *gin.Context) {
+	const op = "handlers.GetBoardWatchers"
+	log := slog.Default()
+	log.With("op", op)
+
+	query, err := getwatchers.NewBoardQuery(c.Param("id"))
+	if err != nil {
+		log.Warn("failed to create query", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	h.getWatchers(c, query)
+}
+
+func (h *HttpHandler) getWatchers(c *gin.Context, query getwatchers.Query) {
+	watchers, err := h.getWatchersUC.Handle(c, query)
+	if err != nil {
+		slog.Default().Error("failed to get watchers", slog.String("err", err.Error()))
+
+		switch {
+		case errors.Is(err, getwatchers.ErrTaskNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "task not found")
+		case errors.Is(err, getwatchers.ErrBoardNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "board not found")
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	resp := GetWatchersResponse{Watchers: make([]WatcherResponse, 0, len(watchers))}
+	for _, w := range watchers {
+		resp.Watchers = append(resp.Watchers, WatcherResponse{UserID: w.UserID, CreatedAt: w.CreatedAt})
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
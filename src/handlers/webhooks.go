@@ -0,0 +1,357 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/createwebhook"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/deletewebhook"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/getwebhookdeliveries"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/getwebhooks"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/redeliverwebhook"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type (
+	CreateWebhookRequest struct {
+		URL    string   `json:"url" binding:"required"`
+		Events []string `json:"events" binding:"required"`
+		// Secret - ключ подписи HMAC-SHA256, если не задан - генерируется
+		Secret string `json:"secret"`
+	}
+
+	WebhookResponse struct {
+		ID        uuid.UUID `json:"id"`
+		BoardID   uuid.UUID `json:"board_id"`
+		URL       string    `json:"url"`
+		Events    []string  `json:"events"`
+		CreatedAt time.Time `json:"created_at"`
+		UpdatedAt time.Time `json:"updated_at"`
+	}
+
+	// CreateWebhookResponse - секрет отдается только при создании
+	CreateWebhookResponse struct {
+		WebhookResponse
+		Secret string `json:"secret"`
+	}
+
+	GetWebhooksResponse struct {
+		Webhooks []WebhookResponse `json:"webhooks"`
+	}
+
+	WebhookDeliveryResponse struct {
+		ID             uuid.UUID  `json:"id"`
+		EventID        uuid.UUID  `json:"event_id"`
+		EventType      string     `json:"event_type"`
+		Status         string     `json:"status"`
+		Attempts       int        `json:"attempts"`
+		NextAttemptAt  *time.Time `json:"next_attempt_at"`
+		LastStatusCode *int       `json:"last_status_code"`
+		LastError      *string    `json:"last_error"`
+		CreatedAt      time.Time  `json:"created_at"`
+		DeliveredAt    *time.Time `json:"delivered_at"`
+	}
+
+	GetWebhookDeliveriesResponse struct {
+		Deliveries []WebhookDeliveryResponse `json:"deliveries"`
+	}
+
+	CreateWebhookUseCase interface {
+		Handle(ctx context.Context, cmd createwebhook.Command) (*domain.Webhook, error)
+	}
+
+	GetWebhooksUseCase interface {
+		Handle(ctx context.Context, query getwebhooks.Query) ([]domain.Webhook, error)
+	}
+
+	DeleteWebhookUseCase interface {
+		Handle(ctx context.Context, cmd deletewebhook.Command) error
+	}
+
+	GetWebhookDeliveriesUseCase interface {
+		Handle(ctx context.Context, query getwebhookdeliveries.Query) ([]domain.WebhookDelivery, error)
+	}
+
+	RedeliverWebhookUseCase interface {
+		Handle(ctx context.Context, cmd redeliverwebhook.Command) (*domain.WebhookDelivery, error)
+	}
+)
+
+// @Summary Подписка на события доски
+// @Description События: task.created, task.updated, task.moved, task.moved_to_board, task.deleted, task.restored, column.created, column.updated, column.deleted, column.restored, board.restored.
+// @Description Тело запроса подписывается HMAC-SHA256 секретом и передается в заголовке X-Teamboard-Signature.
+// @Description Адрес должен вести на публичный хост: localhost, частные и link-local сети запрещены.
+// @Schemes
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param id path string true "ID доски"
+// @Param createWebhookRequest body CreateWebhookRequest true "Адрес получателя, события и секрет"
+// @Success 201 {object} CreateWebhookResponse
+// @Failure     400,404,408,500,503  {object}  ErrorResponse
+// @Router /v1/boards/{id}/webhooks [POST]
+func (h *HttpHandler) CreateWebhook(c *gin.Context) {
+	const op = "handlers.CreateWebhook"
+	log := slog.Default()
+	log.With("op", op)
+
+	var req CreateWebhookRequest
+	if err := c.BindJSON(&req); err != nil {
+		log.Warn("failed to bind request", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, "bad body")
+		return
+	}
+
+	cmd, err := createwebhook.NewCommand(c.Param("id"), req.URL, req.Events, req.Secret)
+	if err != nil {
+		log.Warn("failed to create command", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, "invalid board id")
+		return
+	}
+
+	webhook, err := h.createWebhookUC.Handle(c, cmd)
+	if err != nil {
+		log.Error("failed to create webhook",
+			slog.String("err", err.Error()),
+			slog.String("board_id", cmd.BoardID.String()))
+
+		switch {
+		case errors.Is(err, createwebhook.ErrInvalidWebhook):
+			NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		case errors.Is(err, createwebhook.ErrBoardNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "board not found")
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, CreateWebhookResponse{
+		WebhookResponse: webhookToResponse(webhook),
+		Secret:          webhook.Secret,
+	})
+}
+
+// @Summary Подписки доски на события
+// @Schemes
+// @Tags Webhooks
+// @Produce json
+// @Param id path string true "ID доски"
+// @Success 200 {object} GetWebhooksResponse
+// @Failure     400,404,408,500,503  {object}  ErrorResponse
+// @Router /v1/boards/{id}/webhooks [GET]
+func (h *HttpHandler) GetWebhooks(c *gin.Context) {
+	const op = "handlers.GetWebhooks"
+	log := slog.Default()
+	log.With("op", op)
+
+	query, err := getwebhooks.NewQuery(c.Param("id"))
+	if err != nil {
+		log.Warn("failed to create query", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, "invalid board id")
+		return
+	}
+
+	webhooks, err := h.getWebhooksUC.Handle(c, query)
+	if err != nil {
+		log.Error("failed to get webhooks",
+			slog.String("err", err.Error()),
+			slog.String("board_id", query.BoardID.String()))
+
+		switch {
+		case errors.Is(err, getwebhooks.ErrBoardNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "board not found")
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	resp := GetWebhooksResponse{Webhooks: make([]WebhookResponse, 0, len(webhooks))}
+	for i := range webhooks {
+		resp.Webhooks = append(resp.Webhooks, webhookToResponse(&webhooks[i]))
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Удаление подписки
+// @Description Недоставленные события подписки удаляются вместе с ней.
+// @Schemes
+// @Tags Webhooks
+// @Param webhook_id path string true "ID подписки"
+// @Success 204
+// @Failure     400,404,408,500,503  {object}  ErrorResponse
+// @Router /v1/webhooks/{webhook_id} [DELETE]
+func (h *HttpHandler) DeleteWebhook(c *gin.Context) {
+	const op = "handlers.DeleteWebhook"
+	log := slog.Default()
+	log.With("op", op)
+
+	cmd, err := deletewebhook.NewCommand(c.Param("webhook_id"))
+	if err != nil {
+		log.Warn("failed to create command", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, "invalid webhook id")
+		return
+	}
+
+	if err := h.deleteWebhookUC.Handle(c, cmd); err != nil {
+		log.Error("failed to delete webhook",
+			slog.String("err", err.Error()),
+			slog.String("webhook_id", cmd.WebhookID.String()))
+
+		switch {
+		case errors.Is(err, deletewebhook.ErrWebhookNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "webhook not found")
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary Журнал доставок подписки
+// @Description Последние доставки сначала.
+// @Schemes
+// @Tags Webhooks
+// @Produce json
+// @Param webhook_id path string true "ID подписки"
+// @Param status query string false "pending, delivered или dead"
+// @Param limit query int false "Количество записей (1-200, по умолчанию 50)"
+// @Success 200 {object} GetWebhookDeliveriesResponse
+// @Failure     400,404,408,500,503  {object}  ErrorResponse
+// @Router /v1/webhooks/{webhook_id}/deliveries [GET]
+func (h *HttpHandler) GetWebhookDeliveries(c *gin.Context) {
+	const op = "handlers.GetWebhookDeliveries"
+	log := slog.Default()
+	log.With("op", op)
+
+	query, err := getwebhookdeliveries.NewQuery(c.Param("webhook_id"), c.Query("status"), c.Query("limit"))
+	if err != nil {
+		log.Warn("failed to create query", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	deliveries, err := h.getWebhookDeliveriesUC.Handle(c, query)
+	if err != nil {
+		log.Error("failed to get webhook deliveries",
+			slog.String("err", err.Error()),
+			slog.String("webhook_id", query.WebhookID.String()))
+
+		switch {
+		case errors.Is(err, getwebhookdeliveries.ErrWebhookNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "webhook not found")
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	resp := GetWebhookDeliveriesResponse{Deliveries: make([]WebhookDeliveryResponse, 0, len(deliveries))}
+	for i := range deliveries {
+		resp.Deliveries = append(resp.Deliveries, webhookDeliveryToResponse(&deliveries[i]))
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Повторная доставка события
+// @Description Ставит доставку в очередь заново со сброшенным счетчиком попыток, в том числе из состояния dead.
+// @Schemes
+// @Tags Webhooks
+// @Produce json
+// @Param webhook_id path string true "ID подписки"
+// @Param delivery_id path string true "ID доставки"
+// @Success 202 {object} WebhookDeliveryResponse
+// @Failure     400,404,408,409,500,503  {object}  ErrorResponse
+// @Router /v1/webhooks/{webhook_id}/deliveries/{delivery_id}/redeliver [POST]
+func (h *HttpHandler) RedeliverWebhook(c *gin.Context) {
+	const op = "handlers.RedeliverWebhook"
+	log := slog.Default()
+	log.With("op", op)
+
+	cmd, err := redeliverwebhook.NewCommand(c.Param("webhook_id"), c.Param("delivery_id"))
+	if err != nil {
+		log.Warn("failed to create command", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	delivery, err := h.redeliverWebhookUC.Handle(c, cmd)
+	if err != nil {
+		log.Error("failed to redeliver webhook",
+			slog.String("err", err.Error()),
+			slog.String("delivery_id", cmd.DeliveryID.String()))
+
+		switch {
+		case errors.Is(err, redeliverwebhook.ErrDeliveryNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "webhook delivery not found")
+		case errors.Is(err, redeliverwebhook.ErrDeliveryPending):
+			NewErrorResponse(c, http.StatusConflict, redeliverwebhook.ErrDeliveryPending.Error())
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.JSON(http.StatusAccepted, webhookDeliveryToResponse(delivery))
+}
+
+func webhookToResponse(w *domain.Webhook) WebhookResponse {
+	events := make([]string, 0, len(w.Events))
+	for _, e := range w.Events {
+		events = append(events, string(e))
+	}
+
+	return WebhookResponse{
+		ID:        w.ID,
+		BoardID:   w.BoardID,
+		URL:       w.URL,
+		Events:    events,
+		CreatedAt: w.CreatedAt,
+		UpdatedAt: w.UpdatedAt,
+	}
+}
+
+func webhookDeliveryToResponse(d *domain.WebhookDelivery) WebhookDeliveryResponse {
+	return WebhookDeliveryResponse{
+		ID:             d.ID,
+		EventID:        d.EventID,
+		EventType:      string(d.EventType),
+		Status:         string(d.Status),
+		Attempts:       d.Attempts,
+		NextAttemptAt:  d.NextAttemptAt,
+		LastStatusCode: d.LastStatusCode,
+		LastError:      d.LastError,
+		CreatedAt:      d.CreatedAt,
+		DeliveredAt:    d.DeliveredAt,
+	}
+}
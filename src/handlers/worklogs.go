@@ -0,0 +1,353 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/addworklog"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/deleteworklog"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/getworklogs"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/putworklog"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/settaskestimate"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type (
+	// WorklogRequest - списанное время. Date в формате YYYY-MM-DD.
+	WorklogRequest struct {
+		Minutes int     `json:"minutes"`
+		Date    string  `json:"date"`
+		Note    *string `json:"note"`
+	}
+
+	WorklogResponse struct {
+		ID        uuid.UUID `json:"id"`
+		TaskID    uuid.UUID `json:"task_id"`
+		UserID    string    `json:"user_id"`
+		Minutes   int       `json:"minutes"`
+		Date      string    `json:"date"`
+		Note      *string   `json:"note"`
+		CreatedAt time.Time `json:"created_at"`
+		UpdatedAt time.Time `json:"updated_at"`
+	}
+
+	TaskEstimateDto struct {
+		TaskID                   uuid.UUID `json:"task_id"`
+		OriginalEstimateMinutes  *int      `json:"original_estimate_minutes"`
+		RemainingEstimateMinutes *int      `json:"remaining_estimate_minutes"`
+	}
+
+	GetWorklogsResponse struct {
+		TaskEstimateDto
+		TotalMinutes int               `json:"total_minutes"`
+		Worklogs     []WorklogResponse `json:"worklogs"`
+	}
+
+	// SetTaskEstimateRequest - оценки в минутах. Без remaining_estimate_minutes
+	// оставшаяся оценка сохраняется, а если ее не было - равна исходной.
+	SetTaskEstimateRequest struct {
+		OriginalEstimateMinutes  *int `json:"original_estimate_minutes"`
+		RemainingEstimateMinutes *int `json:"remaining_estimate_minutes"`
+	}
+
+	AddWorklogUseCase interface {
+		Handle(ctx context.Context, cmd addworklog.Command) (*domain.Worklog, error)
+	}
+
+	GetWorklogsUseCase interface {
+		Handle(ctx context.Context, query getworklogs.Query) (*getworklogs.Result, error)
+	}
+
+	PutWorklogUseCase interface {
+		Handle(ctx context.Context, cmd putworklog.Command) (*domain.Worklog, error)
+	}
+
+	DeleteWorklogUseCase interface {
+		Handle(ctx context.Context, cmd deleteworklog.Command) error
+	}
+
+	SetTaskEstimateUseCase interface {
+		Handle(ctx context.Context, cmd settaskestimate.Command) (*domain.Task, error)
+	}
+)
+
+func worklogDomainToResponse(w domain.Worklog) WorklogResponse {
+	return WorklogResponse{
+		ID:        w.ID,
+		TaskID:    w.TaskID,
+		UserID:    w.UserID,
+		Minutes:   w.Minutes,
+		Date:      w.Date.Format(time.DateOnly),
+		Note:      w.Note,
+		CreatedAt: w.CreatedAt,
+		UpdatedAt: w.UpdatedAt,
+	}
+}
+
+func taskEstimateToDto(task *domain.Task) TaskEstimateDto {
+	return TaskEstimateDto{
+		TaskID:                   task.ID,
+		OriginalEstimateMinutes:  task.OriginalEstimate,
+		RemainingEstimateMinutes: task.RemainingEstimate,
+	}
+}
+
+// @Summary Списать время на задачу
+// @Description Время вычитается из оставшейся оценки задачи, но не ниже нуля.
+// @Schemes
+// @Tags Worklogs
+// @Accept json
+// @Produce json
+// @Param User-ID header string true "ID пользователя"
+// @Param task_id path string true "ID задачи"
+// @Param worklogRequest body WorklogRequest true "списанное время"
+// @Success 201 {object} WorklogResponse
+// @Failure     400,404,408,500,503  {object}  ErrorResponse
+// @Router /v1/tasks/{task_id}/worklogs [POST]
+func (h *HttpHandler) AddWorklog(c *gin.Context) {
+	const op = "handlers.AddWorklog"
+	log := slog.Default()
+	log.With("op", op)
+
+	var req WorklogRequest
+	if err := c.BindJSON(&req); err != nil {
+		log.Warn("failed to bind request", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, "bad body")
+		return
+	}
+
+	cmd, err := addworklog.NewCommand(c.Param("task_id"), c.GetHeader("User-ID"), req.Minutes, req.Date, req.Note)
+	if err != nil {
+		log.Warn("failed to create command", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	worklog, err := h.addWorklogUC.Handle(c, cmd)
+	if err != nil {
+		log.Error("failed to add worklog", slog.String("err", err.Error()))
+
+		switch {
+		case errors.Is(err, addworklog.ErrTaskNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "task not found")
+		case errors.Is(err, addworklog.ErrValidationFailed):
+			NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, worklogDomainToResponse(*worklog))
+}
+
+// @Summary Получить списанное на задачу время
+// @Schemes
+// @Tags Worklogs
+// @Produce json
+// @Param task_id path string true "ID задачи"
+// @Success 200 {object} GetWorklogsResponse
+// @Failure     400,404,408,500,503  {object}  ErrorResponse
+// @Router /v1/tasks/{task_id}/worklogs [GET]
+func (h *HttpHandler) GetWorklogs(c *gin.Context) {
+	const op = "handlers.GetWorklogs"
+	log := slog.Default()
+	log.With("op", op)
+
+	query, err := getworklogs.NewQuery(c.Param("task_id"))
+	if err != nil {
+		log.Warn("failed to create query", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	res, err := h.getWorklogsUC.Handle(c, query)
+	if err != nil {
+		log.Error("failed to get worklogs", slog.String("err", err.Error()))
+
+		switch {
+		case errors.Is(err, getworklogs.ErrTaskNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "task not found")
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	resp := GetWorklogsResponse{
+		TaskEstimateDto: taskEstimateToDto(res.Task),
+		TotalMinutes:    res.TotalMinutes,
+		Worklogs:        make([]WorklogResponse, 0, len(res.Worklogs)),
+	}
+	for _, w := range res.Worklogs {
+		resp.Worklogs = append(resp.Worklogs, worklogDomainToResponse(w))
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Изменить списанное время
+// @Description Менять можно только свои записи. Разница во времени учитывается в оставшейся оценке задачи.
+// @Schemes
+// @Tags Worklogs
+// @Accept json
+// @Produce json
+// @Param User-ID header string true "ID пользователя"
+// @Param task_id path string true "ID задачи"
+// @Param worklog_id path string true "ID записи"
+// @Param worklogRequest body WorklogRequest true "списанное время"
+// @Success 200 {object} WorklogResponse
+// @Failure     400,403,404,408,500,503  {object}  ErrorResponse
+// @Router /v1/tasks/{task_id}/worklogs/{worklog_id} [PUT]
+func (h *HttpHandler) PutWorklog(c *gin.Context) {
+	const op = "handlers.PutWorklog"
+	log := slog.Default()
+	log.With("op", op)
+
+	var req WorklogRequest
+	if err := c.BindJSON(&req); err != nil {
+		log.Warn("failed to bind request", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, "bad body")
+		return
+	}
+
+	cmd, err := putworklog.NewCommand(c.Param("task_id"), c.Param("worklog_id"), c.GetHeader("User-ID"),
+		req.Minutes, req.Date, req.Note)
+	if err != nil {
+		log.Warn("failed to create command", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	worklog, err := h.putWorklogUC.Handle(c, cmd)
+	if err != nil {
+		log.Error("failed to update worklog", slog.String("err", err.Error()))
+
+		switch {
+		case errors.Is(err, putworklog.ErrWorklogNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "worklog not found")
+		case errors.Is(err, putworklog.ErrNotWorklogAuthor):
+			NewErrorResponse(c, http.StatusForbidden, err.Error())
+		case errors.Is(err, putworklog.ErrValidationFailed):
+			NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, worklogDomainToResponse(*worklog))
+}
+
+// @Summary Удалить списанное время
+// @Description Удалять можно только свои записи. Время возвращается в оставшуюся оценку задачи.
+// @Schemes
+// @Tags Worklogs
+// @Param User-ID header string true "ID пользователя"
+// @Param task_id path string true "ID задачи"
+// @Param worklog_id path string true "ID записи"
+// @Success 204
+// @Failure     400,403,404,408,500,503  {object}  ErrorResponse
+// @Router /v1/tasks/{task_id}/worklogs/{worklog_id} [DELETE]
+func (h *HttpHandler) DeleteWorklog(c *gin.Context) {
+	const op = "handlers.DeleteWorklog"
+	log := slog.Default()
+	log.With("op", op)
+
+	cmd, err := deleteworklog.NewCommand(c.Param("task_id"), c.Param("worklog_id"), c.GetHeader("User-ID"))
+	if err != nil {
+		log.Warn("failed to create command", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := h.deleteWorklogUC.Handle(c, cmd); err != nil {
+		log.Error("failed to delete worklog", slog.String("err", err.Error()))
+
+		switch {
+		case errors.Is(err, deleteworklog.ErrWorklogNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "worklog not found")
+		case errors.Is(err, deleteworklog.ErrNotWorklogAuthor):
+			NewErrorResponse(c, http.StatusForbidden, err.Error())
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary Оценка задачи
+// @Description Оценки в минутах. Без remaining_estimate_minutes оставшаяся оценка сохраняется,
+// @Description а если ее не было - равна исходной. Обе null убирают оценку.
+// @Schemes
+// @Tags Tasks
+// @Accept json
+// @Produce json
+// @Param task_id path string true "ID задачи"
+// @Param setTaskEstimateRequest body SetTaskEstimateRequest true "оценки"
+// @Success 200 {object} TaskEstimateDto
+// @Failure     400,404,408,500,503  {object}  ErrorResponse
+// @Router /v1/tasks/{task_id}/estimate [PUT]
+func (h *HttpHandler) SetTaskEstimate(c *gin.Context) {
+	const op = "handlers.SetTaskEstimate"
+	log := slog.Default()
+	log.With("op", op)
+
+	var req SetTaskEstimateRequest
+	if err := c.BindJSON(&req); err != nil {
+		log.Warn("failed to bind request", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, "bad body")
+		return
+	}
+
+	cmd, err := settaskestimate.NewCommand(c.Param("task_id"), req.OriginalEstimateMinutes, req.RemainingEstimateMinutes)
+	if err != nil {
+		log.Warn("failed to create command", slog.String("err", err.Error()))
+		NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	task, err := h.setTaskEstimateUC.Handle(c, cmd)
+	if err != nil {
+		log.Error("failed to set task estimate", slog.String("err", err.Error()))
+
+		switch {
+		case errors.Is(err, settaskestimate.ErrTaskNotFound):
+			NewErrorResponse(c, http.StatusNotFound, "task not found")
+		case errors.Is(err, settaskestimate.ErrInvalidEstimate):
+			NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		case errors.Is(err, context.Canceled):
+			NewErrorResponse(c, http.StatusRequestTimeout, "request canceled")
+		case errors.Is(err, context.DeadlineExceeded):
+			NewErrorResponse(c, http.StatusServiceUnavailable, "request timeout")
+		default:
+			NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, taskEstimateToDto(task))
+}
@@ -0,0 +1,22 @@
+package middlewares
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MaxBodySize ограничивает тело запроса limit байтами. Запрос с большим
+// Content-Length отклоняется сразу, иначе чтение сверх лимита вернет
+// *http.MaxBytesError, и ручка сама ответит 413.
+func MaxBodySize(limit int64) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.ContentLength > limit {
+			abortWithError(c, http.StatusRequestEntityTooLarge, "request body too large")
+			return
+		}
+
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, limit)
+		c.Next()
+	}
+}
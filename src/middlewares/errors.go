@@ -0,0 +1,25 @@
+package middlewares
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// errorResponse повторяет формат ошибок ручек: {"error": {"code": ..., "message": ...}}.
+// Своя копия, чтобы middlewares не зависели от пакета handlers.
+type errorResponse struct {
+	Err errorBody `json:"error"`
+}
+
+type errorBody struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func abortWithError(c *gin.Context, statusCode int, message string) {
+	c.AbortWithStatusJSON(statusCode, errorResponse{
+		Err: errorBody{
+			Code:    statusCode,
+			Message: message,
+		},
+	})
+}
@@ -0,0 +1,126 @@
+package middlewares
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	IdempotencyKeyHeader     = "Idempotency-Key"
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+	idempotencyStoreTimeout  = 5 * time.Second
+)
+
+type IdempotencyStore interface {
+	AcquireIdempotencyKey(ctx context.Context, rec *domain.IdempotencyRecord) (*domain.IdempotencyRecord, bool, error)
+	SaveIdempotentResponse(ctx context.Context, rec *domain.IdempotencyRecord) error
+	ReleaseIdempotencyKey(ctx context.Context, scope, key string) error
+}
+
+// Idempotency отдает сохраненный ответ на повтор запроса с тем же Idempotency-Key.
+// Повтор с другим телом отклоняется с 422, повтор во время выполнения первого - с 409.
+// Ответы 5xx и таймауты не сохраняются, такой запрос можно повторить.
+func Idempotency(store IdempotencyStore, ttl time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		const op = "middlewares.Idempotency"
+		log := slog.Default().With("op", op)
+
+		key := c.GetHeader(IdempotencyKeyHeader)
+		if key == "" {
+			c.Next()
+			return
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			abortWithError(c, http.StatusBadRequest, "bad body")
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewBuffer(body))
+
+		scope := strings.Join([]string{c.Request.Method, c.FullPath(), c.GetHeader("User-ID")}, " ")
+		rec, err := domain.NewIdempotencyRecord(scope, key, body, ttl)
+		if err != nil {
+			abortWithError(c, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		existing, acquired, err := store.AcquireIdempotencyKey(c, rec)
+		if err != nil {
+			log.Error("failed to acquire idempotency key", slog.String("err", err.Error()))
+			abortWithError(c, http.StatusInternalServerError, "internal server error")
+			return
+		}
+
+		if !acquired {
+			switch {
+			case !existing.SameRequest(rec):
+				abortWithError(c, http.StatusUnprocessableEntity,
+					"idempotency key was already used with a different request body")
+			case !existing.Completed():
+				abortWithError(c, http.StatusConflict,
+					"request with this idempotency key is still in progress")
+			default:
+				c.Header(IdempotentReplayedHeader, "true")
+				c.Data(existing.StatusCode, existing.ContentType, existing.Body)
+				c.Abort()
+			}
+			return
+		}
+
+		writer := &bodyRecorder{ResponseWriter: c.Writer}
+		c.Writer = writer
+
+		// ответ сохраняется даже если контекст запроса уже отменен
+		storeCtx, cancel := context.WithTimeout(context.WithoutCancel(c.Request.Context()), idempotencyStoreTimeout)
+		defer cancel()
+
+		completed := false
+		defer func() {
+			if completed {
+				return
+			}
+			if err := store.ReleaseIdempotencyKey(storeCtx, rec.Scope, rec.Key); err != nil {
+				log.Error("failed to release idempotency key", slog.String("err", err.Error()))
+			}
+		}()
+
+		c.Next()
+
+		status := writer.Status()
+		if status >= http.StatusInternalServerError || status == http.StatusRequestTimeout {
+			return
+		}
+
+		rec.Complete(status, writer.Header().Get("Content-Type"), writer.body.Bytes())
+		if err := store.SaveIdempotentResponse(storeCtx, rec); err != nil {
+			log.Error("failed to save idempotent response", slog.String("err", err.Error()))
+			return
+		}
+		completed = true
+	}
+}
+
+// bodyRecorder дублирует тело ответа в буфер, чтобы его можно было сохранить.
+type bodyRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *bodyRecorder) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *bodyRecorder) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
@@ -0,0 +1,139 @@
+package middlewares
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryStore struct {
+	mu      sync.Mutex
+	records map[string]*domain.IdempotencyRecord
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{records: map[string]*domain.IdempotencyRecord{}}
+}
+
+func (s *memoryStore) AcquireIdempotencyKey(_ context.Context, rec *domain.IdempotencyRecord) (*domain.IdempotencyRecord, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if existing, ok := s.records[rec.Scope+rec.Key]; ok && existing.ExpiresAt.After(time.Now()) {
+		copied := *existing
+		return &copied, false, nil
+	}
+	copied := *rec
+	s.records[rec.Scope+rec.Key] = &copied
+	return nil, true, nil
+}
+
+func (s *memoryStore) SaveIdempotentResponse(_ context.Context, rec *domain.IdempotencyRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	copied := *rec
+	s.records[rec.Scope+rec.Key] = &copied
+	return nil
+}
+
+func (s *memoryStore) ReleaseIdempotencyKey(_ context.Context, scope, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if rec, ok := s.records[scope+key]; ok && !rec.Completed() {
+		delete(s.records, scope+key)
+	}
+	return nil
+}
+
+func TestIdempotency(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	newRouter := func(store IdempotencyStore, status *int) (*gin.Engine, *int) {
+		calls := 0
+		router := gin.New()
+		router.POST("/tasks", Idempotency(store, time.Hour), func(c *gin.Context) {
+			calls++
+			c.JSON(*status, gin.H{"number": calls})
+		})
+		return router, &calls
+	}
+	do := func(router *gin.Engine, key, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/tasks", strings.NewReader(body))
+		if key != "" {
+			req.Header.Set(IdempotencyKeyHeader, key)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("повтор отдает первый ответ", func(t *testing.T) {
+		status := http.StatusCreated
+		router, calls := newRouter(newMemoryStore(), &status)
+
+		first := do(router, "k1", `{"title":"a"}`)
+		second := do(router, "k1", `{"title":"a"}`)
+
+		require.Equal(t, http.StatusCreated, first.Code)
+		assert.Equal(t, http.StatusCreated, second.Code)
+		assert.Equal(t, first.Body.String(), second.Body.String())
+		assert.Equal(t, "true", second.Header().Get(IdempotentReplayedHeader))
+		assert.Equal(t, 1, *calls)
+	})
+
+	t.Run("тот же ключ с другим телом", func(t *testing.T) {
+		status := http.StatusCreated
+		router, calls := newRouter(newMemoryStore(), &status)
+
+		do(router, "k1", `{"title":"a"}`)
+		w := do(router, "k1", `{"title":"b"}`)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		assert.Equal(t, 1, *calls)
+	})
+
+	t.Run("ошибка сервера не сохраняется", func(t *testing.T) {
+		status := http.StatusInternalServerError
+		router, calls := newRouter(newMemoryStore(), &status)
+
+		do(router, "k1", `{}`)
+		status = http.StatusCreated
+		w := do(router, "k1", `{}`)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, 2, *calls)
+	})
+
+	t.Run("запрос в процессе", func(t *testing.T) {
+		store := newMemoryStore()
+		status := http.StatusCreated
+		router, _ := newRouter(store, &status)
+
+		rec, err := domain.NewIdempotencyRecord("POST /tasks ", "k1", []byte(`{}`), time.Hour)
+		require.NoError(t, err)
+		_, acquired, err := store.AcquireIdempotencyKey(context.Background(), rec)
+		require.NoError(t, err)
+		require.True(t, acquired)
+
+		w := do(router, "k1", `{}`)
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("без ключа запрос выполняется каждый раз", func(t *testing.T) {
+		status := http.StatusCreated
+		router, calls := newRouter(newMemoryStore(), &status)
+
+		do(router, "", `{}`)
+		do(router, "", `{}`)
+
+		assert.Equal(t, 2, *calls)
+	})
+}
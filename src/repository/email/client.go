@@ -0,0 +1,105 @@
+package email
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/pkg/errors"
+)
+
+type Config struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+	// Адрес фронтенда для ссылок на задачи в письмах, может быть пустым
+	BaseURL string
+	Timeout time.Duration
+}
+
+// Client отправляет письма с уведомлениями по SMTP.
+type Client struct {
+	cfg Config
+}
+
+func New(cfg Config) *Client {
+	return &Client{cfg: cfg}
+}
+
+// Send отправляет одно письмо. STARTTLS включается, если сервер его поддерживает,
+// авторизация - если задан пользователь.
+func (c *Client) Send(ctx context.Context, msg domain.NotificationEmail) error {
+	const op = "email.Client.Send"
+
+	now := time.Now().UTC()
+	body, err := render(c.cfg.From, c.cfg.BaseURL, msg, now)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	if c.cfg.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.cfg.Timeout)
+		defer cancel()
+	}
+
+	addr := net.JoinHostPort(c.cfg.Host, strconv.Itoa(c.cfg.Port))
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, c.cfg.Host)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: c.cfg.Host}); err != nil {
+			return errors.Wrap(err, op)
+		}
+	}
+	if c.cfg.Username != "" {
+		auth := smtp.PlainAuth("", c.cfg.Username, c.cfg.Password, c.cfg.Host)
+		if err := client.Auth(auth); err != nil {
+			return errors.Wrap(err, op)
+		}
+	}
+
+	from, err := mail.ParseAddress(c.cfg.From)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+	if err := client.Mail(from.Address); err != nil {
+		return errors.Wrap(err, op)
+	}
+	if err := client.Rcpt(msg.To); err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+	if _, err := w.Write(body); err != nil {
+		return errors.Wrap(err, op)
+	}
+	if err := w.Close(); err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	return errors.Wrap(client.Quit(), op)
+}
@@ -0,0 +1,104 @@
+package email
+
+import (
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"testing"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/repository/email/smtptest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientSend(t *testing.T) {
+	server := smtptest.NewServer(t)
+	client := New(Config{
+		Host:    server.Host,
+		Port:    server.Port,
+		From:    "Teamboard <noreply@teamboard.local>",
+		BaseURL: "https://board.example.com/",
+		Timeout: time.Second,
+	})
+
+	notification := func(text string) domain.Notification {
+		return domain.Notification{
+			ID: uuid.New(), UserID: "alice", Type: domain.NotificationTaskAssigned,
+			BoardID: uuid.New(), TaskID: uuid.New(), Text: text, CreatedAt: time.Now().UTC(),
+		}
+	}
+
+	parts := func(t *testing.T, msg smtptest.Message) map[string]string {
+		parsed := msg.Parse(t)
+		mediaType, params, err := mime.ParseMediaType(parsed.Header.Get("Content-Type"))
+		require.NoError(t, err)
+		require.Equal(t, "multipart/alternative", mediaType)
+
+		res := make(map[string]string)
+		mr := multipart.NewReader(parsed.Body, params["boundary"])
+		for {
+			p, err := mr.NextPart()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			body, err := io.ReadAll(quotedprintable.NewReader(p))
+			require.NoError(t, err)
+			ct, _, _ := mime.ParseMediaType(p.Header.Get("Content-Type"))
+			res[ct] = string(body)
+		}
+		return res
+	}
+
+	t.Run("Success: instant email", func(t *testing.T) {
+		n := notification(`You were assigned to "Fix <login>"`)
+		err := client.Send(context.Background(), domain.NotificationEmail{
+			UserID: "alice", To: "alice@example.com", Notifications: []domain.Notification{n},
+		})
+		require.NoError(t, err)
+
+		msgs := server.Messages()
+		require.Len(t, msgs, 1)
+		assert.Equal(t, "noreply@teamboard.local", msgs[0].From)
+		assert.Equal(t, []string{"alice@example.com"}, msgs[0].To)
+
+		subject, err := new(mime.WordDecoder).DecodeHeader(msgs[0].Parse(t).Header.Get("Subject"))
+		require.NoError(t, err)
+		assert.Equal(t, n.Text, subject)
+
+		body := parts(t, msgs[0])
+		link := "https://board.example.com/boards/" + n.BoardID.String() + "/tasks/" + n.TaskID.String()
+		assert.Contains(t, body["text/plain"], `You were assigned to "Fix <login>"`)
+		assert.Contains(t, body["text/plain"], link)
+		assert.Contains(t, body["text/html"], `You were assigned to &#34;Fix &lt;login&gt;&#34;`)
+		assert.Contains(t, body["text/html"], `href="`+link+`"`)
+	})
+
+	t.Run("Success: digest lists every notification", func(t *testing.T) {
+		err := client.Send(context.Background(), domain.NotificationEmail{
+			UserID: "bob", To: "bob@example.com", Digest: true,
+			Notifications: []domain.Notification{notification("first"), notification("second")},
+		})
+		require.NoError(t, err)
+
+		msgs := server.Messages()
+		require.Len(t, msgs, 2)
+		body := parts(t, msgs[1])
+		assert.Contains(t, body["text/plain"], "You have 2 unread notification(s)")
+		assert.Contains(t, body["text/plain"], "- first")
+		assert.Contains(t, body["text/html"], "second</a>")
+	})
+
+	t.Run("Failure: rejected recipient", func(t *testing.T) {
+		server.RejectRcpt("gone@example.com")
+		err := client.Send(context.Background(), domain.NotificationEmail{
+			UserID: "gone", To: "gone@example.com", Notifications: []domain.Notification{notification("x")},
+		})
+		assert.ErrorContains(t, err, "550")
+	})
+}
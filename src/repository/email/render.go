@@ -0,0 +1,107 @@
+package email
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/textproto"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+//go:embed templates/*.tmpl
+var templatesFS embed.FS
+
+var (
+	textTemplates = texttemplate.Must(texttemplate.ParseFS(templatesFS, "templates/*.txt.tmpl"))
+	htmlTemplates = htmltemplate.Must(htmltemplate.ParseFS(templatesFS, "templates/*.html.tmpl"))
+)
+
+// templateData - данные шаблонов письма. Link строит ссылку на задачу,
+// пустую, если адрес фронтенда не настроен.
+type templateData struct {
+	Notification  domain.Notification
+	Notifications []domain.Notification
+	baseURL       string
+}
+
+func (d templateData) Link(n domain.Notification) string {
+	if d.baseURL == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s/boards/%s/tasks/%s", strings.TrimRight(d.baseURL, "/"), n.BoardID, n.TaskID)
+}
+
+// render собирает письмо multipart/alternative с текстовой и HTML-версией.
+func render(from string, baseURL string, msg domain.NotificationEmail, now time.Time) ([]byte, error) {
+	const op = "email.render"
+
+	if len(msg.Notifications) == 0 {
+		return nil, errors.Wrap(errors.New("email without notifications"), op)
+	}
+
+	data := templateData{Notifications: msg.Notifications, Notification: msg.Notifications[0], baseURL: baseURL}
+	name, subject := "notification", msg.Notifications[0].Text
+	if msg.Digest {
+		name = "digest"
+		subject = fmt.Sprintf("Teamboard digest: %d unread notification(s)", len(msg.Notifications))
+	}
+
+	var text, html bytes.Buffer
+	if err := textTemplates.ExecuteTemplate(&text, name+".txt.tmpl", data); err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	if err := htmlTemplates.ExecuteTemplate(&html, name+".html.tmpl", data); err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", msg.To)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", now.Format(time.RFC1123Z))
+	fmt.Fprintf(&buf, "Message-ID: <%s@teamboard>\r\n", uuid.NewString())
+	fmt.Fprintf(&buf, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&buf, "Content-Type: multipart/alternative; boundary=%s\r\n\r\n", mw.Boundary())
+
+	for _, part := range []struct {
+		contentType string
+		content     []byte
+	}{
+		{"text/plain; charset=utf-8", text.Bytes()},
+		{"text/html; charset=utf-8", html.Bytes()},
+	} {
+		w, err := mw.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, op)
+		}
+		qp := quotedprintable.NewWriter(w)
+		if _, err := qp.Write(part.content); err != nil {
+			return nil, errors.Wrap(err, op)
+		}
+		if err := qp.Close(); err != nil {
+			return nil, errors.Wrap(err, op)
+		}
+	}
+	if err := mw.Close(); err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	buf.Write(body.Bytes())
+	return buf.Bytes(), nil
+}
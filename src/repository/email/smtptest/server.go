@@ -0,0 +1,160 @@
+// Package smtptest - SMTP-сервер в памяти для тестов, по аналогии с httptest.
+package smtptest
+
+import (
+	"bufio"
+	"net"
+	"net/mail"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// Message - принятое сервером письмо.
+type Message struct {
+	From string
+	To   []string
+	Data string
+}
+
+// Parse разбирает заголовки и тело письма.
+func (m Message) Parse(t testing.TB) *mail.Message {
+	t.Helper()
+	msg, err := mail.ReadMessage(strings.NewReader(m.Data))
+	if err != nil {
+		t.Fatalf("smtptest: parse message: %v", err)
+	}
+	return msg
+}
+
+// Server принимает письма без авторизации и TLS. RejectRcpt - адреса,
+// которые сервер отклоняет, чтобы проверить обработку ошибок.
+type Server struct {
+	Host string
+	Port int
+
+	ln         net.Listener
+	mu         sync.Mutex
+	messages   []Message
+	rejectRcpt map[string]bool
+	wg         sync.WaitGroup
+}
+
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("smtptest: listen: %v", err)
+	}
+	addr := ln.Addr().(*net.TCPAddr)
+
+	s := &Server{Host: addr.IP.String(), Port: addr.Port, ln: ln, rejectRcpt: make(map[string]bool)}
+	s.wg.Add(1)
+	go s.serve()
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func (s *Server) RejectRcpt(addr string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rejectRcpt[addr] = true
+}
+
+func (s *Server) Messages() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Message(nil), s.messages...)
+}
+
+func (s *Server) Close() {
+	_ = s.ln.Close()
+	s.wg.Wait()
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.ln.Accept()
+		if err != nil {
+			return
+		}
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.handle(conn)
+		}()
+	}
+}
+
+func (s *Server) handle(conn net.Conn) {
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	reply := func(line string) { _, _ = conn.Write([]byte(line + "\r\n")) }
+
+	reply("220 smtptest ready")
+	var msg Message
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		cmd := strings.ToUpper(line)
+
+		switch {
+		case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+			reply("250 smtptest")
+		case strings.HasPrefix(cmd, "MAIL FROM:"):
+			msg = Message{From: trimAddr(line[len("MAIL FROM:"):])}
+			reply("250 OK")
+		case strings.HasPrefix(cmd, "RCPT TO:"):
+			to := trimAddr(line[len("RCPT TO:"):])
+			s.mu.Lock()
+			rejected := s.rejectRcpt[to]
+			s.mu.Unlock()
+			if rejected {
+				reply("550 mailbox unavailable")
+				continue
+			}
+			msg.To = append(msg.To, to)
+			reply("250 OK")
+		case cmd == "DATA":
+			reply("354 end data with <CR><LF>.<CR><LF>")
+			var data strings.Builder
+			for {
+				l, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if l == ".\r\n" {
+					break
+				}
+				data.WriteString(strings.TrimPrefix(l, "."))
+			}
+			msg.Data = data.String()
+			s.mu.Lock()
+			s.messages = append(s.messages, msg)
+			s.mu.Unlock()
+			reply("250 OK queued")
+		case cmd == "RSET", cmd == "NOOP":
+			reply("250 OK")
+		case cmd == "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("502 command not implemented")
+		}
+	}
+}
+
+func trimAddr(s string) string {
+	s = strings.TrimSpace(s)
+	if i := strings.IndexByte(s, ' '); i >= 0 {
+		s = s[:i]
+	}
+	return strings.Trim(s, "<>")
+}
@@ -0,0 +1,75 @@
+package eventsink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTaskEvents(t *testing.T) []domain.Event {
+	task, err := domain.NewTask(uuid.New(), uuid.New(), 3, "Task", nil, nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, task.MoveToColumn(uuid.New()))
+	return task.Events()
+}
+
+func TestSubscribers(t *testing.T) {
+	ctx := context.Background()
+	events := newTaskEvents(t)
+
+	var all, moved []domain.EventType
+	subs := NewSubscribers()
+	subs.Subscribe(func(_ context.Context, e domain.Event) error {
+		all = append(all, e.Type)
+		return nil
+	})
+	subs.Subscribe(func(_ context.Context, e domain.Event) error {
+		moved = append(moved, e.Type)
+		return nil
+	}, domain.EventTaskMoved)
+
+	for _, e := range events {
+		require.NoError(t, subs.Publish(ctx, e))
+	}
+	assert.Equal(t, []domain.EventType{domain.EventTaskCreated, domain.EventTaskMoved}, all)
+	assert.Equal(t, []domain.EventType{domain.EventTaskMoved}, moved)
+
+	subs.Subscribe(func(context.Context, domain.Event) error {
+		return errors.New("boom")
+	}, domain.EventTaskCreated)
+	assert.ErrorContains(t, subs.Publish(ctx, events[0]), "boom")
+}
+
+func TestJSONL(t *testing.T) {
+	var buf bytes.Buffer
+	sink := NewJSONL(&buf)
+
+	events := newTaskEvents(t)
+	for _, e := range events {
+		require.NoError(t, sink.Publish(context.Background(), e))
+	}
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	require.Len(t, lines, 2)
+
+	var line struct {
+		ID   uuid.UUID        `json:"id"`
+		Type domain.EventType `json:"type"`
+		Data json.RawMessage  `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &line))
+	assert.Equal(t, events[1].ID, line.ID)
+	assert.Equal(t, domain.EventTaskMoved, line.Type)
+
+	data, err := domain.DecodeEventPayload(line.Type, line.Data)
+	require.NoError(t, err)
+	assert.Equal(t, events[1].Payload, data)
+}
@@ -0,0 +1,73 @@
+package eventsink
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// JSONL пишет каждое событие отдельной JSON-строкой, например в stdout
+// для сборщика логов или в файл для отладки.
+type JSONL struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func NewJSONL(w io.Writer) *JSONL {
+	return &JSONL{w: w}
+}
+
+// OpenJSONL открывает файл на дозапись. "stdout" - стандартный вывод.
+func OpenJSONL(path string) (*JSONL, error) {
+	const op = "eventsink.OpenJSONL"
+
+	if path == "stdout" {
+		return NewJSONL(os.Stdout), nil
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	return NewJSONL(f), nil
+}
+
+type jsonlEvent struct {
+	ID          uuid.UUID           `json:"id"`
+	Type        domain.EventType    `json:"type"`
+	AggregateID uuid.UUID           `json:"aggregate_id"`
+	BoardID     uuid.UUID           `json:"board_id"`
+	OccurredAt  time.Time           `json:"occurred_at"`
+	Data        domain.EventPayload `json:"data"`
+}
+
+func (s *JSONL) Name() string {
+	return "jsonl"
+}
+
+func (s *JSONL) Publish(_ context.Context, event domain.Event) error {
+	line, err := json.Marshal(jsonlEvent{
+		ID:          event.ID,
+		Type:        event.Type,
+		AggregateID: event.AggregateID,
+		BoardID:     event.BoardID,
+		OccurredAt:  event.OccurredAt,
+		Data:        event.Payload,
+	})
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.w.Write(line)
+	return err
+}
@@ -0,0 +1,58 @@
+package eventsink
+
+import (
+	"context"
+	"sync"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/pkg/errors"
+)
+
+// Handler обрабатывает событие внутри процесса. Ошибка вернет событие
+// в outbox, и его получат все подписчики заново.
+type Handler func(ctx context.Context, event domain.Event) error
+
+// Subscribers раздает события подписчикам внутри процесса.
+type Subscribers struct {
+	mu       sync.RWMutex
+	handlers map[domain.EventType][]Handler
+	all      []Handler
+}
+
+func NewSubscribers() *Subscribers {
+	return &Subscribers{handlers: make(map[domain.EventType][]Handler)}
+}
+
+// Subscribe подписывает handler на события перечисленных типов,
+// без типов - на все события.
+func (s *Subscribers) Subscribe(handler Handler, types ...domain.EventType) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(types) == 0 {
+		s.all = append(s.all, handler)
+		return
+	}
+	for _, t := range types {
+		s.handlers[t] = append(s.handlers[t], handler)
+	}
+}
+
+func (s *Subscribers) Name() string {
+	return "subscribers"
+}
+
+func (s *Subscribers) Publish(ctx context.Context, event domain.Event) error {
+	s.mu.RLock()
+	handlers := make([]Handler, 0, len(s.all)+len(s.handlers[event.Type]))
+	handlers = append(handlers, s.all...)
+	handlers = append(handlers, s.handlers[event.Type]...)
+	s.mu.RUnlock()
+
+	for _, h := range handlers {
+		if err := h(ctx, event); err != nil {
+			return errors.Wrapf(err, "handle %s", event.Type)
+		}
+	}
+	return nil
+}
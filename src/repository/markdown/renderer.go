@@ -0,0 +1,75 @@
+// Package markdown отрисовывает описания задач из Markdown в безопасный HTML.
+package markdown
+
+import (
+	"bytes"
+	"html"
+	"strings"
+	"unicode"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+)
+
+// ExcerptLen - длина превью в символах.
+const ExcerptLen = 200
+
+// Renderer переводит Markdown (CommonMark + GFM) в HTML и пропускает результат
+// через белый список тегов: сырой HTML и javascript:-ссылки из описания
+// до клиента не доходят.
+type Renderer struct {
+	md     goldmark.Markdown
+	policy *bluemonday.Policy
+	strip  *bluemonday.Policy
+}
+
+func New() *Renderer {
+	policy := bluemonday.UGCPolicy()
+	// чекбоксы списков задач GFM
+	policy.AllowAttrs("type").Matching(bluemonday.SpaceSeparatedTokens).OnElements("input")
+	policy.AllowAttrs("checked", "disabled").OnElements("input")
+	policy.AddTargetBlankToFullyQualifiedLinks(true)
+
+	return &Renderer{
+		md:     goldmark.New(goldmark.WithExtensions(extension.GFM)),
+		policy: policy,
+		strip:  bluemonday.StrictPolicy(),
+	}
+}
+
+// Render возвращает HTML и превью. goldmark пишет в буфер, поэтому ошибка
+// возможна только на некорректном источнике - тогда текст отдается экранированным.
+func (r *Renderer) Render(source string) domain.RenderedText {
+	var buf bytes.Buffer
+	if err := r.md.Convert([]byte(source), &buf); err != nil {
+		escaped := "<p>" + html.EscapeString(source) + "</p>"
+		return domain.RenderedText{HTML: escaped, Excerpt: excerpt(source)}
+	}
+
+	safe := r.policy.SanitizeBytes(buf.Bytes())
+	text := html.UnescapeString(string(r.strip.SanitizeBytes(safe)))
+
+	return domain.RenderedText{HTML: string(safe), Excerpt: excerpt(text)}
+}
+
+// excerpt схлопывает пробелы и обрезает текст по границе слова.
+func excerpt(text string) string {
+	text = strings.Join(strings.Fields(text), " ")
+
+	runes := []rune(text)
+	if len(runes) <= ExcerptLen {
+		return text
+	}
+
+	cut := ExcerptLen
+	for i := ExcerptLen; i > ExcerptLen/2; i-- {
+		if unicode.IsSpace(runes[i]) {
+			cut = i
+			break
+		}
+	}
+	return strings.TrimRightFunc(string(runes[:cut]), unicode.IsPunct) + "…"
+}
@@ -0,0 +1,46 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRender(t *testing.T) {
+	r := New()
+
+	t.Run("markdown becomes html", func(t *testing.T) {
+		out := r.Render("# Title\n\nSome **bold** and `code`.\n\n- [x] done\n- [ ] todo")
+		assert.Contains(t, out.HTML, "<h1>Title</h1>")
+		assert.Contains(t, out.HTML, "<strong>bold</strong>")
+		assert.Contains(t, out.HTML, `<input checked="" disabled="" type="checkbox"`)
+		assert.Equal(t, "Title Some bold and code. done todo", out.Excerpt)
+	})
+
+	t.Run("xss is stripped", func(t *testing.T) {
+		out := r.Render("<script>alert(1)</script>\n\n[click](javascript:alert(1)) <img src=x onerror=alert(1)>\n\n<a href=\"#\" onclick=\"x()\">a</a>")
+		assert.NotContains(t, out.HTML, "<script")
+		assert.NotContains(t, out.HTML, "javascript:")
+		assert.NotContains(t, out.HTML, "onerror")
+		assert.NotContains(t, out.HTML, "onclick")
+		assert.NotContains(t, out.Excerpt, "<")
+	})
+
+	t.Run("external links open in a new tab without referrer", func(t *testing.T) {
+		out := r.Render("see https://example.com")
+		assert.Contains(t, out.HTML, `href="https://example.com"`)
+		assert.Contains(t, out.HTML, `rel="nofollow noopener"`)
+		assert.Contains(t, out.HTML, `target="_blank"`)
+	})
+
+	t.Run("excerpt is cut on a word boundary", func(t *testing.T) {
+		out := r.Render(strings.Repeat("word ", 100))
+		assert.LessOrEqual(t, len([]rune(out.Excerpt)), ExcerptLen+1)
+		assert.True(t, strings.HasSuffix(out.Excerpt, "word…"))
+	})
+
+	t.Run("entities are decoded in the excerpt", func(t *testing.T) {
+		assert.Equal(t, `a < b & "c"`, r.Render(`a < b & "c"`).Excerpt)
+	})
+}
@@ -0,0 +1,108 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+)
+
+func (r Repository) CreateAttachment(ctx context.Context, attachment *domain.Attachment) error {
+	const op = "postgres.CreateAttachment"
+
+	sql, params, err := goqu.Insert("attachments").Rows(AttachmentRecord{
+		ID:         attachment.ID,
+		TaskID:     attachment.TaskID,
+		Name:       attachment.Name,
+		Size:       attachment.Size,
+		MimeType:   attachment.MimeType,
+		Checksum:   attachment.Checksum,
+		StorageKey: attachment.StorageKey,
+		UploadedBy: attachment.UploadedBy,
+		CreatedAt:  attachment.CreatedAt,
+	}).ToSQL()
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	if _, err := r.pool.Exec(ctx, sql, params...); err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	return nil
+}
+
+// GetAttachments возвращает вложения задачи в порядке загрузки.
+func (r Repository) GetAttachments(ctx context.Context, taskID uuid.UUID) ([]domain.Attachment, error) {
+	const op = "postgres.GetAttachments"
+
+	sql, params, err := goqu.From("attachments").
+		Where(goqu.C("task_id").Eq(taskID)).
+		Order(goqu.C("created_at").Asc(), goqu.C("id").Asc()).
+		ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	var records []AttachmentRecord
+	if err := pgxscan.Select(ctx, r.pool, &records, sql, params...); err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	attachments := make([]domain.Attachment, 0, len(records))
+	for _, rec := range records {
+		attachments = append(attachments, rec.toDomain())
+	}
+
+	return attachments, nil
+}
+
+func (r Repository) GetAttachment(ctx context.Context, taskID, attachmentID uuid.UUID) (*domain.Attachment, error) {
+	const op = "postgres.GetAttachment"
+
+	sql, params, err := goqu.From("attachments").
+		Where(
+			goqu.C("id").Eq(attachmentID),
+			goqu.C("task_id").Eq(taskID),
+		).ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	var rec AttachmentRecord
+	if err := pgxscan.Get(ctx, r.pool, &rec, sql, params...); err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	attachment := rec.toDomain()
+	return &attachment, nil
+}
+
+// DeleteAttachment удаляет запись и возвращает ее, чтобы вызывающий мог
+// удалить содержимое из хранилища. Если вложения нет - pgx.ErrNoRows.
+func (r Repository) DeleteAttachment(ctx context.Context, taskID, attachmentID uuid.UUID) (*domain.Attachment, error) {
+	const op = "postgres.DeleteAttachment"
+
+	sql, params, err := goqu.Delete("attachments").
+		Where(
+			goqu.C("id").Eq(attachmentID),
+			goqu.C("task_id").Eq(taskID),
+		).
+		Returning(goqu.Star()).
+		ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	var rec AttachmentRecord
+	if err := pgxscan.Get(ctx, r.pool, &rec, sql, params...); err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	attachment := rec.toDomain()
+	return &attachment, nil
+}
@@ -0,0 +1,82 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var attachmentColumns = []string{
+	"id", "task_id", "name", "size", "mime_type", "checksum", "storage_key", "uploaded_by", "created_at",
+}
+
+func TestCreateAttachment(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	a, err := domain.NewAttachment(uuid.New(), "report.pdf", "application/pdf", "alice", 42)
+	require.NoError(t, err)
+	a.Checksum = "abc"
+
+	mock.ExpectExec(`INSERT INTO "attachments" \("checksum", "created_at", "id", "mime_type", "name", "size", "storage_key", "task_id", "uploaded_by"\) ` +
+		`VALUES \('abc', .+, '` + a.ID.String() + `', 'application/pdf', 'report.pdf', 42, '` + a.StorageKey + `', '` + a.TaskID.String() + `', 'alice'\)`).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+	repo := &Repository{pool: mock}
+	require.NoError(t, repo.CreateAttachment(context.Background(), a))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetAttachments(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	a, err := domain.NewAttachment(uuid.New(), "report.pdf", "application/pdf", "alice", 42)
+	require.NoError(t, err)
+
+	mock.ExpectQuery(`SELECT \* FROM "attachments" WHERE \("task_id" = '` + a.TaskID.String() + `'\) ORDER BY "created_at" ASC, "id" ASC`).
+		WillReturnRows(pgxmock.NewRows(attachmentColumns).
+			AddRow(a.ID, a.TaskID, a.Name, a.Size, a.MimeType, "abc", a.StorageKey, a.UploadedBy, a.CreatedAt))
+
+	repo := &Repository{pool: mock}
+	attachments, err := repo.GetAttachments(context.Background(), a.TaskID)
+	require.NoError(t, err)
+	require.Len(t, attachments, 1)
+	assert.Equal(t, a.ID, attachments[0].ID)
+	assert.Equal(t, "abc", attachments[0].Checksum)
+	assert.Equal(t, a.StorageKey, attachments[0].StorageKey)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteAttachment(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	a, err := domain.NewAttachment(uuid.New(), "report.pdf", "application/pdf", "alice", 42)
+	require.NoError(t, err)
+
+	query := `DELETE FROM "attachments" WHERE \(\("id" = '` + a.ID.String() + `'\) AND \("task_id" = '` + a.TaskID.String() + `'\)\) RETURNING \*`
+	mock.ExpectQuery(query).
+		WillReturnRows(pgxmock.NewRows(attachmentColumns).
+			AddRow(a.ID, a.TaskID, a.Name, a.Size, a.MimeType, "abc", a.StorageKey, a.UploadedBy, a.CreatedAt))
+	mock.ExpectQuery(query).
+		WillReturnRows(pgxmock.NewRows(attachmentColumns))
+
+	repo := &Repository{pool: mock}
+	deleted, err := repo.DeleteAttachment(context.Background(), a.TaskID, a.ID)
+	require.NoError(t, err)
+	assert.Equal(t, a.StorageKey, deleted.StorageKey)
+
+	_, err = repo.DeleteAttachment(context.Background(), a.TaskID, a.ID)
+	assert.ErrorIs(t, err, pgx.ErrNoRows)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
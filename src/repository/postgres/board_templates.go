@@ -0,0 +1,127 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+func (r Repository) CreateBoardTemplate(ctx context.Context, template *domain.BoardTemplate) error {
+	const op = "postgres.CreateBoardTemplate"
+
+	rec, err := boardTemplateToRecord(template)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	sql, params, err := goqu.Insert("board_templates").Rows(rec).ToSQL()
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	_, err = r.pool.Exec(ctx, sql, params...)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	return nil
+}
+
+func (r Repository) GetBoardTemplateByID(ctx context.Context, templateID uuid.UUID) (*domain.BoardTemplate, error) {
+	const op = "postgres.GetBoardTemplateByID"
+
+	ds := goqu.From("board_templates").
+		Where(
+			goqu.C("id").Eq(templateID),
+			goqu.C("deleted_at").IsNull(),
+		)
+
+	sql, params, err := ds.ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	var rec BoardTemplateRecord
+	err = pgxscan.Get(ctx, r.pool, &rec, sql, params...)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	return rec.toDomain()
+}
+
+func (r Repository) GetBoardTemplates(ctx context.Context) ([]domain.BoardTemplate, error) {
+	const op = "postgres.GetBoardTemplates"
+
+	ds := goqu.From("board_templates").
+		Where(goqu.C("deleted_at").IsNull()).
+		Order(goqu.C("name").Asc())
+
+	sql, params, err := ds.ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	records := make([]BoardTemplateRecord, 0)
+	err = pgxscan.Select(ctx, r.pool, &records, sql, params...)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	templates := make([]domain.BoardTemplate, 0, len(records))
+	for _, rec := range records {
+		dmn, err := rec.toDomain()
+		if err != nil {
+			return nil, errors.Wrap(err, op)
+		}
+		templates = append(templates, *dmn)
+	}
+
+	return templates, nil
+}
+
+func (r Repository) UpdateBoardTemplate(ctx context.Context, template *domain.BoardTemplate) error {
+	const op = "postgres.UpdateBoardTemplate"
+
+	ds := goqu.Update("board_templates").Where(
+		goqu.C("id").Eq(template.ID),
+		goqu.C("deleted_at").IsNull(),
+	).Set(goqu.Record{
+		"name":        template.Name,
+		"description": template.Description,
+		"updated_at":  template.UpdatedAt,
+		"deleted_at":  template.DeletedAt,
+	})
+
+	sql, params, err := ds.ToSQL()
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	_, err = r.pool.Exec(ctx, sql, params...)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	return nil
+}
+
+// CheckBoardTemplateName - есть ли живой шаблон с таким именем.
+func (r Repository) CheckBoardTemplateName(ctx context.Context, name string) (bool, error) {
+	const op = "postgres.CheckBoardTemplateName"
+
+	var exists bool
+	err := r.pool.QueryRow(ctx,
+		`SELECT EXISTS (SELECT 1 FROM board_templates WHERE name = $1 AND deleted_at IS NULL)`,
+		name,
+	).Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, op)
+	}
+
+	return exists, nil
+}
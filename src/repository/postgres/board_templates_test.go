@@ -0,0 +1,146 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateBoardWithTasks(t *testing.T) {
+	now := time.Now().UTC()
+	boardID := uuid.New()
+	column := domain.Column{ID: uuid.New(), BoardID: boardID, Name: "TODO", CreatedAt: now, UpdatedAt: now}
+	estimate := 90
+	parent := domain.Task{ID: uuid.New(), BoardID: boardID, ColumnID: column.ID, Number: 1, Title: "Epic", OriginalEstimate: &estimate, RemainingEstimate: &estimate, CreatedAt: now, UpdatedAt: now}
+	child := domain.Task{ID: uuid.New(), BoardID: boardID, ColumnID: column.ID, Number: 2, Title: "Child", ParentID: &parent.ID, Tags: []string{"api"}, CreatedAt: now, UpdatedAt: now}
+
+	board := &domain.Board{
+		ID:        boardID,
+		Name:      "Team",
+		ShortName: "TEAM",
+		CreatedAt: now,
+		UpdatedAt: now,
+		Columns:   []domain.Column{column},
+		Tasks:     []domain.Task{parent, child},
+
+		StoryPointScale: domain.StoryPointPreset("powers_of_two"),
+	}
+
+	tpl, err := domain.NewBoardTemplate("Scrum", nil, board, true)
+	require.NoError(t, err)
+	fromTemplate, err := tpl.NewBoard("From template", "TPL")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		board       *domain.Board
+		mockSetup   func(mock pgxmock.PgxPoolIface)
+		expectedErr error
+	}{
+		{
+			name:  "доска, колонки и задачи в одной транзакции",
+			board: board,
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`INSERT INTO "boards" .+'\{0,1,2,4,8,16,32,64\}'`).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				mock.ExpectExec(`INSERT INTO "columns"`).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				mock.ExpectExec(`INSERT INTO "tasks" \(.*"original_estimate".*"remaining_estimate".*\) VALUES .+'` + parent.ID.String() + `', NULL, NULL, 1, 90, NULL, NULL, 90, .+'` + child.ID.String() + `'`).
+					WillReturnResult(pgxmock.NewResult("INSERT", 2))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:  "события создания колонок и задач пишутся в outbox вместе с переходами",
+			board: &fromTemplate,
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`INSERT INTO "boards"`).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				mock.ExpectExec(`INSERT INTO "columns"`).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				mock.ExpectExec(`INSERT INTO "tasks"`).WillReturnResult(pgxmock.NewResult("INSERT", 2))
+				mock.ExpectExec(`INSERT INTO "outbox" .+'column.created'.+'task.created'.+'task.created'`).
+					WillReturnResult(pgxmock.NewResult("INSERT", 3))
+				mock.ExpectExec(`INSERT INTO "task_transitions"`).WillReturnResult(pgxmock.NewResult("INSERT", 2))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:  "доска без задач",
+			board: &domain.Board{ID: boardID, Name: "Team", ShortName: "TEAM", Columns: []domain.Column{column}},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`INSERT INTO "boards"`).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				mock.ExpectExec(`INSERT INTO "columns"`).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:  "ошибка вставки задач откатывает транзакцию",
+			board: board,
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`INSERT INTO "boards"`).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				mock.ExpectExec(`INSERT INTO "columns"`).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				mock.ExpectExec(`INSERT INTO "tasks"`).WillReturnError(errors.New("tasks insert error"))
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("tasks insert error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.mockSetup(mock)
+
+			repo := &Repository{pool: mock}
+			err = repo.CreateBoardWithTasks(context.Background(), tt.board)
+
+			if tt.expectedErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+
+	assert.Empty(t, fromTemplate.Tasks[0].Events())
+}
+
+func TestGetBoardTemplateByID(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	templateID := uuid.New()
+	now := time.Now().UTC()
+	rows := pgxmock.NewRows([]string{"id", "name", "description", "columns", "tasks", "created_at", "updated_at", "deleted_at"}).
+		AddRow(templateID, "Scrum", nil,
+			[]byte(`[{"name":"TODO","is_done":false},{"name":"Done","is_done":true}]`),
+			[]byte(`[{"column":0,"title":"Epic"},{"column":1,"parent":0,"title":"Child","priority":"high"}]`),
+			now, now, nil)
+	mock.ExpectQuery(`SELECT \* FROM "board_templates"`).WillReturnRows(rows)
+
+	repo := &Repository{pool: mock}
+	tpl, err := repo.GetBoardTemplateByID(context.Background(), templateID)
+	require.NoError(t, err)
+
+	assert.Equal(t, []domain.TemplateColumn{{Name: "TODO"}, {Name: "Done", IsDone: true}}, tpl.Columns)
+	require.Len(t, tpl.Tasks, 2)
+	require.NotNil(t, tpl.Tasks[1].Parent)
+	assert.Equal(t, 0, *tpl.Tasks[1].Parent)
+	assert.Equal(t, domain.PriorityHigh, *tpl.Tasks[1].Priority)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
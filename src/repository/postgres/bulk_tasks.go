@@ -0,0 +1,148 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+// GetTasksByIDs возвращает задачи по идентификаторам, в том числе удаленные.
+// Ненайденные идентификаторы пропускаются.
+func (r Repository) GetTasksByIDs(ctx context.Context, ids []uuid.UUID) ([]domain.Task, error) {
+	const op = "postgres.GetTasksByIDs"
+
+	sql, params, err := goqu.From("tasks").Where(goqu.C("id").In(ids)).ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	var records []TaskRecord
+	err = pgxscan.Select(ctx, r.pool, &records, sql, params...)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	tasks := make([]domain.Task, 0, len(records))
+	for _, rec := range records {
+		task, err := rec.toDomain()
+		if err != nil {
+			return nil, errors.Wrap(err, op)
+		}
+		tasks = append(tasks, *task)
+	}
+
+	return tasks, nil
+}
+
+// BulkUpdateTasks сохраняет измененные массовой операцией задачи в одной транзакции.
+// При allOrNothing первая ошибка откатывает всё. Иначе каждая задача пишется
+// под своей точкой сохранения, а ошибки возвращаются по задачам.
+func (r Repository) BulkUpdateTasks(ctx context.Context, tasks []domain.Task, allOrNothing bool) (map[uuid.UUID]error, error) {
+	const op = "postgres.BulkUpdateTasks"
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	defer tx.Rollback(ctx)
+
+	failed := make(map[uuid.UUID]error)
+	for i := range tasks {
+		task := &tasks[i]
+
+		if allOrNothing {
+			if err := bulkUpdateTask(ctx, tx, task); err != nil {
+				return nil, errors.Wrap(err, op)
+			}
+			continue
+		}
+
+		if _, err := tx.Exec(ctx, `SAVEPOINT bulk_task`); err != nil {
+			return nil, errors.Wrap(err, op)
+		}
+		if err := bulkUpdateTask(ctx, tx, task); err != nil {
+			failed[task.ID] = err
+			if _, err := tx.Exec(ctx, `ROLLBACK TO SAVEPOINT bulk_task`); err != nil {
+				return nil, errors.Wrap(err, op)
+			}
+			continue
+		}
+		if _, err := tx.Exec(ctx, `RELEASE SAVEPOINT bulk_task`); err != nil {
+			return nil, errors.Wrap(err, op)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	for i := range tasks {
+		if _, ok := failed[tasks[i].ID]; !ok {
+			tasks[i].ClearEvents()
+		}
+	}
+
+	return failed, nil
+}
+
+// bulkUpdateTask пишет поля, которые меняют массовые операции. Удаление не каскадное:
+// подзадачи становятся корневыми. Восстановленная задача становится корневой,
+// если ее родитель остался в корзине.
+func bulkUpdateTask(ctx context.Context, tx pgx.Tx, task *domain.Task) error {
+	var priority *string
+	if task.Priority != nil {
+		p := string(*task.Priority)
+		priority = &p
+	}
+
+	var tagsValue interface{}
+	if task.Tags != nil {
+		tagsValue = pq.StringArray(task.Tags)
+	}
+
+	sql, params, err := goqu.Update("tasks").Where(
+		goqu.C("id").Eq(task.ID),
+	).Set(goqu.Record{
+		"column_id":  task.ColumnID,
+		"tags":       tagsValue,
+		"assignee":   task.Assignee,
+		"priority":   priority,
+		"lane":       task.Lane,
+		"updated_at": task.UpdatedAt,
+		"deleted_at": task.DeletedAt,
+	}).ToSQL()
+	if err != nil {
+		return err
+	}
+
+	tag, err := tx.Exec(ctx, sql, params...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	if task.DeletedAt != nil {
+		_, err = tx.Exec(ctx,
+			`UPDATE tasks SET parent_id = NULL, updated_at = $2
+			WHERE parent_id = $1 AND deleted_at IS NULL`,
+			task.ID, task.UpdatedAt)
+	} else {
+		_, err = tx.Exec(ctx,
+			`UPDATE tasks t SET parent_id = NULL
+			FROM tasks p
+			WHERE t.id = $1 AND p.id = t.parent_id AND p.deleted_at IS NOT NULL`,
+			task.ID)
+	}
+	if err != nil {
+		return err
+	}
+
+	return insertOutbox(ctx, tx, task.Events())
+}
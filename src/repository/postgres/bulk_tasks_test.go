@@ -0,0 +1,66 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBulkUpdateTasks(t *testing.T) {
+	now := time.Now().UTC()
+	deleted := &domain.Task{ID: uuid.New(), ColumnID: uuid.New(), UpdatedAt: now, DeletedAt: &now}
+	live := &domain.Task{ID: uuid.New(), ColumnID: uuid.New(), Tags: []string{"bug"}, UpdatedAt: now}
+
+	t.Run("ошибка по задаче откатывается до точки сохранения", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`SAVEPOINT bulk_task`).WillReturnResult(pgxmock.NewResult("SAVEPOINT", 0))
+		mock.ExpectExec(`UPDATE "tasks" SET`).WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+		mock.ExpectExec(`UPDATE tasks SET parent_id = NULL`).
+			WithArgs(deleted.ID, now).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+		mock.ExpectExec(`RELEASE SAVEPOINT bulk_task`).WillReturnResult(pgxmock.NewResult("RELEASE", 0))
+		mock.ExpectExec(`SAVEPOINT bulk_task`).WillReturnResult(pgxmock.NewResult("SAVEPOINT", 0))
+		mock.ExpectExec(`UPDATE "tasks" SET`).WillReturnError(errors.New("update error"))
+		mock.ExpectExec(`ROLLBACK TO SAVEPOINT bulk_task`).WillReturnResult(pgxmock.NewResult("ROLLBACK", 0))
+		mock.ExpectCommit()
+
+		repo := &Repository{pool: mock}
+		failed, err := repo.BulkUpdateTasks(context.Background(), []domain.Task{*deleted, *live}, false)
+		require.NoError(t, err)
+		assert.Len(t, failed, 1)
+		assert.ErrorContains(t, failed[live.ID], "update error")
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("все или ничего откатывает транзакцию", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`UPDATE "tasks" SET`).WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+		mock.ExpectExec(`UPDATE tasks t SET parent_id = NULL`).
+			WithArgs(live.ID).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+		mock.ExpectExec(`UPDATE "tasks" SET`).WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+		mock.ExpectRollback()
+
+		repo := &Repository{pool: mock}
+		_, err = repo.BulkUpdateTasks(context.Background(), []domain.Task{*live, *deleted}, true)
+		require.Error(t, err)
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/pkg/errors"
 )
 
 func (r Repository) CheckBoard(ctx context.Context, id string) bool {
@@ -24,3 +25,19 @@ func (r Repository) CheckBoard(ctx context.Context, id string) bool {
 
 	return exists
 }
+
+// CheckBoardShortName - занято ли короткое имя живой доской.
+func (r Repository) CheckBoardShortName(ctx context.Context, shortName string) (bool, error) {
+	const op = "postgres.CheckBoardShortName"
+
+	var exists bool
+	err := r.pool.QueryRow(ctx,
+		`SELECT EXISTS (SELECT 1 FROM boards WHERE short_name = $1 AND deleted_at IS NULL)`,
+		shortName,
+	).Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, op)
+	}
+
+	return exists, nil
+}
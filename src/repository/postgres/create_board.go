@@ -2,16 +2,26 @@ package postgres
 
 import (
 	"context"
+	"encoding/json"
 
+	"github.com/doug-martin/goqu/v9"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 
 	"github.com/KungurtsevNII/team-board-back/src/domain"
 )
 
+// CreateBoard одной транзакцией сохраняет доску и ее колонки.
 func (r Repository) CreateBoard(ctx context.Context, board domain.Board) error {
 	op := "postgres.CreateBoard"
 
-	_, err := r.pool.Exec(ctx,
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx,
 		`INSERT INTO boards (id, name, short_name, created_at, updated_at, deleted_at)
 		VALUES ($1, $2, $3, $4, $5, $6)`,
 		board.ID,
@@ -24,5 +34,138 @@ func (r Repository) CreateBoard(ctx context.Context, board domain.Board) error {
 	if err != nil {
 		return errors.Wrap(err, op)
 	}
+
+	if len(board.Columns) > 0 {
+		sql, params, err := insertColumnsQuery(board.Columns).ToSQL()
+		if err != nil {
+			return errors.Wrap(err, op)
+		}
+		if _, err := tx.Exec(ctx, sql, params...); err != nil {
+			return errors.Wrap(err, op)
+		}
+	}
+
+	if err := insertOutbox(ctx, tx, boardEvents(board.Columns, nil)); err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return errors.Wrap(err, op)
+	}
+
 	return nil
 }
+
+// CreateBoardWithTasks одной транзакцией сохраняет доску вместе с ее колонками и задачами,
+// событиями их создания и начальными переходами задач.
+// Задачи вставляются одним запросом, поэтому ссылки на родителей внутри доски
+// проверяются уже после вставки всех строк.
+func (r Repository) CreateBoardWithTasks(ctx context.Context, board *domain.Board) error {
+	const op = "postgres.CreateBoardWithTasks"
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+	defer tx.Rollback(ctx)
+
+	queries := make([]*goqu.InsertDataset, 0, 3)
+	queries = append(queries, goqu.Insert("boards").Rows(BoardRecord{
+		ID:        board.ID,
+		Name:      board.Name,
+		ShortName: board.ShortName,
+		CreatedAt: board.CreatedAt,
+		UpdatedAt: board.UpdatedAt,
+		DeletedAt: board.DeletedAt,
+
+		StoryPointScale: storyPointScaleToArray(board.StoryPointScale),
+	}))
+
+	if len(board.Columns) > 0 {
+		queries = append(queries, insertColumnsQuery(board.Columns))
+	}
+
+	tasks := make([]interface{}, 0, len(board.Tasks))
+	for _, t := range board.Tasks {
+		checklistsJSON, err := json.Marshal(t.Checklists)
+		if err != nil {
+			return errors.Wrap(err, op)
+		}
+		var priority *string
+		if t.Priority != nil {
+			p := string(*t.Priority)
+			priority = &p
+		}
+		var tags interface{}
+		if t.Tags != nil {
+			tags = pq.StringArray(t.Tags)
+		}
+		tasks = append(tasks, goqu.Record{
+			"id":                 t.ID,
+			"board_id":           t.BoardID,
+			"column_id":          t.ColumnID,
+			"number":             t.Number,
+			"title":              t.Title,
+			"description":        t.Description,
+			"tags":               tags,
+			"checklists":         checklistsJSON,
+			"parent_id":          t.ParentID,
+			"milestone_id":       t.MilestoneID,
+			"assignee":           t.Assignee,
+			"priority":           priority,
+			"lane":               t.Lane,
+			"original_estimate":  t.OriginalEstimate,
+			"remaining_estimate": t.RemainingEstimate,
+			"created_at":         t.CreatedAt,
+			"updated_at":         t.UpdatedAt,
+			"deleted_at":         t.DeletedAt,
+		})
+	}
+	if len(tasks) > 0 {
+		queries = append(queries, goqu.Insert("tasks").Rows(tasks...))
+	}
+
+	for _, ds := range queries {
+		sql, params, err := ds.ToSQL()
+		if err != nil {
+			return errors.Wrap(err, op)
+		}
+		if _, err := tx.Exec(ctx, sql, params...); err != nil {
+			return errors.Wrap(err, op)
+		}
+	}
+
+	if err := insertOutbox(ctx, tx, boardEvents(board.Columns, board.Tasks)); err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return errors.Wrap(err, op)
+	}
+	for i := range board.Columns {
+		board.Columns[i].ClearEvents()
+	}
+	for i := range board.Tasks {
+		board.Tasks[i].ClearEvents()
+	}
+
+	return nil
+}
+
+func insertColumnsQuery(columns []domain.Column) *goqu.InsertDataset {
+	rows := make([]interface{}, 0, len(columns))
+	for _, c := range columns {
+		rows = append(rows, ColumnRecord{
+			ID:        c.ID,
+			BoardID:   c.BoardID,
+			Name:      c.Name,
+			OrderNum:  c.OrderNum,
+			IsDone:    c.IsDone,
+			WipLimit:  c.WipLimit,
+			CreatedAt: c.CreatedAt,
+			UpdatedAt: c.UpdatedAt,
+			DeletedAt: c.DeletedAt,
+		})
+	}
+	return goqu.Insert("columns").Rows(rows...)
+}
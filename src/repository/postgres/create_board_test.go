@@ -0,0 +1,73 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateBoard(t *testing.T) {
+	board, err := domain.NewBoardWithColumns("Team", "TEAM", []string{"Backlog", "Done"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		mockSetup   func(mock pgxmock.PgxPoolIface)
+		expectedErr error
+	}{
+		{
+			name: "доска и колонки в одной транзакции",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`INSERT INTO boards`).
+					WithArgs(board.ID, board.Name, board.ShortName, board.CreatedAt, board.UpdatedAt, board.DeletedAt).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				mock.ExpectExec(`INSERT INTO "columns" .+'Backlog'.+'Done'`).
+					WillReturnResult(pgxmock.NewResult("INSERT", 2))
+				mock.ExpectExec(`INSERT INTO "outbox" .+'column.created'.+'column.created'`).
+					WillReturnResult(pgxmock.NewResult("INSERT", 2))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "ошибка вставки колонок откатывает доску",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`INSERT INTO boards`).
+					WithArgs(board.ID, board.Name, board.ShortName, board.CreatedAt, board.UpdatedAt, board.DeletedAt).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				mock.ExpectExec(`INSERT INTO "columns"`).
+					WillReturnError(errors.New("columns insert error"))
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("columns insert error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.mockSetup(mock)
+
+			repo := &Repository{pool: mock}
+			err = repo.CreateBoard(context.Background(), board)
+
+			if tt.expectedErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -12,9 +12,9 @@ func (r Repository) CreateTask(ctx context.Context, task *domain.Task) error {
 	
 	sql := `INSERT INTO tasks (
 		id, board_id, column_id, number, title, description, tags,
-		checklists, created_at, updated_at, deleted_at
+		checklists, reporter, created_at, updated_at, deleted_at
 	)
-	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`
 
 	checklistsJSON, err := json.Marshal(task.Checklists)
 	if err != nil {
@@ -30,28 +30,39 @@ func (r Repository) CreateTask(ctx context.Context, task *domain.Task) error {
 		Description: task.Description,
 		Tags:        task.Tags,
 		Checklists:  checklistsJSON,
+		Reporter:    task.Reporter,
 		CreatedAt:   task.CreatedAt,
 		UpdatedAt:   task.UpdatedAt,
 		DeletedAt:   task.DeletedAt,
 	}
 
-	_, err = r.pool.Exec(ctx, sql,
-		taskRecord.ID,
-		taskRecord.BoardID,
-		taskRecord.ColumnID,
-		taskRecord.Number,
-		taskRecord.Title,
-		taskRecord.Description,
-		taskRecord.Tags,
-		taskRecord.Checklists,
-		taskRecord.CreatedAt,
-		taskRecord.UpdatedAt,
-		taskRecord.DeletedAt,
-	)
-
+	err = r.withOutbox(ctx, task.Events(), func(db execer) error {
+		_, err := db.Exec(ctx, sql,
+			taskRecord.ID,
+			taskRecord.BoardID,
+			taskRecord.ColumnID,
+			taskRecord.Number,
+			taskRecord.Title,
+			taskRecord.Description,
+			taskRecord.Tags,
+			taskRecord.Checklists,
+			taskRecord.Reporter,
+			taskRecord.CreatedAt,
+			taskRecord.UpdatedAt,
+			taskRecord.DeletedAt,
+		)
+		if err != nil {
+			return err
+		}
+		if written, replace := descriptionWritten(task.Events()); written {
+			return saveDescriptionReferences(ctx, db, task, replace)
+		}
+		return nil
+	})
 	if err != nil {
 		return errors.Wrap(err, op)
 	}
+	task.ClearEvents()
 
 	return nil
 }
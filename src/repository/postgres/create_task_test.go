@@ -50,6 +50,7 @@ func TestCreateTask(t *testing.T) {
 						task.Description,
 						task.Tags,
 						checklistsJSON,
+						task.Reporter,
 						task.CreatedAt,
 						task.UpdatedAt,
 						task.DeletedAt,
@@ -85,6 +86,7 @@ func TestCreateTask(t *testing.T) {
 						task.Description,
 						task.Tags,
 						checklistsJSON,
+						task.Reporter,
 						task.CreatedAt,
 						task.UpdatedAt,
 						task.DeletedAt,
@@ -127,6 +129,7 @@ func TestCreateTask(t *testing.T) {
 						task.Description,
 						task.Tags,
 						checklistsJSON,
+						task.Reporter,
 						task.CreatedAt,
 						task.UpdatedAt,
 						task.DeletedAt,
@@ -162,6 +165,7 @@ func TestCreateTask(t *testing.T) {
 						task.Description,
 						task.Tags,
 						checklistsJSON,
+						task.Reporter,
 						task.CreatedAt,
 						task.UpdatedAt,
 						task.DeletedAt,
@@ -197,6 +201,7 @@ func TestCreateTask(t *testing.T) {
 						task.Description,
 						task.Tags,
 						checklistsJSON,
+						task.Reporter,
 						task.CreatedAt,
 						task.UpdatedAt,
 						task.DeletedAt,
@@ -232,6 +237,7 @@ func TestCreateTask(t *testing.T) {
 						task.Description,
 						task.Tags,
 						checklistsJSON,
+						task.Reporter,
 						task.CreatedAt,
 						task.UpdatedAt,
 						task.DeletedAt,
@@ -267,6 +273,7 @@ func TestCreateTask(t *testing.T) {
 						task.Description,
 						task.Tags,
 						checklistsJSON,
+						task.Reporter,
 						task.CreatedAt,
 						task.UpdatedAt,
 						task.DeletedAt,
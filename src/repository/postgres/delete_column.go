@@ -0,0 +1,114 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// DeleteColumnMovingTasks переносит все задачи колонки в targetID и мягко удаляет колонку.
+// Живые задачи переносятся через домен, их TaskMoved пишутся в outbox и историю
+// переходов в той же транзакции. Удаленные задачи переносятся молча, чтобы
+// их можно было восстановить из корзины. Возвращает количество перенесенных задач.
+func (r Repository) DeleteColumnMovingTasks(ctx context.Context, column *domain.Column, targetID uuid.UUID) (int64, error) {
+	const op = "postgres.DeleteColumnMovingTasks"
+
+	column.UpdatedAt = time.Now().UTC()
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, op)
+	}
+	defer tx.Rollback(ctx)
+
+	// Блокируем целевую колонку, чтобы ее не удалили параллельно
+	// (pgx.ErrNoRows, если ее уже нет в доске).
+	var lockedID uuid.UUID
+	err = tx.QueryRow(ctx,
+		`SELECT id FROM columns
+		WHERE id = $1 AND board_id = $2 AND deleted_at IS NULL
+		FOR UPDATE`, targetID, column.BoardID).Scan(&lockedID)
+	if err != nil {
+		return 0, errors.Wrap(err, op)
+	}
+
+	var records []TaskRecord
+	err = pgxscan.Select(ctx, tx, &records,
+		`SELECT * FROM tasks
+		WHERE column_id = $1 AND deleted_at IS NULL
+		ORDER BY number
+		FOR UPDATE`, column.ID)
+	if err != nil {
+		return 0, errors.Wrap(err, op)
+	}
+
+	tasks := make([]*domain.Task, 0, len(records))
+	ids := make([]uuid.UUID, 0, len(records))
+	for _, rec := range records {
+		task, err := rec.toDomain()
+		if err != nil {
+			return 0, errors.Wrap(err, op)
+		}
+		if err := task.MoveToColumn(targetID); err != nil {
+			return 0, errors.Wrap(err, op)
+		}
+		task.UpdatedAt = column.UpdatedAt
+		tasks = append(tasks, task)
+		ids = append(ids, task.ID)
+	}
+
+	var moved int64
+	if len(ids) > 0 {
+		tag, err := tx.Exec(ctx,
+			`UPDATE tasks SET column_id = $2, updated_at = $3 WHERE id = ANY($1)`,
+			ids, targetID, column.UpdatedAt)
+		if err != nil {
+			return 0, errors.Wrap(err, op)
+		}
+		moved = tag.RowsAffected()
+	}
+
+	tag, err := tx.Exec(ctx,
+		`UPDATE tasks SET column_id = $2 WHERE column_id = $1 AND deleted_at IS NOT NULL`,
+		column.ID, targetID)
+	if err != nil {
+		return 0, errors.Wrap(err, op)
+	}
+	moved += tag.RowsAffected()
+
+	sql, params, err := goqu.Update("columns").Where(
+		goqu.C("id").Eq(column.ID),
+		goqu.C("deleted_at").IsNull(),
+	).Set(goqu.Record{
+		"deleted_at": column.DeletedAt,
+		"updated_at": column.UpdatedAt,
+	}).ToSQL()
+	if err != nil {
+		return 0, errors.Wrap(err, op)
+	}
+
+	_, err = tx.Exec(ctx, sql, params...)
+	if err != nil {
+		return 0, errors.Wrap(err, op)
+	}
+
+	events := append([]domain.Event(nil), column.Events()...)
+	for _, task := range tasks {
+		events = append(events, task.Events()...)
+	}
+	if err := insertOutbox(ctx, tx, events); err != nil {
+		return 0, errors.Wrap(err, op)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, errors.Wrap(err, op)
+	}
+	column.ClearEvents()
+
+	return moved, nil
+}
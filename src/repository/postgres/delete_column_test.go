@@ -0,0 +1,112 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteColumnMovingTasks(t *testing.T) {
+	now := time.Now().UTC()
+	boardID := uuid.New()
+	targetID := uuid.New()
+
+	t.Run("задачи переносятся с событиями, колонка удаляется", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		column := &domain.Column{ID: uuid.New(), BoardID: boardID, DeletedAt: &now}
+		first, second := uuid.New(), uuid.New()
+
+		taskRows := pgxmock.NewRows([]string{
+			"id", "board_id", "column_id", "number", "title", "checklists", "created_at", "updated_at", "deleted_at",
+		}).
+			AddRow(first, boardID, column.ID, int64(1), "First", []byte(`[]`), now, now, nil).
+			AddRow(second, boardID, column.ID, int64(2), "Second", []byte(`[]`), now, now, nil)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT id FROM columns .+ FOR UPDATE`).
+			WithArgs(targetID, boardID).
+			WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(targetID))
+		mock.ExpectQuery(`SELECT \* FROM tasks\s+WHERE column_id = \$1 AND deleted_at IS NULL.+FOR UPDATE`).
+			WithArgs(column.ID).
+			WillReturnRows(taskRows)
+		mock.ExpectExec(`UPDATE tasks SET column_id = \$2, updated_at = \$3 WHERE id = ANY\(\$1\)`).
+			WithArgs([]uuid.UUID{first, second}, targetID, pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 2))
+		mock.ExpectExec(`UPDATE tasks SET column_id = \$2 WHERE column_id = \$1 AND deleted_at IS NOT NULL`).
+			WithArgs(column.ID, targetID).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+		mock.ExpectExec(`UPDATE "columns" SET "deleted_at"=.+ WHERE`).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+		mock.ExpectExec(`INSERT INTO "outbox" .+'task.moved'.+'task.moved'`).
+			WillReturnResult(pgxmock.NewResult("INSERT", 2))
+		mock.ExpectExec(`INSERT INTO "task_transitions" .+'` + column.ID.String() + `'.+'` + first.String() + `', '` + targetID.String() + `'\).+'` + second.String() + `', '` + targetID.String() + `'\)`).
+			WillReturnResult(pgxmock.NewResult("INSERT", 2))
+		mock.ExpectCommit()
+
+		repo := &Repository{pool: mock}
+		moved, err := repo.DeleteColumnMovingTasks(context.Background(), column, targetID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), moved)
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("пустая колонка удаляется без событий задач", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		column := &domain.Column{ID: uuid.New(), BoardID: boardID, DeletedAt: &now}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT id FROM columns .+ FOR UPDATE`).
+			WithArgs(targetID, boardID).
+			WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(targetID))
+		mock.ExpectQuery(`SELECT \* FROM tasks`).
+			WithArgs(column.ID).
+			WillReturnRows(pgxmock.NewRows([]string{"id"}))
+		mock.ExpectExec(`UPDATE tasks SET column_id = \$2 WHERE column_id = \$1 AND deleted_at IS NOT NULL`).
+			WithArgs(column.ID, targetID).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+		mock.ExpectExec(`UPDATE "columns" SET "deleted_at"=.+ WHERE`).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+		mock.ExpectCommit()
+
+		repo := &Repository{pool: mock}
+		moved, err := repo.DeleteColumnMovingTasks(context.Background(), column, targetID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), moved)
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("целевой колонки нет в доске", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		column := &domain.Column{ID: uuid.New(), BoardID: boardID, DeletedAt: &now}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT id FROM columns`).
+			WithArgs(targetID, boardID).
+			WillReturnError(pgx.ErrNoRows)
+		mock.ExpectRollback()
+
+		repo := &Repository{pool: mock}
+		_, err = repo.DeleteColumnMovingTasks(context.Background(), column, targetID)
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,82 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+// DeleteTask мягко удаляет задачу. Дочерние задачи либо удаляются вместе с ней
+// (cascade), либо становятся корневыми.
+func (r Repository) DeleteTask(ctx context.Context, task *domain.Task, cascade bool) error {
+	const op = "postgres.DeleteTask"
+
+	if task.DeletedAt == nil {
+		return errors.Wrap(errors.New("task is not marked as deleted"), op)
+	}
+	task.UpdatedAt = time.Now().UTC()
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+	defer tx.Rollback(ctx)
+
+	ds := goqu.Update("tasks").Where(
+		goqu.C("id").Eq(task.ID),
+		goqu.C("deleted_at").IsNull(),
+	).Set(goqu.Record{
+		"updated_at": task.UpdatedAt,
+		"deleted_at": task.DeletedAt,
+	})
+
+	sql, params, err := ds.ToSQL()
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	tag, err := tx.Exec(ctx, sql, params...)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+	// задачу успели удалить параллельно: ни каскада, ни событий
+	if tag.RowsAffected() == 0 {
+		return errors.Wrap(pgx.ErrNoRows, op)
+	}
+
+	if cascade {
+		_, err = tx.Exec(ctx,
+			`WITH RECURSIVE subtree AS (
+				SELECT id FROM tasks WHERE parent_id = $1 AND deleted_at IS NULL
+				UNION
+				SELECT t.id FROM tasks t JOIN subtree s ON t.parent_id = s.id
+				WHERE t.deleted_at IS NULL
+			)
+			UPDATE tasks SET deleted_at = $2, updated_at = $3
+			WHERE id IN (SELECT id FROM subtree)`,
+			task.ID, task.DeletedAt, task.UpdatedAt)
+	} else {
+		_, err = tx.Exec(ctx,
+			`UPDATE tasks SET parent_id = NULL, updated_at = $2
+			WHERE parent_id = $1 AND deleted_at IS NULL`,
+			task.ID, task.UpdatedAt)
+	}
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	if err := insertOutbox(ctx, tx, task.Events()); err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return errors.Wrap(err, op)
+	}
+	task.ClearEvents()
+
+	return nil
+}
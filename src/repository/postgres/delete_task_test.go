@@ -0,0 +1,111 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteTask(t *testing.T) {
+	now := time.Now().UTC()
+
+	tests := []struct {
+		name        string
+		task        *domain.Task
+		cascade     bool
+		mockSetup   func(mock pgxmock.PgxPoolIface)
+		expectedErr error
+	}{
+		{
+			name:    "удаление с отвязкой дочерних задач",
+			task:    &domain.Task{ID: uuid.New(), DeletedAt: &now},
+			cascade: false,
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE "tasks"`).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectExec(`UPDATE tasks SET parent_id = NULL`).
+					WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg()).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 2))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "каскадное удаление поддерева",
+			task:    &domain.Task{ID: uuid.New(), DeletedAt: &now},
+			cascade: true,
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE "tasks"`).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectExec(`WITH RECURSIVE subtree AS .+UPDATE tasks SET deleted_at`).
+					WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 3))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "задача уже удалена",
+			task:    &domain.Task{ID: uuid.New(), DeletedAt: &now},
+			cascade: true,
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE "tasks"`).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+				mock.ExpectRollback()
+			},
+			expectedErr: pgx.ErrNoRows,
+		},
+		{
+			name:        "задача не помечена удаленной",
+			task:        &domain.Task{ID: uuid.New()},
+			mockSetup:   func(mock pgxmock.PgxPoolIface) {},
+			expectedErr: errors.New("task is not marked as deleted"),
+		},
+		{
+			name:    "ошибка при каскадном удалении",
+			task:    &domain.Task{ID: uuid.New(), DeletedAt: &now},
+			cascade: true,
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE "tasks"`).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectExec(`WITH RECURSIVE subtree AS`).
+					WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).
+					WillReturnError(errors.New("subtree update error"))
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("subtree update error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.mockSetup(mock)
+
+			repo := &Repository{pool: mock}
+			err = repo.DeleteTask(context.Background(), tt.task, tt.cascade)
+
+			if tt.expectedErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
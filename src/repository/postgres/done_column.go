@@ -0,0 +1,14 @@
+package postgres
+
+// doneColumnCondition - SQL-аналог domain.IsDoneColumn для колонки с алиасом "c":
+// колонка явно помечена is_done, либо на доске нет помеченных колонок и она последняя.
+const doneColumnCondition = `(c.is_done OR (
+	NOT EXISTS (
+		SELECT 1 FROM columns dc
+		WHERE dc.board_id = c.board_id AND dc.is_done AND dc.deleted_at IS NULL
+	)
+	AND c.order_num = (
+		SELECT MAX(lc.order_num) FROM columns lc
+		WHERE lc.board_id = c.board_id AND lc.deleted_at IS NULL
+	)
+))`
@@ -0,0 +1,52 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/pkg/errors"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+)
+
+// GetTaskDeadlines возвращает сроки назначенных незавершенных задач с датой
+// в [from, to]: дату открытой цели и последний день незакрытого спринта.
+// У задачи может быть два срока - ближайший выбирает domain.DueSoonNotifications.
+func (r Repository) GetTaskDeadlines(ctx context.Context, from, to time.Time) ([]domain.TaskDeadline, error) {
+	const op = "postgres.GetTaskDeadlines"
+
+	var records []TaskDeadlineRecord
+	err := pgxscan.Select(ctx, r.pool, &records,
+		`SELECT t.id AS task_id, t.board_id, t.title, t.assignee,
+			m.target_date AS due_date, 'milestone' AS source, m.title AS source_name
+		FROM tasks t
+		JOIN columns c ON c.id = t.column_id
+		JOIN milestones m ON m.id = t.milestone_id
+		WHERE t.deleted_at IS NULL AND t.assignee IS NOT NULL
+		AND m.deleted_at IS NULL AND m.state = 'open'
+		AND m.target_date BETWEEN $1 AND $2
+		AND NOT `+doneColumnCondition+`
+		UNION ALL
+		SELECT t.id, t.board_id, t.title, t.assignee,
+			s.end_date, 'sprint', s.name
+		FROM tasks t
+		JOIN columns c ON c.id = t.column_id
+		JOIN sprints s ON s.id = t.sprint_id
+		WHERE t.deleted_at IS NULL AND t.assignee IS NOT NULL
+		AND s.deleted_at IS NULL AND s.state <> 'closed'
+		AND s.end_date BETWEEN $1 AND $2
+		AND NOT `+doneColumnCondition+`
+		ORDER BY due_date, task_id`,
+		from, to)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	deadlines := make([]domain.TaskDeadline, 0, len(records))
+	for _, rec := range records {
+		deadlines = append(deadlines, rec.toDomain())
+	}
+
+	return deadlines, nil
+}
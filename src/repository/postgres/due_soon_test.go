@@ -0,0 +1,47 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTaskDeadlines(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	from := time.Date(2025, 12, 10, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 1)
+	taskID, boardID := uuid.New(), uuid.New()
+
+	mock.ExpectQuery(`JOIN milestones m ON m.id = t.milestone_id(.|\n)+m.state = 'open'(.|\n)+UNION ALL(.|\n)+JOIN sprints s ON s.id = t.sprint_id(.|\n)+s.state <> 'closed'`).
+		WithArgs(from, to).
+		WillReturnRows(pgxmock.NewRows([]string{"task_id", "board_id", "title", "assignee", "due_date", "source", "source_name"}).
+			AddRow(taskID, boardID, "Fix login", "alice", from, "sprint", "Sprint 5").
+			AddRow(taskID, boardID, "Fix login", "alice", to, "milestone", "v1.0"))
+	mock.ExpectQuery(`UNION ALL`).
+		WithArgs(from, to).
+		WillReturnError(errors.New("db down"))
+
+	repo := &Repository{pool: mock}
+	deadlines, err := repo.GetTaskDeadlines(context.Background(), from, to)
+	require.NoError(t, err)
+	require.Len(t, deadlines, 2)
+	assert.Equal(t, domain.TaskDeadline{
+		TaskID: taskID, BoardID: boardID, Title: "Fix login", Assignee: "alice",
+		DueDate: from, Source: domain.DeadlineSprint, SourceName: "Sprint 5",
+	}, deadlines[0])
+	assert.Equal(t, domain.DeadlineMilestone, deadlines[1].Source)
+
+	_, err = repo.GetTaskDeadlines(context.Background(), from, to)
+	assert.Error(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
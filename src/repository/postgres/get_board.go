@@ -14,7 +14,7 @@ func (r Repository) GetBoard(ctx context.Context, ID uuid.UUID) (*domain.Board,
 
 	var board domain.Board
 	err := pgxscan.Get(ctx, r.pool, &board,
-		`SELECT id, name, short_name, created_at, updated_at, deleted_at 
+		`SELECT id, name, short_name, created_at, updated_at, deleted_at, archived_at
 		FROM boards WHERE id = $1
 		AND deleted_at IS NULL`, ID)
 
@@ -9,16 +9,18 @@ import (
 	"github.com/pkg/errors"
 )
 
-func (r Repository) GetBoards(ctx context.Context, ID uuid.UUID) ([]domain.Board, error) {
+// GetBoards возвращает доски; архивные доски попадают в выборку только при includeArchived.
+func (r Repository) GetBoards(ctx context.Context, ID uuid.UUID, includeArchived bool) ([]domain.Board, error) {
 	const op = "postgres.GetBoards"
 
 	boards := make([]domain.Board, 0)
 	//TODO подставлять user_id в запрос
 	err := pgxscan.Select(ctx, r.pool, &boards,
-		`SELECT id, name, short_name
+		`SELECT id, name, short_name, updated_at, archived_at
 	FROM boards
 	WHERE deleted_at IS NULL
-	ORDER BY updated_at DESC`)
+	AND ($1 OR archived_at IS NULL)
+	ORDER BY updated_at DESC`, includeArchived)
 	if err != nil {
 		return nil, errors.Wrap(err, op)
 	}
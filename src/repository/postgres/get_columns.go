@@ -14,7 +14,7 @@ func (r Repository) GetColumns(ctx context.Context, ID uuid.UUID) ([]domain.Colu
 
 	columns := make([]domain.Column, 0)
 	err := pgxscan.Select(ctx, r.pool, &columns,
-		`SELECT id, board_id, order_num, name 
+		`SELECT id, board_id, order_num, name, is_done, wip_limit
 		FROM columns WHERE board_id = $1 
 		AND deleted_at IS NULL
 		ORDER BY order_num;`, ID)
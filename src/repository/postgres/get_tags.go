@@ -0,0 +1,64 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// GetTags возвращает уникальные теги живых задач с количеством использований.
+// Если boardID == nil, считаем по доскам пользователя, как GetBoards: архивные не входят.
+func (r Repository) GetTags(
+	ctx context.Context,
+	boardID *uuid.UUID,
+	userID uuid.UUID,
+	prefix string,
+	limit uint,
+) ([]domain.TagUsage, error) {
+	const op = "postgres.GetTags"
+
+	tag := goqu.I("tag")
+	ds := goqu.From("tasks").
+		Join(goqu.T("boards"), goqu.On(goqu.T("tasks").Col("board_id").Eq(goqu.T("boards").Col("id")))).
+		CrossJoin(goqu.L(`unnest("tasks"."tags") AS "tag"`)).
+		Select(tag.As("name"), goqu.COUNT("*").As("count")).
+		Where(
+			goqu.T("tasks").Col("deleted_at").IsNull(),
+			goqu.T("boards").Col("deleted_at").IsNull(),
+		)
+
+	if boardID != nil {
+		ds = ds.Where(goqu.T("tasks").Col("board_id").Eq(*boardID))
+	} else {
+		//TODO подставлять user_id в запрос, как в GetBoards
+		ds = ds.Where(goqu.T("boards").Col("archived_at").IsNull())
+	}
+
+	if prefix != "" {
+		ds = ds.Where(goqu.L(`"tag" ILIKE ? ESCAPE '\'`, likeEscaper.Replace(prefix)+"%"))
+	}
+
+	ds = ds.GroupBy(tag).
+		Order(goqu.I("count").Desc(), tag.Asc()).
+		Limit(limit)
+
+	sql, params, err := ds.ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	tags := make([]domain.TagUsage, 0)
+	err = pgxscan.Select(ctx, r.pool, &tags, sql, params...)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	return tags, nil
+}
@@ -0,0 +1,105 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTags(t *testing.T) {
+	boardID, userID := uuid.New(), uuid.New()
+
+	baseSelect := `SELECT "tag" AS "name", COUNT\(\*\) AS "count" FROM "tasks" ` +
+		`INNER JOIN "boards" ON \("tasks"\."board_id" = "boards"\."id"\) ` +
+		`CROSS JOIN unnest\("tasks"\."tags"\) AS "tag" `
+
+	tests := []struct {
+		name         string
+		boardID      *uuid.UUID
+		prefix       string
+		limit        uint
+		mockSetup    func(mock pgxmock.PgxPoolIface)
+		expectedTags []domain.TagUsage
+		expectedErr  error
+	}{
+		{
+			name:    "теги доски, отсортированные по частоте",
+			boardID: &boardID,
+			limit:   20,
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows([]string{"name", "count"}).
+					AddRow("backend", int64(12)).
+					AddRow("api", int64(3))
+				mock.ExpectQuery(baseSelect + `WHERE .+"tasks"\."board_id" = '` + boardID.String() + `'.+` +
+					`GROUP BY "tag" ORDER BY "count" DESC, "tag" ASC LIMIT 20`).
+					WillReturnRows(rows)
+			},
+			expectedTags: []domain.TagUsage{
+				{Name: "backend", Count: 12},
+				{Name: "api", Count: 3},
+			},
+		},
+		{
+			name:   "глобальный поиск по префиксу с экранированием",
+			prefix: "50%_",
+			limit:  5,
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows([]string{"name", "count"}).
+					AddRow("50%_off", int64(1))
+				mock.ExpectQuery(baseSelect + `WHERE .+"boards"\."archived_at" IS NULL.+"tag" ILIKE '50\\%\\_%' ESCAPE .+LIMIT 5`).
+					WillReturnRows(rows)
+			},
+			expectedTags: []domain.TagUsage{
+				{Name: "50%_off", Count: 1},
+			},
+		},
+		{
+			name:  "нет тегов",
+			limit: 20,
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(baseSelect).
+					WillReturnRows(pgxmock.NewRows([]string{"name", "count"}))
+			},
+			expectedTags: []domain.TagUsage{},
+		},
+		{
+			name:  "общая ошибка БД",
+			limit: 20,
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(baseSelect).
+					WillReturnError(errors.New("database connection failed"))
+			},
+			expectedErr: errors.New("database connection failed"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.mockSetup(mock)
+
+			repo := &Repository{pool: mock}
+			tags, err := repo.GetTags(context.Background(), tt.boardID, userID, tt.prefix, tt.limit)
+
+			if tt.expectedErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+				assert.Nil(t, tags)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedTags, tags)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
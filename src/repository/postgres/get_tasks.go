@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/doug-martin/goqu/v9"
 	"github.com/georgysavva/scany/v2/pgxscan"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
@@ -14,7 +15,8 @@ func (r Repository) GetTasks(ctx context.Context, ID uuid.UUID) ([]domain.Task,
 
 	tasks := make([]domain.Task, 0)
 	err := pgxscan.Select(ctx, r.pool, &tasks,
-		`SELECT id, column_id, board_id, number, title 
+		`SELECT id, column_id, board_id, number, title, sprint_id,
+		tags, parent_id, assignee, priority, lane
 		FROM tasks WHERE board_id = $1
 		AND deleted_at IS NULL
 		ORDER BY number;`, ID)
@@ -23,3 +25,37 @@ func (r Repository) GetTasks(ctx context.Context, ID uuid.UUID) ([]domain.Task,
 	}
 	return tasks, nil
 }
+
+// GetBoardTaskDetails возвращает задачи доски со всеми полями, в порядке номеров.
+func (r Repository) GetBoardTaskDetails(ctx context.Context, boardID uuid.UUID) ([]domain.Task, error) {
+	const op = "postgres.GetBoardTaskDetails"
+
+	ds := goqu.From("tasks").
+		Where(
+			goqu.C("board_id").Eq(boardID),
+			goqu.C("deleted_at").IsNull(),
+		).
+		Order(goqu.C("number").Asc())
+
+	sql, params, err := ds.ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	records := make([]TaskRecord, 0)
+	err = pgxscan.Select(ctx, r.pool, &records, sql, params...)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	tasks := make([]domain.Task, 0, len(records))
+	for _, rec := range records {
+		dmn, err := rec.toDomain()
+		if err != nil {
+			return nil, errors.Wrap(err, op)
+		}
+		tasks = append(tasks, *dmn)
+	}
+
+	return tasks, nil
+}
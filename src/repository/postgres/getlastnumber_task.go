@@ -12,8 +12,15 @@ import (
 func (r Repository) GetLastNumberTask(ctx context.Context, boardID uuid.UUID) (int64, error) {
     const op = "postgres.GetLastNumberTask"
 
-    ds := goqu.From("tasks").
+    // Номера перенесенных в другие доски задач заняты редиректами и не переиспользуются
+    numbers := goqu.From("tasks").
 		Where(goqu.C("board_id").Eq(boardID)).
+		Select(goqu.C("number")).
+		UnionAll(goqu.From("task_redirects").
+			Where(goqu.C("board_id").Eq(boardID)).
+			Select(goqu.C("number")))
+
+    ds := goqu.From(numbers.As("numbers")).
 		Select(goqu.C("number")).
 		Order(goqu.I("number").Desc()).Limit(1)
 		
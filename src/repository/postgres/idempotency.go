@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/pkg/errors"
+)
+
+// AcquireIdempotencyKey занимает ключ под новый запрос. Если ключ уже занят
+// и не истек, возвращает существующую запись и false.
+func (r Repository) AcquireIdempotencyKey(ctx context.Context, rec *domain.IdempotencyRecord) (*domain.IdempotencyRecord, bool, error) {
+	const op = "postgres.AcquireIdempotencyKey"
+
+	// истекшие ключи удаляет purge-воркер, а до того истекшая запись
+	// перезаписывается новым запросом, как будто ее уже нет
+	tag, err := r.pool.Exec(ctx,
+		`INSERT INTO idempotency_keys (scope, key, request_hash, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (scope, key) DO UPDATE SET
+			request_hash = EXCLUDED.request_hash,
+			status_code = NULL,
+			content_type = NULL,
+			response_body = NULL,
+			created_at = EXCLUDED.created_at,
+			expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at < EXCLUDED.created_at`,
+		rec.Scope, rec.Key, rec.RequestHash, rec.CreatedAt, rec.ExpiresAt)
+	if err != nil {
+		return nil, false, errors.Wrap(err, op)
+	}
+	if tag.RowsAffected() == 1 {
+		return nil, true, nil
+	}
+
+	var existing IdempotencyKeyRecord
+	err = pgxscan.Get(ctx, r.pool, &existing,
+		`SELECT scope, key, request_hash, status_code, content_type, response_body, created_at, expires_at
+		FROM idempotency_keys WHERE scope = $1 AND key = $2`,
+		rec.Scope, rec.Key)
+	if err != nil {
+		return nil, false, errors.Wrap(err, op)
+	}
+
+	return existing.toDomain(), false, nil
+}
+
+// SaveIdempotentResponse сохраняет ответ на первый запрос для повторов.
+func (r Repository) SaveIdempotentResponse(ctx context.Context, rec *domain.IdempotencyRecord) error {
+	const op = "postgres.SaveIdempotentResponse"
+
+	_, err := r.pool.Exec(ctx,
+		`UPDATE idempotency_keys SET status_code = $3, content_type = $4, response_body = $5
+		WHERE scope = $1 AND key = $2`,
+		rec.Scope, rec.Key, rec.StatusCode, rec.ContentType, rec.Body)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	return nil
+}
+
+// ReleaseIdempotencyKey освобождает ключ, если первый запрос не удался:
+// повтор с тем же ключом выполнится заново.
+func (r Repository) ReleaseIdempotencyKey(ctx context.Context, scope, key string) error {
+	const op = "postgres.ReleaseIdempotencyKey"
+
+	_, err := r.pool.Exec(ctx,
+		`DELETE FROM idempotency_keys WHERE scope = $1 AND key = $2 AND status_code IS NULL`,
+		scope, key)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	return nil
+}
+
+// DeleteExpiredIdempotencyKeys удаляет не больше limit ключей, истекших до now.
+func (r Repository) DeleteExpiredIdempotencyKeys(ctx context.Context, now time.Time, limit int) (int64, error) {
+	const op = "postgres.DeleteExpiredIdempotencyKeys"
+
+	tag, err := r.pool.Exec(ctx,
+		`DELETE FROM idempotency_keys WHERE (scope, key) IN (
+			SELECT scope, key FROM idempotency_keys
+			WHERE expires_at < $1
+			ORDER BY expires_at
+			LIMIT $2
+		)`, now, limit)
+	if err != nil {
+		return 0, errors.Wrap(err, op)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// CountExpiredIdempotencyKeys считает ключи, истекшие до now, для dry-run отчета.
+func (r Repository) CountExpiredIdempotencyKeys(ctx context.Context, now time.Time) (int64, error) {
+	const op = "postgres.CountExpiredIdempotencyKeys"
+
+	var cnt int64
+	err := r.pool.QueryRow(ctx,
+		`SELECT count(*) FROM idempotency_keys WHERE expires_at < $1`, now).Scan(&cnt)
+	if err != nil {
+		return 0, errors.Wrap(err, op)
+	}
+
+	return cnt, nil
+}
@@ -0,0 +1,82 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcquireIdempotencyKey(t *testing.T) {
+	rec, err := domain.NewIdempotencyRecord("POST /api/v1/tasks ", "k1", []byte(`{}`), time.Hour)
+	require.NoError(t, err)
+
+	t.Run("новый ключ", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectExec(`INSERT INTO idempotency_keys .+ON CONFLICT .+WHERE idempotency_keys.expires_at < EXCLUDED.created_at`).
+			WithArgs(rec.Scope, rec.Key, rec.RequestHash, rec.CreatedAt, rec.ExpiresAt).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+		repo := &Repository{pool: mock}
+		existing, acquired, err := repo.AcquireIdempotencyKey(context.Background(), rec)
+		require.NoError(t, err)
+		assert.True(t, acquired)
+		assert.Nil(t, existing)
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ключ уже занят завершенным запросом", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		status := 201
+		contentType := "application/json"
+		mock.ExpectExec(`INSERT INTO idempotency_keys .+ON CONFLICT .+WHERE idempotency_keys.expires_at < EXCLUDED.created_at`).
+			WithArgs(rec.Scope, rec.Key, rec.RequestHash, rec.CreatedAt, rec.ExpiresAt).
+			WillReturnResult(pgxmock.NewResult("INSERT", 0))
+		mock.ExpectQuery(`SELECT scope, key, request_hash`).
+			WithArgs(rec.Scope, rec.Key).
+			WillReturnRows(pgxmock.NewRows([]string{
+				"scope", "key", "request_hash", "status_code", "content_type", "response_body", "created_at", "expires_at",
+			}).AddRow(rec.Scope, rec.Key, rec.RequestHash, &status, &contentType, []byte(`{"id":1}`), rec.CreatedAt, rec.ExpiresAt))
+
+		repo := &Repository{pool: mock}
+		existing, acquired, err := repo.AcquireIdempotencyKey(context.Background(), rec)
+		require.NoError(t, err)
+		assert.False(t, acquired)
+		require.NotNil(t, existing)
+		assert.True(t, existing.Completed())
+		assert.True(t, existing.SameRequest(rec))
+		assert.Equal(t, `{"id":1}`, string(existing.Body))
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestDeleteExpiredIdempotencyKeys(t *testing.T) {
+	now := time.Now().UTC()
+
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	mock.ExpectExec(`DELETE FROM idempotency_keys WHERE \(scope, key\) IN .+expires_at < \$1.+LIMIT \$2`).
+		WithArgs(now, 100).
+		WillReturnResult(pgxmock.NewResult("DELETE", 7))
+
+	repo := &Repository{pool: mock}
+	deleted, err := repo.DeleteExpiredIdempotencyKeys(context.Background(), now, 100)
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), deleted)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -15,6 +15,12 @@ func (task *TaskRecord) toDomain() (*domain.Task, error) {
 		return nil, errors.Wrap(err, op)
 	}
 
+	var priority *domain.Priority
+	if task.Priority != nil {
+		p := domain.Priority(*task.Priority)
+		priority = &p
+	}
+
 	dmn := domain.Task{
 		ID:          task.ID,
 		ColumnID:    task.ColumnID,
@@ -24,6 +30,16 @@ func (task *TaskRecord) toDomain() (*domain.Task, error) {
 		Description: task.Description,
 		Tags:        task.Tags,
 		Checklists:  cl,
+		ParentID:    task.ParentID,
+		MilestoneID: task.MilestoneID,
+		SprintID:    task.SprintID,
+		Assignee:    task.Assignee,
+		Priority:    priority,
+		Lane:        task.Lane,
+		Reporter:    task.Reporter,
+		OriginalEstimate:  task.OriginalEstimate,
+		RemainingEstimate: task.RemainingEstimate,
+		StoryPoints:       task.StoryPoints,
 		CreatedAt:   task.CreatedAt,
 		UpdatedAt:   task.UpdatedAt,
 		DeletedAt:   task.DeletedAt,
@@ -37,6 +53,8 @@ func (col *ColumnRecord) toDomain() (*domain.Column, error) {
 		BoardID:   col.BoardID,
 		OrderNum:  col.OrderNum,
 		Name:      col.Name,
+		IsDone:    col.IsDone,
+		WipLimit:  col.WipLimit,
 		CreatedAt: col.CreatedAt,
 		UpdatedAt: col.UpdatedAt,
 		DeletedAt: col.DeletedAt,
@@ -71,4 +89,349 @@ func (tsrs TaskSearchRecords) toDomain() ([]domain.Task, error) {
         dmn = append(dmn, *d)
     }
 	return dmn, nil
-}
\ No newline at end of file
+}
+
+func (l *TaskLinkRecord) toDomain() domain.TaskLink {
+	return domain.TaskLink{
+		ID:         l.ID,
+		FromTaskID: l.FromTaskID,
+		ToTaskID:   l.ToTaskID,
+		Type:       domain.LinkType(l.Type),
+		CreatedAt:  l.CreatedAt,
+		LinkedTask: &domain.Task{
+			ID:             l.LinkedID,
+			BoardID:        l.LinkedBoardID,
+			BoardShortName: &l.LinkedBoardShortName,
+			ColumnID:       l.LinkedColumnID,
+			Number:         l.LinkedNumber,
+			Title:          l.LinkedTitle,
+		},
+	}
+}
+
+func (b *TaskBlockerRecord) toDomain() domain.Task {
+	return domain.Task{
+		ID:             b.ID,
+		BoardID:        b.BoardID,
+		BoardShortName: &b.BoardShortName,
+		ColumnID:       b.ColumnID,
+		Number:         b.Number,
+		Title:          b.Title,
+	}
+}
+
+func (m *MilestoneRecord) toDomain() *domain.Milestone {
+	return &domain.Milestone{
+		ID:          m.ID,
+		Title:       m.Title,
+		Description: m.Description,
+		TargetDate:  m.TargetDate,
+		State:       domain.MilestoneState(m.State),
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+		DeletedAt:   m.DeletedAt,
+	}
+}
+
+func (c *ColumnTaskCountRecord) toDomain() domain.ColumnTaskCount {
+	return domain.ColumnTaskCount{
+		Column: domain.Column{
+			ID:       c.ColumnID,
+			BoardID:  c.BoardID,
+			OrderNum: c.OrderNum,
+			IsDone:   c.IsDone,
+		},
+		BoardName: c.BoardName,
+		Count:     c.TaskCount,
+	}
+}
+
+func (s *SprintRecord) toDomain() *domain.Sprint {
+	return &domain.Sprint{
+		ID:        s.ID,
+		BoardID:   s.BoardID,
+		Name:      s.Name,
+		Goal:      s.Goal,
+		StartDate: s.StartDate,
+		EndDate:   s.EndDate,
+		State:     domain.SprintState(s.State),
+		CreatedAt: s.CreatedAt,
+		UpdatedAt: s.UpdatedAt,
+		DeletedAt: s.DeletedAt,
+	}
+}
+
+func (t *BoardTemplateRecord) toDomain() (*domain.BoardTemplate, error) {
+	const op = "postgres.BoardTemplateRecord.ToDomain"
+
+	var columns []TemplateColumnJSON
+	if err := json.Unmarshal(t.Columns, &columns); err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	var tasks []TemplateTaskJSON
+	if len(t.Tasks) > 0 {
+		if err := json.Unmarshal(t.Tasks, &tasks); err != nil {
+			return nil, errors.Wrap(err, op)
+		}
+	}
+
+	dmn := &domain.BoardTemplate{
+		ID:          t.ID,
+		Name:        t.Name,
+		Description: t.Description,
+		Columns:     make([]domain.TemplateColumn, 0, len(columns)),
+		Tasks:       make([]domain.TemplateTask, 0, len(tasks)),
+		CreatedAt:   t.CreatedAt,
+		UpdatedAt:   t.UpdatedAt,
+		DeletedAt:   t.DeletedAt,
+	}
+	for _, c := range columns {
+		dmn.Columns = append(dmn.Columns, domain.TemplateColumn{Name: c.Name, IsDone: c.IsDone, WipLimit: c.WipLimit})
+	}
+	for _, tt := range tasks {
+		var priority *domain.Priority
+		if tt.Priority != nil {
+			p := domain.Priority(*tt.Priority)
+			priority = &p
+		}
+		dmn.Tasks = append(dmn.Tasks, domain.TemplateTask{
+			Column:      tt.Column,
+			Parent:      tt.Parent,
+			Title:       tt.Title,
+			Description: tt.Description,
+			Tags:        tt.Tags,
+			Checklists:  tt.Checklists,
+			Priority:    priority,
+			Lane:        tt.Lane,
+
+			OriginalEstimate:  tt.OriginalEstimate,
+			RemainingEstimate: tt.RemainingEstimate,
+		})
+	}
+	return dmn, nil
+}
+
+func boardTemplateToRecord(t *domain.BoardTemplate) (*BoardTemplateRecord, error) {
+	const op = "postgres.boardTemplateToRecord"
+
+	columns := make([]TemplateColumnJSON, 0, len(t.Columns))
+	for _, c := range t.Columns {
+		columns = append(columns, TemplateColumnJSON{Name: c.Name, IsDone: c.IsDone, WipLimit: c.WipLimit})
+	}
+	tasks := make([]TemplateTaskJSON, 0, len(t.Tasks))
+	for _, tt := range t.Tasks {
+		tasks = append(tasks, TemplateTaskJSON{
+			Column:      tt.Column,
+			Parent:      tt.Parent,
+			Title:       tt.Title,
+			Description: tt.Description,
+			Tags:        tt.Tags,
+			Checklists:  tt.Checklists,
+			Priority:    (*string)(tt.Priority),
+			Lane:        tt.Lane,
+
+			OriginalEstimate:  tt.OriginalEstimate,
+			RemainingEstimate: tt.RemainingEstimate,
+		})
+	}
+
+	columnsJSON, err := json.Marshal(columns)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	tasksJSON, err := json.Marshal(tasks)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	return &BoardTemplateRecord{
+		ID:          t.ID,
+		Name:        t.Name,
+		Description: t.Description,
+		Columns:     columnsJSON,
+		Tasks:       tasksJSON,
+		CreatedAt:   t.CreatedAt,
+		UpdatedAt:   t.UpdatedAt,
+		DeletedAt:   t.DeletedAt,
+	}, nil
+}
+
+func (h *TaskHistoryRecord) toDomain() (domain.TaskHistoryEntry, error) {
+	const op = "postgres.TaskHistoryRecord.ToDomain"
+
+	data := map[string]any{}
+	if len(h.Data) > 0 {
+		if err := json.Unmarshal(h.Data, &data); err != nil {
+			return domain.TaskHistoryEntry{}, errors.Wrap(err, op)
+		}
+	}
+
+	return domain.TaskHistoryEntry{
+		ID:        h.ID,
+		TaskID:    h.TaskID,
+		Kind:      domain.TaskHistoryKind(h.Kind),
+		Data:      data,
+		CreatedAt: h.CreatedAt,
+	}, nil
+}
+
+func (k *IdempotencyKeyRecord) toDomain() *domain.IdempotencyRecord {
+	rec := &domain.IdempotencyRecord{
+		Scope:       k.Scope,
+		Key:         k.Key,
+		RequestHash: k.RequestHash,
+		Body:        k.ResponseBody,
+		CreatedAt:   k.CreatedAt,
+		ExpiresAt:   k.ExpiresAt,
+	}
+	if k.StatusCode != nil {
+		rec.StatusCode = *k.StatusCode
+	}
+	if k.ContentType != nil {
+		rec.ContentType = *k.ContentType
+	}
+	return rec
+}
+
+func (w *WebhookRecord) toDomain() domain.Webhook {
+	events := make([]domain.WebhookEventType, 0, len(w.Events))
+	for _, e := range w.Events {
+		events = append(events, domain.WebhookEventType(e))
+	}
+
+	return domain.Webhook{
+		ID:        w.ID,
+		BoardID:   w.BoardID,
+		URL:       w.URL,
+		Events:    events,
+		Secret:    w.Secret,
+		CreatedAt: w.CreatedAt,
+		UpdatedAt: w.UpdatedAt,
+	}
+}
+
+func (d *WebhookDeliveryRecord) toDomain() domain.WebhookDelivery {
+	return domain.WebhookDelivery{
+		ID:             d.ID,
+		WebhookID:      d.WebhookID,
+		EventID:        d.EventID,
+		EventType:      domain.WebhookEventType(d.EventType),
+		Payload:        d.Payload,
+		Status:         domain.WebhookDeliveryStatus(d.Status),
+		Attempts:       d.Attempts,
+		NextAttemptAt:  d.NextAttemptAt,
+		LastStatusCode: d.LastStatusCode,
+		LastError:      d.LastError,
+		CreatedAt:      d.CreatedAt,
+		UpdatedAt:      d.UpdatedAt,
+		DeliveredAt:    d.DeliveredAt,
+	}
+}
+
+func (o *OutboxRecord) toDomain() (domain.Event, error) {
+	payload, err := domain.DecodeEventPayload(domain.EventType(o.EventType), o.Payload)
+	if err != nil {
+		return domain.Event{}, err
+	}
+
+	return domain.Event{
+		ID:          o.ID,
+		Type:        domain.EventType(o.EventType),
+		AggregateID: o.AggregateID,
+		BoardID:     o.BoardID,
+		OccurredAt:  o.OccurredAt,
+		Payload:     payload,
+	}, nil
+}
+
+func (n *NotificationRecord) toDomain() domain.Notification {
+	return domain.Notification{
+		ID:        n.ID,
+		UserID:    n.UserID,
+		Type:      domain.NotificationType(n.Type),
+		EventID:   n.EventID,
+		BoardID:   n.BoardID,
+		TaskID:    n.TaskID,
+		Text:      n.Text,
+		ReadAt:    n.ReadAt,
+		CreatedAt: n.CreatedAt,
+	}
+}
+
+func (p *EmailPreferenceRecord) toDomain() domain.EmailPreference {
+	return domain.EmailPreference{
+		UserID:       p.UserID,
+		Email:        p.Email,
+		Mode:         domain.EmailMode(p.Mode),
+		LastDigestAt: p.LastDigestAt,
+		UpdatedAt:    p.UpdatedAt,
+	}
+}
+
+func (w *WatcherRecord) toDomain() domain.Watcher {
+	return domain.Watcher{
+		UserID:    w.UserID,
+		TaskID:    w.TaskID,
+		BoardID:   w.BoardID,
+		CreatedAt: w.CreatedAt,
+	}
+}
+
+func (a *AttachmentRecord) toDomain() domain.Attachment {
+	return domain.Attachment{
+		ID:         a.ID,
+		TaskID:     a.TaskID,
+		Name:       a.Name,
+		Size:       a.Size,
+		MimeType:   a.MimeType,
+		Checksum:   a.Checksum,
+		StorageKey: a.StorageKey,
+		UploadedBy: a.UploadedBy,
+		CreatedAt:  a.CreatedAt,
+	}
+}
+
+func (w *WorklogRecord) toDomain() domain.Worklog {
+	return domain.Worklog{
+		ID:        w.ID,
+		TaskID:    w.TaskID,
+		UserID:    w.UserID,
+		Minutes:   w.Minutes,
+		Date:      w.Date,
+		Note:      w.Note,
+		CreatedAt: w.CreatedAt,
+		UpdatedAt: w.UpdatedAt,
+	}
+}
+
+func (r *TimeReportRecord) toDomain() domain.TimeReportEntry {
+	return domain.TimeReportEntry{
+		Worklog:   r.WorklogRecord.toDomain(),
+		BoardID:   r.BoardID,
+		TaskKey:   domain.TaskRef{ShortName: r.ShortName, Number: r.Number}.Key(),
+		TaskTitle: r.Title,
+	}
+}
+
+func (t *TaskTransitionRecord) toDomain() domain.TaskTransition {
+	return domain.TaskTransition{
+		ID:           t.ID,
+		TaskID:       t.TaskID,
+		BoardID:      t.BoardID,
+		FromColumnID: t.FromColumnID,
+		ToColumnID:   t.ToColumnID,
+		OccurredAt:   t.OccurredAt,
+	}
+}
+
+func (d *TaskDeadlineRecord) toDomain() domain.TaskDeadline {
+	return domain.TaskDeadline{
+		TaskID:     d.TaskID,
+		BoardID:    d.BoardID,
+		Title:      d.Title,
+		Assignee:   d.Assignee,
+		DueDate:    d.DueDate,
+		Source:     domain.DeadlineSource(d.Source),
+		SourceName: d.SourceName,
+	}
+}
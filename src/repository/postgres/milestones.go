@@ -0,0 +1,180 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+func (r Repository) CreateMilestone(ctx context.Context, milestone *domain.Milestone) error {
+	const op = "postgres.CreateMilestone"
+
+	ds := goqu.Insert("milestones").Rows(goqu.Record{
+		"id":          milestone.ID,
+		"title":       milestone.Title,
+		"description": milestone.Description,
+		"target_date": milestone.TargetDate,
+		"state":       string(milestone.State),
+		"created_at":  milestone.CreatedAt,
+		"updated_at":  milestone.UpdatedAt,
+		"deleted_at":  milestone.DeletedAt,
+	})
+
+	sql, params, err := ds.ToSQL()
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	_, err = r.pool.Exec(ctx, sql, params...)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	return nil
+}
+
+func (r Repository) GetMilestoneByID(ctx context.Context, milestoneID uuid.UUID) (*domain.Milestone, error) {
+	const op = "postgres.GetMilestoneByID"
+
+	ds := goqu.From("milestones").
+		Where(
+			goqu.C("id").Eq(milestoneID),
+			goqu.C("deleted_at").IsNull(),
+		)
+
+	sql, params, err := ds.ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	var rec MilestoneRecord
+	err = pgxscan.Get(ctx, r.pool, &rec, sql, params...)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	return rec.toDomain(), nil
+}
+
+// GetMilestones возвращает milestones, state - необязательный фильтр по состоянию.
+func (r Repository) GetMilestones(ctx context.Context, state *domain.MilestoneState) ([]domain.Milestone, error) {
+	const op = "postgres.GetMilestones"
+
+	ds := goqu.From("milestones").
+		Where(goqu.C("deleted_at").IsNull()).
+		Order(goqu.C("target_date").Asc().NullsLast(), goqu.C("created_at").Asc())
+	if state != nil {
+		ds = ds.Where(goqu.C("state").Eq(string(*state)))
+	}
+
+	sql, params, err := ds.ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	records := make([]MilestoneRecord, 0)
+	err = pgxscan.Select(ctx, r.pool, &records, sql, params...)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	milestones := make([]domain.Milestone, 0, len(records))
+	for _, rec := range records {
+		milestones = append(milestones, *rec.toDomain())
+	}
+
+	return milestones, nil
+}
+
+// UpdateMilestone сохраняет milestone. При мягком удалении задачи отвязываются от него
+// в той же транзакции.
+func (r Repository) UpdateMilestone(ctx context.Context, milestone *domain.Milestone) error {
+	const op = "postgres.UpdateMilestone"
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+	defer tx.Rollback(ctx)
+
+	ds := goqu.Update("milestones").Where(
+		goqu.C("id").Eq(milestone.ID),
+		goqu.C("deleted_at").IsNull(),
+	).Set(
+		MilestoneRecord{
+			Title:       milestone.Title,
+			Description: milestone.Description,
+			TargetDate:  milestone.TargetDate,
+			State:       string(milestone.State),
+			UpdatedAt:   milestone.UpdatedAt,
+			DeletedAt:   milestone.DeletedAt,
+		},
+	)
+
+	sql, params, err := ds.ToSQL()
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	_, err = tx.Exec(ctx, sql, params...)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	if milestone.DeletedAt != nil {
+		dsTasks := goqu.Update("tasks").
+			Where(goqu.C("milestone_id").Eq(milestone.ID)).
+			Set(goqu.Record{"milestone_id": nil})
+
+		sqlTasks, paramsTasks, err := dsTasks.ToSQL()
+		if err != nil {
+			return errors.Wrap(err, op)
+		}
+
+		if _, err := tx.Exec(ctx, sqlTasks, paramsTasks...); err != nil {
+			return errors.Wrap(err, op)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	return nil
+}
+
+// GetMilestoneColumnStats возвращает все колонки досок, где есть задачи milestone,
+// с количеством задач milestone в каждой колонке.
+func (r Repository) GetMilestoneColumnStats(ctx context.Context, milestoneID uuid.UUID) ([]domain.ColumnTaskCount, error) {
+	const op = "postgres.GetMilestoneColumnStats"
+
+	records := make([]ColumnTaskCountRecord, 0)
+	err := pgxscan.Select(ctx, r.pool, &records,
+		`WITH mb AS (
+			SELECT DISTINCT board_id FROM tasks
+			WHERE milestone_id = $1 AND deleted_at IS NULL
+		)
+		SELECT b.id AS board_id, b.name AS board_name,
+			c.id AS column_id, c.order_num, c.is_done,
+			COUNT(t.id) AS task_count
+		FROM mb
+		JOIN boards b ON b.id = mb.board_id AND b.deleted_at IS NULL
+		JOIN columns c ON c.board_id = b.id AND c.deleted_at IS NULL
+		LEFT JOIN tasks t ON t.column_id = c.id AND t.milestone_id = $1 AND t.deleted_at IS NULL
+		GROUP BY b.id, b.name, c.id, c.order_num, c.is_done
+		ORDER BY b.name, b.id, c.order_num`, milestoneID)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	stats := make([]domain.ColumnTaskCount, 0, len(records))
+	for _, rec := range records {
+		stats = append(stats, rec.toDomain())
+	}
+
+	return stats, nil
+}
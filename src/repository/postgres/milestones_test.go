@@ -0,0 +1,150 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateMilestone(t *testing.T) {
+	now := time.Now().UTC()
+	milestoneID := uuid.New()
+
+	tests := []struct {
+		name        string
+		milestone   *domain.Milestone
+		mockSetup   func(mock pgxmock.PgxPoolIface)
+		expectedErr error
+	}{
+		{
+			name: "успешное обновление milestone",
+			milestone: &domain.Milestone{
+				ID:        milestoneID,
+				Title:     "Q1",
+				State:     domain.MilestoneClosed,
+				UpdatedAt: now,
+			},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE "milestones" SET .+"state"='closed',"target_date"=NULL,"title"='Q1'.+ WHERE`).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "мягкое удаление отвязывает задачи",
+			milestone: &domain.Milestone{
+				ID:        milestoneID,
+				Title:     "Q1",
+				State:     domain.MilestoneOpen,
+				UpdatedAt: now,
+				DeletedAt: &now,
+			},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE "milestones" SET "deleted_at"=.+ WHERE`).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectExec(`UPDATE "tasks" SET "milestone_id"=NULL WHERE \("milestone_id" = '` + milestoneID.String() + `'\)`).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 4))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "ошибка отвязки задач",
+			milestone: &domain.Milestone{
+				ID:        milestoneID,
+				Title:     "Q1",
+				DeletedAt: &now,
+			},
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE "milestones"`).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectExec(`UPDATE "tasks"`).
+					WillReturnError(errors.New("tasks update error"))
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("tasks update error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.mockSetup(mock)
+
+			repo := &Repository{pool: mock}
+			err = repo.UpdateMilestone(context.Background(), tt.milestone)
+
+			if tt.expectedErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetMilestones(t *testing.T) {
+	now := time.Now().UTC()
+	columns := []string{"id", "title", "description", "target_date", "state", "created_at", "updated_at", "deleted_at"}
+
+	t.Run("фильтр по состоянию", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		state := domain.MilestoneOpen
+		mock.ExpectQuery(`SELECT \* FROM "milestones" WHERE .+"state" = 'open'.+ORDER BY "target_date" ASC NULLS LAST`).
+			WillReturnRows(pgxmock.NewRows(columns).
+				AddRow(uuid.New(), "Q1", nil, &now, "open", now, now, nil))
+
+		repo := &Repository{pool: mock}
+		milestones, err := repo.GetMilestones(context.Background(), &state)
+		require.NoError(t, err)
+		require.Len(t, milestones, 1)
+		assert.Equal(t, domain.MilestoneOpen, milestones[0].State)
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestGetMilestoneColumnStats(t *testing.T) {
+	milestoneID := uuid.New()
+	boardID := uuid.New()
+
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	mock.ExpectQuery(`WITH mb AS .+ LEFT JOIN tasks t`).
+		WithArgs(milestoneID).
+		WillReturnRows(pgxmock.NewRows([]string{
+			"board_id", "board_name", "column_id", "order_num", "is_done", "task_count",
+		}).
+			AddRow(boardID, "Team", uuid.New(), int64(0), false, int64(2)).
+			AddRow(boardID, "Team", uuid.New(), int64(1), false, int64(1)))
+
+	repo := &Repository{pool: mock}
+	stats, err := repo.GetMilestoneColumnStats(context.Background(), milestoneID)
+	require.NoError(t, err)
+	require.Len(t, stats, 2)
+	assert.Equal(t, boardID, stats[0].Column.BoardID)
+	assert.Equal(t, "Team", stats[0].BoardName)
+	assert.Equal(t, int64(2), stats[0].Count)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,215 @@
+package postgres
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+)
+
+const notificationColumns = `id, user_id, type, event_id, board_id, task_id, text, read_at, created_at, emailed_at`
+
+func (r Repository) GetEmailPreference(ctx context.Context, userID string) (*domain.EmailPreference, error) {
+	const op = "postgres.GetEmailPreference"
+
+	var rec EmailPreferenceRecord
+	err := pgxscan.Get(ctx, r.pool, &rec,
+		`SELECT user_id, email, mode, last_digest_at, updated_at
+		FROM notification_preferences WHERE user_id = $1`, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	pref := rec.toDomain()
+	return &pref, nil
+}
+
+// SaveEmailPreference создает или заменяет настройки пользователя. Время
+// последнего дайджеста сохраняется, чтобы смена адреса не вызвала лишнюю рассылку.
+func (r Repository) SaveEmailPreference(ctx context.Context, pref *domain.EmailPreference) error {
+	const op = "postgres.SaveEmailPreference"
+
+	err := r.pool.QueryRow(ctx,
+		`INSERT INTO notification_preferences (user_id, email, mode, updated_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (user_id) DO UPDATE
+		SET email = EXCLUDED.email, mode = EXCLUDED.mode, updated_at = EXCLUDED.updated_at
+		RETURNING last_digest_at`,
+		pref.UserID, pref.Email, string(pref.Mode), pref.UpdatedAt).Scan(&pref.LastDigestAt)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	return nil
+}
+
+// ClaimInstantNotificationEmails забирает уведомления пользователей в режиме instant,
+// созданные после включения режима, и сразу отмечает их отправленными.
+// Письмо, которое не ушло, возвращается через ReleaseNotificationEmails.
+func (r Repository) ClaimInstantNotificationEmails(ctx context.Context, now time.Time, limit int) ([]domain.NotificationEmail, error) {
+	const op = "postgres.ClaimInstantNotificationEmails"
+
+	var records []struct {
+		NotificationRecord
+		Email string `db:"email"`
+	}
+	err := pgxscan.Select(ctx, r.pool, &records,
+		`WITH claimed AS (
+			UPDATE notifications SET emailed_at = $1
+			WHERE id IN (
+				SELECT n.id FROM notifications n
+				JOIN notification_preferences p ON p.user_id = n.user_id
+				WHERE p.mode = 'instant' AND n.emailed_at IS NULL AND n.created_at >= p.updated_at
+				ORDER BY n.created_at
+				LIMIT $2
+				FOR UPDATE OF n SKIP LOCKED
+			)
+			RETURNING `+notificationColumns+`
+		)
+		SELECT c.*, p.email FROM claimed c
+		JOIN notification_preferences p ON p.user_id = c.user_id
+		ORDER BY c.created_at`,
+		now, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	emails := make([]domain.NotificationEmail, 0, len(records))
+	for _, rec := range records {
+		emails = append(emails, domain.NotificationEmail{
+			UserID:        rec.UserID,
+			To:            rec.Email,
+			Notifications: []domain.Notification{rec.NotificationRecord.toDomain()},
+		})
+	}
+
+	return emails, nil
+}
+
+// ReleaseNotificationEmails возвращает уведомления в очередь писем.
+func (r Repository) ReleaseNotificationEmails(ctx context.Context, ids []uuid.UUID) error {
+	const op = "postgres.ReleaseNotificationEmails"
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	_, err := r.pool.Exec(ctx, `UPDATE notifications SET emailed_at = NULL WHERE id = ANY($1)`, ids)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	return nil
+}
+
+// GetDueDigests возвращает пользователей в режиме digest, которым дайджест
+// не отправлялся после scheduled.
+func (r Repository) GetDueDigests(ctx context.Context, scheduled time.Time, limit int) ([]domain.EmailPreference, error) {
+	const op = "postgres.GetDueDigests"
+
+	var records []EmailPreferenceRecord
+	err := pgxscan.Select(ctx, r.pool, &records,
+		`SELECT user_id, email, mode, last_digest_at, updated_at
+		FROM notification_preferences
+		WHERE mode = 'digest' AND (last_digest_at IS NULL OR last_digest_at < $1)
+		ORDER BY user_id
+		LIMIT $2`,
+		scheduled, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	prefs := make([]domain.EmailPreference, 0, len(records))
+	for _, rec := range records {
+		prefs = append(prefs, rec.toDomain())
+	}
+
+	return prefs, nil
+}
+
+// ClaimDigest отмечает дайджест пользователя отправленным и забирает
+// непрочитанные уведомления, еще не ушедшие на почту. Если дайджест уже забрал
+// другой экземпляр сервиса, claimed = false.
+func (r Repository) ClaimDigest(ctx context.Context, pref *domain.EmailPreference, scheduled, now time.Time) (notifications []domain.Notification, claimed bool, err error) {
+	const op = "postgres.ClaimDigest"
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, false, errors.Wrap(err, op)
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx,
+		`UPDATE notification_preferences SET last_digest_at = $2
+		WHERE user_id = $1 AND mode = 'digest' AND (last_digest_at IS NULL OR last_digest_at < $3)`,
+		pref.UserID, now, scheduled)
+	if err != nil {
+		return nil, false, errors.Wrap(err, op)
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, false, nil
+	}
+
+	var records []NotificationRecord
+	err = pgxscan.Select(ctx, tx, &records,
+		`UPDATE notifications SET emailed_at = $2
+		WHERE user_id = $1 AND emailed_at IS NULL AND read_at IS NULL
+		RETURNING `+notificationColumns,
+		pref.UserID, now)
+	if err != nil {
+		return nil, false, errors.Wrap(err, op)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, false, errors.Wrap(err, op)
+	}
+
+	notifications = make([]domain.Notification, 0, len(records))
+	for _, rec := range records {
+		notifications = append(notifications, rec.toDomain())
+	}
+	sortNotifications(notifications)
+
+	return notifications, true, nil
+}
+
+// ReleaseDigest возвращает неотправленный дайджест: уведомления снова ждут
+// письма, а время последней рассылки откатывается к прежнему.
+func (r Repository) ReleaseDigest(ctx context.Context, pref *domain.EmailPreference, ids []uuid.UUID) error {
+	const op = "postgres.ReleaseDigest"
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx,
+		`UPDATE notification_preferences SET last_digest_at = $2 WHERE user_id = $1`,
+		pref.UserID, pref.LastDigestAt); err != nil {
+		return errors.Wrap(err, op)
+	}
+	if len(ids) > 0 {
+		if _, err := tx.Exec(ctx,
+			`UPDATE notifications SET emailed_at = NULL WHERE id = ANY($1)`, ids); err != nil {
+			return errors.Wrap(err, op)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	return nil
+}
+
+func sortNotifications(notifications []domain.Notification) {
+	sort.SliceStable(notifications, func(i, j int) bool {
+		return notifications[i].CreatedAt.Before(notifications[j].CreatedAt)
+	})
+}
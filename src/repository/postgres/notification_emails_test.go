@@ -0,0 +1,67 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClaimDigest(t *testing.T) {
+	now := time.Now().UTC()
+	scheduled := now.Add(-time.Hour)
+	pref := &domain.EmailPreference{UserID: "bob", Email: "bob@example.com", Mode: domain.EmailDigest}
+
+	t.Run("уведомления забираются вместе с отметкой о рассылке", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		first, second := uuid.New(), uuid.New()
+		mock.ExpectBegin()
+		mock.ExpectExec(`UPDATE notification_preferences SET last_digest_at = \$2`).
+			WithArgs("bob", now, scheduled).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+		mock.ExpectQuery(`UPDATE notifications SET emailed_at = \$2 .+ read_at IS NULL`).
+			WithArgs("bob", now).
+			WillReturnRows(pgxmock.NewRows([]string{
+				"id", "user_id", "type", "event_id", "board_id", "task_id", "text", "read_at", "created_at", "emailed_at",
+			}).
+				AddRow(second, "bob", "task_moved", uuid.New(), uuid.New(), uuid.New(), "second", nil, now.Add(-time.Minute), &now).
+				AddRow(first, "bob", "task_assigned", uuid.New(), uuid.New(), uuid.New(), "first", nil, now.Add(-time.Hour), &now))
+		mock.ExpectCommit()
+
+		repo := &Repository{pool: mock}
+		notifications, claimed, err := repo.ClaimDigest(context.Background(), pref, scheduled, now)
+		require.NoError(t, err)
+		assert.True(t, claimed)
+		require.Len(t, notifications, 2)
+		assert.Equal(t, first, notifications[0].ID)
+		assert.Equal(t, second, notifications[1].ID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("дайджест уже забран другим экземпляром", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`UPDATE notification_preferences SET last_digest_at = \$2`).
+			WithArgs("bob", now, scheduled).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+		mock.ExpectRollback()
+
+		repo := &Repository{pool: mock}
+		notifications, claimed, err := repo.ClaimDigest(context.Background(), pref, scheduled, now)
+		require.NoError(t, err)
+		assert.False(t, claimed)
+		assert.Empty(t, notifications)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,130 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+)
+
+// CreateNotifications сохраняет уведомления, уже созданные для того же события
+// и пользователя пропускаются.
+func (r Repository) CreateNotifications(ctx context.Context, notifications []domain.Notification) error {
+	const op = "postgres.CreateNotifications"
+
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	rows := make([]interface{}, 0, len(notifications))
+	for _, n := range notifications {
+		rows = append(rows, NotificationRecord{
+			ID:        n.ID,
+			UserID:    n.UserID,
+			Type:      string(n.Type),
+			EventID:   n.EventID,
+			BoardID:   n.BoardID,
+			TaskID:    n.TaskID,
+			Text:      n.Text,
+			ReadAt:    n.ReadAt,
+			CreatedAt: n.CreatedAt,
+		})
+	}
+
+	sql, params, err := goqu.Insert("notifications").Rows(rows...).
+		OnConflict(goqu.DoNothing()).ToSQL()
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	if _, err := r.pool.Exec(ctx, sql, params...); err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	return nil
+}
+
+// GetNotifications возвращает уведомления пользователя, новые первыми.
+func (r Repository) GetNotifications(ctx context.Context, userID string, unreadOnly bool, limit, offset uint) ([]domain.Notification, error) {
+	const op = "postgres.GetNotifications"
+
+	ds := goqu.From("notifications").
+		Where(goqu.C("user_id").Eq(userID)).
+		Order(goqu.C("created_at").Desc(), goqu.C("id").Desc()).
+		Limit(limit).
+		Offset(offset)
+	if unreadOnly {
+		ds = ds.Where(goqu.C("read_at").IsNull())
+	}
+
+	sql, params, err := ds.ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	var records []NotificationRecord
+	if err := pgxscan.Select(ctx, r.pool, &records, sql, params...); err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	notifications := make([]domain.Notification, 0, len(records))
+	for _, rec := range records {
+		notifications = append(notifications, rec.toDomain())
+	}
+
+	return notifications, nil
+}
+
+func (r Repository) CountUnreadNotifications(ctx context.Context, userID string) (int64, error) {
+	const op = "postgres.CountUnreadNotifications"
+
+	var count int64
+	err := r.pool.QueryRow(ctx,
+		`SELECT COUNT(*) FROM notifications WHERE user_id = $1 AND read_at IS NULL`,
+		userID).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, op)
+	}
+
+	return count, nil
+}
+
+// MarkNotificationRead отмечает уведомление прочитанным. Чужое или несуществующее
+// уведомление - pgx.ErrNoRows, повторная отметка не меняет время прочтения.
+func (r Repository) MarkNotificationRead(ctx context.Context, userID string, notificationID uuid.UUID, now time.Time) error {
+	const op = "postgres.MarkNotificationRead"
+
+	tag, err := r.pool.Exec(ctx,
+		`UPDATE notifications SET read_at = COALESCE(read_at, $3)
+		WHERE id = $1 AND user_id = $2`,
+		notificationID, userID, now)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Wrap(pgx.ErrNoRows, op)
+	}
+
+	return nil
+}
+
+// MarkAllNotificationsRead отмечает прочитанными все уведомления пользователя
+// и возвращает, сколько их было непрочитано.
+func (r Repository) MarkAllNotificationsRead(ctx context.Context, userID string, now time.Time) (int64, error) {
+	const op = "postgres.MarkAllNotificationsRead"
+
+	tag, err := r.pool.Exec(ctx,
+		`UPDATE notifications SET read_at = $2 WHERE user_id = $1 AND read_at IS NULL`,
+		userID, now)
+	if err != nil {
+		return 0, errors.Wrap(err, op)
+	}
+
+	return tag.RowsAffected(), nil
+}
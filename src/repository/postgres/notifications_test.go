@@ -0,0 +1,54 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateNotifications(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	n := domain.Notification{
+		ID: uuid.New(), UserID: "alice", Type: domain.NotificationTaskAssigned,
+		EventID: uuid.New(), BoardID: uuid.New(), TaskID: uuid.New(), Text: "assigned", CreatedAt: time.Now().UTC(),
+	}
+
+	mock.ExpectExec(`INSERT INTO "notifications" .+'task_assigned', 'alice'\) ON CONFLICT DO NOTHING`).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+	repo := &Repository{pool: mock}
+	require.NoError(t, repo.CreateNotifications(context.Background(), []domain.Notification{n}))
+	require.NoError(t, repo.CreateNotifications(context.Background(), nil))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMarkNotificationRead(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	now := time.Now().UTC()
+	id := uuid.New()
+
+	mock.ExpectExec(`UPDATE notifications SET read_at = COALESCE\(read_at, \$3\)`).
+		WithArgs(id, "alice", now).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	mock.ExpectExec(`UPDATE notifications SET read_at = COALESCE\(read_at, \$3\)`).
+		WithArgs(id, "bob", now).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+	repo := &Repository{pool: mock}
+	require.NoError(t, repo.MarkNotificationRead(context.Background(), "alice", id, now))
+	assert.ErrorIs(t, repo.MarkNotificationRead(context.Background(), "bob", id, now), pgx.ErrNoRows)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,218 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pkg/errors"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+)
+
+const outboxColumns = `id, event_type, aggregate_id, board_id, payload, occurred_at`
+
+// execer - общий интерфейс пула и транзакции для записи изменений вместе с outbox.
+type execer interface {
+	Exec(ctx context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error)
+}
+
+// withOutbox выполняет write и сохраняет события одной транзакцией.
+// Если событий нет, транзакция не нужна и write выполняется прямо на пуле.
+func (r Repository) withOutbox(ctx context.Context, events []domain.Event, write func(db execer) error) error {
+	if len(events) == 0 {
+		return write(r.pool)
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := write(tx); err != nil {
+		return err
+	}
+	if err := insertOutbox(ctx, tx, events); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// insertOutbox сохраняет события одним запросом, а перемещения задач - еще и
+// в историю переходов. Вызывается внутри транзакции, в которой меняется сама сущность.
+func insertOutbox(ctx context.Context, db execer, events []domain.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	rows := make([]interface{}, 0, len(events))
+	for _, e := range events {
+		payload, err := json.Marshal(e.Payload)
+		if err != nil {
+			return err
+		}
+		rows = append(rows, OutboxRecord{
+			ID:          e.ID,
+			EventType:   string(e.Type),
+			AggregateID: e.AggregateID,
+			BoardID:     e.BoardID,
+			Payload:     payload,
+			OccurredAt:  e.OccurredAt,
+		})
+	}
+
+	sql, params, err := goqu.Insert("outbox").Rows(rows...).ToSQL()
+	if err != nil {
+		return err
+	}
+
+	if _, err := db.Exec(ctx, sql, params...); err != nil {
+		return err
+	}
+
+	return insertTransitions(ctx, db, domain.TransitionsFromEvents(events))
+}
+
+// boardEvents собирает события колонок и задач новой доски.
+func boardEvents(columns []domain.Column, tasks []domain.Task) []domain.Event {
+	var events []domain.Event
+	for i := range columns {
+		events = append(events, columns[i].Events()...)
+	}
+	for i := range tasks {
+		events = append(events, tasks[i].Events()...)
+	}
+	return events
+}
+
+// ClaimOutboxEvents забирает неопубликованные события в порядке возникновения
+// и блокирует их на lease, чтобы параллельный relay не опубликовал их повторно.
+// Припаркованные события не забираются. Событие не забирается и тогда, когда
+// более раннее событие того же агрегата еще в работе или ждет повтора,
+// так порядок внутри агрегата сохраняется.
+func (r Repository) ClaimOutboxEvents(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]domain.Event, error) {
+	const op = "postgres.ClaimOutboxEvents"
+
+	var records []OutboxRecord
+	err := pgxscan.Select(ctx, r.pool, &records,
+		`UPDATE outbox SET locked_until = $2
+		WHERE id IN (
+			SELECT o.id FROM outbox o
+			WHERE o.published_at IS NULL AND o.parked_at IS NULL
+			AND (o.locked_until IS NULL OR o.locked_until <= $1)
+			AND NOT EXISTS (
+				SELECT 1 FROM outbox p
+				WHERE p.aggregate_id = o.aggregate_id AND p.occurred_at < o.occurred_at
+				AND p.published_at IS NULL AND p.parked_at IS NULL AND p.locked_until > $1
+			)
+			ORDER BY o.occurred_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING `+outboxColumns,
+		now, now.Add(lease), limit)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	events := make([]domain.Event, 0, len(records))
+	for _, rec := range records {
+		event, err := rec.toDomain()
+		if err != nil {
+			return nil, errors.Wrap(err, op)
+		}
+		events = append(events, event)
+	}
+
+	// UPDATE ... RETURNING не сохраняет порядок подзапроса.
+	sortEvents(events)
+
+	return events, nil
+}
+
+func (r Repository) MarkOutboxEventsPublished(ctx context.Context, ids []uuid.UUID, now time.Time) error {
+	const op = "postgres.MarkOutboxEventsPublished"
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	_, err := r.pool.Exec(ctx,
+		`UPDATE outbox SET published_at = $2, locked_until = NULL WHERE id = ANY($1)`,
+		ids, now)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	return nil
+}
+
+// MarkOutboxEventFailed запоминает ошибку и по политике либо откладывает
+// следующую попытку, либо паркует событие. Возвращает true, если событие припарковано.
+func (r Repository) MarkOutboxEventFailed(
+	ctx context.Context, id uuid.UUID, reason string, now time.Time, policy domain.OutboxRetryPolicy,
+) (bool, error) {
+	const op = "postgres.MarkOutboxEventFailed"
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, op)
+	}
+	defer tx.Rollback(ctx)
+
+	var attempts int
+	err = tx.QueryRow(ctx,
+		`UPDATE outbox SET attempts = attempts + 1, last_error = $2 WHERE id = $1 RETURNING attempts`,
+		id, reason).Scan(&attempts)
+	if err != nil {
+		return false, errors.Wrap(err, op)
+	}
+
+	retryAt, retry := policy.Retry(attempts, now)
+	if retry {
+		_, err = tx.Exec(ctx, `UPDATE outbox SET locked_until = $2 WHERE id = $1`, id, retryAt)
+	} else {
+		_, err = tx.Exec(ctx, `UPDATE outbox SET locked_until = NULL, parked_at = $2 WHERE id = $1`, id, now)
+	}
+	if err != nil {
+		return false, errors.Wrap(err, op)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, errors.Wrap(err, op)
+	}
+
+	return !retry, nil
+}
+
+// ReleaseOutboxEvents снимает блокировку с забранных, но не опубликованных событий,
+// чтобы следующий проход начал с них и порядок публикации сохранился.
+func (r Repository) ReleaseOutboxEvents(ctx context.Context, ids []uuid.UUID) error {
+	const op = "postgres.ReleaseOutboxEvents"
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	_, err := r.pool.Exec(ctx,
+		`UPDATE outbox SET locked_until = NULL WHERE id = ANY($1) AND published_at IS NULL`,
+		ids)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	return nil
+}
+
+func sortEvents(events []domain.Event) {
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].OccurredAt.Before(events[j].OccurredAt)
+	})
+}
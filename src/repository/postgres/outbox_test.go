@@ -0,0 +1,154 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateTaskWritesOutbox(t *testing.T) {
+	t.Run("задача и событие в одной транзакции", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		task, err := domain.NewTask(uuid.New(), uuid.New(), 7, "Task", nil, nil, nil)
+		require.NoError(t, err)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`INSERT INTO tasks`).
+			WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
+				pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
+				pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		mock.ExpectExec(`INSERT INTO "outbox" .+'task.created'`).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		mock.ExpectExec(`INSERT INTO "task_transitions" .+NULL, .+'` + task.ColumnID.String() + `'\)`).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		mock.ExpectCommit()
+
+		repo := &Repository{pool: mock}
+		require.NoError(t, repo.CreateTask(context.Background(), task))
+		assert.Empty(t, task.Events())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ошибка outbox откатывает задачу", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		task, err := domain.NewTask(uuid.New(), uuid.New(), 7, "Task", nil, nil, nil)
+		require.NoError(t, err)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`INSERT INTO tasks`).
+			WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
+				pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
+				pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		mock.ExpectExec(`INSERT INTO "outbox"`).
+			WillReturnError(errors.New("outbox error"))
+		mock.ExpectRollback()
+
+		repo := &Repository{pool: mock}
+		err = repo.CreateTask(context.Background(), task)
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "outbox error")
+		assert.Len(t, task.Events(), 1)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestClaimOutboxEvents(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	now := time.Now().UTC()
+	boardID := uuid.New()
+	taskID := uuid.New()
+	from, to := uuid.New(), uuid.New()
+	first, second := uuid.New(), uuid.New()
+
+	mock.ExpectQuery(`UPDATE outbox SET locked_until = \$2 .+parked_at IS NULL.+NOT EXISTS .+p.aggregate_id = o.aggregate_id.+ FOR UPDATE SKIP LOCKED`).
+		WithArgs(now, now.Add(time.Minute), 10).
+		WillReturnRows(pgxmock.NewRows([]string{
+			"id", "event_type", "aggregate_id", "board_id", "payload", "occurred_at",
+		}).
+			AddRow(second, "task.moved", taskID, boardID,
+				[]byte(`{"task_id":"`+taskID.String()+`","from_column_id":"`+from.String()+`","to_column_id":"`+to.String()+`"}`),
+				now.Add(-time.Second)).
+			AddRow(first, "task.created", taskID, boardID,
+				[]byte(`{"task_id":"`+taskID.String()+`","number":3,"title":"Task"}`),
+				now.Add(-time.Minute)))
+
+	repo := &Repository{pool: mock}
+	events, err := repo.ClaimOutboxEvents(context.Background(), now, time.Minute, 10)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+
+	assert.Equal(t, first, events[0].ID)
+	assert.Equal(t, domain.TaskCreated{TaskID: taskID, Number: 3, Title: "Task"}, events[0].Payload)
+	assert.Equal(t, second, events[1].ID)
+	assert.Equal(t, domain.TaskMoved{TaskID: taskID, FromColumnID: from, ToColumnID: to}, events[1].Payload)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMarkOutboxEventFailed(t *testing.T) {
+	now := time.Now().UTC()
+	id := uuid.New()
+	policy := domain.OutboxRetryPolicy{MaxAttempts: 3, BaseDelay: time.Second, MaxDelay: time.Minute}
+
+	t.Run("попытки остались: повтор откладывается", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`UPDATE outbox SET attempts = attempts \+ 1, last_error = \$2 WHERE id = \$1 RETURNING attempts`).
+			WithArgs(id, "broker down").
+			WillReturnRows(pgxmock.NewRows([]string{"attempts"}).AddRow(2))
+		mock.ExpectExec(`UPDATE outbox SET locked_until = \$2 WHERE id = \$1`).
+			WithArgs(id, now.Add(2*time.Second)).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+		mock.ExpectCommit()
+
+		repo := &Repository{pool: mock}
+		parked, err := repo.MarkOutboxEventFailed(context.Background(), id, "broker down", now, policy)
+		require.NoError(t, err)
+		assert.False(t, parked)
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("попытки исчерпаны: событие паркуется", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`UPDATE outbox SET attempts = attempts \+ 1`).
+			WithArgs(id, "bad payload").
+			WillReturnRows(pgxmock.NewRows([]string{"attempts"}).AddRow(3))
+		mock.ExpectExec(`UPDATE outbox SET locked_until = NULL, parked_at = \$2 WHERE id = \$1`).
+			WithArgs(id, now).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+		mock.ExpectCommit()
+
+		repo := &Repository{pool: mock}
+		parked, err := repo.MarkOutboxEventFailed(context.Background(), id, "bad payload", now, policy)
+		require.NoError(t, err)
+		assert.True(t, parked)
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,104 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/pkg/errors"
+)
+
+// Условия, при которых строку можно удалить навсегда: она в корзине дольше срока хранения
+// и не держит через ON DELETE CASCADE живые или недавно удаленные строки.
+const (
+	purgeableTasks = `FROM tasks t
+		WHERE t.deleted_at < $1`
+
+	purgeableColumns = `FROM columns c
+		WHERE c.deleted_at < $1
+		AND NOT EXISTS (
+			SELECT 1 FROM tasks t
+			WHERE t.column_id = c.id AND (t.deleted_at IS NULL OR t.deleted_at >= $1)
+		)`
+
+	purgeableBoards = `FROM boards b
+		WHERE b.deleted_at < $1
+		AND NOT EXISTS (
+			SELECT 1 FROM columns c
+			WHERE c.board_id = b.id AND (c.deleted_at IS NULL OR c.deleted_at >= $1)
+		)
+		AND NOT EXISTS (
+			SELECT 1 FROM tasks t
+			WHERE t.board_id = b.id AND (t.deleted_at IS NULL OR t.deleted_at >= $1)
+		)`
+)
+
+// PurgeDeleted удаляет навсегда не больше limit строк из каждой таблицы.
+// Сначала задачи, потом колонки, потом доски, чтобы каскад не забирал чужие строки.
+// Вместе с задачами каскадом уходят записи вложений: возвращаются ключи их содержимого
+// в хранилище - и при ошибке, для уже закоммиченных удалений.
+func (r Repository) PurgeDeleted(ctx context.Context, before time.Time, limit int) (domain.PurgeStats, []string, error) {
+	const op = "postgres.PurgeDeleted"
+
+	var (
+		stats       domain.PurgeStats
+		storageKeys []string
+	)
+	for _, q := range []struct {
+		table      string
+		alias      string
+		purgeable  string
+		taskColumn string
+		deletedCnt *int64
+	}{
+		{"tasks", "t", purgeableTasks, "id", &stats.Tasks},
+		{"columns", "c", purgeableColumns, "column_id", &stats.Columns},
+		{"boards", "b", purgeableBoards, "board_id", &stats.Boards},
+	} {
+		// все части WITH видят данные до удаления, поэтому вложения каскадно
+		// удаляемых задач еще доступны для выборки
+		var keys []string
+		err := r.pool.QueryRow(ctx,
+			`WITH purged AS (
+				DELETE FROM `+q.table+` WHERE id IN (
+					SELECT `+q.alias+`.id `+q.purgeable+`
+					ORDER BY `+q.alias+`.deleted_at
+					LIMIT $2
+				)
+				RETURNING id
+			)
+			SELECT
+				(SELECT count(*) FROM purged),
+				ARRAY(
+					SELECT a.storage_key FROM attachments a
+					JOIN tasks pt ON pt.id = a.task_id
+					WHERE pt.`+q.taskColumn+` IN (SELECT id FROM purged)
+				)`, before, limit).
+			Scan(q.deletedCnt, &keys)
+		if err != nil {
+			return stats, storageKeys, errors.Wrap(err, op)
+		}
+		storageKeys = append(storageKeys, keys...)
+	}
+
+	return stats, storageKeys, nil
+}
+
+// CountPurgeable считает, что удалит полный прогон PurgeDeleted по всем пачкам.
+// Условия те же, поэтому dry-run отчет совпадает с реальным удалением.
+func (r Repository) CountPurgeable(ctx context.Context, before time.Time) (domain.PurgeStats, error) {
+	const op = "postgres.CountPurgeable"
+
+	var stats domain.PurgeStats
+	err := r.pool.QueryRow(ctx,
+		`SELECT
+			(SELECT count(*) `+purgeableTasks+`),
+			(SELECT count(*) `+purgeableColumns+`),
+			(SELECT count(*) `+purgeableBoards+`)`, before).
+		Scan(&stats.Tasks, &stats.Columns, &stats.Boards)
+	if err != nil {
+		return domain.PurgeStats{}, errors.Wrap(err, op)
+	}
+
+	return stats, nil
+}
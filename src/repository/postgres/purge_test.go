@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPurgeDeleted(t *testing.T) {
+	before := time.Now().UTC().Add(-720 * time.Hour)
+	purgedRows := func(count int64, keys ...string) *pgxmock.Rows {
+		return pgxmock.NewRows([]string{"count", "storage_keys"}).AddRow(count, keys)
+	}
+
+	t.Run("задачи, колонки и доски удаляются по очереди пачками", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery(`DELETE FROM tasks WHERE id IN .+ LIMIT \$2\s+\)\s+RETURNING id.+JOIN tasks pt ON pt.id = a.task_id\s+WHERE pt.id IN \(SELECT id FROM purged\)`).
+			WithArgs(before, 100).
+			WillReturnRows(purgedRows(100, "tasks/1/a", "tasks/2/b"))
+		mock.ExpectQuery(`DELETE FROM columns WHERE id IN .+NOT EXISTS .+ LIMIT \$2.+WHERE pt.column_id IN \(SELECT id FROM purged\)`).
+			WithArgs(before, 100).
+			WillReturnRows(purgedRows(2))
+		mock.ExpectQuery(`DELETE FROM boards WHERE id IN .+NOT EXISTS .+ LIMIT \$2.+WHERE pt.board_id IN \(SELECT id FROM purged\)`).
+			WithArgs(before, 100).
+			WillReturnRows(purgedRows(1, "tasks/3/c"))
+
+		repo := &Repository{pool: mock}
+		stats, keys, err := repo.PurgeDeleted(context.Background(), before, 100)
+		require.NoError(t, err)
+		assert.Equal(t, domain.PurgeStats{Boards: 1, Columns: 2, Tasks: 100}, stats)
+		assert.Equal(t, []string{"tasks/1/a", "tasks/2/b", "tasks/3/c"}, keys)
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ошибка прерывает пачку, ключи удаленного раньше возвращаются", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery(`DELETE FROM tasks`).
+			WithArgs(before, 100).
+			WillReturnRows(purgedRows(5, "tasks/1/a"))
+		mock.ExpectQuery(`DELETE FROM columns`).
+			WithArgs(before, 100).
+			WillReturnError(errors.New("columns delete error"))
+
+		repo := &Repository{pool: mock}
+		stats, keys, err := repo.PurgeDeleted(context.Background(), before, 100)
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "columns delete error")
+		assert.Equal(t, int64(5), stats.Tasks)
+		assert.Equal(t, []string{"tasks/1/a"}, keys)
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCountPurgeable(t *testing.T) {
+	before := time.Now().UTC().Add(-720 * time.Hour)
+
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	mock.ExpectQuery(`SELECT .+count\(\*\) FROM tasks t.+count\(\*\) FROM columns c.+count\(\*\) FROM boards b`).
+		WithArgs(before).
+		WillReturnRows(pgxmock.NewRows([]string{"tasks", "columns", "boards"}).
+			AddRow(int64(1200), int64(10), int64(3)))
+
+	repo := &Repository{pool: mock}
+	stats, err := repo.CountPurgeable(context.Background(), before)
+	require.NoError(t, err)
+	assert.Equal(t, domain.PurgeStats{Boards: 3, Columns: 10, Tasks: 1200}, stats)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -3,7 +3,9 @@ package postgres
 import (
 	"time"
 
+	"github.com/KungurtsevNII/team-board-back/src/domain"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 type ColumnRecord struct {
@@ -11,6 +13,8 @@ type ColumnRecord struct {
 	BoardID   uuid.UUID  `db:"board_id"`
 	Name      string     `db:"name"`
 	OrderNum  int64      `db:"order_num"`
+	IsDone    bool       `db:"is_done"`
+	WipLimit  *int64     `db:"wip_limit"`
 	CreatedAt time.Time  `db:"created_at" goqu:"skipupdate"`
 	DeletedAt *time.Time `db:"deleted_at"`
 	UpdatedAt time.Time  `db:"updated_at"`
@@ -25,6 +29,16 @@ type TaskRecord struct {
 	Description *string    `db:"description"`
 	Tags        []string   `db:"tags"`
 	Checklists  []byte     `db:"checklists"`
+	ParentID    *uuid.UUID `db:"parent_id"`
+	MilestoneID *uuid.UUID `db:"milestone_id"`
+	SprintID    *uuid.UUID `db:"sprint_id"`
+	Assignee    *string    `db:"assignee"`
+	Priority    *string    `db:"priority"`
+	Lane        *string    `db:"lane"`
+	Reporter    *string    `db:"reporter"`
+	OriginalEstimate  *int `db:"original_estimate"`
+	RemainingEstimate *int `db:"remaining_estimate"`
+	StoryPoints       *int `db:"story_points"`
 	CreatedAt   time.Time  `db:"created_at"`
 	UpdatedAt   time.Time  `db:"updated_at"`
 	DeletedAt   *time.Time `db:"deleted_at"`
@@ -53,4 +67,258 @@ type BoardRecord struct {
 	CreatedAt time.Time  `db:"created_at" goqu:"skipupdate"`
 	UpdatedAt time.Time  `db:"updated_at"`
 	DeletedAt *time.Time `db:"deleted_at"`
-}
\ No newline at end of file
+	// StoryPointScale меняется только через SetStoryPointScale
+	StoryPointScale pq.Int64Array `db:"story_point_scale" goqu:"skipupdate"`
+}
+
+type TaskLinkRecord struct {
+	ID                   uuid.UUID `db:"id"`
+	FromTaskID           uuid.UUID `db:"from_task_id"`
+	ToTaskID             uuid.UUID `db:"to_task_id"`
+	Type                 string    `db:"type"`
+	CreatedAt            time.Time `db:"created_at"`
+	LinkedID             uuid.UUID `db:"linked_id"`
+	LinkedBoardID        uuid.UUID `db:"linked_board_id"`
+	LinkedBoardShortName string    `db:"linked_board_short_name"`
+	LinkedColumnID       uuid.UUID `db:"linked_column_id"`
+	LinkedNumber         int64     `db:"linked_number"`
+	LinkedTitle          string    `db:"linked_title"`
+}
+
+type TaskBlockerRecord struct {
+	ID             uuid.UUID `db:"id"`
+	BoardID        uuid.UUID `db:"board_id"`
+	BoardShortName string    `db:"board_short_name"`
+	ColumnID       uuid.UUID `db:"column_id"`
+	Number         int64     `db:"number"`
+	Title          string    `db:"title"`
+}
+
+type MilestoneRecord struct {
+	ID          uuid.UUID  `db:"id" goqu:"skipupdate"`
+	Title       string     `db:"title"`
+	Description *string    `db:"description"`
+	TargetDate  *time.Time `db:"target_date"`
+	State       string     `db:"state"`
+	CreatedAt   time.Time  `db:"created_at" goqu:"skipupdate"`
+	UpdatedAt   time.Time  `db:"updated_at"`
+	DeletedAt   *time.Time `db:"deleted_at"`
+}
+
+type ColumnTaskCountRecord struct {
+	BoardID   uuid.UUID `db:"board_id"`
+	BoardName string    `db:"board_name"`
+	ColumnID  uuid.UUID `db:"column_id"`
+	OrderNum  int64     `db:"order_num"`
+	IsDone    bool      `db:"is_done"`
+	TaskCount int64     `db:"task_count"`
+}
+
+type SprintRecord struct {
+	ID        uuid.UUID  `db:"id" goqu:"skipupdate"`
+	BoardID   uuid.UUID  `db:"board_id" goqu:"skipupdate"`
+	Name      string     `db:"name"`
+	Goal      *string    `db:"goal"`
+	StartDate time.Time  `db:"start_date"`
+	EndDate   time.Time  `db:"end_date"`
+	State     string     `db:"state"`
+	CreatedAt time.Time  `db:"created_at" goqu:"skipupdate"`
+	UpdatedAt time.Time  `db:"updated_at"`
+	DeletedAt *time.Time `db:"deleted_at"`
+}
+
+type BoardTemplateRecord struct {
+	ID          uuid.UUID  `db:"id" goqu:"skipupdate"`
+	Name        string     `db:"name"`
+	Description *string    `db:"description"`
+	Columns     []byte     `db:"columns"`
+	Tasks       []byte     `db:"tasks"`
+	CreatedAt   time.Time  `db:"created_at" goqu:"skipupdate"`
+	UpdatedAt   time.Time  `db:"updated_at"`
+	DeletedAt   *time.Time `db:"deleted_at"`
+}
+
+// TemplateColumnJSON и TemplateTaskJSON - формат колонок и задач в JSONB шаблона
+type TemplateColumnJSON struct {
+	Name     string `json:"name"`
+	IsDone   bool   `json:"is_done"`
+	WipLimit *int64 `json:"wip_limit,omitempty"`
+}
+
+type TemplateTaskJSON struct {
+	Column      int                `json:"column"`
+	Parent      *int               `json:"parent,omitempty"`
+	Title       string             `json:"title"`
+	Description *string            `json:"description,omitempty"`
+	Tags        []string           `json:"tags,omitempty"`
+	Checklists  []domain.Checklist `json:"checklists,omitempty"`
+	Priority    *string            `json:"priority,omitempty"`
+	Lane        *string            `json:"lane,omitempty"`
+
+	OriginalEstimate  *int `json:"original_estimate,omitempty"`
+	RemainingEstimate *int `json:"remaining_estimate,omitempty"`
+}
+
+type TaskHistoryRecord struct {
+	ID        uuid.UUID `db:"id"`
+	TaskID    uuid.UUID `db:"task_id"`
+	Kind      string    `db:"kind"`
+	Data      []byte    `db:"data"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+type IdempotencyKeyRecord struct {
+	Scope        string    `db:"scope"`
+	Key          string    `db:"key"`
+	RequestHash  string    `db:"request_hash"`
+	StatusCode   *int      `db:"status_code"`
+	ContentType  *string   `db:"content_type"`
+	ResponseBody []byte    `db:"response_body"`
+	CreatedAt    time.Time `db:"created_at"`
+	ExpiresAt    time.Time `db:"expires_at"`
+}
+
+type WebhookRecord struct {
+	ID        uuid.UUID `db:"id"`
+	BoardID   uuid.UUID `db:"board_id"`
+	URL       string    `db:"url"`
+	Events    []string  `db:"events"`
+	Secret    string    `db:"secret"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+type WebhookDeliveryRecord struct {
+	ID             uuid.UUID  `db:"id"`
+	WebhookID      uuid.UUID  `db:"webhook_id"`
+	EventID        uuid.UUID  `db:"event_id"`
+	EventType      string     `db:"event_type"`
+	Payload        []byte     `db:"payload"`
+	Status         string     `db:"status"`
+	Attempts       int        `db:"attempts"`
+	NextAttemptAt  *time.Time `db:"next_attempt_at"`
+	LastStatusCode *int       `db:"last_status_code"`
+	LastError      *string    `db:"last_error"`
+	CreatedAt      time.Time  `db:"created_at"`
+	UpdatedAt      time.Time  `db:"updated_at"`
+	DeliveredAt    *time.Time `db:"delivered_at"`
+}
+
+type OutboxRecord struct {
+	ID          uuid.UUID `db:"id"`
+	EventType   string    `db:"event_type"`
+	AggregateID uuid.UUID `db:"aggregate_id"`
+	BoardID     uuid.UUID `db:"board_id"`
+	Payload     []byte    `db:"payload"`
+	OccurredAt  time.Time `db:"occurred_at"`
+}
+
+type NotificationRecord struct {
+	ID        uuid.UUID  `db:"id"`
+	UserID    string     `db:"user_id"`
+	Type      string     `db:"type"`
+	EventID   uuid.UUID  `db:"event_id"`
+	BoardID   uuid.UUID  `db:"board_id"`
+	TaskID    uuid.UUID  `db:"task_id"`
+	Text      string     `db:"text"`
+	ReadAt    *time.Time `db:"read_at"`
+	CreatedAt time.Time  `db:"created_at"`
+	EmailedAt *time.Time `db:"emailed_at"`
+}
+
+type EmailPreferenceRecord struct {
+	UserID       string     `db:"user_id"`
+	Email        string     `db:"email"`
+	Mode         string     `db:"mode"`
+	LastDigestAt *time.Time `db:"last_digest_at"`
+	UpdatedAt    time.Time  `db:"updated_at"`
+}
+
+type WatcherRecord struct {
+	UserID    string     `db:"user_id"`
+	TaskID    *uuid.UUID `db:"task_id"`
+	BoardID   *uuid.UUID `db:"board_id"`
+	CreatedAt time.Time  `db:"created_at"`
+}
+
+type TaskMentionRecord struct {
+	TaskID   uuid.UUID `db:"task_id"`
+	Source   string    `db:"source"`
+	UserID   string    `db:"user_id"`
+	Position int       `db:"position"`
+}
+
+type TaskRefRecord struct {
+	TaskID    uuid.UUID `db:"task_id"`
+	Source    string    `db:"source"`
+	ShortName string    `db:"short_name"`
+	Number    int64     `db:"number"`
+	Position  int       `db:"position"`
+}
+
+// ResolvedTaskRefRecord - задача, найденная по ключу ссылки.
+// Redirected - ключ остался за задачей после переноса в другую доску.
+type ResolvedTaskRefRecord struct {
+	ShortName  string    `db:"short_name"`
+	Number     int64     `db:"number"`
+	ID         uuid.UUID `db:"id"`
+	Title      string    `db:"title"`
+	Redirected bool      `db:"redirected"`
+}
+
+type AttachmentRecord struct {
+	ID         uuid.UUID `db:"id"`
+	TaskID     uuid.UUID `db:"task_id"`
+	Name       string    `db:"name"`
+	Size       int64     `db:"size"`
+	MimeType   string    `db:"mime_type"`
+	Checksum   string    `db:"checksum"`
+	StorageKey string    `db:"storage_key"`
+	UploadedBy string    `db:"uploaded_by"`
+	CreatedAt  time.Time `db:"created_at"`
+}
+
+type WorklogRecord struct {
+	ID        uuid.UUID `db:"id"`
+	TaskID    uuid.UUID `db:"task_id"`
+	UserID    string    `db:"user_id"`
+	Minutes   int       `db:"minutes"`
+	Date      time.Time `db:"date"`
+	Note      *string   `db:"note"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+type TimeReportRecord struct {
+	WorklogRecord
+	BoardID   uuid.UUID `db:"board_id"`
+	ShortName string    `db:"short_name"`
+	Number    int64     `db:"number"`
+	Title     string    `db:"title"`
+}
+
+type TaskTransitionRecord struct {
+	ID           uuid.UUID  `db:"id"`
+	TaskID       uuid.UUID  `db:"task_id"`
+	BoardID      uuid.UUID  `db:"board_id"`
+	FromColumnID *uuid.UUID `db:"from_column_id"`
+	ToColumnID   uuid.UUID  `db:"to_column_id"`
+	OccurredAt   time.Time  `db:"occurred_at"`
+}
+
+type TaskCompletionRecord struct {
+	TaskID      uuid.UUID `db:"task_id"`
+	StoryPoints *int      `db:"story_points"`
+	CompletedAt time.Time `db:"completed_at"`
+}
+
+// TaskDeadlineRecord - срок задачи из ее цели или спринта.
+type TaskDeadlineRecord struct {
+	TaskID     uuid.UUID `db:"task_id"`
+	BoardID    uuid.UUID `db:"board_id"`
+	Title      string    `db:"title"`
+	Assignee   string    `db:"assignee"`
+	DueDate    time.Time `db:"due_date"`
+	Source     string    `db:"source"`
+	SourceName string    `db:"source_name"`
+}
@@ -0,0 +1,160 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/pkg/errors"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+)
+
+// descriptionWritten - меняет ли запись описание задачи. Описание задают только
+// создание и полное обновление, остальные изменения задачи его не трогают.
+func descriptionWritten(events []domain.Event) (written, replace bool) {
+	for _, e := range events {
+		switch e.Type {
+		case domain.EventTaskCreated:
+			written = true
+		case domain.EventTaskUpdated:
+			written, replace = true, true
+		}
+	}
+	return written, replace
+}
+
+// saveDescriptionReferences сохраняет упоминания и ссылки из описания задачи.
+// replace удаляет прежние - для новой задачи удалять нечего.
+func saveDescriptionReferences(ctx context.Context, db execer, task *domain.Task, replace bool) error {
+	source := string(domain.ReferenceDescription)
+
+	if replace {
+		_, err := db.Exec(ctx,
+			`WITH m AS (DELETE FROM task_mentions WHERE task_id = $1 AND source = $2)
+			DELETE FROM task_refs WHERE task_id = $1 AND source = $2`,
+			task.ID, source)
+		if err != nil {
+			return err
+		}
+	}
+
+	if mentions := task.Mentions(); len(mentions) > 0 {
+		rows := make([]interface{}, 0, len(mentions))
+		for i, user := range mentions {
+			rows = append(rows, TaskMentionRecord{TaskID: task.ID, Source: source, UserID: user, Position: i})
+		}
+		sql, params, err := goqu.Insert("task_mentions").Rows(rows...).ToSQL()
+		if err != nil {
+			return err
+		}
+		if _, err := db.Exec(ctx, sql, params...); err != nil {
+			return err
+		}
+	}
+
+	if refs := task.TaskRefs(); len(refs) > 0 {
+		rows := make([]interface{}, 0, len(refs))
+		for i, ref := range refs {
+			rows = append(rows, TaskRefRecord{
+				TaskID: task.ID, Source: source, ShortName: ref.ShortName, Number: ref.Number, Position: i,
+			})
+		}
+		sql, params, err := goqu.Insert("task_refs").Rows(rows...).ToSQL()
+		if err != nil {
+			return err
+		}
+		if _, err := db.Exec(ctx, sql, params...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ResolveTaskRefs находит задачи по ключам ссылок. Порядок ссылок сохраняется,
+// ссылки на несуществующие и удаленные задачи остаются неразрешенными.
+// Ключ задачи, перенесенной в другую доску, разрешается через task_redirects,
+// и редирект важнее задачи, занявшей тот же номер позже.
+func (r Repository) ResolveTaskRefs(ctx context.Context, refs []domain.TaskRef) ([]domain.TaskRef, error) {
+	const op = "postgres.ResolveTaskRefs"
+
+	if len(refs) == 0 {
+		return []domain.TaskRef{}, nil
+	}
+
+	liveKeys := make([]exp.Expression, 0, len(refs))
+	redirectKeys := make([]exp.Expression, 0, len(refs))
+	for _, ref := range refs {
+		liveKeys = append(liveKeys, goqu.And(
+			goqu.T("boards").Col("short_name").Eq(ref.ShortName),
+			goqu.T("tasks").Col("number").Eq(ref.Number),
+		))
+		redirectKeys = append(redirectKeys, goqu.And(
+			goqu.T("boards").Col("short_name").Eq(ref.ShortName),
+			goqu.T("task_redirects").Col("number").Eq(ref.Number),
+		))
+	}
+
+	live := goqu.From("tasks").
+		Select(
+			goqu.T("boards").Col("short_name"),
+			goqu.T("tasks").Col("number"),
+			goqu.T("tasks").Col("id"),
+			goqu.T("tasks").Col("title"),
+			goqu.L("false").As("redirected"),
+		).
+		Join(goqu.T("boards"), goqu.On(goqu.T("tasks").Col("board_id").Eq(goqu.T("boards").Col("id")))).
+		Where(
+			goqu.T("tasks").Col("deleted_at").IsNull(),
+			goqu.T("boards").Col("deleted_at").IsNull(),
+			goqu.Or(liveKeys...),
+		)
+
+	redirected := goqu.From("task_redirects").
+		Select(
+			goqu.T("boards").Col("short_name"),
+			goqu.T("task_redirects").Col("number"),
+			goqu.T("tasks").Col("id"),
+			goqu.T("tasks").Col("title"),
+			goqu.L("true").As("redirected"),
+		).
+		Join(goqu.T("boards"), goqu.On(goqu.T("task_redirects").Col("board_id").Eq(goqu.T("boards").Col("id")))).
+		Join(goqu.T("tasks"), goqu.On(goqu.T("task_redirects").Col("task_id").Eq(goqu.T("tasks").Col("id")))).
+		Where(
+			goqu.T("tasks").Col("deleted_at").IsNull(),
+			goqu.T("boards").Col("deleted_at").IsNull(),
+			goqu.Or(redirectKeys...),
+		)
+
+	sql, params, err := live.UnionAll(redirected).ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	var records []ResolvedTaskRefRecord
+	if err := pgxscan.Select(ctx, r.pool, &records, sql, params...); err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	found := make(map[string]ResolvedTaskRefRecord, len(records))
+	for _, rec := range records {
+		key := domain.TaskRef{ShortName: rec.ShortName, Number: rec.Number}.Key()
+		if prev, ok := found[key]; ok && prev.Redirected {
+			continue
+		}
+		found[key] = rec
+	}
+
+	resolved := make([]domain.TaskRef, 0, len(refs))
+	for _, ref := range refs {
+		if rec, ok := found[ref.Key()]; ok {
+			id, title := rec.ID, rec.Title
+			ref.TaskID, ref.Title = &id, &title
+		}
+		resolved = append(resolved, ref)
+	}
+
+	return resolved, nil
+}
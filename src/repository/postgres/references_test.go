@@ -0,0 +1,75 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateTaskSavesDescriptionReferences(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	task, err := domain.NewTask(uuid.New(), uuid.New(), 7, "Task", nil, nil, nil)
+	require.NoError(t, err)
+	task.ClearEvents()
+	description := "@alice see #TEAM-42"
+	task.Update(task.ColumnID, task.BoardID, task.Number, task.Title, &description, nil, nil)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "tasks"`).WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	mock.ExpectExec(`WITH m AS \(DELETE FROM task_mentions WHERE task_id = \$1 AND source = \$2\)`).
+		WithArgs(task.ID, "description").
+		WillReturnResult(pgxmock.NewResult("DELETE", 2))
+	mock.ExpectExec(`INSERT INTO "task_mentions" .+\(0, 'description', '` + task.ID.String() + `', 'alice'\)`).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectExec(`INSERT INTO "task_refs" .+\(42, 0, 'TEAM', 'description', '` + task.ID.String() + `'\)`).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectExec(`INSERT INTO "outbox"`).WillReturnResult(pgxmock.NewResult("INSERT", 2))
+	mock.ExpectCommit()
+
+	repo := &Repository{pool: mock}
+	require.NoError(t, repo.UpdateTask(context.Background(), task))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestResolveTaskRefs(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	id, movedID, reusedID := uuid.New(), uuid.New(), uuid.New()
+	refs := []domain.TaskRef{
+		{ShortName: "TEAM", Number: 42},
+		{ShortName: "OPS", Number: 1},
+		{ShortName: "OPS", Number: 7},
+	}
+
+	mock.ExpectQuery(`SELECT "boards"."short_name", "tasks"."number", "tasks"."id", "tasks"."title", false AS "redirected" FROM "tasks" .+` +
+		`UNION ALL \(SELECT "boards"."short_name", "task_redirects"."number", "tasks"."id", "tasks"."title", true AS "redirected" FROM "task_redirects" ` +
+		`INNER JOIN "boards" .+INNER JOIN "tasks" ON \("task_redirects"."task_id" = "tasks"."id"\)`).
+		WillReturnRows(pgxmock.NewRows([]string{"short_name", "number", "id", "title", "redirected"}).
+			AddRow("TEAM", int64(42), id, "Login page", false).
+			AddRow("OPS", int64(7), movedID, "Moved task", true).
+			AddRow("OPS", int64(7), reusedID, "Reused number", false))
+
+	repo := &Repository{pool: mock}
+	resolved, err := repo.ResolveTaskRefs(context.Background(), refs)
+	require.NoError(t, err)
+	require.Len(t, resolved, 3)
+	assert.Equal(t, id, *resolved[0].TaskID)
+	assert.Equal(t, "Login page", *resolved[0].Title)
+	assert.Nil(t, resolved[1].TaskID)
+	assert.Equal(t, movedID, *resolved[2].TaskID, "redirect wins")
+
+	empty, err := repo.ResolveTaskRefs(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
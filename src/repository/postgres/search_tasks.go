@@ -6,6 +6,7 @@ import (
 	"github.com/doug-martin/goqu/v9"
 	"github.com/pkg/errors"
 	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
 	"github.com/lib/pq"
 )
 
@@ -13,6 +14,8 @@ func (r Repository) SearchTasks(
     ctx context.Context,
     tags []string,
     query string,
+    milestoneID *uuid.UUID,
+    mentionedUser string,
     limit, offset uint,
 ) ([]domain.Task, error) {
     const op = "postgres.SearchTasks"
@@ -28,6 +31,16 @@ func (r Repository) SearchTasks(
     if query != "" {
         ds = ds.Where(goqu.Ex{"title": goqu.Op{"ilike": "%" + query + "%"}})
     }
+
+    if milestoneID != nil {
+        ds = ds.Where(goqu.T("tasks").Col("milestone_id").Eq(*milestoneID))
+    }
+
+    if mentionedUser != "" {
+        ds = ds.Where(goqu.L(
+            `EXISTS (SELECT 1 FROM task_mentions WHERE task_mentions.task_id = "tasks"."id" AND task_mentions.user_id = ?)`,
+            mentionedUser))
+    }
     
     ds = ds.Select(&TaskSearchRecord{}).
         Join(goqu.T("boards"), goqu.On(goqu.T("tasks").Col("board_id").Eq(goqu.T("boards").Col("id")))).
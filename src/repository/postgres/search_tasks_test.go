@@ -16,6 +16,7 @@ func TestSearchTasks(t *testing.T) {
 	now := time.Now()
 	boardID := uuid.New()
 	columnID := uuid.New()
+	milestoneID := uuid.New()
 
 	baseCols := []string{
 		"tasks.id",
@@ -43,6 +44,8 @@ func TestSearchTasks(t *testing.T) {
 		name        string
 		tags        []string
 		query       string
+		milestoneID *uuid.UUID
+		mentioned   string
 		limit       uint
 		offset      uint
 		mockSetup   func(mock pgxmock.PgxPoolIface)
@@ -123,6 +126,43 @@ func TestSearchTasks(t *testing.T) {
 			},
 			expectedLen: 1,
 		},
+		{
+			name:        "поиск задач по milestone",
+			tags:        []string{},
+			query:       "",
+			milestoneID: &milestoneID,
+			limit:       10, offset: 0,
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows(baseCols).
+					AddRow(uuid.New(), boardID, "Board 1", "B1", "Todo", columnID, int64(1), "Goal Task", now, now, nil)
+
+				mock.ExpectQuery(
+					baseFromJoin +
+						`WHERE .+\"tasks\"\.\"milestone_id\" = '` + milestoneID.String() + `'.+` +
+						`\"tasks\"\.\"deleted_at\" IS NULL.+\"boards\"\.\"deleted_at\" IS NULL.+` +
+						`ORDER BY "tasks"\."created_at" DESC LIMIT 10`,
+				).WillReturnRows(rows)
+			},
+			expectedLen: 1,
+		},
+		{
+			name:      "поиск задач, где упомянут пользователь",
+			tags:      []string{},
+			mentioned: "alice",
+			limit:     10, offset: 0,
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows(baseCols).
+					AddRow(uuid.New(), boardID, "Board 1", "B1", "Todo", columnID, int64(1), "Review", now, now, nil)
+
+				mock.ExpectQuery(
+					baseFromJoin +
+						`WHERE .+EXISTS \(SELECT 1 FROM task_mentions WHERE task_mentions\.task_id = "tasks"\."id" AND task_mentions\.user_id = 'alice'\).+` +
+						`\"tasks\"\.\"deleted_at\" IS NULL.+` +
+						`ORDER BY "tasks"\."created_at" DESC LIMIT 10`,
+				).WillReturnRows(rows)
+			},
+			expectedLen: 1,
+		},
 		{
 			name:  "поиск с пагинацией",
 			tags:  []string{},
@@ -183,7 +223,7 @@ func TestSearchTasks(t *testing.T) {
 			tt.mockSetup(mock)
 
 			repo := &Repository{pool: mock}
-			tasks, err := repo.SearchTasks(context.Background(), tt.tags, tt.query, tt.limit, tt.offset)
+			tasks, err := repo.SearchTasks(context.Background(), tt.tags, tt.query, tt.milestoneID, tt.mentioned, tt.limit, tt.offset)
 
 			if tt.expectedErr != nil {
 				require.Error(t, err)
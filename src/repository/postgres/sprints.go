@@ -0,0 +1,191 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+func (r Repository) CreateSprint(ctx context.Context, sprint *domain.Sprint) error {
+	const op = "postgres.CreateSprint"
+
+	ds := goqu.Insert("sprints").Rows(goqu.Record{
+		"id":         sprint.ID,
+		"board_id":   sprint.BoardID,
+		"name":       sprint.Name,
+		"goal":       sprint.Goal,
+		"start_date": sprint.StartDate,
+		"end_date":   sprint.EndDate,
+		"state":      string(sprint.State),
+		"created_at": sprint.CreatedAt,
+		"updated_at": sprint.UpdatedAt,
+		"deleted_at": sprint.DeletedAt,
+	})
+
+	sql, params, err := ds.ToSQL()
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	_, err = r.pool.Exec(ctx, sql, params...)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	return nil
+}
+
+func (r Repository) GetSprintByID(ctx context.Context, sprintID uuid.UUID) (*domain.Sprint, error) {
+	const op = "postgres.GetSprintByID"
+
+	ds := goqu.From("sprints").
+		Where(
+			goqu.C("id").Eq(sprintID),
+			goqu.C("deleted_at").IsNull(),
+		)
+
+	sql, params, err := ds.ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	var rec SprintRecord
+	err = pgxscan.Get(ctx, r.pool, &rec, sql, params...)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	return rec.toDomain(), nil
+}
+
+// GetActiveSprint возвращает активный спринт доски или pgx.ErrNoRows.
+func (r Repository) GetActiveSprint(ctx context.Context, boardID uuid.UUID) (*domain.Sprint, error) {
+	const op = "postgres.GetActiveSprint"
+
+	ds := goqu.From("sprints").
+		Where(
+			goqu.C("board_id").Eq(boardID),
+			goqu.C("state").Eq(string(domain.SprintActive)),
+			goqu.C("deleted_at").IsNull(),
+		)
+
+	sql, params, err := ds.ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	var rec SprintRecord
+	err = pgxscan.Get(ctx, r.pool, &rec, sql, params...)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	return rec.toDomain(), nil
+}
+
+func (r Repository) GetSprints(ctx context.Context, boardID uuid.UUID) ([]domain.Sprint, error) {
+	const op = "postgres.GetSprints"
+
+	ds := goqu.From("sprints").
+		Where(
+			goqu.C("board_id").Eq(boardID),
+			goqu.C("deleted_at").IsNull(),
+		).
+		Order(goqu.C("start_date").Asc(), goqu.C("created_at").Asc())
+
+	sql, params, err := ds.ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	records := make([]SprintRecord, 0)
+	err = pgxscan.Select(ctx, r.pool, &records, sql, params...)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	sprints := make([]domain.Sprint, 0, len(records))
+	for _, rec := range records {
+		sprints = append(sprints, *rec.toDomain())
+	}
+
+	return sprints, nil
+}
+
+func (r Repository) UpdateSprint(ctx context.Context, sprint *domain.Sprint) error {
+	const op = "postgres.UpdateSprint"
+
+	sql, params, err := updateSprintQuery(sprint).ToSQL()
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	_, err = r.pool.Exec(ctx, sql, params...)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	return nil
+}
+
+// CloseSprint сохраняет закрытый спринт и в той же транзакции переносит его незавершенные
+// задачи (не в завершающей колонке) в спринт nextSprintID, либо в бэклог, если он nil.
+// Возвращает количество перенесенных задач.
+func (r Repository) CloseSprint(ctx context.Context, sprint *domain.Sprint, nextSprintID *uuid.UUID) (int64, error) {
+	const op = "postgres.CloseSprint"
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, op)
+	}
+	defer tx.Rollback(ctx)
+
+	sql, params, err := updateSprintQuery(sprint).ToSQL()
+	if err != nil {
+		return 0, errors.Wrap(err, op)
+	}
+
+	_, err = tx.Exec(ctx, sql, params...)
+	if err != nil {
+		return 0, errors.Wrap(err, op)
+	}
+
+	tag, err := tx.Exec(ctx,
+		`UPDATE tasks t SET sprint_id = $1, updated_at = $2
+		FROM columns c
+		WHERE c.id = t.column_id
+		AND t.sprint_id = $3
+		AND t.deleted_at IS NULL
+		AND NOT `+doneColumnCondition,
+		nextSprintID, sprint.UpdatedAt, sprint.ID)
+	if err != nil {
+		return 0, errors.Wrap(err, op)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, errors.Wrap(err, op)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+func updateSprintQuery(sprint *domain.Sprint) *goqu.UpdateDataset {
+	return goqu.Update("sprints").Where(
+		goqu.C("id").Eq(sprint.ID),
+		goqu.C("deleted_at").IsNull(),
+	).Set(
+		SprintRecord{
+			Name:      sprint.Name,
+			Goal:      sprint.Goal,
+			StartDate: sprint.StartDate,
+			EndDate:   sprint.EndDate,
+			State:     string(sprint.State),
+			UpdatedAt: sprint.UpdatedAt,
+			DeletedAt: sprint.DeletedAt,
+		},
+	)
+}
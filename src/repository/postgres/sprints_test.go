@@ -0,0 +1,101 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloseSprint(t *testing.T) {
+	now := time.Now().UTC()
+	sprint := &domain.Sprint{
+		ID:        uuid.New(),
+		BoardID:   uuid.New(),
+		Name:      "Sprint 1",
+		StartDate: now,
+		EndDate:   now,
+		State:     domain.SprintClosed,
+		UpdatedAt: now,
+	}
+	nextID := uuid.New()
+
+	tests := []struct {
+		name          string
+		nextSprintID  *uuid.UUID
+		mockSetup     func(mock pgxmock.PgxPoolIface)
+		expectedMoved int64
+		expectedErr   error
+	}{
+		{
+			name:         "перенос незавершенных задач в следующий спринт",
+			nextSprintID: &nextID,
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE "sprints" SET .+"state"='closed'.+ WHERE`).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectExec(`UPDATE tasks t SET sprint_id = \$1.+AND NOT`).
+					WithArgs(&nextID, now, sprint.ID).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 3))
+				mock.ExpectCommit()
+			},
+			expectedMoved: 3,
+		},
+		{
+			name: "перенос незавершенных задач в бэклог",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE "sprints"`).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectExec(`UPDATE tasks t SET sprint_id = \$1`).
+					WithArgs((*uuid.UUID)(nil), now, sprint.ID).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 2))
+				mock.ExpectCommit()
+			},
+			expectedMoved: 2,
+		},
+		{
+			name:         "ошибка переноса задач откатывает транзакцию",
+			nextSprintID: &nextID,
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE "sprints"`).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectExec(`UPDATE tasks t`).
+					WithArgs(&nextID, now, sprint.ID).
+					WillReturnError(errors.New("tasks update error"))
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("tasks update error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.mockSetup(mock)
+
+			repo := &Repository{pool: mock}
+			moved, err := repo.CloseSprint(context.Background(), sprint, tt.nextSprintID)
+
+			if tt.expectedErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedMoved, moved)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+// GetStoryPointScale возвращает шкалу доски, без своей шкалы - шкалу по умолчанию.
+func (r Repository) GetStoryPointScale(ctx context.Context, boardID uuid.UUID) (domain.StoryPointScale, error) {
+	const op = "postgres.GetStoryPointScale"
+
+	var values []int64
+	err := r.pool.QueryRow(ctx,
+		`SELECT story_point_scale FROM boards WHERE id = $1 AND deleted_at IS NULL`,
+		boardID,
+	).Scan(&values)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	if values == nil {
+		return domain.DefaultStoryPointScale(), nil
+	}
+
+	scale := make(domain.StoryPointScale, 0, len(values))
+	for _, v := range values {
+		scale = append(scale, int(v))
+	}
+	return scale, nil
+}
+
+// SetStoryPointScale сохраняет шкалу доски. Оценки задач не пересчитываются:
+// значения вне новой шкалы остаются, пока задачу не переоценят.
+func (r Repository) SetStoryPointScale(ctx context.Context, boardID uuid.UUID, scale domain.StoryPointScale) error {
+	const op = "postgres.SetStoryPointScale"
+
+	sql, params, err := goqu.Update("boards").
+		Set(goqu.Record{"story_point_scale": storyPointScaleToArray(scale)}).
+		Where(goqu.C("id").Eq(boardID), goqu.C("deleted_at").IsNull()).
+		ToSQL()
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	tag, err := r.pool.Exec(ctx, sql, params...)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Wrap(pgx.ErrNoRows, op)
+	}
+
+	return nil
+}
+
+// storyPointScaleToArray переводит шкалу в массив для story_point_scale, nil остается NULL.
+func storyPointScaleToArray(scale domain.StoryPointScale) pq.Int64Array {
+	if scale == nil {
+		return nil
+	}
+	values := make(pq.Int64Array, 0, len(scale))
+	for _, v := range scale {
+		values = append(values, int64(v))
+	}
+	return values
+}
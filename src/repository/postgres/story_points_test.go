@@ -0,0 +1,62 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetStoryPointScale(t *testing.T) {
+	boardID := uuid.New()
+
+	tests := []struct {
+		name     string
+		values   []int64
+		expected domain.StoryPointScale
+	}{
+		{name: "своя шкала", values: []int64{1, 2, 4}, expected: domain.StoryPointScale{1, 2, 4}},
+		{name: "шкала по умолчанию", values: nil, expected: domain.DefaultStoryPointScale()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			mock.ExpectQuery(`SELECT story_point_scale FROM boards`).
+				WithArgs(boardID).
+				WillReturnRows(pgxmock.NewRows([]string{"story_point_scale"}).AddRow(tt.values))
+
+			repo := &Repository{pool: mock}
+			scale, err := repo.GetStoryPointScale(context.Background(), boardID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, scale)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSetStoryPointScale(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	boardID := uuid.New()
+	mock.ExpectExec(`UPDATE "boards" SET "story_point_scale"='\{1,2,4\}' WHERE \(\("id" = '` + boardID.String() + `'\)`).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	mock.ExpectExec(`UPDATE "boards"`).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+	repo := &Repository{pool: mock}
+	require.NoError(t, repo.SetStoryPointScale(context.Background(), boardID, domain.StoryPointScale{1, 2, 4}))
+	err = repo.SetStoryPointScale(context.Background(), boardID, domain.StoryPointScale{1})
+	assert.ErrorIs(t, err, pgx.ErrNoRows)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
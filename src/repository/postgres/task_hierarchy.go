@@ -0,0 +1,92 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// Ограничение рекурсии на случай битых данных
+const maxHierarchyRecursion = 100
+
+// GetTaskAncestors возвращает предков задачи, начиная с ближайшего родителя.
+func (r Repository) GetTaskAncestors(ctx context.Context, taskID uuid.UUID) ([]uuid.UUID, error) {
+	const op = "postgres.GetTaskAncestors"
+
+	ancestors := make([]uuid.UUID, 0)
+	err := pgxscan.Select(ctx, r.pool, &ancestors,
+		`WITH RECURSIVE ancestors AS (
+			SELECT parent_id, 1 AS depth FROM tasks WHERE id = $1
+			UNION ALL
+			SELECT t.parent_id, a.depth + 1
+			FROM tasks t JOIN ancestors a ON t.id = a.parent_id
+			WHERE a.depth < $2
+		)
+		SELECT parent_id FROM ancestors
+		WHERE parent_id IS NOT NULL
+		ORDER BY depth`, taskID, maxHierarchyRecursion)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	return ancestors, nil
+}
+
+// GetSubtreeHeight возвращает высоту поддерева задачи (0 - детей нет).
+func (r Repository) GetSubtreeHeight(ctx context.Context, taskID uuid.UUID) (int, error) {
+	const op = "postgres.GetSubtreeHeight"
+
+	var height int
+	err := r.pool.QueryRow(ctx,
+		`WITH RECURSIVE subtree AS (
+			SELECT id, 0 AS depth FROM tasks WHERE id = $1
+			UNION ALL
+			SELECT t.id, s.depth + 1
+			FROM tasks t JOIN subtree s ON t.parent_id = s.id
+			WHERE t.deleted_at IS NULL AND s.depth < $2
+		)
+		SELECT COALESCE(MAX(depth), 0) FROM subtree`, taskID, maxHierarchyRecursion).Scan(&height)
+	if err != nil {
+		return 0, errors.Wrap(err, op)
+	}
+
+	return height, nil
+}
+
+func (r Repository) GetTaskChildren(ctx context.Context, parentID uuid.UUID) ([]domain.Task, error) {
+	const op = "postgres.GetTaskChildren"
+
+	tasks := make([]domain.Task, 0)
+	err := pgxscan.Select(ctx, r.pool, &tasks,
+		`SELECT id, column_id, board_id, parent_id, number, title, created_at, updated_at
+		FROM tasks WHERE parent_id = $1
+		AND deleted_at IS NULL
+		ORDER BY number`, parentID)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	return tasks, nil
+}
+
+// GetSubtaskProgress считает детей задачи и сколько из них в завершающих колонках.
+func (r Repository) GetSubtaskProgress(ctx context.Context, parentID uuid.UUID) (domain.SubtaskProgress, error) {
+	const op = "postgres.GetSubtaskProgress"
+
+	var progress domain.SubtaskProgress
+	err := pgxscan.Get(ctx, r.pool, &progress,
+		`SELECT COUNT(*) AS total,
+			COUNT(*) FILTER (WHERE `+doneColumnCondition+`) AS done
+		FROM tasks t
+		JOIN columns c ON c.id = t.column_id
+		WHERE t.parent_id = $1
+		AND t.deleted_at IS NULL`, parentID)
+	if err != nil {
+		return domain.SubtaskProgress{}, errors.Wrap(err, op)
+	}
+
+	return progress, nil
+}
@@ -0,0 +1,115 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+func (r Repository) CreateTaskLink(ctx context.Context, link *domain.TaskLink) error {
+	const op = "postgres.CreateTaskLink"
+
+	ds := goqu.Insert("task_links").Rows(goqu.Record{
+		"id":           link.ID,
+		"from_task_id": link.FromTaskID,
+		"to_task_id":   link.ToTaskID,
+		"type":         string(link.Type),
+		"created_at":   link.CreatedAt,
+	})
+
+	sql, params, err := ds.ToSQL()
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	_, err = r.pool.Exec(ctx, sql, params...)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	return nil
+}
+
+// GetTaskLinks возвращает все связи задачи (в обе стороны) вместе с данными связанной задачи.
+func (r Repository) GetTaskLinks(ctx context.Context, taskID uuid.UUID) ([]domain.TaskLink, error) {
+	const op = "postgres.GetTaskLinks"
+
+	records := make([]TaskLinkRecord, 0)
+	err := pgxscan.Select(ctx, r.pool, &records,
+		`SELECT l.id, l.from_task_id, l.to_task_id, l.type, l.created_at,
+			t.id AS linked_id,
+			t.board_id AS linked_board_id,
+			b.short_name AS linked_board_short_name,
+			t.column_id AS linked_column_id,
+			t.number AS linked_number,
+			t.title AS linked_title
+		FROM task_links l
+		JOIN tasks t ON t.id = CASE WHEN l.from_task_id = $1 THEN l.to_task_id ELSE l.from_task_id END
+		JOIN boards b ON b.id = t.board_id
+		WHERE (l.from_task_id = $1 OR l.to_task_id = $1)
+		AND t.deleted_at IS NULL
+		AND b.deleted_at IS NULL
+		ORDER BY l.created_at`, taskID)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	links := make([]domain.TaskLink, 0, len(records))
+	for _, rec := range records {
+		links = append(links, rec.toDomain())
+	}
+
+	return links, nil
+}
+
+func (r Repository) DeleteTaskLink(ctx context.Context, linkID uuid.UUID) error {
+	const op = "postgres.DeleteTaskLink"
+
+	ds := goqu.Delete("task_links").Where(goqu.C("id").Eq(linkID))
+
+	sql, params, err := ds.ToSQL()
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	_, err = r.pool.Exec(ctx, sql, params...)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	return nil
+}
+
+// GetOpenBlockers возвращает задачи, которые блокируют taskID и еще не в завершающей колонке.
+func (r Repository) GetOpenBlockers(ctx context.Context, taskID uuid.UUID) ([]domain.Task, error) {
+	const op = "postgres.GetOpenBlockers"
+
+	records := make([]TaskBlockerRecord, 0)
+	err := pgxscan.Select(ctx, r.pool, &records,
+		`SELECT t.id, t.board_id, t.column_id, t.number, t.title,
+			b.short_name AS board_short_name
+		FROM task_links l
+		JOIN tasks t ON t.id = l.from_task_id
+		JOIN boards b ON b.id = t.board_id
+		JOIN columns c ON c.id = t.column_id
+		WHERE l.to_task_id = $1
+		AND l.type = $2
+		AND t.deleted_at IS NULL
+		AND b.deleted_at IS NULL
+		AND NOT `+doneColumnCondition+`
+		ORDER BY b.short_name, t.number`, taskID, string(domain.LinkBlocks))
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	tasks := make([]domain.Task, 0, len(records))
+	for _, rec := range records {
+		tasks = append(tasks, rec.toDomain())
+	}
+
+	return tasks, nil
+}
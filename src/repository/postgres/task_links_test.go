@@ -0,0 +1,149 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateTaskLink(t *testing.T) {
+	link := &domain.TaskLink{
+		ID:         uuid.New(),
+		FromTaskID: uuid.New(),
+		ToTaskID:   uuid.New(),
+		Type:       domain.LinkBlocks,
+		CreatedAt:  time.Now().UTC(),
+	}
+
+	tests := []struct {
+		name        string
+		mockSetup   func(mock pgxmock.PgxPoolIface)
+		expectedErr error
+	}{
+		{
+			name: "успешное создание связи",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectExec(`INSERT INTO "task_links" .+'blocks'`).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+			},
+		},
+		{
+			name: "ошибка вставки",
+			mockSetup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectExec(`INSERT INTO "task_links"`).
+					WillReturnError(errors.New("insert error"))
+			},
+			expectedErr: errors.New("insert error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.mockSetup(mock)
+
+			repo := &Repository{pool: mock}
+			err = repo.CreateTaskLink(context.Background(), link)
+
+			if tt.expectedErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetTaskLinks(t *testing.T) {
+	taskID := uuid.New()
+	otherID := uuid.New()
+	boardID := uuid.New()
+	columnID := uuid.New()
+	linkID := uuid.New()
+	now := time.Now().UTC()
+
+	columns := []string{
+		"id", "from_task_id", "to_task_id", "type", "created_at",
+		"linked_id", "linked_board_id", "linked_board_short_name",
+		"linked_column_id", "linked_number", "linked_title",
+	}
+
+	t.Run("связи в обе стороны", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery(`SELECT .+ FROM task_links l`).
+			WithArgs(taskID).
+			WillReturnRows(pgxmock.NewRows(columns).
+				AddRow(linkID, otherID, taskID, "blocks", now,
+					otherID, boardID, "TEAM", columnID, int64(12), "Blocker"))
+
+		repo := &Repository{pool: mock}
+		links, err := repo.GetTaskLinks(context.Background(), taskID)
+		require.NoError(t, err)
+		require.Len(t, links, 1)
+
+		assert.Equal(t, linkID, links[0].ID)
+		assert.Equal(t, domain.LinkBlocks, links[0].Type)
+		assert.False(t, links[0].IsOutward(taskID))
+		require.NotNil(t, links[0].LinkedTask)
+		assert.Equal(t, "TEAM-12", links[0].LinkedTask.Key())
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ошибка запроса", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery(`SELECT .+ FROM task_links l`).
+			WithArgs(taskID).
+			WillReturnError(errors.New("select error"))
+
+		repo := &Repository{pool: mock}
+		_, err = repo.GetTaskLinks(context.Background(), taskID)
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "select error")
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestGetOpenBlockers(t *testing.T) {
+	taskID := uuid.New()
+	blockerID := uuid.New()
+
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	mock.ExpectQuery(`SELECT .+ FROM task_links l .+ NOT \(c.is_done OR`).
+		WithArgs(taskID, "blocks").
+		WillReturnRows(pgxmock.NewRows([]string{
+			"id", "board_id", "board_short_name", "column_id", "number", "title",
+		}).AddRow(blockerID, uuid.New(), "OPS", uuid.New(), int64(3), "Deploy"))
+
+	repo := &Repository{pool: mock}
+	blockers, err := repo.GetOpenBlockers(context.Background(), taskID)
+	require.NoError(t, err)
+	require.Len(t, blockers, 1)
+	assert.Equal(t, blockerID, blockers[0].ID)
+	assert.Equal(t, "OPS-3", blockers[0].Key())
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
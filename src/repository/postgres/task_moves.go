@@ -0,0 +1,124 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+// MoveTaskToBoard сохраняет перенос задачи в другую доску: новый номер и теги,
+// редирект со старого номера, отвязку подзадач, оставшихся в старой доске, запись в истории
+// и событие TaskMovedToBoard вместе с переходом - одной транзакцией.
+// Если задачи уже нет (удалена или стерта), возвращает pgx.ErrNoRows.
+func (r Repository) MoveTaskToBoard(ctx context.Context, task *domain.Task, move domain.BoardMove, entry domain.TaskHistoryEntry) error {
+	const op = "postgres.MoveTaskToBoard"
+
+	data, err := json.Marshal(entry.Data)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	err = r.withOutbox(ctx, task.Events(), func(db execer) error {
+		tag, err := db.Exec(ctx,
+			`UPDATE tasks SET board_id = $2, column_id = $3, number = $4, tags = $5,
+				parent_id = NULL, sprint_id = NULL, updated_at = $6
+			WHERE id = $1 AND deleted_at IS NULL`,
+			task.ID, task.BoardID, task.ColumnID, task.Number, task.Tags, task.UpdatedAt)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return pgx.ErrNoRows
+		}
+
+		_, err = db.Exec(ctx,
+			`UPDATE tasks SET parent_id = NULL, updated_at = $2
+			WHERE parent_id = $1 AND board_id <> $3`,
+			task.ID, task.UpdatedAt, task.BoardID)
+		if err != nil {
+			return err
+		}
+
+		_, err = db.Exec(ctx,
+			`INSERT INTO task_redirects (board_id, number, task_id, created_at)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (board_id, number) DO UPDATE SET task_id = EXCLUDED.task_id, created_at = EXCLUDED.created_at`,
+			move.FromBoardID, move.FromNumber, task.ID, task.UpdatedAt)
+		if err != nil {
+			return err
+		}
+
+		_, err = db.Exec(ctx,
+			`INSERT INTO task_history (id, task_id, kind, data, created_at)
+			VALUES ($1, $2, $3, $4, $5)`,
+			entry.ID, entry.TaskID, string(entry.Kind), data, entry.CreatedAt)
+		return err
+	})
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	task.ClearEvents()
+	return nil
+}
+
+// ResolveTaskKey ищет задачу по короткому имени доски и номеру. Если задачи с таким
+// номером в доске нет, смотрит редиректы перенесенных задач; redirected = true.
+func (r Repository) ResolveTaskKey(ctx context.Context, shortName string, number int64) (uuid.UUID, bool, error) {
+	const op = "postgres.ResolveTaskKey"
+
+	var taskID uuid.UUID
+	err := r.pool.QueryRow(ctx,
+		`SELECT t.id FROM tasks t
+		JOIN boards b ON b.id = t.board_id
+		WHERE b.short_name = $1 AND b.deleted_at IS NULL
+		AND t.number = $2 AND t.deleted_at IS NULL`,
+		shortName, number).Scan(&taskID)
+	if err == nil {
+		return taskID, false, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return uuid.Nil, false, errors.Wrap(err, op)
+	}
+
+	err = r.pool.QueryRow(ctx,
+		`SELECT r.task_id FROM task_redirects r
+		JOIN boards b ON b.id = r.board_id
+		WHERE b.short_name = $1 AND b.deleted_at IS NULL AND r.number = $2`,
+		shortName, number).Scan(&taskID)
+	if err != nil {
+		return uuid.Nil, false, errors.Wrap(err, op)
+	}
+
+	return taskID, true, nil
+}
+
+func (r Repository) GetTaskHistory(ctx context.Context, taskID uuid.UUID) ([]domain.TaskHistoryEntry, error) {
+	const op = "postgres.GetTaskHistory"
+
+	var records []TaskHistoryRecord
+	err := pgxscan.Select(ctx, r.pool, &records,
+		`SELECT id, task_id, kind, data, created_at
+		FROM task_history
+		WHERE task_id = $1
+		ORDER BY created_at`, taskID)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	entries := make([]domain.TaskHistoryEntry, 0, len(records))
+	for i := range records {
+		entry, err := records[i].toDomain()
+		if err != nil {
+			return nil, errors.Wrap(err, op)
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,153 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoveTaskToBoard(t *testing.T) {
+	sourceID := uuid.New()
+	fromColumnID := uuid.New()
+	source := &domain.Board{ID: sourceID, ShortName: "OPS"}
+	target := &domain.Board{ID: uuid.New(), ShortName: "TEAM"}
+	toColumnID := uuid.New()
+
+	newMove := func(t *testing.T) (*domain.Task, domain.BoardMove, domain.TaskHistoryEntry) {
+		task := &domain.Task{ID: uuid.New(), BoardID: sourceID, ColumnID: fromColumnID, Number: 7, Tags: []string{"bug"}}
+		move, err := task.MoveToBoard(source, target, toColumnID, 42, []string{"bug"}, nil, nil)
+		require.NoError(t, err)
+		return task, move, move.HistoryEntry(task.ID)
+	}
+
+	t.Run("перенос с редиректом, историей, событием и переходом", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		task, move, entry := newMove(t)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`UPDATE tasks SET board_id = \$2`).
+			WithArgs(task.ID, target.ID, toColumnID, int64(42), task.Tags, task.UpdatedAt).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+		mock.ExpectExec(`UPDATE tasks SET parent_id = NULL`).
+			WithArgs(task.ID, task.UpdatedAt, target.ID).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 2))
+		mock.ExpectExec(`INSERT INTO task_redirects`).
+			WithArgs(sourceID, int64(7), task.ID, task.UpdatedAt).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		mock.ExpectExec(`INSERT INTO task_history`).
+			WithArgs(entry.ID, task.ID, "moved_to_board", pgxmock.AnyArg(), entry.CreatedAt).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		mock.ExpectExec(`INSERT INTO "outbox" .+'task.moved_to_board'.+"from_key":"OPS-7"`).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		mock.ExpectExec(`INSERT INTO "task_transitions" .+VALUES \('` + target.ID.String() + `', '` + fromColumnID.String() + `', .+'` + toColumnID.String() + `'\)`).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		mock.ExpectCommit()
+
+		repo := &Repository{pool: mock}
+		require.NoError(t, repo.MoveTaskToBoard(context.Background(), task, move, entry))
+		assert.Empty(t, task.Events())
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("задачу удалили одновременно с переносом", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		task, move, entry := newMove(t)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`UPDATE tasks SET board_id`).
+			WithArgs(task.ID, target.ID, toColumnID, int64(42), task.Tags, task.UpdatedAt).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+		mock.ExpectRollback()
+
+		repo := &Repository{pool: mock}
+		err = repo.MoveTaskToBoard(context.Background(), task, move, entry)
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+		assert.Len(t, task.Events(), 1)
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ошибка редиректа откатывает перенос", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		task, move, entry := newMove(t)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`UPDATE tasks SET board_id`).
+			WithArgs(task.ID, target.ID, toColumnID, int64(42), task.Tags, task.UpdatedAt).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+		mock.ExpectExec(`UPDATE tasks SET parent_id = NULL`).
+			WithArgs(task.ID, task.UpdatedAt, target.ID).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+		mock.ExpectExec(`INSERT INTO task_redirects`).
+			WithArgs(sourceID, int64(7), task.ID, task.UpdatedAt).
+			WillReturnError(errors.New("redirect insert error"))
+		mock.ExpectRollback()
+
+		repo := &Repository{pool: mock}
+		err = repo.MoveTaskToBoard(context.Background(), task, move, entry)
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "redirect insert error")
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestResolveTaskKey(t *testing.T) {
+	taskID := uuid.New()
+
+	t.Run("живая задача", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery(`SELECT t.id FROM tasks t`).
+			WithArgs("TEAM", int64(42)).
+			WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(taskID))
+
+		repo := &Repository{pool: mock}
+		id, redirected, err := repo.ResolveTaskKey(context.Background(), "TEAM", 42)
+		require.NoError(t, err)
+		assert.Equal(t, taskID, id)
+		assert.False(t, redirected)
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("перенесенная задача через редирект", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery(`SELECT t.id FROM tasks t`).
+			WithArgs("OPS", int64(7)).
+			WillReturnError(pgx.ErrNoRows)
+		mock.ExpectQuery(`SELECT r.task_id FROM task_redirects r`).
+			WithArgs("OPS", int64(7)).
+			WillReturnRows(pgxmock.NewRows([]string{"task_id"}).AddRow(taskID))
+
+		repo := &Repository{pool: mock}
+		id, redirected, err := repo.ResolveTaskKey(context.Background(), "OPS", 7)
+		require.NoError(t, err)
+		assert.Equal(t, taskID, id)
+		assert.True(t, redirected)
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
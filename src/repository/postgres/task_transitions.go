@@ -0,0 +1,104 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// insertTransitions сохраняет переходы задач. Для событий вызывается из insertOutbox,
+// поэтому история пишется на любом пути создания и перемещения задачи.
+func insertTransitions(ctx context.Context, db execer, transitions []domain.TaskTransition) error {
+	if len(transitions) == 0 {
+		return nil
+	}
+
+	rows := make([]interface{}, 0, len(transitions))
+	for _, t := range transitions {
+		rows = append(rows, TaskTransitionRecord{
+			ID:           t.ID,
+			TaskID:       t.TaskID,
+			BoardID:      t.BoardID,
+			FromColumnID: t.FromColumnID,
+			ToColumnID:   t.ToColumnID,
+			OccurredAt:   t.OccurredAt,
+		})
+	}
+
+	sql, params, err := goqu.Insert("task_transitions").Rows(rows...).ToSQL()
+	if err != nil {
+		return err
+	}
+
+	_, err = db.Exec(ctx, sql, params...)
+	return err
+}
+
+// GetTaskCompletions возвращает живые задачи, впервые попавшие в done-колонку
+// доски в [from, to). Done определяется по текущему состоянию колонок.
+func (r Repository) GetTaskCompletions(ctx context.Context, boardID uuid.UUID, from, to time.Time) ([]domain.TaskCompletion, error) {
+	const op = "postgres.GetTaskCompletions"
+
+	var records []TaskCompletionRecord
+	err := pgxscan.Select(ctx, r.pool, &records,
+		`SELECT t.id AS task_id, t.story_points, done.completed_at
+		FROM (
+			SELECT tr.task_id, MIN(tr.occurred_at) AS completed_at
+			FROM task_transitions tr
+			JOIN columns c ON c.id = tr.to_column_id
+			WHERE tr.board_id = $1 AND `+doneColumnCondition+`
+			GROUP BY tr.task_id
+		) done
+		JOIN tasks t ON t.id = done.task_id
+		WHERE t.deleted_at IS NULL AND done.completed_at >= $2 AND done.completed_at < $3
+		ORDER BY done.completed_at`,
+		boardID, from, to)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	completions := make([]domain.TaskCompletion, 0, len(records))
+	for _, rec := range records {
+		completions = append(completions, domain.TaskCompletion{
+			TaskID:      rec.TaskID,
+			StoryPoints: rec.StoryPoints,
+			CompletedAt: rec.CompletedAt,
+		})
+	}
+
+	return completions, nil
+}
+
+// GetTaskTransitions возвращает историю на доске живых задач, которые
+// переходили по ней в [from, to): завершиться в этом периоде могли только они.
+func (r Repository) GetTaskTransitions(ctx context.Context, boardID uuid.UUID, from, to time.Time) ([]domain.TaskTransition, error) {
+	const op = "postgres.GetTaskTransitions"
+
+	var records []TaskTransitionRecord
+	err := pgxscan.Select(ctx, r.pool, &records,
+		`SELECT tr.id, tr.task_id, tr.board_id, tr.from_column_id, tr.to_column_id, tr.occurred_at
+		FROM task_transitions tr
+		JOIN tasks t ON t.id = tr.task_id
+		WHERE tr.board_id = $1 AND tr.occurred_at < $3 AND t.deleted_at IS NULL
+			AND tr.task_id IN (
+				SELECT task_id FROM task_transitions
+				WHERE board_id = $1 AND occurred_at >= $2 AND occurred_at < $3
+			)
+		ORDER BY tr.task_id, tr.occurred_at, tr.id`,
+		boardID, from, to)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	transitions := make([]domain.TaskTransition, 0, len(records))
+	for _, rec := range records {
+		transitions = append(transitions, rec.toDomain())
+	}
+
+	return transitions, nil
+}
@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateTaskWritesTransition(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	todo, done := uuid.New(), uuid.New()
+	task := &domain.Task{ID: uuid.New(), BoardID: uuid.New(), ColumnID: todo, Title: "Task"}
+	require.NoError(t, task.MoveToColumn(done))
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "tasks"`).WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	mock.ExpectExec(`INSERT INTO "outbox" .+'task.moved'`).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectExec(`INSERT INTO "task_transitions" \("board_id", "from_column_id", "id", "occurred_at", "task_id", "to_column_id"\) ` +
+		`VALUES \('` + task.BoardID.String() + `', '` + todo.String() + `', .+'` + done.String() + `'\)`).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectCommit()
+
+	repo := &Repository{pool: mock}
+	require.NoError(t, repo.UpdateTask(context.Background(), task))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetTaskCompletions(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	boardID, taskID := uuid.New(), uuid.New()
+	from := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+	completedAt := from.Add(30 * time.Hour)
+	points := 5
+
+	mock.ExpectQuery(`SELECT t.id AS task_id, t.story_points, done.completed_at .+MIN\(tr.occurred_at\).+c.is_done`).
+		WithArgs(boardID, from, to).
+		WillReturnRows(pgxmock.NewRows([]string{"task_id", "story_points", "completed_at"}).
+			AddRow(taskID, &points, completedAt))
+
+	repo := &Repository{pool: mock}
+	completions, err := repo.GetTaskCompletions(context.Background(), boardID, from, to)
+	require.NoError(t, err)
+	assert.Equal(t, []domain.TaskCompletion{{TaskID: taskID, StoryPoints: &points, CompletedAt: completedAt}}, completions)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetTaskTransitions(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	boardID, taskID, todo, done := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	from := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+	created, moved := from.Add(-time.Hour), from.Add(time.Hour)
+	createdID, movedID := uuid.New(), uuid.New()
+
+	mock.ExpectQuery(`FROM task_transitions tr\s+JOIN tasks t .+tr.task_id IN`).
+		WithArgs(boardID, from, to).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "task_id", "board_id", "from_column_id", "to_column_id", "occurred_at"}).
+			AddRow(createdID, taskID, boardID, nil, todo, created).
+			AddRow(movedID, taskID, boardID, &todo, done, moved))
+
+	repo := &Repository{pool: mock}
+	transitions, err := repo.GetTaskTransitions(context.Background(), boardID, from, to)
+	require.NoError(t, err)
+	assert.Equal(t, []domain.TaskTransition{
+		{ID: createdID, TaskID: taskID, BoardID: boardID, ToColumnID: todo, OccurredAt: created},
+		{ID: movedID, TaskID: taskID, BoardID: boardID, FromColumnID: &todo, ToColumnID: done, OccurredAt: moved},
+	}, transitions)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
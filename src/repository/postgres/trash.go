@@ -0,0 +1,246 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+// GetBoardIncludingDeleted возвращает доску без колонок и задач, в том числе удаленную.
+func (r Repository) GetBoardIncludingDeleted(ctx context.Context, boardID uuid.UUID) (*domain.Board, error) {
+	const op = "postgres.GetBoardIncludingDeleted"
+
+	var board domain.Board
+	err := pgxscan.Get(ctx, r.pool, &board,
+		`SELECT id, name, short_name, created_at, updated_at, deleted_at, archived_at
+		FROM boards WHERE id = $1`, boardID)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	return &board, nil
+}
+
+func (r Repository) GetColumnIncludingDeleted(ctx context.Context, columnID uuid.UUID) (*domain.Column, error) {
+	const op = "postgres.GetColumnIncludingDeleted"
+
+	sql, params, err := goqu.From("columns").Where(goqu.C("id").Eq(columnID)).ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	var column ColumnRecord
+	err = pgxscan.Get(ctx, r.pool, &column, sql, params...)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	return column.toDomain()
+}
+
+func (r Repository) GetTaskIncludingDeleted(ctx context.Context, taskID uuid.UUID) (*domain.Task, error) {
+	const op = "postgres.GetTaskIncludingDeleted"
+
+	sql, params, err := goqu.From("tasks").Where(goqu.C("id").Eq(taskID)).ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	var task TaskRecord
+	err = pgxscan.Get(ctx, r.pool, &task, sql, params...)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	return task.toDomain()
+}
+
+// GetDeletedBoards - удаленные доски, последние удаленные сначала.
+func (r Repository) GetDeletedBoards(ctx context.Context) ([]domain.Board, error) {
+	const op = "postgres.GetDeletedBoards"
+
+	boards := make([]domain.Board, 0)
+	err := pgxscan.Select(ctx, r.pool, &boards,
+		`SELECT id, name, short_name, created_at, updated_at, deleted_at, archived_at
+		FROM boards
+		WHERE deleted_at IS NOT NULL
+		ORDER BY deleted_at DESC`)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	return boards, nil
+}
+
+// GetTrash возвращает удаленные колонки и задачи доски, последние удаленные сначала.
+func (r Repository) GetTrash(ctx context.Context, board *domain.Board) (*domain.Trash, error) {
+	const op = "postgres.GetTrash"
+
+	trash := domain.Trash{
+		Board:   *board,
+		Columns: make([]domain.Column, 0),
+		Tasks:   make([]domain.Task, 0),
+	}
+
+	err := pgxscan.Select(ctx, r.pool, &trash.Columns,
+		`SELECT id, board_id, order_num, name, is_done, wip_limit, created_at, updated_at, deleted_at
+		FROM columns
+		WHERE board_id = $1 AND deleted_at IS NOT NULL
+		ORDER BY deleted_at DESC, order_num`, board.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	err = pgxscan.Select(ctx, r.pool, &trash.Tasks,
+		`SELECT id, column_id, board_id, number, title, parent_id, created_at, updated_at, deleted_at
+		FROM tasks
+		WHERE board_id = $1 AND deleted_at IS NOT NULL
+		ORDER BY deleted_at DESC, number`, board.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	return &trash, nil
+}
+
+// RestoreBoard восстанавливает доску вместе с колонками и задачами, удаленными
+// в том же каскаде (UpdateBoard проставляет им тот же deleted_at, что и доске).
+func (r Repository) RestoreBoard(ctx context.Context, board *domain.Board, deletedAt time.Time) error {
+	const op = "postgres.RestoreBoard"
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx,
+		`UPDATE boards SET deleted_at = NULL, updated_at = $2
+		WHERE id = $1 AND deleted_at = $3`,
+		board.ID, board.UpdatedAt, deletedAt)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	for _, table := range []string{"columns", "tasks"} {
+		_, err = tx.Exec(ctx,
+			`UPDATE `+table+` SET deleted_at = NULL, updated_at = $2
+			WHERE board_id = $1 AND deleted_at = $3`,
+			board.ID, board.UpdatedAt, deletedAt)
+		if err != nil {
+			return errors.Wrap(err, op)
+		}
+	}
+
+	if err := insertOutbox(ctx, tx, board.Events()); err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return errors.Wrap(err, op)
+	}
+	board.ClearEvents()
+
+	return nil
+}
+
+func (r Repository) RestoreColumn(ctx context.Context, column *domain.Column) error {
+	const op = "postgres.RestoreColumn"
+
+	err := r.withOutbox(ctx, column.Events(), func(db execer) error {
+		tag, err := db.Exec(ctx,
+			`UPDATE columns SET deleted_at = NULL, updated_at = $2
+			WHERE id = $1 AND deleted_at IS NOT NULL`,
+			column.ID, column.UpdatedAt)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return pgx.ErrNoRows
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+	column.ClearEvents()
+
+	return nil
+}
+
+// RestoreTask восстанавливает задачу и подзадачи, удаленные каскадом вместе с ней.
+// Если родитель задачи все еще в корзине, задача становится корневой.
+// Возвращает количество восстановленных задач.
+func (r Repository) RestoreTask(ctx context.Context, task *domain.Task, deletedAt time.Time) (int64, error) {
+	const op = "postgres.RestoreTask"
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, op)
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx,
+		`WITH RECURSIVE subtree AS (
+			SELECT id FROM tasks WHERE id = $1 AND deleted_at = $3
+			UNION
+			SELECT t.id FROM tasks t JOIN subtree s ON t.parent_id = s.id
+			WHERE t.deleted_at = $3
+		)
+		UPDATE tasks SET deleted_at = NULL, updated_at = $2
+		WHERE id IN (SELECT id FROM subtree)`,
+		task.ID, task.UpdatedAt, deletedAt)
+	if err != nil {
+		return 0, errors.Wrap(err, op)
+	}
+
+	_, err = tx.Exec(ctx,
+		`UPDATE tasks t SET parent_id = NULL
+		FROM tasks p
+		WHERE t.id = $1 AND p.id = t.parent_id AND p.deleted_at IS NOT NULL`,
+		task.ID)
+	if err != nil {
+		return 0, errors.Wrap(err, op)
+	}
+
+	if err := insertOutbox(ctx, tx, task.Events()); err != nil {
+		return 0, errors.Wrap(err, op)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, errors.Wrap(err, op)
+	}
+	task.ClearEvents()
+
+	return tag.RowsAffected(), nil
+}
+
+func (r Repository) ArchiveBoard(ctx context.Context, board *domain.Board) error {
+	const op = "postgres.ArchiveBoard"
+
+	ds := goqu.Update("boards").Where(
+		goqu.C("id").Eq(board.ID),
+		goqu.C("deleted_at").IsNull(),
+	).Set(goqu.Record{
+		"archived_at": board.ArchivedAt,
+		"updated_at":  board.UpdatedAt,
+	})
+
+	sql, params, err := ds.ToSQL()
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	_, err = r.pool.Exec(ctx, sql, params...)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestoreBoard(t *testing.T) {
+	deletedAt := time.Now().UTC().Add(-time.Hour)
+
+	tests := []struct {
+		name        string
+		mockSetup   func(mock pgxmock.PgxPoolIface, board *domain.Board)
+		expectedErr error
+	}{
+		{
+			name: "доска восстанавливается вместе с каскадом и событием в outbox",
+			mockSetup: func(mock pgxmock.PgxPoolIface, board *domain.Board) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE boards SET deleted_at = NULL`).
+					WithArgs(board.ID, board.UpdatedAt, deletedAt).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectExec(`UPDATE columns SET deleted_at = NULL`).
+					WithArgs(board.ID, board.UpdatedAt, deletedAt).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 3))
+				mock.ExpectExec(`UPDATE tasks SET deleted_at = NULL`).
+					WithArgs(board.ID, board.UpdatedAt, deletedAt).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 10))
+				mock.ExpectExec(`INSERT INTO "outbox" .+'board.restored'`).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "ошибка восстановления задач откатывает транзакцию",
+			mockSetup: func(mock pgxmock.PgxPoolIface, board *domain.Board) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE boards`).
+					WithArgs(board.ID, board.UpdatedAt, deletedAt).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectExec(`UPDATE columns`).
+					WithArgs(board.ID, board.UpdatedAt, deletedAt).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 3))
+				mock.ExpectExec(`UPDATE tasks`).
+					WithArgs(board.ID, board.UpdatedAt, deletedAt).
+					WillReturnError(errors.New("tasks update error"))
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("tasks update error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			board := &domain.Board{ID: uuid.New(), DeletedAt: &deletedAt}
+			require.NoError(t, board.Restore())
+			tt.mockSetup(mock, board)
+
+			repo := &Repository{pool: mock}
+			err = repo.RestoreBoard(context.Background(), board, deletedAt)
+
+			if tt.expectedErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+				assert.Len(t, board.Events(), 1)
+			} else {
+				require.NoError(t, err)
+				assert.Empty(t, board.Events())
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRestoreColumn(t *testing.T) {
+	deletedAt := time.Now().UTC().Add(-time.Hour)
+
+	tests := []struct {
+		name        string
+		affected    int64
+		expectedErr error
+	}{
+		{
+			name:     "колонка восстанавливается с событием в outbox",
+			affected: 1,
+		},
+		{
+			name:        "колонка уже восстановлена или не найдена",
+			affected:    0,
+			expectedErr: pgx.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			column := &domain.Column{ID: uuid.New(), DeletedAt: &deletedAt}
+			require.NoError(t, column.Restore())
+
+			mock.ExpectBegin()
+			mock.ExpectExec(`UPDATE columns SET deleted_at = NULL`).
+				WithArgs(column.ID, column.UpdatedAt).
+				WillReturnResult(pgxmock.NewResult("UPDATE", tt.affected))
+			if tt.expectedErr != nil {
+				mock.ExpectRollback()
+			} else {
+				mock.ExpectExec(`INSERT INTO "outbox" .+'column.restored'`).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				mock.ExpectCommit()
+			}
+
+			repo := &Repository{pool: mock}
+			err = repo.RestoreColumn(context.Background(), column)
+
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Empty(t, column.Events())
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRestoreTask(t *testing.T) {
+	deletedAt := time.Now().UTC().Add(-time.Hour)
+	task := &domain.Task{ID: uuid.New(), DeletedAt: &deletedAt}
+	require.NoError(t, task.Restore())
+
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`WITH RECURSIVE subtree AS .+ UPDATE tasks SET deleted_at = NULL`).
+		WithArgs(task.ID, task.UpdatedAt, deletedAt).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 3))
+	mock.ExpectExec(`UPDATE tasks t SET parent_id = NULL`).
+		WithArgs(task.ID).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+	mock.ExpectExec(`INSERT INTO "outbox" .+'task.restored'`).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectCommit()
+
+	repo := &Repository{pool: mock}
+	restored, err := repo.RestoreTask(context.Background(), task, deletedAt)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), restored)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -23,6 +23,8 @@ func (r Repository) UpdateColumn(ctx context.Context, column *domain.Column) err
 			BoardID: column.BoardID,
 			Name:        column.Name,
 			OrderNum: column.OrderNum,
+			IsDone:   column.IsDone,
+			WipLimit: column.WipLimit,
 			UpdatedAt:   column.UpdatedAt,
 			DeletedAt: column.DeletedAt,
 		},
@@ -32,10 +34,14 @@ func (r Repository) UpdateColumn(ctx context.Context, column *domain.Column) err
 		return errors.Wrap(err, op)
 	}
 
-	_, err = r.pool.Exec(ctx, sql, params...)
+	err = r.withOutbox(ctx, column.Events(), func(db execer) error {
+		_, err := db.Exec(ctx, sql, params...)
+		return err
+	})
 	if err != nil {
 		return errors.Wrap(err, op)
 	}
+	column.ClearEvents()
 
 	return nil
 }
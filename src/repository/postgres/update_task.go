@@ -21,6 +21,12 @@ func (r Repository) UpdateTask(ctx context.Context, task *domain.Task) error {
 		return errors.Wrap(err, op)
 	}
 
+	var priority *string
+	if task.Priority != nil {
+		p := string(*task.Priority)
+		priority = &p
+	}
+
 	var tagsValue interface{}
 	if task.Tags != nil {
 		tagsValue = pq.StringArray(task.Tags)
@@ -33,15 +39,24 @@ func (r Repository) UpdateTask(ctx context.Context, task *domain.Task) error {
 		goqu.C("deleted_at").IsNull(),
 	).Set(
 		goqu.Record{
-			"board_id":    task.BoardID,
-			"column_id":   task.ColumnID,
-			"number":      task.Number,
-			"title":       task.Title,
-			"description": task.Description,
-			"tags":        tagsValue,
-			"checklists":  checklistsJSON,
-			"updated_at":  task.UpdatedAt,
-			"deleted_at":  task.DeletedAt,
+			"board_id":     task.BoardID,
+			"column_id":    task.ColumnID,
+			"number":       task.Number,
+			"title":        task.Title,
+			"description":  task.Description,
+			"tags":         tagsValue,
+			"checklists":   checklistsJSON,
+			"parent_id":    task.ParentID,
+			"milestone_id": task.MilestoneID,
+			"sprint_id":    task.SprintID,
+			"assignee":     task.Assignee,
+			"priority":     priority,
+			"lane":         task.Lane,
+			"original_estimate":  task.OriginalEstimate,
+			"remaining_estimate": task.RemainingEstimate,
+			"story_points":       task.StoryPoints,
+			"updated_at":   task.UpdatedAt,
+			"deleted_at":   task.DeletedAt,
 		},
 	)
 
@@ -50,10 +65,19 @@ func (r Repository) UpdateTask(ctx context.Context, task *domain.Task) error {
 		return errors.Wrap(err, op)
 	}
 
-	_, err = r.pool.Exec(ctx, sql, params...)
+	err = r.withOutbox(ctx, task.Events(), func(db execer) error {
+		if _, err := db.Exec(ctx, sql, params...); err != nil {
+			return err
+		}
+		if written, replace := descriptionWritten(task.Events()); written {
+			return saveDescriptionReferences(ctx, db, task, replace)
+		}
+		return nil
+	})
 	if err != nil {
 		return errors.Wrap(err, op)
 	}
+	task.ClearEvents()
 
 	return nil
 }
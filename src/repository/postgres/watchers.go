@@ -0,0 +1,98 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+)
+
+// AddWatchers подписывает пользователей, существующие подписки пропускаются.
+func (r Repository) AddWatchers(ctx context.Context, watchers []domain.Watcher) error {
+	const op = "postgres.AddWatchers"
+
+	if len(watchers) == 0 {
+		return nil
+	}
+
+	rows := make([]interface{}, 0, len(watchers))
+	for _, w := range watchers {
+		rows = append(rows, WatcherRecord{
+			UserID:    w.UserID,
+			TaskID:    w.TaskID,
+			BoardID:   w.BoardID,
+			CreatedAt: w.CreatedAt,
+		})
+	}
+
+	sql, params, err := goqu.Insert("watchers").Rows(rows...).
+		OnConflict(goqu.DoNothing()).ToSQL()
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	if _, err := r.pool.Exec(ctx, sql, params...); err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	return nil
+}
+
+// RemoveWatcher отписывает пользователя. Отсутствие подписки не ошибка.
+func (r Repository) RemoveWatcher(ctx context.Context, watcher domain.Watcher) error {
+	const op = "postgres.RemoveWatcher"
+
+	ds := goqu.Delete("watchers").Where(goqu.C("user_id").Eq(watcher.UserID))
+	if watcher.TaskID != nil {
+		ds = ds.Where(goqu.C("task_id").Eq(*watcher.TaskID))
+	} else {
+		ds = ds.Where(goqu.C("board_id").Eq(watcher.BoardID))
+	}
+
+	sql, params, err := ds.ToSQL()
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	if _, err := r.pool.Exec(ctx, sql, params...); err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	return nil
+}
+
+// GetTaskWatchers возвращает подписчиков задачи в порядке подписки.
+func (r Repository) GetTaskWatchers(ctx context.Context, taskID uuid.UUID) ([]domain.Watcher, error) {
+	return r.getWatchers(ctx, "postgres.GetTaskWatchers", goqu.C("task_id").Eq(taskID))
+}
+
+// GetBoardWatchers возвращает подписчиков доски в порядке подписки.
+func (r Repository) GetBoardWatchers(ctx context.Context, boardID uuid.UUID) ([]domain.Watcher, error) {
+	return r.getWatchers(ctx, "postgres.GetBoardWatchers", goqu.C("board_id").Eq(boardID))
+}
+
+func (r Repository) getWatchers(ctx context.Context, op string, where goqu.Expression) ([]domain.Watcher, error) {
+	sql, params, err := goqu.From("watchers").
+		Where(where).
+		Order(goqu.C("created_at").Asc(), goqu.C("user_id").Asc()).
+		ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	var records []WatcherRecord
+	if err := pgxscan.Select(ctx, r.pool, &records, sql, params...); err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	watchers := make([]domain.Watcher, 0, len(records))
+	for _, rec := range records {
+		watchers = append(watchers, rec.toDomain())
+	}
+
+	return watchers, nil
+}
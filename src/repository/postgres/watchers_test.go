@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddWatchers(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	taskID := uuid.New()
+	w, err := domain.NewTaskWatcher("alice", taskID)
+	require.NoError(t, err)
+
+	mock.ExpectExec(`INSERT INTO "watchers" \("board_id", "created_at", "task_id", "user_id"\) VALUES \(NULL, .+'` +
+		taskID.String() + `', 'alice'\) ON CONFLICT DO NOTHING`).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+	repo := &Repository{pool: mock}
+	require.NoError(t, repo.AddWatchers(context.Background(), []domain.Watcher{*w}))
+	require.NoError(t, repo.AddWatchers(context.Background(), nil))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRemoveWatcher(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	boardID := uuid.New()
+	w, err := domain.NewBoardWatcher("alice", boardID)
+	require.NoError(t, err)
+
+	mock.ExpectExec(`DELETE FROM "watchers" WHERE \(\("user_id" = 'alice'\) AND \("board_id" = '` + boardID.String() + `'\)\)`).
+		WillReturnResult(pgxmock.NewResult("DELETE", 0))
+
+	repo := &Repository{pool: mock}
+	require.NoError(t, repo.RemoveWatcher(context.Background(), *w))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetTaskWatchers(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	taskID := uuid.New()
+	now := time.Now().UTC()
+
+	mock.ExpectQuery(`SELECT \* FROM "watchers" WHERE \("task_id" = '` + taskID.String() + `'\) ORDER BY "created_at" ASC`).
+		WillReturnRows(pgxmock.NewRows([]string{"user_id", "task_id", "board_id", "created_at"}).
+			AddRow("alice", &taskID, nil, now).
+			AddRow("bob", &taskID, nil, now))
+
+	repo := &Repository{pool: mock}
+	watchers, err := repo.GetTaskWatchers(context.Background(), taskID)
+	require.NoError(t, err)
+	require.Len(t, watchers, 2)
+	assert.Equal(t, "alice", watchers[0].UserID)
+	assert.Equal(t, taskID, *watchers[1].TaskID)
+	assert.Nil(t, watchers[1].BoardID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
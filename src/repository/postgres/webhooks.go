@@ -0,0 +1,217 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+const webhookDeliveryColumns = `id, webhook_id, event_id, event_type, payload, status, attempts,
+	next_attempt_at, last_status_code, last_error, created_at, updated_at, delivered_at`
+
+func (r Repository) CreateWebhook(ctx context.Context, webhook *domain.Webhook) error {
+	const op = "postgres.CreateWebhook"
+
+	events := make([]string, 0, len(webhook.Events))
+	for _, e := range webhook.Events {
+		events = append(events, string(e))
+	}
+
+	_, err := r.pool.Exec(ctx,
+		`INSERT INTO webhooks (id, board_id, url, events, secret, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		webhook.ID, webhook.BoardID, webhook.URL, pq.StringArray(events), webhook.Secret,
+		webhook.CreatedAt, webhook.UpdatedAt)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	return nil
+}
+
+func (r Repository) GetWebhook(ctx context.Context, webhookID uuid.UUID) (*domain.Webhook, error) {
+	const op = "postgres.GetWebhook"
+
+	var rec WebhookRecord
+	err := pgxscan.Get(ctx, r.pool, &rec,
+		`SELECT id, board_id, url, events, secret, created_at, updated_at
+		FROM webhooks WHERE id = $1`, webhookID)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	webhook := rec.toDomain()
+	return &webhook, nil
+}
+
+// GetWebhooks возвращает подписки доски. Если задан event - только подписанные на него.
+func (r Repository) GetWebhooks(ctx context.Context, boardID uuid.UUID, event *domain.WebhookEventType) ([]domain.Webhook, error) {
+	const op = "postgres.GetWebhooks"
+
+	var eventName *string
+	if event != nil {
+		e := string(*event)
+		eventName = &e
+	}
+
+	var records []WebhookRecord
+	err := pgxscan.Select(ctx, r.pool, &records,
+		`SELECT id, board_id, url, events, secret, created_at, updated_at
+		FROM webhooks
+		WHERE board_id = $1 AND ($2::text IS NULL OR $2 = ANY(events))
+		ORDER BY created_at`, boardID, eventName)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	webhooks := make([]domain.Webhook, 0, len(records))
+	for _, rec := range records {
+		webhooks = append(webhooks, rec.toDomain())
+	}
+
+	return webhooks, nil
+}
+
+func (r Repository) DeleteWebhook(ctx context.Context, webhookID uuid.UUID) error {
+	const op = "postgres.DeleteWebhook"
+
+	tag, err := r.pool.Exec(ctx, `DELETE FROM webhooks WHERE id = $1`, webhookID)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Wrap(pgx.ErrNoRows, op)
+	}
+
+	return nil
+}
+
+// CreateWebhookDeliveries ставит доставки в очередь одной транзакцией.
+// Уже созданная доставка того же события тому же подписчику пропускается.
+func (r Repository) CreateWebhookDeliveries(ctx context.Context, deliveries []domain.WebhookDelivery) error {
+	const op = "postgres.CreateWebhookDeliveries"
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+	defer tx.Rollback(ctx)
+
+	for _, d := range deliveries {
+		_, err := tx.Exec(ctx,
+			`INSERT INTO webhook_deliveries
+			(id, webhook_id, event_id, event_type, payload, status, attempts, next_attempt_at, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+			ON CONFLICT (webhook_id, event_id) DO NOTHING`,
+			d.ID, d.WebhookID, d.EventID, string(d.EventType), d.Payload, string(d.Status), d.Attempts,
+			d.NextAttemptAt, d.CreatedAt, d.UpdatedAt)
+		if err != nil {
+			return errors.Wrap(err, op)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	return nil
+}
+
+// ClaimWebhookDeliveries забирает доставки, время которых пришло, и откладывает
+// их на lease: если отправитель упадет, доставка вернется в очередь сама.
+func (r Repository) ClaimWebhookDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]domain.WebhookDelivery, error) {
+	const op = "postgres.ClaimWebhookDeliveries"
+
+	var records []WebhookDeliveryRecord
+	err := pgxscan.Select(ctx, r.pool, &records,
+		`UPDATE webhook_deliveries SET next_attempt_at = $2
+		WHERE id IN (
+			SELECT id FROM webhook_deliveries
+			WHERE status = 'pending' AND next_attempt_at <= $1
+			ORDER BY next_attempt_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING `+webhookDeliveryColumns,
+		now, now.Add(lease), limit)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	deliveries := make([]domain.WebhookDelivery, 0, len(records))
+	for _, rec := range records {
+		deliveries = append(deliveries, rec.toDomain())
+	}
+
+	return deliveries, nil
+}
+
+func (r Repository) UpdateWebhookDelivery(ctx context.Context, d *domain.WebhookDelivery) error {
+	const op = "postgres.UpdateWebhookDelivery"
+
+	_, err := r.pool.Exec(ctx,
+		`UPDATE webhook_deliveries SET status = $2, attempts = $3, next_attempt_at = $4,
+			last_status_code = $5, last_error = $6, updated_at = $7, delivered_at = $8
+		WHERE id = $1`,
+		d.ID, string(d.Status), d.Attempts, d.NextAttemptAt, d.LastStatusCode, d.LastError,
+		d.UpdatedAt, d.DeliveredAt)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	return nil
+}
+
+func (r Repository) GetWebhookDelivery(ctx context.Context, deliveryID uuid.UUID) (*domain.WebhookDelivery, error) {
+	const op = "postgres.GetWebhookDelivery"
+
+	var rec WebhookDeliveryRecord
+	err := pgxscan.Get(ctx, r.pool, &rec,
+		`SELECT `+webhookDeliveryColumns+` FROM webhook_deliveries WHERE id = $1`, deliveryID)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	delivery := rec.toDomain()
+	return &delivery, nil
+}
+
+// GetWebhookDeliveries - журнал доставок подписки, последние сначала.
+func (r Repository) GetWebhookDeliveries(
+	ctx context.Context,
+	webhookID uuid.UUID,
+	status *domain.WebhookDeliveryStatus,
+	limit uint,
+) ([]domain.WebhookDelivery, error) {
+	const op = "postgres.GetWebhookDeliveries"
+
+	var statusName *string
+	if status != nil {
+		s := string(*status)
+		statusName = &s
+	}
+
+	var records []WebhookDeliveryRecord
+	err := pgxscan.Select(ctx, r.pool, &records,
+		`SELECT `+webhookDeliveryColumns+`
+		FROM webhook_deliveries
+		WHERE webhook_id = $1 AND ($2::text IS NULL OR status = $2)
+		ORDER BY created_at DESC
+		LIMIT $3`, webhookID, statusName, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	deliveries := make([]domain.WebhookDelivery, 0, len(records))
+	for _, rec := range records {
+		deliveries = append(deliveries, rec.toDomain())
+	}
+
+	return deliveries, nil
+}
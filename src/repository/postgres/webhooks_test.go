@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClaimWebhookDeliveries(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	now := time.Now().UTC()
+	deliveryID := uuid.New()
+	webhookID := uuid.New()
+
+	mock.ExpectQuery(`UPDATE webhook_deliveries SET next_attempt_at = \$2 .+ FOR UPDATE SKIP LOCKED`).
+		WithArgs(now, now.Add(time.Minute), 10).
+		WillReturnRows(pgxmock.NewRows([]string{
+			"id", "webhook_id", "event_id", "event_type", "payload", "status", "attempts",
+			"next_attempt_at", "last_status_code", "last_error", "created_at", "updated_at", "delivered_at",
+		}).AddRow(deliveryID, webhookID, uuid.New(), "task.moved", []byte(`{}`), "pending", 2,
+			&now, nil, nil, now, now, nil))
+
+	repo := &Repository{pool: mock}
+	deliveries, err := repo.ClaimWebhookDeliveries(context.Background(), now, time.Minute, 10)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, deliveryID, deliveries[0].ID)
+	assert.Equal(t, domain.WebhookDeliveryPending, deliveries[0].Status)
+	assert.Equal(t, 2, deliveries[0].Attempts)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateWebhookDeliveries(t *testing.T) {
+	task, err := domain.NewTask(uuid.New(), uuid.New(), 1, "Fix login", nil, nil, nil)
+	require.NoError(t, err)
+	event, ok := domain.WebhookEventFromDomain(task.Events()[0])
+	require.True(t, ok)
+	first := domain.NewWebhookDelivery(uuid.New(), event, []byte(`{}`))
+	second := domain.NewWebhookDelivery(uuid.New(), event, []byte(`{}`))
+
+	args := func(d domain.WebhookDelivery) []any {
+		return []any{d.ID, d.WebhookID, d.EventID, "task.created", d.Payload, "pending", 0,
+			d.NextAttemptAt, d.CreatedAt, d.UpdatedAt}
+	}
+
+	t.Run("все доставки в одной транзакции", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`INSERT INTO webhook_deliveries .+ON CONFLICT \(webhook_id, event_id\) DO NOTHING`).WithArgs(args(first)...).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		mock.ExpectExec(`INSERT INTO webhook_deliveries`).WithArgs(args(second)...).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		mock.ExpectCommit()
+
+		repo := &Repository{pool: mock}
+		require.NoError(t, repo.CreateWebhookDeliveries(context.Background(), []domain.WebhookDelivery{first, second}))
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ошибка откатывает все", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`INSERT INTO webhook_deliveries`).WithArgs(args(first)...).
+			WillReturnError(errors.New("insert error"))
+		mock.ExpectRollback()
+
+		repo := &Repository{pool: mock}
+		err = repo.CreateWebhookDeliveries(context.Background(), []domain.WebhookDelivery{first, second})
+		assert.ErrorContains(t, err, "insert error")
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,207 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+)
+
+// CreateWorklog сохраняет запись и уменьшает оставшуюся оценку задачи
+// на ее время, но не ниже нуля.
+func (r Repository) CreateWorklog(ctx context.Context, worklog *domain.Worklog) error {
+	const op = "postgres.CreateWorklog"
+
+	sql, params, err := goqu.Insert("worklogs").Rows(WorklogRecord{
+		ID:        worklog.ID,
+		TaskID:    worklog.TaskID,
+		UserID:    worklog.UserID,
+		Minutes:   worklog.Minutes,
+		Date:      worklog.Date,
+		Note:      worklog.Note,
+		CreatedAt: worklog.CreatedAt,
+		UpdatedAt: worklog.UpdatedAt,
+	}).ToSQL()
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	if err := r.writeWorklog(ctx, sql, params, worklog.TaskID, worklog.Minutes); err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	return nil
+}
+
+// UpdateWorklog сохраняет измененную запись, оставшаяся оценка задачи
+// меняется на разницу с прежним временем.
+func (r Repository) UpdateWorklog(ctx context.Context, worklog *domain.Worklog, prevMinutes int) error {
+	const op = "postgres.UpdateWorklog"
+
+	sql, params, err := goqu.Update("worklogs").
+		Where(goqu.C("id").Eq(worklog.ID)).
+		Set(goqu.Record{
+			"minutes":    worklog.Minutes,
+			"date":       worklog.Date,
+			"note":       worklog.Note,
+			"updated_at": worklog.UpdatedAt,
+		}).ToSQL()
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	if err := r.writeWorklog(ctx, sql, params, worklog.TaskID, worklog.Minutes-prevMinutes); err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	return nil
+}
+
+// DeleteWorklog удаляет запись и возвращает ее время в оставшуюся оценку.
+func (r Repository) DeleteWorklog(ctx context.Context, worklog *domain.Worklog) error {
+	const op = "postgres.DeleteWorklog"
+
+	sql, params, err := goqu.Delete("worklogs").
+		Where(goqu.C("id").Eq(worklog.ID)).ToSQL()
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	if err := r.writeWorklog(ctx, sql, params, worklog.TaskID, -worklog.Minutes); err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	return nil
+}
+
+// writeWorklog выполняет запрос к worklogs и списывает spent минут
+// с оставшейся оценки задачи одной транзакцией.
+func (r Repository) writeWorklog(ctx context.Context, sql string, params []interface{}, taskID uuid.UUID, spent int) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, sql, params...); err != nil {
+		return err
+	}
+
+	if spent != 0 {
+		sqlTask, paramsTask, err := goqu.Update("tasks").
+			Where(
+				goqu.C("id").Eq(taskID),
+				goqu.C("remaining_estimate").IsNotNull(),
+			).
+			Set(goqu.Record{
+				"remaining_estimate": goqu.L("GREATEST(remaining_estimate - ?, 0)", spent),
+			}).ToSQL()
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(ctx, sqlTask, paramsTask...); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// GetWorklogs возвращает записи задачи по дням работы.
+func (r Repository) GetWorklogs(ctx context.Context, taskID uuid.UUID) ([]domain.Worklog, error) {
+	const op = "postgres.GetWorklogs"
+
+	sql, params, err := goqu.From("worklogs").
+		Where(goqu.C("task_id").Eq(taskID)).
+		Order(goqu.C("date").Asc(), goqu.C("created_at").Asc()).
+		ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	var records []WorklogRecord
+	if err := pgxscan.Select(ctx, r.pool, &records, sql, params...); err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	worklogs := make([]domain.Worklog, 0, len(records))
+	for _, rec := range records {
+		worklogs = append(worklogs, rec.toDomain())
+	}
+
+	return worklogs, nil
+}
+
+func (r Repository) GetWorklog(ctx context.Context, taskID, worklogID uuid.UUID) (*domain.Worklog, error) {
+	const op = "postgres.GetWorklog"
+
+	sql, params, err := goqu.From("worklogs").
+		Where(
+			goqu.C("id").Eq(worklogID),
+			goqu.C("task_id").Eq(taskID),
+		).ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	var rec WorklogRecord
+	if err := pgxscan.Get(ctx, r.pool, &rec, sql, params...); err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	worklog := rec.toDomain()
+	return &worklog, nil
+}
+
+// GetTimeReportEntries возвращает записи за период вместе с задачами.
+// Время удаленных задач в отчет не попадает.
+func (r Repository) GetTimeReportEntries(ctx context.Context, filter domain.TimeReportFilter) ([]domain.TimeReportEntry, error) {
+	const op = "postgres.GetTimeReportEntries"
+
+	where := []exp.Expression{
+		goqu.I("w.date").Gte(filter.From.Format("2006-01-02")),
+		goqu.I("w.date").Lte(filter.To.Format("2006-01-02")),
+		goqu.I("t.deleted_at").IsNull(),
+	}
+	if filter.BoardID != nil {
+		where = append(where, goqu.I("t.board_id").Eq(*filter.BoardID))
+	}
+	if filter.UserID != nil {
+		where = append(where, goqu.I("w.user_id").Eq(*filter.UserID))
+	}
+
+	sql, params, err := goqu.From(goqu.T("worklogs").As("w")).
+		Join(goqu.T("tasks").As("t"), goqu.On(goqu.I("t.id").Eq(goqu.I("w.task_id")))).
+		Join(goqu.T("boards").As("b"), goqu.On(goqu.I("b.id").Eq(goqu.I("t.board_id")))).
+		Select(
+			goqu.I("w.*"),
+			goqu.I("t.board_id"),
+			goqu.I("b.short_name"),
+			goqu.I("t.number"),
+			goqu.I("t.title"),
+		).
+		Where(where...).
+		Order(goqu.I("w.date").Asc(), goqu.I("w.user_id").Asc(), goqu.I("w.created_at").Asc()).
+		ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	var records []TimeReportRecord
+	if err := pgxscan.Select(ctx, r.pool, &records, sql, params...); err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	entries := make([]domain.TimeReportEntry, 0, len(records))
+	for _, rec := range records {
+		entries = append(entries, rec.toDomain())
+	}
+
+	return entries, nil
+}
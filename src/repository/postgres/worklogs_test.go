@@ -0,0 +1,97 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateWorklog(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	w, err := domain.NewWorklog(uuid.New(), "alice", 90, time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC), nil)
+	require.NoError(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT INTO "worklogs" \("created_at", "date", "id", "minutes", "note", "task_id", "updated_at", "user_id"\) ` +
+		`VALUES \(.+, '2025-03-10T00:00:00Z', '` + w.ID.String() + `', 90, NULL, '` + w.TaskID.String() + `', .+, 'alice'\)`).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectExec(`UPDATE "tasks" SET "remaining_estimate"=GREATEST\(remaining_estimate - 90, 0\) ` +
+		`WHERE \(\("id" = '` + w.TaskID.String() + `'\) AND \("remaining_estimate" IS NOT NULL\)\)`).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	mock.ExpectCommit()
+
+	repo := &Repository{pool: mock}
+	require.NoError(t, repo.CreateWorklog(context.Background(), w))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateWorklog(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	w, err := domain.NewWorklog(uuid.New(), "alice", 60, time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC), nil)
+	require.NoError(t, err)
+
+	repo := &Repository{pool: mock}
+
+	// время уменьшилось на 30 минут - они возвращаются в оценку
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "worklogs" SET .*"minutes"=60.* WHERE \("id" = '` + w.ID.String() + `'\)`).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	mock.ExpectExec(`UPDATE "tasks" SET "remaining_estimate"=GREATEST\(remaining_estimate - -30, 0\)`).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	mock.ExpectCommit()
+	require.NoError(t, repo.UpdateWorklog(context.Background(), w, 90))
+
+	// время не изменилось - задача не трогается
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "worklogs"`).WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	mock.ExpectCommit()
+	require.NoError(t, repo.UpdateWorklog(context.Background(), w, 60))
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetTimeReportEntries(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	boardID := uuid.New()
+	user := "alice"
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	filter, err := domain.NewTimeReportFilter(&boardID, &user, from, from.AddDate(0, 0, 30))
+	require.NoError(t, err)
+
+	taskID := uuid.New()
+	now := time.Now().UTC()
+	mock.ExpectQuery(`SELECT "w".\*, "t"."board_id", "b"."short_name", "t"."number", "t"."title" FROM "worklogs" AS "w" ` +
+		`INNER JOIN "tasks" AS "t" ON \("t"."id" = "w"."task_id"\) INNER JOIN "boards" AS "b" ON \("b"."id" = "t"."board_id"\) ` +
+		`WHERE \(\("w"."date" >= '2025-03-01'\) AND \("w"."date" <= '2025-03-31'\) AND \("t"."deleted_at" IS NULL\) ` +
+		`AND \("t"."board_id" = '` + boardID.String() + `'\) AND \("w"."user_id" = 'alice'\)\) ` +
+		`ORDER BY "w"."date" ASC, "w"."user_id" ASC, "w"."created_at" ASC`).
+		WillReturnRows(pgxmock.NewRows([]string{
+			"id", "task_id", "user_id", "minutes", "date", "note", "created_at", "updated_at",
+			"board_id", "short_name", "number", "title",
+		}).AddRow(uuid.New(), taskID, "alice", 45, from, nil, now, now, boardID, "TEAM", int64(7), "Invoice"))
+
+	repo := &Repository{pool: mock}
+	entries, err := repo.GetTimeReportEntries(context.Background(), filter)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "TEAM-7", entries[0].TaskKey)
+	assert.Equal(t, "Invoice", entries[0].TaskTitle)
+	assert.Equal(t, taskID, entries[0].TaskID)
+	assert.Equal(t, 45, entries[0].Minutes)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
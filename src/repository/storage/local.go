@@ -0,0 +1,108 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+// Local хранит объекты файлами в каталоге, ключ - относительный путь.
+type Local struct {
+	dir string
+}
+
+func NewLocal(dir string) (*Local, error) {
+	const op = "storage.NewLocal"
+
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	return &Local{dir: dir}, nil
+}
+
+// Put пишет во временный файл и переименовывает его, чтобы читатели
+// не увидели объект, записанный наполовину.
+func (l *Local) Put(ctx context.Context, key string, content io.Reader, size int64, _ string) error {
+	const op = "storage.Local.Put"
+
+	path, err := l.path(key)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+	defer os.Remove(tmp.Name())
+
+	n, err := io.Copy(tmp, content)
+	if err == nil && n != size {
+		err = errors.Errorf("wrote %d bytes, expected %d", n, size)
+	}
+	if err == nil {
+		err = ctx.Err()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	return nil
+}
+
+func (l *Local) Open(_ context.Context, key string) (io.ReadSeekCloser, error) {
+	const op = "storage.Local.Open"
+
+	path, err := l.path(key)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	f, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, errors.Wrap(ErrNotFound, op)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	return f, nil
+}
+
+// Delete удаляет объект, отсутствие объекта не ошибка.
+func (l *Local) Delete(_ context.Context, key string) error {
+	const op = "storage.Local.Delete"
+
+	path, err := l.path(key)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return errors.Wrap(err, op)
+	}
+
+	return nil
+}
+
+func (l *Local) path(key string) (string, error) {
+	rel := filepath.FromSlash(key)
+	if !filepath.IsLocal(rel) {
+		return "", errInvalidKey
+	}
+	return filepath.Join(l.dir, rel), nil
+}
@@ -0,0 +1,236 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type S3Config struct {
+	// Endpoint - адрес хранилища со схемой, например https://s3.amazonaws.com
+	// или http://localhost:9000 для MinIO
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	// PathStyle - бакет в пути (endpoint/bucket/key), а не в имени хоста.
+	// Большинство S3-совместимых хранилищ поддерживают только такой вариант
+	PathStyle bool
+	Timeout   time.Duration
+}
+
+// S3 хранит объекты в S3-совместимом хранилище. Запросы подписываются
+// AWS Signature V4, тело PUT не хэшируется (UNSIGNED-PAYLOAD), чтобы
+// загружать файлы потоком.
+type S3 struct {
+	cfg    S3Config
+	base   *url.URL
+	client *http.Client
+}
+
+func NewS3(cfg S3Config) (*S3, error) {
+	const op = "storage.NewS3"
+
+	base, err := url.Parse(strings.TrimSuffix(cfg.Endpoint, "/"))
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	if base.Scheme == "" || base.Host == "" {
+		return nil, errors.Wrap(errors.Errorf("endpoint %q must include scheme and host", cfg.Endpoint), op)
+	}
+	if cfg.Bucket == "" {
+		return nil, errors.Wrap(errors.New("bucket is required"), op)
+	}
+	if !cfg.PathStyle {
+		base.Host = cfg.Bucket + "." + base.Host
+	}
+
+	return &S3{cfg: cfg, base: base, client: &http.Client{Timeout: cfg.Timeout}}, nil
+}
+
+func (s *S3) Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error {
+	const op = "storage.S3.Put"
+
+	req, err := s.newRequest(ctx, http.MethodPut, key, io.NopCloser(content))
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+	req.ContentLength = size
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := s.do(req, unsignedPayload)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.Wrap(statusError(resp), op)
+	}
+
+	return nil
+}
+
+// Open проверяет, что объект есть, и возвращает читателя, который скачивает
+// содержимое диапазонами с текущей позиции - так http.ServeContent
+// отдает Range-запросы без загрузки всего объекта.
+func (s *S3) Open(ctx context.Context, key string) (io.ReadSeekCloser, error) {
+	const op = "storage.S3.Open"
+
+	req, err := s.newRequest(ctx, http.MethodHead, key, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	resp, err := s.do(req, emptyPayload)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, errors.Wrap(ErrNotFound, op)
+	case resp.StatusCode != http.StatusOK:
+		return nil, errors.Wrap(statusError(resp), op)
+	case resp.ContentLength < 0:
+		return nil, errors.Wrap(errors.New("object size is unknown"), op)
+	}
+
+	return &s3Object{s: s, ctx: ctx, key: key, size: resp.ContentLength}, nil
+}
+
+// Delete удаляет объект, отсутствие объекта не ошибка.
+func (s *S3) Delete(ctx context.Context, key string) error {
+	const op = "storage.S3.Delete"
+
+	req, err := s.newRequest(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	resp, err := s.do(req, emptyPayload)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK &&
+		resp.StatusCode != http.StatusNotFound {
+		return errors.Wrap(statusError(resp), op)
+	}
+
+	return nil
+}
+
+func (s *S3) newRequest(ctx context.Context, method, key string, body io.Reader) (*http.Request, error) {
+	if key == "" || strings.HasPrefix(key, "/") {
+		return nil, errInvalidKey
+	}
+
+	u := *s.base
+	if s.cfg.PathStyle {
+		u.Path += "/" + s.cfg.Bucket + "/" + key
+	} else {
+		u.Path += "/" + key
+	}
+	u.RawPath = escapePath(u.Path)
+
+	return http.NewRequestWithContext(ctx, method, u.String(), body)
+}
+
+func (s *S3) do(req *http.Request, payloadHash string) (*http.Response, error) {
+	signV4(req, s.cfg.AccessKey, s.cfg.SecretKey, s.cfg.Region, payloadHash, time.Now())
+	return s.client.Do(req)
+}
+
+func statusError(resp *http.Response) error {
+	// S3 присылает XML с кодом ошибки, для лога достаточно начала
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	return errors.Errorf("unexpected status %s: %s", resp.Status, strings.TrimSpace(string(body)))
+}
+
+// s3Object читает объект GET-запросами с заголовком Range. Тело ответа
+// держится открытым, пока чтение идет подряд, и закрывается при Seek.
+type s3Object struct {
+	s    *S3
+	ctx  context.Context
+	key  string
+	size int64
+	pos  int64
+	body io.ReadCloser
+}
+
+func (o *s3Object) Read(p []byte) (int, error) {
+	if o.pos >= o.size {
+		return 0, io.EOF
+	}
+
+	if o.body == nil {
+		req, err := o.s.newRequest(o.ctx, http.MethodGet, o.key, nil)
+		if err != nil {
+			return 0, err
+		}
+		req.Header.Set("Range", "bytes="+strconv.FormatInt(o.pos, 10)+"-")
+
+		resp, err := o.s.do(req, emptyPayload)
+		if err != nil {
+			return 0, err
+		}
+		if resp.StatusCode != http.StatusPartialContent {
+			err := statusError(resp)
+			resp.Body.Close()
+			return 0, err
+		}
+		o.body = resp.Body
+	}
+
+	n, err := o.body.Read(p)
+	o.pos += int64(n)
+	if err == io.EOF && o.pos < o.size {
+		err = io.ErrUnexpectedEOF
+	}
+	return n, err
+}
+
+func (o *s3Object) Seek(offset int64, whence int) (int64, error) {
+	var pos int64
+	switch whence {
+	case io.SeekStart:
+		pos = offset
+	case io.SeekCurrent:
+		pos = o.pos + offset
+	case io.SeekEnd:
+		pos = o.size + offset
+	default:
+		return 0, errors.New("invalid whence")
+	}
+	if pos < 0 {
+		return 0, errors.New("negative position")
+	}
+
+	if pos != o.pos && o.body != nil {
+		o.body.Close()
+		o.body = nil
+	}
+	o.pos = pos
+	return pos, nil
+}
+
+func (o *s3Object) Close() error {
+	if o.body == nil {
+		return nil
+	}
+	err := o.body.Close()
+	o.body = nil
+	return err
+}
@@ -0,0 +1,116 @@
+// Package s3test - S3-совместимый сервер в памяти для тестов, по аналогии
+// с httptest. Поддерживает path-style PUT, GET с Range, HEAD и DELETE.
+package s3test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	AccessKey = "TESTACCESSKEY"
+	SecretKey = "testsecretkey"
+	Region    = "us-east-1"
+)
+
+// Object - сохраненный сервером объект.
+type Object struct {
+	Data        []byte
+	ContentType string
+}
+
+// Server хранит объекты одного бакета и отклоняет запросы без подписи
+// с ключом AccessKey. Подпись не проверяется, только ее наличие.
+type Server struct {
+	URL    string
+	Bucket string
+
+	srv      *httptest.Server
+	mu       sync.Mutex
+	objects  map[string]Object
+	requests []string
+}
+
+func NewServer(t testing.TB, bucket string) *Server {
+	t.Helper()
+
+	s := &Server{Bucket: bucket, objects: make(map[string]Object)}
+	s.srv = httptest.NewServer(http.HandlerFunc(s.handle))
+	s.URL = s.srv.URL
+	t.Cleanup(s.srv.Close)
+
+	return s
+}
+
+// Object возвращает объект по ключу.
+func (s *Server) Object(key string) (Object, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	obj, ok := s.objects[key]
+	return obj, ok
+}
+
+// Requests возвращает принятые запросы в виде "METHOD key [range]".
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string(nil), s.requests...)
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential="+AccessKey+"/") ||
+		r.Header.Get("X-Amz-Date") == "" || r.Header.Get("X-Amz-Content-Sha256") == "" {
+		http.Error(w, "AccessDenied", http.StatusForbidden)
+		return
+	}
+
+	key, ok := strings.CutPrefix(r.URL.Path, "/"+s.Bucket+"/")
+	if !ok || key == "" {
+		http.Error(w, "NoSuchBucket", http.StatusNotFound)
+		return
+	}
+
+	s.mu.Lock()
+	s.requests = append(s.requests, strings.TrimSpace(r.Method+" "+key+" "+r.Header.Get("Range")))
+	obj, exists := s.objects[key]
+	s.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodPut:
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if r.ContentLength >= 0 && int64(len(data)) != r.ContentLength {
+			http.Error(w, "IncompleteBody", http.StatusBadRequest)
+			return
+		}
+		s.mu.Lock()
+		s.objects[key] = Object{Data: data, ContentType: r.Header.Get("Content-Type")}
+		s.mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	case http.MethodGet, http.MethodHead:
+		if !exists {
+			http.Error(w, "NoSuchKey", http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", obj.ContentType)
+		http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(obj.Data))
+	case http.MethodDelete:
+		s.mu.Lock()
+		delete(s.objects, key)
+		s.mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.Error(w, "MethodNotAllowed", http.StatusMethodNotAllowed)
+	}
+}
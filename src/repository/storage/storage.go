@@ -0,0 +1,15 @@
+// Package storage хранит содержимое вложений: на локальном диске или
+// в S3-совместимом хранилище. Ключи объектов - пути через "/".
+package storage
+
+import (
+	"io/fs"
+
+	"github.com/pkg/errors"
+)
+
+// ErrNotFound оборачивает fs.ErrNotExist, чтобы вызывающий код мог проверять
+// отсутствие объекта без зависимости от конкретного хранилища.
+var ErrNotFound = errors.Wrap(fs.ErrNotExist, "object not found")
+
+var errInvalidKey = errors.New("invalid object key")
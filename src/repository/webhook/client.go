@@ -0,0 +1,94 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/pkg/errors"
+)
+
+const (
+	EventHeader     = "X-Teamboard-Event"
+	DeliveryHeader  = "X-Teamboard-Delivery"
+	SignatureHeader = "X-Teamboard-Signature"
+	userAgent       = "teamboard-webhooks/1.0"
+	// Сколько тела ответа получателя попадает в журнал доставок
+	maxResponseSnippet = 512
+)
+
+var ErrAddressNotAllowed = errors.New("webhook address is not public")
+
+// Client доставляет события подписчикам по HTTP.
+type Client struct {
+	http *http.Client
+}
+
+// New создает клиент, который соединяется только с публичными адресами:
+// имя из URL подписки могут разрешить во внутреннюю сеть уже после проверки при создании.
+func New(timeout time.Duration) *Client {
+	dialer := &net.Dialer{Timeout: timeout, Control: denyNonPublic}
+	return &Client{
+		http: &http.Client{
+			Timeout: timeout,
+			// без прокси из окружения: проверяться должен адрес самого получателя
+			Transport: &http.Transport{
+				DialContext:         dialer.DialContext,
+				TLSHandshakeTimeout: timeout,
+				MaxIdleConns:        100,
+				IdleConnTimeout:     90 * time.Second,
+			},
+		},
+	}
+}
+
+// denyNonPublic вызывается перед каждым соединением с уже разрешенным адресом,
+// в том числе при редиректах.
+func denyNonPublic(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !domain.PublicWebhookIP(ip) {
+		return errors.Wrap(ErrAddressNotAllowed, address)
+	}
+	return nil
+}
+
+// Send отправляет тело события POST-запросом с подписью HMAC-SHA256.
+// Успехом считается любой ответ 2xx.
+func (c *Client) Send(ctx context.Context, webhook *domain.Webhook, delivery *domain.WebhookDelivery) (int, error) {
+	const op = "webhook.Client.Send"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, errors.Wrap(err, op)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set(EventHeader, string(delivery.EventType))
+	req.Header.Set(DeliveryHeader, delivery.ID.String())
+	req.Header.Set(SignatureHeader, domain.SignWebhookPayload(webhook.Secret, delivery.Payload))
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return 0, errors.Wrap(err, op)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		snippet, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseSnippet))
+		return resp.StatusCode, fmt.Errorf("unexpected status %d: %s", resp.StatusCode, snippet)
+	}
+	// дочитываем тело, чтобы соединение вернулось в пул
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,82 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientSend(t *testing.T) {
+	hook := &domain.Webhook{ID: uuid.New(), URL: "", Secret: "0123456789abcdef"}
+	task, err := domain.NewTask(uuid.New(), uuid.New(), 1, "Fix login", nil, nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, task.MoveToColumn(uuid.New()))
+	event, ok := domain.WebhookEventFromDomain(task.Events()[1])
+	require.True(t, ok)
+	payload, err := event.Payload()
+	require.NoError(t, err)
+	delivery := domain.NewWebhookDelivery(hook.ID, event, payload)
+
+	t.Run("подписанный запрос, ответ 2xx", func(t *testing.T) {
+		var got *http.Request
+		var body []byte
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got = r
+			body, _ = io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer srv.Close()
+
+		hook.URL = srv.URL + "/hook"
+		client := &Client{http: srv.Client()}
+		status, err := client.Send(context.Background(), hook, &delivery)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, status)
+
+		require.NotNil(t, got)
+		assert.Equal(t, http.MethodPost, got.Method)
+		assert.Equal(t, "/hook", got.URL.Path)
+		assert.Equal(t, "application/json", got.Header.Get("Content-Type"))
+		assert.Equal(t, string(domain.WebhookTaskMoved), got.Header.Get(EventHeader))
+		assert.Equal(t, delivery.ID.String(), got.Header.Get(DeliveryHeader))
+		assert.Equal(t, domain.SignWebhookPayload(hook.Secret, payload), got.Header.Get(SignatureHeader))
+		assert.JSONEq(t, string(payload), string(body))
+	})
+
+	t.Run("ответ не 2xx - ошибка с началом тела", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+			_, _ = w.Write([]byte("upstream is down"))
+		}))
+		defer srv.Close()
+
+		hook.URL = srv.URL
+		client := &Client{http: srv.Client()}
+		status, err := client.Send(context.Background(), hook, &delivery)
+		require.Error(t, err)
+		assert.Equal(t, http.StatusBadGateway, status)
+		assert.ErrorContains(t, err, "upstream is down")
+	})
+
+	t.Run("внутренний адрес не запрашивается", func(t *testing.T) {
+		called := false
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+		}))
+		defer srv.Close()
+
+		hook.URL = srv.URL
+		status, err := New(time.Second).Send(context.Background(), hook, &delivery)
+		assert.ErrorIs(t, err, ErrAddressNotAllowed)
+		assert.Zero(t, status)
+		assert.False(t, called)
+	})
+}
@@ -0,0 +1,13 @@
+package addsprinttask
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidUUID          = errors.New("invalid uuid")
+	ErrSprintNotFound       = errors.New("sprint not found")
+	ErrTaskNotFound         = errors.New("task not found")
+	ErrInvalidSprint        = errors.New("task can't be added to the sprint")
+	ErrAddSprintTaskUnknown = errors.New("unknown error adding task to sprint")
+)
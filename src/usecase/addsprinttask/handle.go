@@ -0,0 +1,56 @@
+package addsprinttask
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetSprintByID(ctx context.Context, sprintID uuid.UUID) (*domain.Sprint, error)
+	GetTaskByID(ctx context.Context, taskID uuid.UUID) (*domain.Task, error)
+	UpdateTask(ctx context.Context, task *domain.Task) error
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+func (uc *UC) Handle(ctx context.Context, cmd Command) (*domain.Task, error) {
+	sprint, err := uc.repo.GetSprintByID(ctx, cmd.SprintID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrSprintNotFound
+		}
+		return nil, errors.Wrap(ErrAddSprintTaskUnknown, err.Error())
+	}
+
+	task, err := uc.repo.GetTaskByID(ctx, cmd.TaskID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrTaskNotFound
+		}
+		return nil, errors.Wrap(ErrAddSprintTaskUnknown, err.Error())
+	}
+
+	err = task.AddToSprint(sprint)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidSprint, err.Error())
+	}
+
+	err = uc.repo.UpdateTask(ctx, task)
+	if err != nil {
+		return nil, errors.Wrap(ErrAddSprintTaskUnknown, err.Error())
+	}
+
+	return task, nil
+}
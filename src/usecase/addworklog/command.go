@@ -0,0 +1,36 @@
+package addworklog
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type Command struct {
+	TaskID  uuid.UUID
+	UserID  string
+	Minutes int
+	Date    time.Time
+	Note    *string
+}
+
+func NewCommand(taskID, userID string, minutes int, date string, note *string) (Command, error) {
+	tID, err := uuid.Parse(taskID)
+	if err != nil {
+		return Command{}, ErrInvalidTaskID
+	}
+
+	d, err := time.Parse(time.DateOnly, date)
+	if err != nil {
+		return Command{}, errors.Wrap(ErrValidationFailed, err.Error())
+	}
+
+	return Command{
+		TaskID:  tID,
+		UserID:  userID,
+		Minutes: minutes,
+		Date:    d,
+		Note:    note,
+	}, nil
+}
@@ -0,0 +1,12 @@
+package addworklog
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidTaskID     = errors.New("invalid task id")
+	ErrValidationFailed  = errors.New("validation failed")
+	ErrTaskNotFound      = errors.New("task not found")
+	ErrAddWorklogUnknown = errors.New("unknown error adding worklog")
+)
@@ -0,0 +1,48 @@
+package addworklog
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetTaskByID(ctx context.Context, taskID uuid.UUID) (*domain.Task, error)
+	CreateWorklog(ctx context.Context, worklog *domain.Worklog) error
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+// Handle списывает время на задачу. Оставшаяся оценка задачи уменьшается
+// в репозитории той же транзакцией.
+func (uc *UC) Handle(ctx context.Context, cmd Command) (*domain.Worklog, error) {
+	_, err := uc.repo.GetTaskByID(ctx, cmd.TaskID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrTaskNotFound
+		}
+		return nil, errors.Wrap(ErrAddWorklogUnknown, err.Error())
+	}
+
+	worklog, err := domain.NewWorklog(cmd.TaskID, cmd.UserID, cmd.Minutes, cmd.Date, cmd.Note)
+	if err != nil {
+		return nil, errors.Wrap(ErrValidationFailed, err.Error())
+	}
+
+	if err := uc.repo.CreateWorklog(ctx, worklog); err != nil {
+		return nil, errors.Wrap(ErrAddWorklogUnknown, err.Error())
+	}
+
+	return worklog, nil
+}
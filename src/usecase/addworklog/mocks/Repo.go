@@ -0,0 +1,79 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/KungurtsevNII/team-board-back/src/domain"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// Repo is an autogenerated mock type for the Repo type
+type Repo struct {
+	mock.Mock
+}
+
+// CreateWorklog provides a mock function with given fields: ctx, worklog
+func (_m *Repo) CreateWorklog(ctx context.Context, worklog *domain.Worklog) error {
+	ret := _m.Called(ctx, worklog)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWorklog")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Worklog) error); ok {
+		r0 = rf(ctx, worklog)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetTaskByID provides a mock function with given fields: ctx, taskID
+func (_m *Repo) GetTaskByID(ctx context.Context, taskID uuid.UUID) (*domain.Task, error) {
+	ret := _m.Called(ctx, taskID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTaskByID")
+	}
+
+	var r0 *domain.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.Task, error)); ok {
+		return rf(ctx, taskID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.Task); ok {
+		r0 = rf(ctx, taskID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, taskID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewRepo creates a new instance of Repo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Repo {
+	mock := &Repo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,24 @@
+package archiveboard
+
+import (
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// Command: Archive = false возвращает доску из архива.
+type Command struct {
+	BoardID uuid.UUID
+	Archive bool
+}
+
+func NewCommand(boardID string, archive bool) (Command, error) {
+	uid, err := uuid.Parse(boardID)
+	if err != nil {
+		return Command{}, errors.Wrap(ErrInvalidBoardID, err.Error())
+	}
+
+	return Command{
+		BoardID: uid,
+		Archive: archive,
+	}, nil
+}
@@ -0,0 +1,13 @@
+package archiveboard
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidBoardID      = errors.New("invalid board id")
+	ErrBoardNotFound       = errors.New("board not found")
+	ErrBoardArchived       = errors.New("board is already archived")
+	ErrBoardNotArchived    = errors.New("board is not archived")
+	ErrArchiveBoardUnknown = errors.New("unknown error archiving board")
+)
@@ -0,0 +1,55 @@
+package archiveboard
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetBoardIncludingDeleted(ctx context.Context, boardID uuid.UUID) (*domain.Board, error)
+	ArchiveBoard(ctx context.Context, board *domain.Board) error
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+func (uc *UC) Handle(ctx context.Context, cmd Command) (*domain.Board, error) {
+	board, err := uc.repo.GetBoardIncludingDeleted(ctx, cmd.BoardID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrBoardNotFound
+		}
+		return nil, errors.Wrap(ErrArchiveBoardUnknown, err.Error())
+	}
+	if board.DeletedAt != nil {
+		return nil, ErrBoardNotFound
+	}
+
+	if cmd.Archive {
+		if err := board.Archive(); err != nil {
+			return nil, errors.Wrap(ErrBoardArchived, err.Error())
+		}
+	} else {
+		if err := board.Unarchive(); err != nil {
+			return nil, errors.Wrap(ErrBoardNotArchived, err.Error())
+		}
+	}
+
+	err = uc.repo.ArchiveBoard(ctx, board)
+	if err != nil {
+		return nil, errors.Wrap(ErrArchiveBoardUnknown, err.Error())
+	}
+
+	return board, nil
+}
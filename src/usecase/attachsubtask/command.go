@@ -0,0 +1,28 @@
+package attachsubtask
+
+import (
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type Command struct {
+	ParentID uuid.UUID
+	ChildID  uuid.UUID
+}
+
+func NewCommand(parentID, childID string) (Command, error) {
+	pID, err := uuid.Parse(parentID)
+	if err != nil {
+		return Command{}, errors.Wrap(ErrInvalidUUID, err.Error())
+	}
+
+	cID, err := uuid.Parse(childID)
+	if err != nil {
+		return Command{}, errors.Wrap(ErrInvalidUUID, err.Error())
+	}
+
+	return Command{
+		ParentID: pID,
+		ChildID:  cID,
+	}, nil
+}
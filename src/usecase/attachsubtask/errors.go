@@ -0,0 +1,13 @@
+package attachsubtask
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidUUID          = errors.New("invalid uuid")
+	ErrParentNotFound       = errors.New("parent task not found")
+	ErrTaskNotFound         = errors.New("task not found")
+	ErrInvalidHierarchy     = errors.New("invalid task hierarchy")
+	ErrAttachSubtaskUnknown = errors.New("unknown error attaching subtask")
+)
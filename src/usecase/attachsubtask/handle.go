@@ -0,0 +1,67 @@
+package attachsubtask
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetTaskByID(ctx context.Context, taskID uuid.UUID) (*domain.Task, error)
+	GetTaskAncestors(ctx context.Context, taskID uuid.UUID) ([]uuid.UUID, error)
+	GetSubtreeHeight(ctx context.Context, taskID uuid.UUID) (int, error)
+	UpdateTask(ctx context.Context, task *domain.Task) error
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+func (uc *UC) Handle(ctx context.Context, cmd Command) (*domain.Task, error) {
+	parent, err := uc.repo.GetTaskByID(ctx, cmd.ParentID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrParentNotFound
+		}
+		return nil, errors.Wrap(ErrAttachSubtaskUnknown, err.Error())
+	}
+
+	child, err := uc.repo.GetTaskByID(ctx, cmd.ChildID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrTaskNotFound
+		}
+		return nil, errors.Wrap(ErrAttachSubtaskUnknown, err.Error())
+	}
+
+	ancestors, err := uc.repo.GetTaskAncestors(ctx, parent.ID)
+	if err != nil {
+		return nil, errors.Wrap(ErrAttachSubtaskUnknown, err.Error())
+	}
+
+	height, err := uc.repo.GetSubtreeHeight(ctx, child.ID)
+	if err != nil {
+		return nil, errors.Wrap(ErrAttachSubtaskUnknown, err.Error())
+	}
+
+	err = child.AttachToParent(parent, ancestors, height)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidHierarchy, err.Error())
+	}
+
+	err = uc.repo.UpdateTask(ctx, child)
+	if err != nil {
+		return nil, errors.Wrap(ErrAttachSubtaskUnknown, err.Error())
+	}
+
+	return child, nil
+}
@@ -0,0 +1,111 @@
+package attachsubtask
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/attachsubtask/mocks"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandle(t *testing.T) {
+	ctx := context.Background()
+	boardID := uuid.New()
+	parentID := uuid.New()
+	childID := uuid.New()
+
+	parent := func() *domain.Task {
+		return &domain.Task{ID: parentID, BoardID: boardID}
+	}
+	child := func() *domain.Task {
+		return &domain.Task{ID: childID, BoardID: boardID}
+	}
+
+	testCases := []struct {
+		name        string
+		command     Command
+		setupMock   func(*mocks.Repo)
+		expectError error
+	}{
+		{
+			name:    "Success: attaches child to parent",
+			command: Command{ParentID: parentID, ChildID: childID},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTaskByID", mock.Anything, parentID).Return(parent(), nil).Once()
+				repo.On("GetTaskByID", mock.Anything, childID).Return(child(), nil).Once()
+				repo.On("GetTaskAncestors", mock.Anything, parentID).Return([]uuid.UUID{}, nil).Once()
+				repo.On("GetSubtreeHeight", mock.Anything, childID).Return(0, nil).Once()
+				repo.On("UpdateTask", mock.Anything, mock.AnythingOfType("*domain.Task")).Return(nil).Once()
+			},
+		},
+		{
+			name:    "Failure: parent not found",
+			command: Command{ParentID: parentID, ChildID: childID},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTaskByID", mock.Anything, parentID).Return(nil, pgx.ErrNoRows).Once()
+			},
+			expectError: ErrParentNotFound,
+		},
+		{
+			name:    "Failure: child not found",
+			command: Command{ParentID: parentID, ChildID: childID},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTaskByID", mock.Anything, parentID).Return(parent(), nil).Once()
+				repo.On("GetTaskByID", mock.Anything, childID).Return(nil, pgx.ErrNoRows).Once()
+			},
+			expectError: ErrTaskNotFound,
+		},
+		{
+			name:    "Failure: cycle in hierarchy",
+			command: Command{ParentID: parentID, ChildID: childID},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTaskByID", mock.Anything, parentID).Return(parent(), nil).Once()
+				repo.On("GetTaskByID", mock.Anything, childID).Return(child(), nil).Once()
+				repo.On("GetTaskAncestors", mock.Anything, parentID).Return([]uuid.UUID{childID}, nil).Once()
+				repo.On("GetSubtreeHeight", mock.Anything, childID).Return(1, nil).Once()
+			},
+			expectError: ErrInvalidHierarchy,
+		},
+		{
+			name:    "Failure: update fails",
+			command: Command{ParentID: parentID, ChildID: childID},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTaskByID", mock.Anything, parentID).Return(parent(), nil).Once()
+				repo.On("GetTaskByID", mock.Anything, childID).Return(child(), nil).Once()
+				repo.On("GetTaskAncestors", mock.Anything, parentID).Return([]uuid.UUID{}, nil).Once()
+				repo.On("GetSubtreeHeight", mock.Anything, childID).Return(0, nil).Once()
+				repo.On("UpdateTask", mock.Anything, mock.AnythingOfType("*domain.Task")).Return(errors.New("db error")).Once()
+			},
+			expectError: ErrAttachSubtaskUnknown,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := mocks.NewRepo(t)
+			tc.setupMock(repo)
+
+			uc := NewUC(repo)
+			task, err := uc.Handle(ctx, tc.command)
+
+			if tc.expectError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tc.expectError, "Wrong error type")
+				assert.Nil(t, task)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, task)
+				require.NotNil(t, task.ParentID)
+				assert.Equal(t, parentID, *task.ParentID)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,7 @@
+package autowatchtasks
+
+import (
+	"errors"
+)
+
+var ErrAutoWatchUnknown = errors.New("unknown error subscribing task watchers")
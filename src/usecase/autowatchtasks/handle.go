@@ -0,0 +1,49 @@
+package autowatchtasks
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetTaskByID(ctx context.Context, taskID uuid.UUID) (*domain.Task, error)
+	AddWatchers(ctx context.Context, watchers []domain.Watcher) error
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+// Events - события, после которых автор и исполнитель подписываются на задачу.
+var Events = []domain.EventType{
+	domain.EventTaskCreated,
+	domain.EventTaskAssigned,
+}
+
+// Handle подписывает автора и текущего исполнителя задачи. Подписка
+// идемпотентна, поэтому повторная доставка события безопасна.
+func (uc *UC) Handle(ctx context.Context, event domain.Event) error {
+	task, err := uc.repo.GetTaskByID(ctx, event.AggregateID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return errors.Wrap(ErrAutoWatchUnknown, err.Error())
+	}
+
+	if err := uc.repo.AddWatchers(ctx, task.AutoWatchers()); err != nil {
+		return errors.Wrap(ErrAutoWatchUnknown, err.Error())
+	}
+
+	return nil
+}
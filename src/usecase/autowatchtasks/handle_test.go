@@ -0,0 +1,42 @@
+package autowatchtasks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/autowatchtasks/mocks"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandle(t *testing.T) {
+	ctx := context.Background()
+	reporter, assignee := "alice", "bob"
+
+	task, err := domain.NewTask(uuid.New(), uuid.New(), 1, "Task", nil, nil, nil)
+	require.NoError(t, err)
+	task.Reporter = &reporter
+	require.NoError(t, task.SetLaneFields(&assignee, nil, nil))
+	assigned := task.Events()[len(task.Events())-1]
+
+	t.Run("Success: reporter and assignee watch the task", func(t *testing.T) {
+		repo := mocks.NewRepo(t)
+		repo.On("GetTaskByID", mock.Anything, task.ID).Return(task, nil).Once()
+		repo.On("AddWatchers", mock.Anything, mock.MatchedBy(func(ws []domain.Watcher) bool {
+			return len(ws) == 2 && ws[0].UserID == reporter && ws[1].UserID == assignee &&
+				*ws[0].TaskID == task.ID && ws[0].BoardID == nil
+		})).Return(nil).Once()
+
+		require.NoError(t, NewUC(repo).Handle(ctx, assigned))
+	})
+
+	t.Run("Success: deleted task is skipped", func(t *testing.T) {
+		repo := mocks.NewRepo(t)
+		repo.On("GetTaskByID", mock.Anything, task.ID).Return(nil, pgx.ErrNoRows).Once()
+
+		require.NoError(t, NewUC(repo).Handle(ctx, assigned))
+	})
+}
@@ -0,0 +1,69 @@
+package bulktasks
+
+import (
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// MaxTaskIDs - ограничение на размер одного запроса, чтобы транзакция не держала
+// блокировки слишком долго.
+const MaxTaskIDs = 500
+
+type Command struct {
+	TaskIDs   []uuid.UUID
+	Operation domain.BulkTaskOperation
+	// AllOrNothing - при ошибке хотя бы по одной задаче не сохранять ничего
+	AllOrNothing bool
+}
+
+func NewCommand(
+	taskIDs []string,
+	operation string,
+	columnID *string,
+	tags []string,
+	field string,
+	value *string,
+	allOrNothing bool,
+) (Command, error) {
+	if len(taskIDs) == 0 {
+		return Command{}, ErrNoTaskIDs
+	}
+	if len(taskIDs) > MaxTaskIDs {
+		return Command{}, errors.Wrapf(ErrTooManyTaskIDs, "max %d", MaxTaskIDs)
+	}
+
+	ids := make([]uuid.UUID, 0, len(taskIDs))
+	seen := make(map[uuid.UUID]struct{}, len(taskIDs))
+	for _, raw := range taskIDs {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return Command{}, errors.Wrap(ErrInvalidTaskID, err.Error())
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, id)
+	}
+
+	var cID *uuid.UUID
+	if columnID != nil {
+		id, err := uuid.Parse(*columnID)
+		if err != nil {
+			return Command{}, errors.Wrap(ErrInvalidColumnID, err.Error())
+		}
+		cID = &id
+	}
+
+	op, err := domain.NewBulkTaskOperation(operation, cID, tags, field, value)
+	if err != nil {
+		return Command{}, errors.Wrap(ErrInvalidOperation, err.Error())
+	}
+
+	return Command{
+		TaskIDs:      ids,
+		Operation:    op,
+		AllOrNothing: allOrNothing,
+	}, nil
+}
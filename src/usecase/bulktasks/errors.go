@@ -0,0 +1,19 @@
+package bulktasks
+
+import (
+	"errors"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+)
+
+var (
+	ErrNoTaskIDs        = errors.New("task_ids must not be empty")
+	ErrTooManyTaskIDs   = errors.New("too many task_ids")
+	ErrInvalidTaskID    = errors.New("invalid task id")
+	ErrInvalidColumnID  = errors.New("invalid column id")
+	ErrInvalidOperation = errors.New("invalid bulk operation")
+	ErrTaskNotFound     = errors.New("task not found")
+	// ErrTaskIsBlocked приходит из domain.CheckBlockers вместе с ключами блокеров
+	ErrTaskIsBlocked    = domain.ErrTaskIsBlocked
+	ErrBulkTasksUnknown = errors.New("unknown error in bulk task operation")
+)
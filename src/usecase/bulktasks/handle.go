@@ -0,0 +1,179 @@
+package bulktasks
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Status string
+
+const (
+	StatusUpdated    Status = "updated"
+	StatusUnchanged  Status = "unchanged"
+	StatusFailed     Status = "failed"
+	StatusRolledBack Status = "rolled_back"
+)
+
+type Repo interface {
+	GetTasksByIDs(ctx context.Context, ids []uuid.UUID) ([]domain.Task, error)
+	GetColumns(ctx context.Context, boardID uuid.UUID) ([]domain.Column, error)
+	GetOpenBlockers(ctx context.Context, taskID uuid.UUID) ([]domain.Task, error)
+	BulkUpdateTasks(ctx context.Context, tasks []domain.Task, allOrNothing bool) (map[uuid.UUID]error, error)
+}
+
+type UC struct {
+	repo Repo
+	// rejectBlocked - как и при одиночном переносе, не пускать заблокированные
+	// задачи в завершающую колонку вместо предупреждения.
+	rejectBlocked bool
+}
+
+func NewUC(repo Repo, rejectBlocked bool) *UC {
+	return &UC{
+		repo:          repo,
+		rejectBlocked: rejectBlocked,
+	}
+}
+
+type TaskResult struct {
+	TaskID   uuid.UUID
+	Status   Status
+	Err      error
+	Warnings []string
+}
+
+type Result struct {
+	Tasks      []TaskResult
+	Updated    int
+	Unchanged  int
+	Failed     int
+	RolledBack int
+}
+
+// Succeeded - false, если в режиме "все или ничего" изменения были отменены.
+func (r *Result) Succeeded() bool {
+	return r.RolledBack == 0
+}
+
+func (uc *UC) Handle(ctx context.Context, cmd Command) (*Result, error) {
+	found, err := uc.repo.GetTasksByIDs(ctx, cmd.TaskIDs)
+	if err != nil {
+		return nil, errors.Wrap(ErrBulkTasksUnknown, err.Error())
+	}
+	byID := make(map[uuid.UUID]domain.Task, len(found))
+	for _, t := range found {
+		byID[t.ID] = t
+	}
+
+	results := make([]TaskResult, len(cmd.TaskIDs))
+	changed := make([]domain.Task, 0, len(found))
+	changedIdx := make(map[uuid.UUID]int, len(found))
+	columns := make(map[uuid.UUID][]domain.Column)
+	hasFailed := false
+
+	for i, id := range cmd.TaskIDs {
+		results[i].TaskID = id
+
+		task, ok := byID[id]
+		if !ok {
+			results[i].Status, results[i].Err = StatusFailed, ErrTaskNotFound
+			hasFailed = true
+			continue
+		}
+
+		boardColumns, ok := columns[task.BoardID]
+		if !ok {
+			boardColumns, err = uc.repo.GetColumns(ctx, task.BoardID)
+			if err != nil {
+				return nil, errors.Wrap(ErrBulkTasksUnknown, err.Error())
+			}
+			columns[task.BoardID] = boardColumns
+		}
+
+		err := cmd.Operation.Apply(&task, boardColumns)
+		if errors.Is(err, domain.ErrBulkNoChange) {
+			results[i].Status = StatusUnchanged
+			continue
+		}
+		if err == nil && cmd.Operation.Operation == domain.BulkMove {
+			results[i].Warnings, err = uc.checkBlockers(ctx, &task, boardColumns)
+		}
+		if err != nil {
+			results[i].Status, results[i].Err = StatusFailed, err
+			hasFailed = true
+			continue
+		}
+
+		results[i].Status = StatusUpdated
+		changedIdx[task.ID] = i
+		changed = append(changed, task)
+	}
+
+	if cmd.AllOrNothing && hasFailed {
+		return summarize(rollBack(results)), nil
+	}
+	if len(changed) == 0 {
+		return summarize(results), nil
+	}
+
+	failed, err := uc.repo.BulkUpdateTasks(ctx, changed, cmd.AllOrNothing)
+	if err != nil {
+		return nil, errors.Wrap(ErrBulkTasksUnknown, err.Error())
+	}
+	for id, ferr := range failed {
+		i := changedIdx[id]
+		results[i].Status = StatusFailed
+		if errors.Is(ferr, pgx.ErrNoRows) {
+			results[i].Err = ErrTaskNotFound
+		} else {
+			results[i].Err = errors.Wrap(ErrBulkTasksUnknown, ferr.Error())
+		}
+	}
+
+	return summarize(results), nil
+}
+
+// checkBlockers проверяет открытые блокирующие задачи при переносе в завершающую колонку.
+func (uc *UC) checkBlockers(ctx context.Context, task *domain.Task, columns []domain.Column) ([]string, error) {
+	if !domain.IsDoneColumn(columns, task.ColumnID) {
+		return nil, nil
+	}
+
+	blockers, err := uc.repo.GetOpenBlockers(ctx, task.ID)
+	if err != nil {
+		return nil, errors.Wrap(ErrBulkTasksUnknown, err.Error())
+	}
+	return domain.CheckBlockers(blockers, uc.rejectBlocked)
+}
+
+// rollBack помечает задачи, которые были бы изменены, как отмененные.
+func rollBack(results []TaskResult) []TaskResult {
+	for i := range results {
+		if results[i].Status == StatusUpdated {
+			results[i].Status = StatusRolledBack
+			results[i].Warnings = nil
+		}
+	}
+	return results
+}
+
+func summarize(results []TaskResult) *Result {
+	res := &Result{Tasks: results}
+	for _, r := range results {
+		switch r.Status {
+		case StatusUpdated:
+			res.Updated++
+		case StatusUnchanged:
+			res.Unchanged++
+		case StatusFailed:
+			res.Failed++
+		case StatusRolledBack:
+			res.RolledBack++
+		}
+	}
+	return res
+}
@@ -0,0 +1,143 @@
+package bulktasks
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/bulktasks/mocks"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCommand(t *testing.T) {
+	_, err := NewCommand(nil, "delete", nil, nil, "", nil, false)
+	assert.ErrorIs(t, err, ErrNoTaskIDs)
+
+	_, err = NewCommand([]string{"bad"}, "delete", nil, nil, "", nil, false)
+	assert.ErrorIs(t, err, ErrInvalidTaskID)
+
+	_, err = NewCommand([]string{uuid.NewString()}, "move", nil, nil, "", nil, false)
+	assert.ErrorIs(t, err, ErrInvalidOperation)
+
+	id := uuid.NewString()
+	cmd, err := NewCommand([]string{id, id}, "delete", nil, nil, "", nil, true)
+	require.NoError(t, err)
+	assert.Len(t, cmd.TaskIDs, 1)
+	assert.True(t, cmd.AllOrNothing)
+}
+
+func TestHandle(t *testing.T) {
+	ctx := context.Background()
+	boardID := uuid.New()
+	todo := domain.Column{ID: uuid.New(), BoardID: boardID, OrderNum: 0}
+	done := domain.Column{ID: uuid.New(), BoardID: boardID, OrderNum: 1, IsDone: true}
+	columns := []domain.Column{todo, done}
+
+	first := domain.Task{ID: uuid.New(), BoardID: boardID, ColumnID: todo.ID}
+	second := domain.Task{ID: uuid.New(), BoardID: boardID, ColumnID: done.ID}
+	missing := uuid.New()
+	moveToDone := domain.BulkTaskOperation{Operation: domain.BulkMove, ColumnID: done.ID}
+
+	testCases := []struct {
+		name          string
+		command       Command
+		rejectBlocked bool
+		setupMock     func(*mocks.Repo)
+		expectError   error
+		expectStatus  []Status
+	}{
+		{
+			name:    "Success: moved, unchanged and missing reported per task",
+			command: Command{TaskIDs: []uuid.UUID{first.ID, second.ID, missing}, Operation: moveToDone},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTasksByIDs", mock.Anything, []uuid.UUID{first.ID, second.ID, missing}).
+					Return([]domain.Task{first, second}, nil).Once()
+				repo.On("GetColumns", mock.Anything, boardID).Return(columns, nil).Once()
+				repo.On("GetOpenBlockers", mock.Anything, first.ID).Return([]domain.Task{}, nil).Once()
+				repo.On("BulkUpdateTasks", mock.Anything,
+					mock.MatchedBy(func(tasks []domain.Task) bool {
+						return len(tasks) == 1 && tasks[0].ID == first.ID && tasks[0].ColumnID == done.ID
+					}), false).
+					Return(map[uuid.UUID]error{}, nil).Once()
+			},
+			expectStatus: []Status{StatusUpdated, StatusUnchanged, StatusFailed},
+		},
+		{
+			name:    "Success: per-task save error does not fail others",
+			command: Command{TaskIDs: []uuid.UUID{first.ID}, Operation: domain.BulkTaskOperation{Operation: domain.BulkDelete}},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTasksByIDs", mock.Anything, mock.Anything).Return([]domain.Task{first}, nil).Once()
+				repo.On("GetColumns", mock.Anything, boardID).Return(columns, nil).Once()
+				repo.On("BulkUpdateTasks", mock.Anything, mock.Anything, false).
+					Return(map[uuid.UUID]error{first.ID: pgx.ErrNoRows}, nil).Once()
+			},
+			expectStatus: []Status{StatusFailed},
+		},
+		{
+			name: "Success: all or nothing rolls back when one task fails",
+			command: Command{
+				TaskIDs:      []uuid.UUID{first.ID, missing},
+				Operation:    moveToDone,
+				AllOrNothing: true,
+			},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTasksByIDs", mock.Anything, mock.Anything).Return([]domain.Task{first}, nil).Once()
+				repo.On("GetColumns", mock.Anything, boardID).Return(columns, nil).Once()
+				repo.On("GetOpenBlockers", mock.Anything, first.ID).Return([]domain.Task{}, nil).Once()
+			},
+			expectStatus: []Status{StatusRolledBack, StatusFailed},
+		},
+		{
+			name:          "Success: blocked task rejected",
+			command:       Command{TaskIDs: []uuid.UUID{first.ID}, Operation: moveToDone},
+			rejectBlocked: true,
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTasksByIDs", mock.Anything, mock.Anything).Return([]domain.Task{first}, nil).Once()
+				repo.On("GetColumns", mock.Anything, boardID).Return(columns, nil).Once()
+				repo.On("GetOpenBlockers", mock.Anything, first.ID).
+					Return([]domain.Task{{ID: second.ID, Number: 1}}, nil).Once()
+			},
+			expectStatus: []Status{StatusFailed},
+		},
+		{
+			name:    "Failure: transaction error",
+			command: Command{TaskIDs: []uuid.UUID{first.ID}, Operation: domain.BulkTaskOperation{Operation: domain.BulkDelete}},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTasksByIDs", mock.Anything, mock.Anything).Return([]domain.Task{first}, nil).Once()
+				repo.On("GetColumns", mock.Anything, boardID).Return(columns, nil).Once()
+				repo.On("BulkUpdateTasks", mock.Anything, mock.Anything, false).
+					Return(nil, errors.New("db error")).Once()
+			},
+			expectError: ErrBulkTasksUnknown,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := mocks.NewRepo(t)
+			tc.setupMock(repo)
+
+			uc := NewUC(repo, tc.rejectBlocked)
+			res, err := uc.Handle(ctx, tc.command)
+
+			if tc.expectError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tc.expectError)
+				assert.Nil(t, res)
+			} else {
+				require.NoError(t, err)
+				require.Len(t, res.Tasks, len(tc.expectStatus))
+				for i, status := range tc.expectStatus {
+					assert.Equal(t, status, res.Tasks[i].Status, "task %d", i)
+				}
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,151 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/KungurtsevNII/team-board-back/src/domain"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// Repo is an autogenerated mock type for the Repo type
+type Repo struct {
+	mock.Mock
+}
+
+// BulkUpdateTasks provides a mock function with given fields: ctx, tasks, allOrNothing
+func (_m *Repo) BulkUpdateTasks(ctx context.Context, tasks []domain.Task, allOrNothing bool) (map[uuid.UUID]error, error) {
+	ret := _m.Called(ctx, tasks, allOrNothing)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkUpdateTasks")
+	}
+
+	var r0 map[uuid.UUID]error
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.Task, bool) (map[uuid.UUID]error, error)); ok {
+		return rf(ctx, tasks, allOrNothing)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.Task, bool) map[uuid.UUID]error); ok {
+		r0 = rf(ctx, tasks, allOrNothing)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID]error)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []domain.Task, bool) error); ok {
+		r1 = rf(ctx, tasks, allOrNothing)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetColumns provides a mock function with given fields: ctx, boardID
+func (_m *Repo) GetColumns(ctx context.Context, boardID uuid.UUID) ([]domain.Column, error) {
+	ret := _m.Called(ctx, boardID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetColumns")
+	}
+
+	var r0 []domain.Column
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]domain.Column, error)); ok {
+		return rf(ctx, boardID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []domain.Column); ok {
+		r0 = rf(ctx, boardID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Column)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, boardID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOpenBlockers provides a mock function with given fields: ctx, taskID
+func (_m *Repo) GetOpenBlockers(ctx context.Context, taskID uuid.UUID) ([]domain.Task, error) {
+	ret := _m.Called(ctx, taskID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOpenBlockers")
+	}
+
+	var r0 []domain.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]domain.Task, error)); ok {
+		return rf(ctx, taskID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []domain.Task); ok {
+		r0 = rf(ctx, taskID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, taskID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTasksByIDs provides a mock function with given fields: ctx, ids
+func (_m *Repo) GetTasksByIDs(ctx context.Context, ids []uuid.UUID) ([]domain.Task, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTasksByIDs")
+	}
+
+	var r0 []domain.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) ([]domain.Task, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []domain.Task); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewRepo creates a new instance of Repo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Repo {
+	mock := &Repo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,43 @@
+package closesprint
+
+import (
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const (
+	// MoveToBacklog - незавершенные задачи уходят в бэклог доски
+	MoveToBacklog = "backlog"
+)
+
+type Command struct {
+	SprintID uuid.UUID
+	// ToBacklog - перенести незавершенные задачи в бэклог
+	ToBacklog bool
+	// NextSprintID - явно выбранный спринт для переноса.
+	// Если не задан и ToBacklog == false, берется ближайший запланированный спринт доски.
+	NextSprintID *uuid.UUID
+}
+
+// NewCommand: moveTo - "backlog", ID спринта или пустая строка (ближайший запланированный).
+func NewCommand(sprintID, moveTo string) (Command, error) {
+	sID, err := uuid.Parse(sprintID)
+	if err != nil {
+		return Command{}, errors.Wrap(ErrInvalidUUID, err.Error())
+	}
+
+	cmd := Command{SprintID: sID}
+	switch moveTo {
+	case "":
+	case MoveToBacklog:
+		cmd.ToBacklog = true
+	default:
+		nID, err := uuid.Parse(moveTo)
+		if err != nil {
+			return Command{}, errors.Wrap(ErrInvalidUUID, err.Error())
+		}
+		cmd.NextSprintID = &nID
+	}
+
+	return cmd, nil
+}
@@ -0,0 +1,14 @@
+package closesprint
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidUUID           = errors.New("invalid uuid")
+	ErrSprintNotFound        = errors.New("sprint not found")
+	ErrNextSprintNotFound    = errors.New("next sprint not found")
+	ErrSprintNotActive       = errors.New("sprint is not active")
+	ErrInvalidRolloverTarget = errors.New("next sprint must be a planned sprint of the same board")
+	ErrCloseSprintUnknown    = errors.New("unknown error closing sprint")
+)
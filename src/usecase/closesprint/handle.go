@@ -0,0 +1,98 @@
+package closesprint
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetSprintByID(ctx context.Context, sprintID uuid.UUID) (*domain.Sprint, error)
+	GetSprints(ctx context.Context, boardID uuid.UUID) ([]domain.Sprint, error)
+	CloseSprint(ctx context.Context, sprint *domain.Sprint, nextSprintID *uuid.UUID) (int64, error)
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+type Result struct {
+	Sprint *domain.Sprint
+	// NextSprint - куда перенесены незавершенные задачи, nil - в бэклог
+	NextSprint *domain.Sprint
+	MovedTasks int64
+}
+
+func (uc *UC) Handle(ctx context.Context, cmd Command) (*Result, error) {
+	sprint, err := uc.repo.GetSprintByID(ctx, cmd.SprintID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrSprintNotFound
+		}
+		return nil, errors.Wrap(ErrCloseSprintUnknown, err.Error())
+	}
+
+	next, err := uc.rolloverTarget(ctx, sprint, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	err = sprint.Close(next)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrSprintNotActive):
+			return nil, ErrSprintNotActive
+		case errors.Is(err, domain.ErrInvalidRolloverSprint):
+			return nil, ErrInvalidRolloverTarget
+		}
+		return nil, errors.Wrap(ErrCloseSprintUnknown, err.Error())
+	}
+
+	var nextID *uuid.UUID
+	if next != nil {
+		nextID = &next.ID
+	}
+
+	moved, err := uc.repo.CloseSprint(ctx, sprint, nextID)
+	if err != nil {
+		return nil, errors.Wrap(ErrCloseSprintUnknown, err.Error())
+	}
+
+	return &Result{
+		Sprint:     sprint,
+		NextSprint: next,
+		MovedTasks: moved,
+	}, nil
+}
+
+func (uc *UC) rolloverTarget(ctx context.Context, sprint *domain.Sprint, cmd Command) (*domain.Sprint, error) {
+	if cmd.ToBacklog {
+		return nil, nil
+	}
+
+	if cmd.NextSprintID != nil {
+		next, err := uc.repo.GetSprintByID(ctx, *cmd.NextSprintID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, ErrNextSprintNotFound
+			}
+			return nil, errors.Wrap(ErrCloseSprintUnknown, err.Error())
+		}
+		return next, nil
+	}
+
+	sprints, err := uc.repo.GetSprints(ctx, sprint.BoardID)
+	if err != nil {
+		return nil, errors.Wrap(ErrCloseSprintUnknown, err.Error())
+	}
+	return domain.NextPlannedSprint(sprints, sprint.ID), nil
+}
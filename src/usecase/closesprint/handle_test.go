@@ -0,0 +1,155 @@
+package closesprint
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/closesprint/mocks"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandle(t *testing.T) {
+	ctx := context.Background()
+	boardID := uuid.New()
+	sprintID := uuid.New()
+	nextID := uuid.New()
+
+	active := func() *domain.Sprint {
+		return &domain.Sprint{ID: sprintID, BoardID: boardID, State: domain.SprintActive}
+	}
+	planned := func() *domain.Sprint {
+		return &domain.Sprint{ID: nextID, BoardID: boardID, State: domain.SprintPlanned}
+	}
+
+	testCases := []struct {
+		name         string
+		command      Command
+		setupMock    func(*mocks.Repo)
+		expectedNext *uuid.UUID
+		expectedMove int64
+		expectError  error
+	}{
+		{
+			name:    "Success: unfinished tasks rolled over to nearest planned sprint",
+			command: Command{SprintID: sprintID},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetSprintByID", mock.Anything, sprintID).Return(active(), nil).Once()
+				repo.On("GetSprints", mock.Anything, boardID).Return([]domain.Sprint{*active(), *planned()}, nil).Once()
+				repo.On("CloseSprint", mock.Anything, mock.AnythingOfType("*domain.Sprint"), &nextID).Return(int64(3), nil).Once()
+			},
+			expectedNext: &nextID,
+			expectedMove: 3,
+		},
+		{
+			name:    "Success: no planned sprint, tasks go to backlog",
+			command: Command{SprintID: sprintID},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetSprintByID", mock.Anything, sprintID).Return(active(), nil).Once()
+				repo.On("GetSprints", mock.Anything, boardID).Return([]domain.Sprint{*active()}, nil).Once()
+				repo.On("CloseSprint", mock.Anything, mock.AnythingOfType("*domain.Sprint"), (*uuid.UUID)(nil)).Return(int64(2), nil).Once()
+			},
+			expectedMove: 2,
+		},
+		{
+			name:    "Success: explicit backlog",
+			command: Command{SprintID: sprintID, ToBacklog: true},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetSprintByID", mock.Anything, sprintID).Return(active(), nil).Once()
+				repo.On("CloseSprint", mock.Anything, mock.AnythingOfType("*domain.Sprint"), (*uuid.UUID)(nil)).Return(int64(1), nil).Once()
+			},
+			expectedMove: 1,
+		},
+		{
+			name:    "Success: explicit next sprint",
+			command: Command{SprintID: sprintID, NextSprintID: &nextID},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetSprintByID", mock.Anything, sprintID).Return(active(), nil).Once()
+				repo.On("GetSprintByID", mock.Anything, nextID).Return(planned(), nil).Once()
+				repo.On("CloseSprint", mock.Anything, mock.AnythingOfType("*domain.Sprint"), &nextID).Return(int64(0), nil).Once()
+			},
+			expectedNext: &nextID,
+		},
+		{
+			name:    "Failure: sprint not found",
+			command: Command{SprintID: sprintID},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetSprintByID", mock.Anything, sprintID).Return(nil, pgx.ErrNoRows).Once()
+			},
+			expectError: ErrSprintNotFound,
+		},
+		{
+			name:    "Failure: next sprint not found",
+			command: Command{SprintID: sprintID, NextSprintID: &nextID},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetSprintByID", mock.Anything, sprintID).Return(active(), nil).Once()
+				repo.On("GetSprintByID", mock.Anything, nextID).Return(nil, pgx.ErrNoRows).Once()
+			},
+			expectError: ErrNextSprintNotFound,
+		},
+		{
+			name:    "Failure: next sprint from another board",
+			command: Command{SprintID: sprintID, NextSprintID: &nextID},
+			setupMock: func(repo *mocks.Repo) {
+				other := planned()
+				other.BoardID = uuid.New()
+				repo.On("GetSprintByID", mock.Anything, sprintID).Return(active(), nil).Once()
+				repo.On("GetSprintByID", mock.Anything, nextID).Return(other, nil).Once()
+			},
+			expectError: ErrInvalidRolloverTarget,
+		},
+		{
+			name:    "Failure: sprint is not active",
+			command: Command{SprintID: sprintID, ToBacklog: true},
+			setupMock: func(repo *mocks.Repo) {
+				s := active()
+				s.State = domain.SprintPlanned
+				repo.On("GetSprintByID", mock.Anything, sprintID).Return(s, nil).Once()
+			},
+			expectError: ErrSprintNotActive,
+		},
+		{
+			name:    "Failure: close sprint error",
+			command: Command{SprintID: sprintID, ToBacklog: true},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetSprintByID", mock.Anything, sprintID).Return(active(), nil).Once()
+				repo.On("CloseSprint", mock.Anything, mock.AnythingOfType("*domain.Sprint"), (*uuid.UUID)(nil)).Return(int64(0), errors.New("db error")).Once()
+			},
+			expectError: ErrCloseSprintUnknown,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := mocks.NewRepo(t)
+			tc.setupMock(repo)
+
+			uc := NewUC(repo)
+			res, err := uc.Handle(ctx, tc.command)
+
+			if tc.expectError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tc.expectError)
+				assert.Nil(t, res)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+				assert.Equal(t, domain.SprintClosed, res.Sprint.State)
+				assert.Equal(t, tc.expectedMove, res.MovedTasks)
+				if tc.expectedNext == nil {
+					assert.Nil(t, res.NextSprint)
+				} else {
+					require.NotNil(t, res.NextSprint)
+					assert.Equal(t, *tc.expectedNext, res.NextSprint.ID)
+				}
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,119 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/KungurtsevNII/team-board-back/src/domain"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// Repo is an autogenerated mock type for the Repo type
+type Repo struct {
+	mock.Mock
+}
+
+// CloseSprint provides a mock function with given fields: ctx, sprint, nextSprintID
+func (_m *Repo) CloseSprint(ctx context.Context, sprint *domain.Sprint, nextSprintID *uuid.UUID) (int64, error) {
+	ret := _m.Called(ctx, sprint, nextSprintID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloseSprint")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Sprint, *uuid.UUID) (int64, error)); ok {
+		return rf(ctx, sprint, nextSprintID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Sprint, *uuid.UUID) int64); ok {
+		r0 = rf(ctx, sprint, nextSprintID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.Sprint, *uuid.UUID) error); ok {
+		r1 = rf(ctx, sprint, nextSprintID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSprintByID provides a mock function with given fields: ctx, sprintID
+func (_m *Repo) GetSprintByID(ctx context.Context, sprintID uuid.UUID) (*domain.Sprint, error) {
+	ret := _m.Called(ctx, sprintID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSprintByID")
+	}
+
+	var r0 *domain.Sprint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.Sprint, error)); ok {
+		return rf(ctx, sprintID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.Sprint); ok {
+		r0 = rf(ctx, sprintID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Sprint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, sprintID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSprints provides a mock function with given fields: ctx, boardID
+func (_m *Repo) GetSprints(ctx context.Context, boardID uuid.UUID) ([]domain.Sprint, error) {
+	ret := _m.Called(ctx, boardID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSprints")
+	}
+
+	var r0 []domain.Sprint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]domain.Sprint, error)); ok {
+		return rf(ctx, boardID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []domain.Sprint); ok {
+		r0 = rf(ctx, boardID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Sprint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, boardID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewRepo creates a new instance of Repo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Repo {
+	mock := &Repo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
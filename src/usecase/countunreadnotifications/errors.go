@@ -0,0 +1,10 @@
+package countunreadnotifications
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidUserID                   = errors.New("invalid user id")
+	ErrCountUnreadNotificationsUnknown = errors.New("unknown error counting unread notifications")
+)
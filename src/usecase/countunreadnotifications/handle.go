@@ -0,0 +1,29 @@
+package countunreadnotifications
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	CountUnreadNotifications(ctx context.Context, userID string) (int64, error)
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+func (uc *UC) Handle(ctx context.Context, q Query) (int64, error) {
+	count, err := uc.repo.CountUnreadNotifications(ctx, q.UserID)
+	if err != nil {
+		return 0, errors.Wrap(ErrCountUnreadNotificationsUnknown, err.Error())
+	}
+	return count, nil
+}
@@ -3,15 +3,18 @@ package createboard
 import "regexp"
 
 type Command struct {
-	Name       string
-	ShortName  string
+	Name      string
+	ShortName string
+	// Columns - имена начальных колонок по порядку, пусто - одна колонка TODO.
+	// Проверяются в domain.NewColumn, как и при создании колонки отдельно.
+	Columns []string
 }
 
 var (
 	ShortNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]{2,10}$`)
 )
 
-func NewCommand(name string, shortName string) (Command, error) {
+func NewCommand(name string, shortName string, columns []string) (Command, error) {
 	if len(name) > 100 || name == "" {
 		return Command{}, ErrInvalidName
 	}
@@ -22,5 +25,6 @@ func NewCommand(name string, shortName string) (Command, error) {
 	return Command{
 		Name:      name,
 		ShortName: shortName,
+		Columns:   columns,
 	}, nil
 }
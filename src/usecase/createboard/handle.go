@@ -8,12 +8,8 @@ import (
 )
 
 type Repo interface {
-	CheckBoard(ctx context.Context, shortName string) bool
+	CheckBoardShortName(ctx context.Context, shortName string) (bool, error)
 	CreateBoard(ctx context.Context, board domain.Board) error
-	CreateColumn(
-		ctx context.Context,
-		column *domain.Column,
-	) (err error)
 }
 
 type UC struct {
@@ -27,12 +23,19 @@ func NewUC(repo Repo) *UC {
 }
 
 func (uc *UC) Handle(ctx context.Context, cmd Command) (*domain.Board, error) {
-	if uc.repo.CheckBoard(ctx, cmd.ShortName) {
+	exists, err := uc.repo.CheckBoardShortName(ctx, cmd.ShortName)
+	if err != nil {
+		return nil, errors.Wrap(ErrCreateBoard, err.Error())
+	}
+	if exists {
 		return nil, ErrBoardIsExists
 	}
 
-	board, err := domain.NewBoard(cmd.Name, cmd.ShortName)
+	board, err := domain.NewBoardWithColumns(cmd.Name, cmd.ShortName, cmd.Columns)
 	if err != nil {
+		if errors.Is(err, domain.ErrEmptyColumnName) {
+			return nil, errors.Wrap(ErrInvalidColumnName, err.Error())
+		}
 		return nil, errors.Wrap(ErrValidationFailed, err.Error())
 	}
 
@@ -41,15 +44,5 @@ func (uc *UC) Handle(ctx context.Context, cmd Command) (*domain.Board, error) {
 		return nil, errors.Wrap(ErrCreateBoard, err.Error())
 	}
 
-	col, err := board.GetFirstColumn()
-	if err != nil {
-		return nil, errors.Wrap(ErrCreateColumnUnknown, err.Error())
-	}
-
-	err = uc.repo.CreateColumn(ctx, &col)
-	if err != nil {
-		return nil, errors.Wrap(ErrCreateColumnUnknown, err.Error())
-	}
-
 	return &board, nil
 }
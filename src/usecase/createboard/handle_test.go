@@ -0,0 +1,80 @@
+package createboard
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/createboard/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandle(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		name        string
+		command     Command
+		setupMock   func(*mocks.Repo)
+		expectError error
+	}{
+		{
+			name:    "Success: board created with its columns",
+			command: Command{Name: "Team", ShortName: "TEAM", Columns: []string{"Backlog", "Done"}},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("CheckBoardShortName", mock.Anything, "TEAM").Return(false, nil).Once()
+				repo.On("CreateBoard", mock.Anything, mock.MatchedBy(func(b domain.Board) bool {
+					return b.ShortName == "TEAM" && len(b.Columns) == 2
+				})).Return(nil).Once()
+			},
+		},
+		{
+			name:    "Failure: short name is taken",
+			command: Command{Name: "Team", ShortName: "TEAM"},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("CheckBoardShortName", mock.Anything, "TEAM").Return(true, nil).Once()
+			},
+			expectError: ErrBoardIsExists,
+		},
+		{
+			name:    "Failure: short name check error",
+			command: Command{Name: "Team", ShortName: "TEAM"},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("CheckBoardShortName", mock.Anything, "TEAM").Return(false, errors.New("db error")).Once()
+			},
+			expectError: ErrCreateBoard,
+		},
+		{
+			name:    "Failure: empty column name",
+			command: Command{Name: "Team", ShortName: "TEAM", Columns: []string{""}},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("CheckBoardShortName", mock.Anything, "TEAM").Return(false, nil).Once()
+			},
+			expectError: ErrInvalidColumnName,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := mocks.NewRepo(t)
+			tc.setupMock(repo)
+
+			uc := NewUC(repo)
+			board, err := uc.Handle(ctx, tc.command)
+
+			if tc.expectError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tc.expectError)
+				assert.Nil(t, board)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, board)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
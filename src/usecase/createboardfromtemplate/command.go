@@ -0,0 +1,26 @@
+package createboardfromtemplate
+
+import (
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type Command struct {
+	TemplateID uuid.UUID
+	Name       string
+	ShortName  string
+}
+
+// NewCommand: имена доски проверяются в domain при создании доски по шаблону.
+func NewCommand(templateID string, name string, shortName string) (Command, error) {
+	tID, err := uuid.Parse(templateID)
+	if err != nil {
+		return Command{}, errors.Wrap(ErrInvalidUUID, err.Error())
+	}
+
+	return Command{
+		TemplateID: tID,
+		Name:       name,
+		ShortName:  shortName,
+	}, nil
+}
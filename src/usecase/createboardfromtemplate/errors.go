@@ -0,0 +1,13 @@
+package createboardfromtemplate
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidUUID                    = errors.New("invalid uuid")
+	ErrValidationFailed               = errors.New("validation failed")
+	ErrTemplateNotFound               = errors.New("board template not found")
+	ErrBoardIsExists                  = errors.New("board with this shortname already exists")
+	ErrCreateBoardFromTemplateUnknown = errors.New("unknown error creating board from template")
+)
@@ -0,0 +1,56 @@
+package createboardfromtemplate
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetBoardTemplateByID(ctx context.Context, templateID uuid.UUID) (*domain.BoardTemplate, error)
+	CheckBoardShortName(ctx context.Context, shortName string) (bool, error)
+	CreateBoardWithTasks(ctx context.Context, board *domain.Board) error
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+func (uc *UC) Handle(ctx context.Context, cmd Command) (*domain.Board, error) {
+	tpl, err := uc.repo.GetBoardTemplateByID(ctx, cmd.TemplateID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrTemplateNotFound
+		}
+		return nil, errors.Wrap(ErrCreateBoardFromTemplateUnknown, err.Error())
+	}
+
+	board, err := tpl.NewBoard(cmd.Name, cmd.ShortName)
+	if err != nil {
+		return nil, errors.Wrap(ErrValidationFailed, err.Error())
+	}
+
+	exists, err := uc.repo.CheckBoardShortName(ctx, cmd.ShortName)
+	if err != nil {
+		return nil, errors.Wrap(ErrCreateBoardFromTemplateUnknown, err.Error())
+	}
+	if exists {
+		return nil, ErrBoardIsExists
+	}
+
+	err = uc.repo.CreateBoardWithTasks(ctx, &board)
+	if err != nil {
+		return nil, errors.Wrap(ErrCreateBoardFromTemplateUnknown, err.Error())
+	}
+
+	return &board, nil
+}
@@ -0,0 +1,37 @@
+package createboardtemplate
+
+import (
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type Command struct {
+	BoardID      uuid.UUID
+	Name         string `validate:"required,min=1,max=100"`
+	Description  *string
+	IncludeTasks bool
+}
+
+func NewCommand(boardID string, name string, description *string, includeTasks bool) (Command, error) {
+	validate := validator.New()
+
+	bID, err := uuid.Parse(boardID)
+	if err != nil {
+		return Command{}, errors.Wrap(ErrInvalidUUID, err.Error())
+	}
+
+	cmd := Command{
+		BoardID:      bID,
+		Name:         name,
+		Description:  description,
+		IncludeTasks: includeTasks,
+	}
+
+	err = validate.Struct(cmd)
+	if err != nil {
+		return Command{}, errors.Wrap(ErrValidationFailed, err.Error())
+	}
+
+	return cmd, nil
+}
@@ -0,0 +1,13 @@
+package createboardtemplate
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidUUID                = errors.New("invalid uuid")
+	ErrValidationFailed           = errors.New("validation failed")
+	ErrBoardNotFound              = errors.New("board not found")
+	ErrTemplateExists             = errors.New("template with this name already exists")
+	ErrCreateBoardTemplateUnknown = errors.New("unknown error creating board template")
+)
@@ -0,0 +1,64 @@
+package createboardtemplate
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetBoard(ctx context.Context, ID uuid.UUID) (*domain.Board, error)
+	GetBoardTaskDetails(ctx context.Context, boardID uuid.UUID) ([]domain.Task, error)
+	CheckBoardTemplateName(ctx context.Context, name string) (bool, error)
+	CreateBoardTemplate(ctx context.Context, template *domain.BoardTemplate) error
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+func (uc *UC) Handle(ctx context.Context, cmd Command) (*domain.BoardTemplate, error) {
+	exists, err := uc.repo.CheckBoardTemplateName(ctx, cmd.Name)
+	if err != nil {
+		return nil, errors.Wrap(ErrCreateBoardTemplateUnknown, err.Error())
+	}
+	if exists {
+		return nil, ErrTemplateExists
+	}
+
+	board, err := uc.repo.GetBoard(ctx, cmd.BoardID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrBoardNotFound
+		}
+		return nil, errors.Wrap(ErrCreateBoardTemplateUnknown, err.Error())
+	}
+
+	if cmd.IncludeTasks {
+		board.Tasks, err = uc.repo.GetBoardTaskDetails(ctx, board.ID)
+		if err != nil {
+			return nil, errors.Wrap(ErrCreateBoardTemplateUnknown, err.Error())
+		}
+	}
+
+	tpl, err := domain.NewBoardTemplate(cmd.Name, cmd.Description, board, cmd.IncludeTasks)
+	if err != nil {
+		return nil, errors.Wrap(ErrValidationFailed, err.Error())
+	}
+
+	err = uc.repo.CreateBoardTemplate(ctx, tpl)
+	if err != nil {
+		return nil, errors.Wrap(ErrCreateBoardTemplateUnknown, err.Error())
+	}
+
+	return tpl, nil
+}
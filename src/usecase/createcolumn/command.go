@@ -9,9 +9,10 @@ import (
 type Command struct {
 	BoardID uuid.UUID `validate:"required,uuid"`
 	Name    string    `validate:"required,min=1,max=100"`
+	IsDone  bool
 }
 
-func NewCommand(boardID, name string) (Command, error) {
+func NewCommand(boardID, name string, isDone bool) (Command, error) {
 	validate := validator.New()
 
 	bID, err := uuid.Parse(boardID)
@@ -22,6 +23,7 @@ func NewCommand(boardID, name string) (Command, error) {
 	ccc := Command{
 		BoardID: bID,
 		Name:    name,
+		IsDone:  isDone,
 	}
 
 	err = validate.Struct(ccc)
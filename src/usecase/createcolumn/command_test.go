@@ -46,7 +46,7 @@ func TestNewCommand(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			cmd, err := NewCommand(tc.boardID, tc.columnName)
+			cmd, err := NewCommand(tc.boardID, tc.columnName, false)
 
 			if tc.expectError != nil {
 				require.Error(t, err)
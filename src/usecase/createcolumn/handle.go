@@ -52,6 +52,7 @@ func (uc *UC) Handle(ctx context.Context, cmd Command) (column *domain.Column, e
 	if err != nil {
 		return nil, errors.Wrap(ErrValidationFailed, err.Error())
 	}
+	column.IsDone = cmd.IsDone
 
 	err = uc.repo.CreateColumn(ctx, column)
 	if err != nil {
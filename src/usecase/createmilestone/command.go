@@ -0,0 +1,40 @@
+package createmilestone
+
+import (
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+)
+
+type Command struct {
+	Title       string `validate:"required,min=1,max=255"`
+	Description *string
+	TargetDate  *time.Time
+}
+
+func NewCommand(title string, description *string, targetDate *string) (Command, error) {
+	validate := validator.New()
+
+	var td *time.Time
+	if targetDate != nil && *targetDate != "" {
+		parsed, err := time.Parse(time.DateOnly, *targetDate)
+		if err != nil {
+			return Command{}, errors.Wrap(ErrInvalidTargetDate, err.Error())
+		}
+		td = &parsed
+	}
+
+	cmd := Command{
+		Title:       title,
+		Description: description,
+		TargetDate:  td,
+	}
+
+	err := validate.Struct(cmd)
+	if err != nil {
+		return Command{}, errors.Wrap(ErrValidationFailed, err.Error())
+	}
+
+	return cmd, nil
+}
@@ -0,0 +1,72 @@
+package createmilestone
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func TestNewCommand(t *testing.T) {
+	testCases := []struct {
+		name         string
+		title        string
+		targetDate   *string
+		expectedDate *time.Time
+		expectError  error
+	}{
+		{
+			name:  "Success: without target date",
+			title: "Q1 goals",
+		},
+		{
+			name:         "Success: with target date",
+			title:        "Q1 goals",
+			targetDate:   strPtr("2026-03-31"),
+			expectedDate: func() *time.Time { d := time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC); return &d }(),
+		},
+		{
+			name:       "Success: empty target date is ignored",
+			title:      "Q1 goals",
+			targetDate: strPtr(""),
+		},
+		{
+			name:        "Failure: invalid target date",
+			title:       "Q1 goals",
+			targetDate:  strPtr("31.03.2026"),
+			expectError: ErrInvalidTargetDate,
+		},
+		{
+			name:        "Failure: empty title",
+			title:       "",
+			expectError: ErrValidationFailed,
+		},
+		{
+			name:        "Failure: title too long",
+			title:       strings.Repeat("a", 256),
+			expectError: ErrValidationFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd, err := NewCommand(tc.title, nil, tc.targetDate)
+
+			if tc.expectError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tc.expectError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.title, cmd.Title)
+			assert.Equal(t, tc.expectedDate, cmd.TargetDate)
+		})
+	}
+}
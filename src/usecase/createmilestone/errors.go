@@ -0,0 +1,11 @@
+package createmilestone
+
+import (
+	"errors"
+)
+
+var (
+	ErrValidationFailed       = errors.New("validation failed")
+	ErrInvalidTargetDate      = errors.New("invalid target date")
+	ErrCreateMilestoneUnknown = errors.New("failed to create milestone")
+)
@@ -0,0 +1,36 @@
+package createmilestone
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	CreateMilestone(ctx context.Context, milestone *domain.Milestone) error
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+func (uc *UC) Handle(ctx context.Context, cmd Command) (*domain.Milestone, error) {
+	milestone, err := domain.NewMilestone(cmd.Title, cmd.Description, cmd.TargetDate)
+	if err != nil {
+		return nil, errors.Wrap(ErrValidationFailed, err.Error())
+	}
+
+	err = uc.repo.CreateMilestone(ctx, milestone)
+	if err != nil {
+		return nil, errors.Wrap(ErrCreateMilestoneUnknown, err.Error())
+	}
+
+	return milestone, nil
+}
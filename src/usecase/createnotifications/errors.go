@@ -0,0 +1,7 @@
+package createnotifications
+
+import (
+	"errors"
+)
+
+var ErrCreateNotificationsUnknown = errors.New("unknown error creating notifications")
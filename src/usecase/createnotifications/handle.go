@@ -0,0 +1,83 @@
+package createnotifications
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetTaskIncludingDeleted(ctx context.Context, taskID uuid.UUID) (*domain.Task, error)
+	GetColumnByID(ctx context.Context, columnID uuid.UUID) (*domain.Column, error)
+	CreateNotifications(ctx context.Context, notifications []domain.Notification) error
+}
+
+// Recipients находит подписчиков, которым интересно изменение задачи.
+type Recipients interface {
+	Resolve(ctx context.Context, event domain.Event) ([]string, error)
+}
+
+type UC struct {
+	repo       Repo
+	recipients Recipients
+}
+
+func NewUC(repo Repo, recipients Recipients) *UC {
+	return &UC{
+		repo:       repo,
+		recipients: recipients,
+	}
+}
+
+// Events - события, из которых получаются уведомления.
+var Events = []domain.EventType{
+	domain.EventTaskAssigned,
+	domain.EventTaskMentioned,
+	domain.EventTaskMoved,
+	domain.EventTaskMovedToBoard,
+	domain.EventTaskUpdated,
+	domain.EventTaskDeleted,
+}
+
+// Handle превращает событие задачи во входящие уведомления. Подписан на события
+// из outbox, поэтому событие может прийти повторно - дубликаты отсекает репозиторий.
+func (uc *UC) Handle(ctx context.Context, event domain.Event) error {
+	task, err := uc.repo.GetTaskIncludingDeleted(ctx, event.AggregateID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			// задачу уже удалили окончательно, уведомлять не о чем
+			return nil
+		}
+		return errors.Wrap(ErrCreateNotificationsUnknown, err.Error())
+	}
+
+	var columnName string
+	if moved, ok := event.Payload.(domain.TaskMoved); ok {
+		column, err := uc.repo.GetColumnByID(ctx, moved.ToColumnID)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return errors.Wrap(ErrCreateNotificationsUnknown, err.Error())
+		}
+		if column != nil {
+			columnName = column.Name
+		}
+	}
+
+	var watchers []string
+	switch event.Type {
+	case domain.EventTaskMoved, domain.EventTaskMovedToBoard, domain.EventTaskUpdated, domain.EventTaskDeleted:
+		watchers, err = uc.recipients.Resolve(ctx, event)
+		if err != nil {
+			return errors.Wrap(ErrCreateNotificationsUnknown, err.Error())
+		}
+	}
+
+	notifications := domain.NotificationsForEvent(event, task, columnName, watchers)
+	if err := uc.repo.CreateNotifications(ctx, notifications); err != nil {
+		return errors.Wrap(ErrCreateNotificationsUnknown, err.Error())
+	}
+
+	return nil
+}
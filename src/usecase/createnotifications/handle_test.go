@@ -0,0 +1,101 @@
+package createnotifications
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/createnotifications/mocks"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandle(t *testing.T) {
+	ctx := context.Background()
+	assignee := "alice"
+
+	newTask := func(t *testing.T) *domain.Task {
+		task, err := domain.NewTask(uuid.New(), uuid.New(), 1, "Task", nil, nil, nil)
+		require.NoError(t, err)
+		require.NoError(t, task.SetLaneFields(&assignee, nil, nil))
+		return task
+	}
+
+	t.Run("Success: move notifies watchers with column name", func(t *testing.T) {
+		task := newTask(t)
+		done := uuid.New()
+		require.NoError(t, task.MoveToColumn(done))
+		moved := task.Events()[len(task.Events())-1]
+
+		recipients := mocks.NewRecipients(t)
+		recipients.On("Resolve", mock.Anything, moved).Return([]string{assignee}, nil).Once()
+
+		repo := mocks.NewRepo(t)
+		repo.On("GetTaskIncludingDeleted", mock.Anything, task.ID).Return(task, nil).Once()
+		repo.On("GetColumnByID", mock.Anything, done).Return(&domain.Column{ID: done, Name: "Done"}, nil).Once()
+		repo.On("CreateNotifications", mock.Anything, mock.MatchedBy(func(ns []domain.Notification) bool {
+			return len(ns) == 1 && ns[0].UserID == assignee && ns[0].Type == domain.NotificationTaskMoved &&
+				ns[0].EventID == moved.ID && ns[0].Text == `"Task" was moved to Done`
+		})).Return(nil).Once()
+
+		require.NoError(t, NewUC(repo, recipients).Handle(ctx, moved))
+	})
+
+	t.Run("Success: delete notifies watchers of the deleted task", func(t *testing.T) {
+		task := newTask(t)
+		task.Delete()
+		deleted := task.Events()[len(task.Events())-1]
+
+		recipients := mocks.NewRecipients(t)
+		recipients.On("Resolve", mock.Anything, deleted).Return([]string{"bob", assignee}, nil).Once()
+
+		repo := mocks.NewRepo(t)
+		repo.On("GetTaskIncludingDeleted", mock.Anything, task.ID).Return(task, nil).Once()
+		repo.On("CreateNotifications", mock.Anything, mock.MatchedBy(func(ns []domain.Notification) bool {
+			return len(ns) == 2 && ns[0].UserID == "bob" && ns[0].Type == domain.NotificationTaskDeleted
+		})).Return(nil).Once()
+
+		require.NoError(t, NewUC(repo, recipients).Handle(ctx, deleted))
+	})
+
+	t.Run("Failure: recipients error is returned for retry", func(t *testing.T) {
+		task := newTask(t)
+		task.Update(task.ColumnID, task.BoardID, 1, "Task", nil, nil, nil)
+		updated := task.Events()[len(task.Events())-1]
+
+		recipients := mocks.NewRecipients(t)
+		recipients.On("Resolve", mock.Anything, updated).Return(nil, errors.New("db down")).Once()
+
+		repo := mocks.NewRepo(t)
+		repo.On("GetTaskIncludingDeleted", mock.Anything, task.ID).Return(task, nil).Once()
+
+		err := NewUC(repo, recipients).Handle(ctx, updated)
+		assert.ErrorIs(t, err, ErrCreateNotificationsUnknown)
+	})
+
+	t.Run("Success: purged task is skipped", func(t *testing.T) {
+		task := newTask(t)
+		assigned := task.Events()[len(task.Events())-1]
+
+		repo := mocks.NewRepo(t)
+		repo.On("GetTaskIncludingDeleted", mock.Anything, task.ID).Return(nil, pgx.ErrNoRows).Once()
+
+		require.NoError(t, NewUC(repo, mocks.NewRecipients(t)).Handle(ctx, assigned))
+	})
+
+	t.Run("Failure: repo error is returned for retry", func(t *testing.T) {
+		task := newTask(t)
+		assigned := task.Events()[len(task.Events())-1]
+
+		repo := mocks.NewRepo(t)
+		repo.On("GetTaskIncludingDeleted", mock.Anything, task.ID).Return(task, nil).Once()
+		repo.On("CreateNotifications", mock.Anything, mock.Anything).Return(errors.New("db down")).Once()
+
+		err := NewUC(repo, mocks.NewRecipients(t)).Handle(ctx, assigned)
+		assert.ErrorIs(t, err, ErrCreateNotificationsUnknown)
+	})
+}
@@ -0,0 +1,110 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/KungurtsevNII/team-board-back/src/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// Repo is an autogenerated mock type for the Repo type
+type Repo struct {
+	mock.Mock
+}
+
+// CreateNotifications provides a mock function with given fields: ctx, notifications
+func (_m *Repo) CreateNotifications(ctx context.Context, notifications []domain.Notification) error {
+	ret := _m.Called(ctx, notifications)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateNotifications")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.Notification) error); ok {
+		r0 = rf(ctx, notifications)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetColumnByID provides a mock function with given fields: ctx, columnID
+func (_m *Repo) GetColumnByID(ctx context.Context, columnID uuid.UUID) (*domain.Column, error) {
+	ret := _m.Called(ctx, columnID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetColumnByID")
+	}
+
+	var r0 *domain.Column
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.Column, error)); ok {
+		return rf(ctx, columnID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.Column); ok {
+		r0 = rf(ctx, columnID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Column)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, columnID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTaskIncludingDeleted provides a mock function with given fields: ctx, taskID
+func (_m *Repo) GetTaskIncludingDeleted(ctx context.Context, taskID uuid.UUID) (*domain.Task, error) {
+	ret := _m.Called(ctx, taskID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTaskIncludingDeleted")
+	}
+
+	var r0 *domain.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.Task, error)); ok {
+		return rf(ctx, taskID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.Task); ok {
+		r0 = rf(ctx, taskID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, taskID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewRepo creates a new instance of Repo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Repo {
+	mock := &Repo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
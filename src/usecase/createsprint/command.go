@@ -0,0 +1,51 @@
+package createsprint
+
+import (
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type Command struct {
+	BoardID   uuid.UUID `validate:"required,uuid"`
+	Name      string    `validate:"required,min=1,max=100"`
+	Goal      *string
+	StartDate time.Time
+	EndDate   time.Time
+}
+
+func NewCommand(boardID, name string, goal *string, startDate, endDate string) (Command, error) {
+	validate := validator.New()
+
+	bID, err := uuid.Parse(boardID)
+	if err != nil {
+		return Command{}, errors.Wrap(ErrInvalidUUID, err.Error())
+	}
+
+	start, err := time.Parse(time.DateOnly, startDate)
+	if err != nil {
+		return Command{}, errors.Wrap(ErrInvalidDate, err.Error())
+	}
+
+	end, err := time.Parse(time.DateOnly, endDate)
+	if err != nil {
+		return Command{}, errors.Wrap(ErrInvalidDate, err.Error())
+	}
+
+	cmd := Command{
+		BoardID:   bID,
+		Name:      name,
+		Goal:      goal,
+		StartDate: start,
+		EndDate:   end,
+	}
+
+	err = validate.Struct(cmd)
+	if err != nil {
+		return Command{}, errors.Wrap(ErrValidationFailed, err.Error())
+	}
+
+	return cmd, nil
+}
@@ -0,0 +1,13 @@
+package createsprint
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidUUID         = errors.New("invalid uuid")
+	ErrInvalidDate         = errors.New("invalid date")
+	ErrValidationFailed    = errors.New("validation failed")
+	ErrBoardNotFound       = errors.New("board not found")
+	ErrCreateSprintUnknown = errors.New("failed to create sprint")
+)
@@ -0,0 +1,41 @@
+package createsprint
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	CheckBoard(ctx context.Context, id string) bool
+	CreateSprint(ctx context.Context, sprint *domain.Sprint) error
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+func (uc *UC) Handle(ctx context.Context, cmd Command) (*domain.Sprint, error) {
+	if !uc.repo.CheckBoard(ctx, cmd.BoardID.String()) {
+		return nil, ErrBoardNotFound
+	}
+
+	sprint, err := domain.NewSprint(cmd.BoardID, cmd.Name, cmd.Goal, cmd.StartDate, cmd.EndDate)
+	if err != nil {
+		return nil, errors.Wrap(ErrValidationFailed, err.Error())
+	}
+
+	err = uc.repo.CreateSprint(ctx, sprint)
+	if err != nil {
+		return nil, errors.Wrap(ErrCreateSprintUnknown, err.Error())
+	}
+
+	return sprint, nil
+}
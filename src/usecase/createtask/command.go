@@ -11,9 +11,13 @@ type Command struct {
 	ColumnID    uuid.UUID `validate:"required,uuid"`
 	BoardID     uuid.UUID `validate:"required,uuid"`
 	Title       string    `validate:"required,min=1,max=255"`
-	Description *string
+	Description *string   `validate:"omitempty,max=20000"`
 	Tags        []string
 	Checklists  []domain.Checklist
+	// SyncChecklists - собрать чеклист из списка задач "- [ ]" в описании
+	SyncChecklists bool
+	// Reporter - автор задачи из заголовка User-ID, может быть пустым
+	Reporter *string `validate:"omitempty,max=100"`
 }
 
 func NewCommand(
@@ -21,6 +25,8 @@ func NewCommand(
 	description *string,
 	tags []string,
 	checklists []domain.Checklist,
+	reporter string,
+	syncChecklists bool,
 ) (Command, error) {
 	validate := validator.New()
 
@@ -35,12 +41,16 @@ func NewCommand(
 	}
 
 	ctc := Command{
-		ColumnID:    cID,
-		BoardID:     bID,
-		Title:       name,
-		Description: description,
-		Tags:        tags,
-		Checklists:  checklists,
+		ColumnID:       cID,
+		BoardID:        bID,
+		Title:          name,
+		Description:    description,
+		Tags:           tags,
+		Checklists:     checklists,
+		SyncChecklists: syncChecklists,
+	}
+	if reporter != "" {
+		ctc.Reporter = &reporter
 	}
 
 	err = validate.Struct(ctc)
@@ -23,6 +23,7 @@ type Repo interface {
 	CheckColumnInBoard(ctx context.Context, boardID uuid.UUID, columnID uuid.UUID) (bool, error)
 	GetLastNumberTask(ctx context.Context, boardID uuid.UUID) (int64, error)
 	CreateTask(ctx context.Context, task *domain.Task) error
+	ResolveTaskRefs(ctx context.Context, refs []domain.TaskRef) ([]domain.TaskRef, error)
 }
 
 func (uc *UC) Handle(ctx context.Context, cmd Command) (task *domain.Task, err error) {
@@ -43,6 +44,11 @@ func (uc *UC) Handle(ctx context.Context, cmd Command) (task *domain.Task, err e
 		number = 0
 	}
 
+	checklists := cmd.Checklists
+	if cmd.SyncChecklists {
+		checklists = domain.SyncTaskListChecklist(cmd.Description, checklists)
+	}
+
 	task, err = domain.NewTask(
 		cmd.ColumnID,
 		cmd.BoardID,
@@ -50,16 +56,22 @@ func (uc *UC) Handle(ctx context.Context, cmd Command) (task *domain.Task, err e
 		cmd.Title,
 		cmd.Description,
 		cmd.Tags,
-		cmd.Checklists,
+		checklists,
 	)
 	if err != nil {
 		return nil, errors.Wrap(ErrValidationFailed, err.Error())
 	}
+	task.Reporter = cmd.Reporter
 
 	err = uc.repo.CreateTask(ctx, task)
 	if err != nil {
 		return nil, errors.Wrap(ErrCreateTaskUnknown, err.Error())
 	}
 
+	task.References, err = uc.repo.ResolveTaskRefs(ctx, task.TaskRefs())
+	if err != nil {
+		return nil, errors.Wrap(ErrCreateTaskUnknown, err.Error())
+	}
+
 	return task, nil
 }
@@ -0,0 +1,30 @@
+package createtasklink
+
+import (
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type Command struct {
+	TaskID   uuid.UUID
+	ToTaskID uuid.UUID
+	Type     string
+}
+
+func NewCommand(taskID, toTaskID, linkType string) (Command, error) {
+	tID, err := uuid.Parse(taskID)
+	if err != nil {
+		return Command{}, errors.Wrap(ErrInvalidUUID, err.Error())
+	}
+
+	toID, err := uuid.Parse(toTaskID)
+	if err != nil {
+		return Command{}, errors.Wrap(ErrInvalidUUID, err.Error())
+	}
+
+	return Command{
+		TaskID:   tID,
+		ToTaskID: toID,
+		Type:     linkType,
+	}, nil
+}
@@ -0,0 +1,14 @@
+package createtasklink
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidUUID           = errors.New("invalid uuid")
+	ErrValidationFailed      = errors.New("validation failed")
+	ErrTaskNotFound          = errors.New("task not found")
+	ErrLinkedTaskNotFound    = errors.New("linked task not found")
+	ErrLinkAlreadyExists     = errors.New("link already exists")
+	ErrCreateTaskLinkUnknown = errors.New("unknown error creating task link")
+)
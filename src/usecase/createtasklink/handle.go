@@ -0,0 +1,65 @@
+package createtasklink
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetTaskByID(ctx context.Context, taskID uuid.UUID) (*domain.Task, error)
+	GetTaskLinks(ctx context.Context, taskID uuid.UUID) ([]domain.TaskLink, error)
+	CreateTaskLink(ctx context.Context, link *domain.TaskLink) error
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+func (uc *UC) Handle(ctx context.Context, cmd Command) (*domain.TaskLink, error) {
+	_, err := uc.repo.GetTaskByID(ctx, cmd.TaskID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrTaskNotFound
+		}
+		return nil, errors.Wrap(ErrCreateTaskLinkUnknown, err.Error())
+	}
+
+	linked, err := uc.repo.GetTaskByID(ctx, cmd.ToTaskID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrLinkedTaskNotFound
+		}
+		return nil, errors.Wrap(ErrCreateTaskLinkUnknown, err.Error())
+	}
+
+	link, err := domain.NewTaskLink(cmd.TaskID, cmd.ToTaskID, cmd.Type)
+	if err != nil {
+		return nil, errors.Wrap(ErrValidationFailed, err.Error())
+	}
+
+	existing, err := uc.repo.GetTaskLinks(ctx, cmd.TaskID)
+	if err != nil {
+		return nil, errors.Wrap(ErrCreateTaskLinkUnknown, err.Error())
+	}
+	if link.Duplicates(existing) {
+		return nil, ErrLinkAlreadyExists
+	}
+
+	err = uc.repo.CreateTaskLink(ctx, link)
+	if err != nil {
+		return nil, errors.Wrap(ErrCreateTaskLinkUnknown, err.Error())
+	}
+
+	link.LinkedTask = linked
+	return link, nil
+}
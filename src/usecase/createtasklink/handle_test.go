@@ -0,0 +1,118 @@
+package createtasklink
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/createtasklink/mocks"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandle(t *testing.T) {
+	ctx := context.Background()
+	taskID := uuid.New()
+	toTaskID := uuid.New()
+
+	task := &domain.Task{ID: taskID}
+	toTask := &domain.Task{ID: toTaskID}
+
+	testCases := []struct {
+		name        string
+		command     Command
+		setupMock   func(*mocks.Repo)
+		expectError error
+	}{
+		{
+			name:    "Success: link created",
+			command: Command{TaskID: taskID, ToTaskID: toTaskID, Type: "blocks"},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTaskByID", mock.Anything, taskID).Return(task, nil).Once()
+				repo.On("GetTaskByID", mock.Anything, toTaskID).Return(toTask, nil).Once()
+				repo.On("GetTaskLinks", mock.Anything, taskID).Return([]domain.TaskLink{}, nil).Once()
+				repo.On("CreateTaskLink", mock.Anything, mock.AnythingOfType("*domain.TaskLink")).Return(nil).Once()
+			},
+		},
+		{
+			name:    "Failure: task not found",
+			command: Command{TaskID: taskID, ToTaskID: toTaskID, Type: "blocks"},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTaskByID", mock.Anything, taskID).Return(nil, pgx.ErrNoRows).Once()
+			},
+			expectError: ErrTaskNotFound,
+		},
+		{
+			name:    "Failure: linked task not found",
+			command: Command{TaskID: taskID, ToTaskID: toTaskID, Type: "blocks"},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTaskByID", mock.Anything, taskID).Return(task, nil).Once()
+				repo.On("GetTaskByID", mock.Anything, toTaskID).Return(nil, pgx.ErrNoRows).Once()
+			},
+			expectError: ErrLinkedTaskNotFound,
+		},
+		{
+			name:    "Failure: invalid link type",
+			command: Command{TaskID: taskID, ToTaskID: toTaskID, Type: "depends_on"},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTaskByID", mock.Anything, taskID).Return(task, nil).Once()
+				repo.On("GetTaskByID", mock.Anything, toTaskID).Return(toTask, nil).Once()
+			},
+			expectError: ErrValidationFailed,
+		},
+		{
+			name:    "Failure: symmetric link already exists",
+			command: Command{TaskID: taskID, ToTaskID: toTaskID, Type: "relates_to"},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTaskByID", mock.Anything, taskID).Return(task, nil).Once()
+				repo.On("GetTaskByID", mock.Anything, toTaskID).Return(toTask, nil).Once()
+				repo.On("GetTaskLinks", mock.Anything, taskID).Return([]domain.TaskLink{{
+					ID:         uuid.New(),
+					FromTaskID: toTaskID,
+					ToTaskID:   taskID,
+					Type:       domain.LinkRelatesTo,
+				}}, nil).Once()
+			},
+			expectError: ErrLinkAlreadyExists,
+		},
+		{
+			name:    "Failure: create link error",
+			command: Command{TaskID: taskID, ToTaskID: toTaskID, Type: "duplicates"},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTaskByID", mock.Anything, taskID).Return(task, nil).Once()
+				repo.On("GetTaskByID", mock.Anything, toTaskID).Return(toTask, nil).Once()
+				repo.On("GetTaskLinks", mock.Anything, taskID).Return([]domain.TaskLink{}, nil).Once()
+				repo.On("CreateTaskLink", mock.Anything, mock.AnythingOfType("*domain.TaskLink")).Return(errors.New("db error")).Once()
+			},
+			expectError: ErrCreateTaskLinkUnknown,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := mocks.NewRepo(t)
+			tc.setupMock(repo)
+
+			uc := NewUC(repo)
+			link, err := uc.Handle(ctx, tc.command)
+
+			if tc.expectError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tc.expectError)
+				assert.Nil(t, link)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, link)
+				assert.Equal(t, taskID, link.FromTaskID)
+				assert.Equal(t, toTaskID, link.ToTaskID)
+				assert.Equal(t, toTask, link.LinkedTask)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
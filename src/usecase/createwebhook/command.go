@@ -0,0 +1,28 @@
+package createwebhook
+
+import (
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type Command struct {
+	BoardID uuid.UUID
+	URL     string
+	Events  []string
+	// Secret - ключ подписи HMAC, пустой сгенерируется
+	Secret string
+}
+
+func NewCommand(boardID string, url string, events []string, secret string) (Command, error) {
+	bID, err := uuid.Parse(boardID)
+	if err != nil {
+		return Command{}, errors.Wrap(ErrInvalidBoardID, err.Error())
+	}
+
+	return Command{
+		BoardID: bID,
+		URL:     url,
+		Events:  events,
+		Secret:  secret,
+	}, nil
+}
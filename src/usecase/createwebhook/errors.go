@@ -0,0 +1,12 @@
+package createwebhook
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidBoardID       = errors.New("invalid board id")
+	ErrInvalidWebhook       = errors.New("invalid webhook")
+	ErrBoardNotFound        = errors.New("board not found")
+	ErrCreateWebhookUnknown = errors.New("unknown error creating webhook")
+)
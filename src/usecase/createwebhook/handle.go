@@ -0,0 +1,49 @@
+package createwebhook
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetBoardIncludingDeleted(ctx context.Context, boardID uuid.UUID) (*domain.Board, error)
+	CreateWebhook(ctx context.Context, webhook *domain.Webhook) error
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+func (uc *UC) Handle(ctx context.Context, cmd Command) (*domain.Webhook, error) {
+	board, err := uc.repo.GetBoardIncludingDeleted(ctx, cmd.BoardID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrBoardNotFound
+		}
+		return nil, errors.Wrap(ErrCreateWebhookUnknown, err.Error())
+	}
+	if board.DeletedAt != nil {
+		return nil, ErrBoardNotFound
+	}
+
+	webhook, err := domain.NewWebhook(cmd.BoardID, cmd.URL, cmd.Events, cmd.Secret)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidWebhook, err.Error())
+	}
+
+	if err := uc.repo.CreateWebhook(ctx, webhook); err != nil {
+		return nil, errors.Wrap(ErrCreateWebhookUnknown, err.Error())
+	}
+
+	return webhook, nil
+}
@@ -0,0 +1,24 @@
+package deleteattachment
+
+import (
+	"github.com/google/uuid"
+)
+
+type Command struct {
+	TaskID       uuid.UUID
+	AttachmentID uuid.UUID
+}
+
+func NewCommand(taskID, attachmentID string) (Command, error) {
+	tID, err := uuid.Parse(taskID)
+	if err != nil {
+		return Command{}, ErrInvalidTaskID
+	}
+
+	aID, err := uuid.Parse(attachmentID)
+	if err != nil {
+		return Command{}, ErrInvalidAttachmentID
+	}
+
+	return Command{TaskID: tID, AttachmentID: aID}, nil
+}
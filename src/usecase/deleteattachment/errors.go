@@ -0,0 +1,12 @@
+package deleteattachment
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidTaskID       = errors.New("invalid task id")
+	ErrInvalidAttachmentID = errors.New("invalid attachment id")
+	ErrAttachmentNotFound  = errors.New("attachment not found")
+	ErrDeleteUnknown       = errors.New("unknown error deleting attachment")
+)
@@ -0,0 +1,57 @@
+package deleteattachment
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetAttachment(ctx context.Context, taskID, attachmentID uuid.UUID) (*domain.Attachment, error)
+	DeleteAttachment(ctx context.Context, taskID, attachmentID uuid.UUID) (*domain.Attachment, error)
+}
+
+type Storage interface {
+	Delete(ctx context.Context, key string) error
+}
+
+type UC struct {
+	repo    Repo
+	storage Storage
+}
+
+func NewUC(repo Repo, storage Storage) *UC {
+	return &UC{
+		repo:    repo,
+		storage: storage,
+	}
+}
+
+// Handle сначала удаляет содержимое, потом запись: если удаление записи
+// не удалось, повторный запрос доведет его до конца, а объект без записи
+// в хранилище не останется.
+func (uc *UC) Handle(ctx context.Context, cmd Command) error {
+	attachment, err := uc.repo.GetAttachment(ctx, cmd.TaskID, cmd.AttachmentID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrAttachmentNotFound
+		}
+		return errors.Wrap(ErrDeleteUnknown, err.Error())
+	}
+
+	if err := uc.storage.Delete(ctx, attachment.StorageKey); err != nil {
+		return errors.Wrap(ErrDeleteUnknown, err.Error())
+	}
+
+	if _, err := uc.repo.DeleteAttachment(ctx, cmd.TaskID, cmd.AttachmentID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrAttachmentNotFound
+		}
+		return errors.Wrap(ErrDeleteUnknown, err.Error())
+	}
+
+	return nil
+}
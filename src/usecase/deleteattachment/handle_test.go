@@ -0,0 +1,47 @@
+package deleteattachment
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/deleteattachment/mocks"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandle(t *testing.T) {
+	ctx := context.Background()
+	a, err := domain.NewAttachment(uuid.New(), "a.txt", "text/plain", "alice", 1)
+	assert.NoError(t, err)
+	cmd := Command{TaskID: a.TaskID, AttachmentID: a.ID}
+
+	t.Run("Success", func(t *testing.T) {
+		repo := mocks.NewRepo(t)
+		storage := mocks.NewStorage(t)
+		repo.On("GetAttachment", ctx, a.TaskID, a.ID).Return(a, nil)
+		storage.On("Delete", ctx, a.StorageKey).Return(nil)
+		repo.On("DeleteAttachment", ctx, a.TaskID, a.ID).Return(a, nil)
+
+		assert.NoError(t, NewUC(repo, storage).Handle(ctx, cmd))
+	})
+
+	t.Run("Error: not found", func(t *testing.T) {
+		repo := mocks.NewRepo(t)
+		storage := mocks.NewStorage(t)
+		repo.On("GetAttachment", ctx, a.TaskID, a.ID).Return(nil, pgx.ErrNoRows)
+
+		assert.ErrorIs(t, NewUC(repo, storage).Handle(ctx, cmd), ErrAttachmentNotFound)
+	})
+
+	t.Run("Error: storage failed, record kept", func(t *testing.T) {
+		repo := mocks.NewRepo(t)
+		storage := mocks.NewStorage(t)
+		repo.On("GetAttachment", ctx, a.TaskID, a.ID).Return(a, nil)
+		storage.On("Delete", ctx, a.StorageKey).Return(errors.New("s3 down"))
+
+		assert.ErrorIs(t, NewUC(repo, storage).Handle(ctx, cmd), ErrDeleteUnknown)
+	})
+}
@@ -0,0 +1,19 @@
+package deleteboardtemplate
+
+import (
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type Command struct {
+	TemplateID uuid.UUID
+}
+
+func NewCommand(templateID string) (Command, error) {
+	tID, err := uuid.Parse(templateID)
+	if err != nil {
+		return Command{}, errors.Wrap(ErrInvalidUUID, err.Error())
+	}
+
+	return Command{TemplateID: tID}, nil
+}
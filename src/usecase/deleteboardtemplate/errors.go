@@ -0,0 +1,11 @@
+package deleteboardtemplate
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidUUID                = errors.New("invalid uuid")
+	ErrTemplateNotFound           = errors.New("board template not found")
+	ErrDeleteBoardTemplateUnknown = errors.New("unknown error deleting board template")
+)
@@ -0,0 +1,44 @@
+package deleteboardtemplate
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetBoardTemplateByID(ctx context.Context, templateID uuid.UUID) (*domain.BoardTemplate, error)
+	UpdateBoardTemplate(ctx context.Context, template *domain.BoardTemplate) error
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+func (uc *UC) Handle(ctx context.Context, cmd Command) error {
+	tpl, err := uc.repo.GetBoardTemplateByID(ctx, cmd.TemplateID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrTemplateNotFound
+		}
+		return errors.Wrap(ErrDeleteBoardTemplateUnknown, err.Error())
+	}
+
+	tpl.Delete()
+
+	err = uc.repo.UpdateBoardTemplate(ctx, tpl)
+	if err != nil {
+		return errors.Wrap(ErrDeleteBoardTemplateUnknown, err.Error())
+	}
+
+	return nil
+}
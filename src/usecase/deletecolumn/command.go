@@ -5,16 +5,31 @@ import (
 )
 
 type Command struct {
-	ColumnID uuid.UUID 
+	ColumnID uuid.UUID
+	// Куда перенести задачи перед удалением; nil - колонка должна быть пустой
+	MoveTasksTo *uuid.UUID
 }
 
-func NewCommand(taskID string) (Command, error) {
+func NewCommand(taskID string, moveTasksTo string) (Command, error) {
 	uid, err := uuid.Parse(taskID)
 	if err != nil {
 		return Command{}, ErrInvalidColumnID
 	}
 
-	return Command{
+	cmd := Command{
 		ColumnID: uid,
-	}, nil
+	}
+
+	if moveTasksTo != "" {
+		targetID, err := uuid.Parse(moveTasksTo)
+		if err != nil {
+			return Command{}, ErrInvalidTargetColumnID
+		}
+		if targetID == uid {
+			return Command{}, ErrInvalidTargetColumnID
+		}
+		cmd.MoveTasksTo = &targetID
+	}
+
+	return cmd, nil
 }
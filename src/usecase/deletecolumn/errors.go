@@ -11,4 +11,7 @@ var (
 	ErrGetColumnUnknown = errors.New("unknown error getting column")
 	ErrCheckColumnIsEmptyUnknown = errors.New("unknown error checking if column is empty")
 	ErrColumnNotEmpty = errors.New("column is not empty")
+	ErrInvalidTargetColumnID = errors.New("invalid move_tasks_to column id")
+	ErrTargetColumnNotInBoard = errors.New("move_tasks_to column not found in the same board")
+	ErrLastColumn = errors.New("cannot delete the last column of the board")
 )
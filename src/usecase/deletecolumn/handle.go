@@ -13,6 +13,8 @@ type Repo interface {
 	GetColumnByID(ctx context.Context, columnID uuid.UUID) (*domain.Column, error)
 	CheckColumnIsEmpty(ctx context.Context, columnID uuid.UUID) (bool, error)
 	UpdateColumn(ctx context.Context, column *domain.Column) error
+	GetColumns(ctx context.Context, boardID uuid.UUID) ([]domain.Column, error)
+	DeleteColumnMovingTasks(ctx context.Context, column *domain.Column, targetID uuid.UUID) (int64, error)
 }
 
 type UC struct {
@@ -34,6 +36,18 @@ func (uc *UC) Handle(ctx context.Context, cmd Command) error{
 		return errors.Wrap(ErrGetColumnUnknown, err.Error())
 	}
 
+	columns, err := uc.repo.GetColumns(ctx, dmn.BoardID)
+	if err != nil {
+		return errors.Wrap(ErrGetColumnUnknown, err.Error())
+	}
+	if len(columns) <= 1 {
+		return ErrLastColumn
+	}
+
+	if cmd.MoveTasksTo != nil {
+		return uc.deleteMovingTasks(ctx, dmn, columns, *cmd.MoveTasksTo)
+	}
+
 	isEmpty, err := uc.repo.CheckColumnIsEmpty(ctx, cmd.ColumnID)
 	if err != nil {
 		return errors.Wrap(ErrCheckColumnIsEmptyUnknown, err.Error())
@@ -50,3 +64,28 @@ func (uc *UC) Handle(ctx context.Context, cmd Command) error{
 	}
 	return nil
 }
+
+// deleteMovingTasks переносит задачи колонки в targetID той же доски и удаляет колонку одной транзакцией.
+func (uc *UC) deleteMovingTasks(ctx context.Context, dmn *domain.Column, columns []domain.Column, targetID uuid.UUID) error {
+	inBoard := false
+	for _, c := range columns {
+		if c.ID == targetID {
+			inBoard = true
+			break
+		}
+	}
+	if !inBoard {
+		return ErrTargetColumnNotInBoard
+	}
+
+	dmn.Delete()
+
+	_, err := uc.repo.DeleteColumnMovingTasks(ctx, dmn, targetID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrTargetColumnNotInBoard
+		}
+		return errors.Wrap(ErrDeleteColumnUnknown, err.Error())
+	}
+	return nil
+}
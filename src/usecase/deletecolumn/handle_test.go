@@ -0,0 +1,153 @@
+package deletecolumn
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/deletecolumn/mocks"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandle(t *testing.T) {
+	ctx := context.Background()
+	boardID := uuid.New()
+	columnID := uuid.New()
+	targetID := uuid.New()
+	otherBoardColumnID := uuid.New()
+
+	column := func() *domain.Column {
+		return &domain.Column{ID: columnID, BoardID: boardID, Name: "TODO"}
+	}
+	columns := []domain.Column{
+		{ID: columnID, BoardID: boardID},
+		{ID: targetID, BoardID: boardID},
+	}
+
+	testCases := []struct {
+		name        string
+		command     Command
+		setupMock   func(*mocks.Repo)
+		expectError error
+	}{
+		{
+			name:    "Success: empty column deleted",
+			command: Command{ColumnID: columnID},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetColumnByID", mock.Anything, columnID).Return(column(), nil).Once()
+				repo.On("GetColumns", mock.Anything, boardID).Return(columns, nil).Once()
+				repo.On("CheckColumnIsEmpty", mock.Anything, columnID).Return(true, nil).Once()
+				repo.On("UpdateColumn", mock.Anything, mock.MatchedBy(func(c *domain.Column) bool {
+					return c.DeletedAt != nil
+				})).Return(nil).Once()
+			},
+		},
+		{
+			name:    "Success: tasks moved and column deleted",
+			command: Command{ColumnID: columnID, MoveTasksTo: &targetID},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetColumnByID", mock.Anything, columnID).Return(column(), nil).Once()
+				repo.On("GetColumns", mock.Anything, boardID).Return(columns, nil).Once()
+				repo.On("DeleteColumnMovingTasks", mock.Anything, mock.MatchedBy(func(c *domain.Column) bool {
+					return c.DeletedAt != nil
+				}), targetID).Return(int64(5), nil).Once()
+			},
+		},
+		{
+			name:    "Failure: column not found",
+			command: Command{ColumnID: columnID},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetColumnByID", mock.Anything, columnID).Return(nil, pgx.ErrNoRows).Once()
+			},
+			expectError: ErrColumnNotFound,
+		},
+		{
+			name:    "Failure: last column of the board",
+			command: Command{ColumnID: columnID, MoveTasksTo: &targetID},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetColumnByID", mock.Anything, columnID).Return(column(), nil).Once()
+				repo.On("GetColumns", mock.Anything, boardID).Return(columns[:1], nil).Once()
+			},
+			expectError: ErrLastColumn,
+		},
+		{
+			name:    "Failure: column is not empty",
+			command: Command{ColumnID: columnID},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetColumnByID", mock.Anything, columnID).Return(column(), nil).Once()
+				repo.On("GetColumns", mock.Anything, boardID).Return(columns, nil).Once()
+				repo.On("CheckColumnIsEmpty", mock.Anything, columnID).Return(false, nil).Once()
+			},
+			expectError: ErrColumnNotEmpty,
+		},
+		{
+			name:    "Failure: target column from another board",
+			command: Command{ColumnID: columnID, MoveTasksTo: &otherBoardColumnID},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetColumnByID", mock.Anything, columnID).Return(column(), nil).Once()
+				repo.On("GetColumns", mock.Anything, boardID).Return(columns, nil).Once()
+			},
+			expectError: ErrTargetColumnNotInBoard,
+		},
+		{
+			name:    "Failure: target column deleted concurrently",
+			command: Command{ColumnID: columnID, MoveTasksTo: &targetID},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetColumnByID", mock.Anything, columnID).Return(column(), nil).Once()
+				repo.On("GetColumns", mock.Anything, boardID).Return(columns, nil).Once()
+				repo.On("DeleteColumnMovingTasks", mock.Anything, mock.AnythingOfType("*domain.Column"), targetID).
+					Return(int64(0), pgx.ErrNoRows).Once()
+			},
+			expectError: ErrTargetColumnNotInBoard,
+		},
+		{
+			name:    "Failure: move tasks error",
+			command: Command{ColumnID: columnID, MoveTasksTo: &targetID},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetColumnByID", mock.Anything, columnID).Return(column(), nil).Once()
+				repo.On("GetColumns", mock.Anything, boardID).Return(columns, nil).Once()
+				repo.On("DeleteColumnMovingTasks", mock.Anything, mock.AnythingOfType("*domain.Column"), targetID).
+					Return(int64(0), errors.New("db error")).Once()
+			},
+			expectError: ErrDeleteColumnUnknown,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := mocks.NewRepo(t)
+			tc.setupMock(repo)
+
+			uc := NewUC(repo)
+			err := uc.Handle(ctx, tc.command)
+
+			if tc.expectError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tc.expectError)
+			} else {
+				require.NoError(t, err)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestNewCommand(t *testing.T) {
+	columnID := uuid.New()
+
+	cmd, err := NewCommand(columnID.String(), "")
+	require.NoError(t, err)
+	assert.Nil(t, cmd.MoveTasksTo)
+
+	_, err = NewCommand(columnID.String(), "not-a-uuid")
+	assert.ErrorIs(t, err, ErrInvalidTargetColumnID)
+
+	_, err = NewCommand(columnID.String(), columnID.String())
+	assert.ErrorIs(t, err, ErrInvalidTargetColumnID)
+}
@@ -0,0 +1,166 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/KungurtsevNII/team-board-back/src/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// Repo is an autogenerated mock type for the Repo type
+type Repo struct {
+	mock.Mock
+}
+
+// CheckColumnIsEmpty provides a mock function with given fields: ctx, columnID
+func (_m *Repo) CheckColumnIsEmpty(ctx context.Context, columnID uuid.UUID) (bool, error) {
+	ret := _m.Called(ctx, columnID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckColumnIsEmpty")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (bool, error)); ok {
+		return rf(ctx, columnID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) bool); ok {
+		r0 = rf(ctx, columnID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, columnID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteColumnMovingTasks provides a mock function with given fields: ctx, column, targetID
+func (_m *Repo) DeleteColumnMovingTasks(ctx context.Context, column *domain.Column, targetID uuid.UUID) (int64, error) {
+	ret := _m.Called(ctx, column, targetID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteColumnMovingTasks")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Column, uuid.UUID) (int64, error)); ok {
+		return rf(ctx, column, targetID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Column, uuid.UUID) int64); ok {
+		r0 = rf(ctx, column, targetID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.Column, uuid.UUID) error); ok {
+		r1 = rf(ctx, column, targetID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetColumnByID provides a mock function with given fields: ctx, columnID
+func (_m *Repo) GetColumnByID(ctx context.Context, columnID uuid.UUID) (*domain.Column, error) {
+	ret := _m.Called(ctx, columnID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetColumnByID")
+	}
+
+	var r0 *domain.Column
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.Column, error)); ok {
+		return rf(ctx, columnID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.Column); ok {
+		r0 = rf(ctx, columnID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Column)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, columnID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetColumns provides a mock function with given fields: ctx, boardID
+func (_m *Repo) GetColumns(ctx context.Context, boardID uuid.UUID) ([]domain.Column, error) {
+	ret := _m.Called(ctx, boardID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetColumns")
+	}
+
+	var r0 []domain.Column
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]domain.Column, error)); ok {
+		return rf(ctx, boardID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []domain.Column); ok {
+		r0 = rf(ctx, boardID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Column)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, boardID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateColumn provides a mock function with given fields: ctx, column
+func (_m *Repo) UpdateColumn(ctx context.Context, column *domain.Column) error {
+	ret := _m.Called(ctx, column)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateColumn")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Column) error); ok {
+		r0 = rf(ctx, column)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewRepo creates a new instance of Repo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Repo {
+	mock := &Repo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,20 @@
+package deletemilestone
+
+import (
+	"github.com/google/uuid"
+)
+
+type Command struct {
+	ID uuid.UUID
+}
+
+func NewCommand(id string) (Command, error) {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return Command{}, ErrInvalidMilestoneID
+	}
+
+	return Command{
+		ID: uid,
+	}, nil
+}
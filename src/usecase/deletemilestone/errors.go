@@ -0,0 +1,11 @@
+package deletemilestone
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidMilestoneID     = errors.New("invalid milestone id")
+	ErrMilestoneNotFound      = errors.New("milestone not found")
+	ErrDeleteMilestoneUnknown = errors.New("unknown error deleting milestone")
+)
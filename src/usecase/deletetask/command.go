@@ -4,17 +4,34 @@ import (
 	"github.com/google/uuid"
 )
 
+const (
+	ChildrenOrphan  = "orphan"
+	ChildrenCascade = "cascade"
+)
+
 type Command struct {
-	TaskID uuid.UUID 
+	TaskID  uuid.UUID
+	Cascade bool // удалить дочерние задачи вместе с родителем
 }
 
-func NewCommand(taskID string) (Command, error) {
+func NewCommand(taskID string, children string) (Command, error) {
 	uid, err := uuid.Parse(taskID)
 	if err != nil {
 		return Command{}, ErrInvalidTaskID
 	}
 
+	var cascade bool
+	switch children {
+	case "", ChildrenOrphan:
+		cascade = false
+	case ChildrenCascade:
+		cascade = true
+	default:
+		return Command{}, ErrInvalidChildrenMode
+	}
+
 	return Command{
-		TaskID: uid,
+		TaskID:  uid,
+		Cascade: cascade,
 	}, nil
 }
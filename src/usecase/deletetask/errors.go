@@ -9,4 +9,5 @@ var (
 	ErrInvalidTaskID  = errors.New("invalid task id")
 	ErrTaskNotFound = errors.New("task not found")
 	ErrGetTaskUnknown = errors.New("unknown error getting task")
+	ErrInvalidChildrenMode = errors.New("children mode must be orphan or cascade")
 )
@@ -10,7 +10,7 @@ import (
 
 type Repo interface {
 	GetTaskByID(ctx context.Context, taskID uuid.UUID) (*domain.Task, error)
-	UpdateTask(ctx context.Context, task *domain.Task) error
+	DeleteTask(ctx context.Context, task *domain.Task, cascade bool) error
 }
 
 type UC struct {
@@ -33,8 +33,11 @@ func (uc *UC) Handle(ctx context.Context, cmd Command) error{
 	}
 
 	dmn.Delete()
-	err = uc.repo.UpdateTask(ctx, dmn)
+	err = uc.repo.DeleteTask(ctx, dmn, cmd.Cascade)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrTaskNotFound
+		}
 		return errors.Wrap(ErrDeleteTaskUnknown, err.Error())
 	}
 	return nil
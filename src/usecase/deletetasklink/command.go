@@ -0,0 +1,28 @@
+package deletetasklink
+
+import (
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type Command struct {
+	TaskID uuid.UUID
+	LinkID uuid.UUID
+}
+
+func NewCommand(taskID, linkID string) (Command, error) {
+	tID, err := uuid.Parse(taskID)
+	if err != nil {
+		return Command{}, errors.Wrap(ErrInvalidUUID, err.Error())
+	}
+
+	lID, err := uuid.Parse(linkID)
+	if err != nil {
+		return Command{}, errors.Wrap(ErrInvalidUUID, err.Error())
+	}
+
+	return Command{
+		TaskID: tID,
+		LinkID: lID,
+	}, nil
+}
@@ -0,0 +1,11 @@
+package deletetasklink
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidUUID           = errors.New("invalid uuid")
+	ErrLinkNotFound          = errors.New("task link not found")
+	ErrDeleteTaskLinkUnknown = errors.New("unknown error deleting task link")
+)
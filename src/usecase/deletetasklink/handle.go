@@ -0,0 +1,49 @@
+package deletetasklink
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetTaskLinks(ctx context.Context, taskID uuid.UUID) ([]domain.TaskLink, error)
+	DeleteTaskLink(ctx context.Context, linkID uuid.UUID) error
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+func (uc *UC) Handle(ctx context.Context, cmd Command) error {
+	links, err := uc.repo.GetTaskLinks(ctx, cmd.TaskID)
+	if err != nil {
+		return errors.Wrap(ErrDeleteTaskLinkUnknown, err.Error())
+	}
+
+	found := false
+	for _, link := range links {
+		if link.ID == cmd.LinkID && link.Involves(cmd.TaskID) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return ErrLinkNotFound
+	}
+
+	err = uc.repo.DeleteTaskLink(ctx, cmd.LinkID)
+	if err != nil {
+		return errors.Wrap(ErrDeleteTaskLinkUnknown, err.Error())
+	}
+
+	return nil
+}
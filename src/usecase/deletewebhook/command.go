@@ -0,0 +1,18 @@
+package deletewebhook
+
+import (
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type Command struct {
+	WebhookID uuid.UUID
+}
+
+func NewCommand(webhookID string) (Command, error) {
+	id, err := uuid.Parse(webhookID)
+	if err != nil {
+		return Command{}, errors.Wrap(ErrInvalidWebhookID, err.Error())
+	}
+	return Command{WebhookID: id}, nil
+}
@@ -0,0 +1,11 @@
+package deletewebhook
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidWebhookID     = errors.New("invalid webhook id")
+	ErrWebhookNotFound      = errors.New("webhook not found")
+	ErrDeleteWebhookUnknown = errors.New("unknown error deleting webhook")
+)
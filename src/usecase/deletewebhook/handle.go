@@ -0,0 +1,35 @@
+package deletewebhook
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	DeleteWebhook(ctx context.Context, webhookID uuid.UUID) error
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+// Handle удаляет подписку вместе с журналом доставок, недоставленное не отправится.
+func (uc *UC) Handle(ctx context.Context, cmd Command) error {
+	err := uc.repo.DeleteWebhook(ctx, cmd.WebhookID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrWebhookNotFound
+		}
+		return errors.Wrap(ErrDeleteWebhookUnknown, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package deleteworklog
+
+import (
+	"github.com/google/uuid"
+)
+
+type Command struct {
+	TaskID    uuid.UUID
+	WorklogID uuid.UUID
+	// UserID - кто удаляет запись, удалять можно только свои
+	UserID string
+}
+
+func NewCommand(taskID, worklogID, userID string) (Command, error) {
+	tID, err := uuid.Parse(taskID)
+	if err != nil {
+		return Command{}, ErrInvalidTaskID
+	}
+
+	wID, err := uuid.Parse(worklogID)
+	if err != nil {
+		return Command{}, ErrInvalidWorklogID
+	}
+
+	if userID == "" {
+		return Command{}, ErrInvalidUserID
+	}
+
+	return Command{TaskID: tID, WorklogID: wID, UserID: userID}, nil
+}
@@ -0,0 +1,14 @@
+package deleteworklog
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidTaskID        = errors.New("invalid task id")
+	ErrInvalidWorklogID     = errors.New("invalid worklog id")
+	ErrInvalidUserID        = errors.New("invalid user id")
+	ErrWorklogNotFound      = errors.New("worklog not found")
+	ErrNotWorklogAuthor     = errors.New("only the author can delete a worklog")
+	ErrDeleteWorklogUnknown = errors.New("unknown error deleting worklog")
+)
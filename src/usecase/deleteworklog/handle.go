@@ -0,0 +1,44 @@
+package deleteworklog
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetWorklog(ctx context.Context, taskID, worklogID uuid.UUID) (*domain.Worklog, error)
+	DeleteWorklog(ctx context.Context, worklog *domain.Worklog) error
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+func (uc *UC) Handle(ctx context.Context, cmd Command) error {
+	worklog, err := uc.repo.GetWorklog(ctx, cmd.TaskID, cmd.WorklogID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrWorklogNotFound
+		}
+		return errors.Wrap(ErrDeleteWorklogUnknown, err.Error())
+	}
+	if worklog.UserID != cmd.UserID {
+		return ErrNotWorklogAuthor
+	}
+
+	if err := uc.repo.DeleteWorklog(ctx, worklog); err != nil {
+		return errors.Wrap(ErrDeleteWorklogUnknown, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,12 @@
+package deliverwebhooks
+
+type Command struct {
+	BatchSize int
+}
+
+func NewCommand(batchSize int) (Command, error) {
+	if batchSize <= 0 {
+		return Command{}, ErrInvalidBatchSize
+	}
+	return Command{BatchSize: batchSize}, nil
+}
@@ -0,0 +1,10 @@
+package deliverwebhooks
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidBatchSize       = errors.New("batch size must be positive")
+	ErrDeliverWebhooksUnknown = errors.New("unknown error delivering webhooks")
+)
@@ -0,0 +1,98 @@
+package deliverwebhooks
+
+import (
+	"context"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	ClaimWebhookDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]domain.WebhookDelivery, error)
+	GetWebhook(ctx context.Context, webhookID uuid.UUID) (*domain.Webhook, error)
+	UpdateWebhookDelivery(ctx context.Context, delivery *domain.WebhookDelivery) error
+}
+
+// Sender отправляет подписанное событие получателю. Возвращает код ответа
+// или 0, если ответа не было.
+type Sender interface {
+	Send(ctx context.Context, webhook *domain.Webhook, delivery *domain.WebhookDelivery) (int, error)
+}
+
+type UC struct {
+	repo   Repo
+	sender Sender
+	policy domain.WebhookRetryPolicy
+	// lease - на сколько забранная доставка скрывается от других отправителей
+	lease time.Duration
+}
+
+func NewUC(repo Repo, sender Sender, policy domain.WebhookRetryPolicy, lease time.Duration) *UC {
+	return &UC{
+		repo:   repo,
+		sender: sender,
+		policy: policy,
+		lease:  lease,
+	}
+}
+
+type Stats struct {
+	Delivered int
+	Retried   int
+	Dead      int
+}
+
+func (s Stats) Total() int {
+	return s.Delivered + s.Retried + s.Dead
+}
+
+// Handle отправляет одну пачку доставок, время которых пришло.
+func (uc *UC) Handle(ctx context.Context, cmd Command) (Stats, error) {
+	var stats Stats
+
+	deliveries, err := uc.repo.ClaimWebhookDeliveries(ctx, time.Now().UTC(), uc.lease, cmd.BatchSize)
+	if err != nil {
+		return stats, errors.Wrap(ErrDeliverWebhooksUnknown, err.Error())
+	}
+
+	webhooks := make(map[uuid.UUID]*domain.Webhook)
+	for i := range deliveries {
+		d := &deliveries[i]
+
+		webhook, ok := webhooks[d.WebhookID]
+		if !ok {
+			webhook, err = uc.repo.GetWebhook(ctx, d.WebhookID)
+			if err != nil {
+				if errors.Is(err, pgx.ErrNoRows) {
+					// подписку удалили, доставки уйдут вместе с ней
+					continue
+				}
+				return stats, errors.Wrap(ErrDeliverWebhooksUnknown, err.Error())
+			}
+			webhooks[d.WebhookID] = webhook
+		}
+
+		status, sendErr := uc.sender.Send(ctx, webhook, d)
+		now := time.Now().UTC()
+		if sendErr == nil {
+			d.MarkDelivered(status, now)
+			stats.Delivered++
+		} else {
+			d.MarkFailed(status, sendErr.Error(), uc.policy, now)
+			if d.Status == domain.WebhookDeliveryDead {
+				stats.Dead++
+			} else {
+				stats.Retried++
+			}
+		}
+
+		if err := uc.repo.UpdateWebhookDelivery(ctx, d); err != nil {
+			return stats, errors.Wrap(ErrDeliverWebhooksUnknown, err.Error())
+		}
+	}
+
+	return stats, nil
+}
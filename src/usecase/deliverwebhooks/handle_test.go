@@ -0,0 +1,114 @@
+package deliverwebhooks
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/deliverwebhooks/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSender отвечает одинаково на все доставки и запоминает их.
+type fakeSender struct {
+	status int
+	err    error
+	sent   []uuid.UUID
+}
+
+func (s *fakeSender) Send(_ context.Context, _ *domain.Webhook, delivery *domain.WebhookDelivery) (int, error) {
+	s.sent = append(s.sent, delivery.ID)
+	return s.status, s.err
+}
+
+func TestHandle(t *testing.T) {
+	ctx := context.Background()
+	policy := domain.WebhookRetryPolicy{MaxAttempts: 3, BaseDelay: time.Minute, MaxDelay: time.Hour}
+
+	newDelivery := func(webhookID uuid.UUID, attempts int) domain.WebhookDelivery {
+		task, err := domain.NewTask(uuid.New(), uuid.New(), 42, "Fix login", nil, nil, nil)
+		require.NoError(t, err)
+		require.NoError(t, task.MoveToColumn(uuid.New()))
+		event, ok := domain.WebhookEventFromDomain(task.Events()[1])
+		require.True(t, ok)
+		payload, err := event.Payload()
+		require.NoError(t, err)
+		d := domain.NewWebhookDelivery(webhookID, event, payload)
+		d.Attempts = attempts
+		return d
+	}
+
+	newHook := func() *domain.Webhook {
+		hook, err := domain.NewWebhook(uuid.New(), "https://ci.example.com/hook", []string{"task.moved"}, "")
+		require.NoError(t, err)
+		return hook
+	}
+
+	t.Run("Success: delivered on 2xx", func(t *testing.T) {
+		hook := newHook()
+		delivery := newDelivery(hook.ID, 0)
+		sender := &fakeSender{status: http.StatusNoContent}
+
+		repo := mocks.NewRepo(t)
+		repo.On("ClaimWebhookDeliveries", mock.Anything, mock.Anything, time.Minute, 10).
+			Return([]domain.WebhookDelivery{delivery}, nil).Once()
+		repo.On("GetWebhook", mock.Anything, hook.ID).Return(hook, nil).Once()
+		repo.On("UpdateWebhookDelivery", mock.Anything, mock.MatchedBy(func(d *domain.WebhookDelivery) bool {
+			return d.Status == domain.WebhookDeliveryDelivered && *d.LastStatusCode == http.StatusNoContent
+		})).Return(nil).Once()
+
+		uc := NewUC(repo, sender, policy, time.Minute)
+		stats, err := uc.Handle(ctx, Command{BatchSize: 10})
+		require.NoError(t, err)
+		assert.Equal(t, Stats{Delivered: 1}, stats)
+		assert.Equal(t, []uuid.UUID{delivery.ID}, sender.sent)
+	})
+
+	t.Run("Success: failed delivery is retried then dead-lettered", func(t *testing.T) {
+		hook := newHook()
+		first := newDelivery(hook.ID, 0)
+		last := newDelivery(hook.ID, policy.MaxAttempts-1)
+		sender := &fakeSender{status: http.StatusServiceUnavailable, err: errors.New("unexpected status 503: maintenance")}
+
+		repo := mocks.NewRepo(t)
+		repo.On("ClaimWebhookDeliveries", mock.Anything, mock.Anything, time.Minute, 10).
+			Return([]domain.WebhookDelivery{first, last}, nil).Once()
+		repo.On("GetWebhook", mock.Anything, hook.ID).Return(hook, nil).Once()
+		repo.On("UpdateWebhookDelivery", mock.Anything, mock.MatchedBy(func(d *domain.WebhookDelivery) bool {
+			return d.ID == first.ID && d.Status == domain.WebhookDeliveryPending &&
+				d.Attempts == 1 && d.NextAttemptAt != nil && *d.LastStatusCode == http.StatusServiceUnavailable
+		})).Return(nil).Once()
+		repo.On("UpdateWebhookDelivery", mock.Anything, mock.MatchedBy(func(d *domain.WebhookDelivery) bool {
+			return d.ID == last.ID && d.Status == domain.WebhookDeliveryDead && d.NextAttemptAt == nil
+		})).Return(nil).Once()
+
+		uc := NewUC(repo, sender, policy, time.Minute)
+		stats, err := uc.Handle(ctx, Command{BatchSize: 10})
+		require.NoError(t, err)
+		assert.Equal(t, Stats{Retried: 1, Dead: 1}, stats)
+	})
+
+	t.Run("Success: unreachable receiver counts as failed attempt", func(t *testing.T) {
+		hook := newHook()
+		sender := &fakeSender{err: errors.New("connection refused")}
+
+		repo := mocks.NewRepo(t)
+		repo.On("ClaimWebhookDeliveries", mock.Anything, mock.Anything, time.Minute, 10).
+			Return([]domain.WebhookDelivery{newDelivery(hook.ID, 0)}, nil).Once()
+		repo.On("GetWebhook", mock.Anything, hook.ID).Return(hook, nil).Once()
+		repo.On("UpdateWebhookDelivery", mock.Anything, mock.MatchedBy(func(d *domain.WebhookDelivery) bool {
+			return d.LastStatusCode == nil && d.LastError != nil && d.Status == domain.WebhookDeliveryPending
+		})).Return(nil).Once()
+
+		uc := NewUC(repo, sender, policy, time.Minute)
+		stats, err := uc.Handle(ctx, Command{BatchSize: 10})
+		require.NoError(t, err)
+		assert.Equal(t, Stats{Retried: 1}, stats)
+	})
+}
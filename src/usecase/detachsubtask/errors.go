@@ -0,0 +1,12 @@
+package detachsubtask
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidUUID          = errors.New("invalid uuid")
+	ErrTaskNotFound         = errors.New("task not found")
+	ErrTaskIsNotChild       = errors.New("task is not a child of the parent")
+	ErrDetachSubtaskUnknown = errors.New("unknown error detaching subtask")
+)
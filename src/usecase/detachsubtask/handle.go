@@ -0,0 +1,50 @@
+package detachsubtask
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetTaskByID(ctx context.Context, taskID uuid.UUID) (*domain.Task, error)
+	UpdateTask(ctx context.Context, task *domain.Task) error
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+func (uc *UC) Handle(ctx context.Context, cmd Command) error {
+	child, err := uc.repo.GetTaskByID(ctx, cmd.ChildID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrTaskNotFound
+		}
+		return errors.Wrap(ErrDetachSubtaskUnknown, err.Error())
+	}
+
+	err = child.DetachFromParent(cmd.ParentID)
+	if err != nil {
+		if errors.Is(err, domain.ErrTaskIsNotChild) {
+			return ErrTaskIsNotChild
+		}
+		return errors.Wrap(ErrDetachSubtaskUnknown, err.Error())
+	}
+
+	err = uc.repo.UpdateTask(ctx, child)
+	if err != nil {
+		return errors.Wrap(ErrDetachSubtaskUnknown, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,13 @@
+package downloadattachment
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidTaskID       = errors.New("invalid task id")
+	ErrInvalidAttachmentID = errors.New("invalid attachment id")
+	ErrAttachmentNotFound  = errors.New("attachment not found")
+	ErrContentNotFound     = errors.New("attachment content not found")
+	ErrDownloadUnknown     = errors.New("unknown error downloading attachment")
+)
@@ -0,0 +1,58 @@
+package downloadattachment
+
+import (
+	"context"
+	"io"
+	"io/fs"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetAttachment(ctx context.Context, taskID, attachmentID uuid.UUID) (*domain.Attachment, error)
+}
+
+type Storage interface {
+	Open(ctx context.Context, key string) (io.ReadSeekCloser, error)
+}
+
+// Result - метаданные и содержимое вложения. Content закрывает вызывающий.
+type Result struct {
+	Attachment domain.Attachment
+	Content    io.ReadSeekCloser
+}
+
+type UC struct {
+	repo    Repo
+	storage Storage
+}
+
+func NewUC(repo Repo, storage Storage) *UC {
+	return &UC{
+		repo:    repo,
+		storage: storage,
+	}
+}
+
+func (uc *UC) Handle(ctx context.Context, q Query) (*Result, error) {
+	attachment, err := uc.repo.GetAttachment(ctx, q.TaskID, q.AttachmentID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrAttachmentNotFound
+		}
+		return nil, errors.Wrap(ErrDownloadUnknown, err.Error())
+	}
+
+	content, err := uc.storage.Open(ctx, attachment.StorageKey)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, ErrContentNotFound
+		}
+		return nil, errors.Wrap(ErrDownloadUnknown, err.Error())
+	}
+
+	return &Result{Attachment: *attachment, Content: content}, nil
+}
@@ -0,0 +1,24 @@
+package downloadattachment
+
+import (
+	"github.com/google/uuid"
+)
+
+type Query struct {
+	TaskID       uuid.UUID
+	AttachmentID uuid.UUID
+}
+
+func NewQuery(taskID, attachmentID string) (Query, error) {
+	tID, err := uuid.Parse(taskID)
+	if err != nil {
+		return Query{}, ErrInvalidTaskID
+	}
+
+	aID, err := uuid.Parse(attachmentID)
+	if err != nil {
+		return Query{}, ErrInvalidAttachmentID
+	}
+
+	return Query{TaskID: tID, AttachmentID: aID}, nil
+}
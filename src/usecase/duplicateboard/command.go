@@ -0,0 +1,26 @@
+package duplicateboard
+
+import (
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type Command struct {
+	BoardID   uuid.UUID
+	Name      string
+	ShortName string
+}
+
+// NewCommand: имена новой доски проверяются в domain при копировании.
+func NewCommand(boardID string, name string, shortName string) (Command, error) {
+	bID, err := uuid.Parse(boardID)
+	if err != nil {
+		return Command{}, errors.Wrap(ErrInvalidUUID, err.Error())
+	}
+
+	return Command{
+		BoardID:   bID,
+		Name:      name,
+		ShortName: shortName,
+	}, nil
+}
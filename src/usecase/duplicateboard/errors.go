@@ -0,0 +1,13 @@
+package duplicateboard
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidUUID           = errors.New("invalid uuid")
+	ErrValidationFailed      = errors.New("validation failed")
+	ErrBoardNotFound         = errors.New("board not found")
+	ErrBoardIsExists         = errors.New("board with this shortname already exists")
+	ErrDuplicateBoardUnknown = errors.New("unknown error duplicating board")
+)
@@ -0,0 +1,68 @@
+package duplicateboard
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetBoard(ctx context.Context, ID uuid.UUID) (*domain.Board, error)
+	GetBoardTaskDetails(ctx context.Context, boardID uuid.UUID) ([]domain.Task, error)
+	GetStoryPointScale(ctx context.Context, boardID uuid.UUID) (domain.StoryPointScale, error)
+	CheckBoardShortName(ctx context.Context, shortName string) (bool, error)
+	CreateBoardWithTasks(ctx context.Context, board *domain.Board) error
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+func (uc *UC) Handle(ctx context.Context, cmd Command) (*domain.Board, error) {
+	src, err := uc.repo.GetBoard(ctx, cmd.BoardID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrBoardNotFound
+		}
+		return nil, errors.Wrap(ErrDuplicateBoardUnknown, err.Error())
+	}
+
+	src.Tasks, err = uc.repo.GetBoardTaskDetails(ctx, src.ID)
+	if err != nil {
+		return nil, errors.Wrap(ErrDuplicateBoardUnknown, err.Error())
+	}
+
+	src.StoryPointScale, err = uc.repo.GetStoryPointScale(ctx, src.ID)
+	if err != nil {
+		return nil, errors.Wrap(ErrDuplicateBoardUnknown, err.Error())
+	}
+
+	board, err := src.Duplicate(cmd.Name, cmd.ShortName)
+	if err != nil {
+		return nil, errors.Wrap(ErrValidationFailed, err.Error())
+	}
+
+	exists, err := uc.repo.CheckBoardShortName(ctx, cmd.ShortName)
+	if err != nil {
+		return nil, errors.Wrap(ErrDuplicateBoardUnknown, err.Error())
+	}
+	if exists {
+		return nil, ErrBoardIsExists
+	}
+
+	err = uc.repo.CreateBoardWithTasks(ctx, &board)
+	if err != nil {
+		return nil, errors.Wrap(ErrDuplicateBoardUnknown, err.Error())
+	}
+
+	return &board, nil
+}
@@ -0,0 +1,143 @@
+package duplicateboard
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/duplicateboard/mocks"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandle(t *testing.T) {
+	ctx := context.Background()
+	boardID := uuid.New()
+	column := domain.Column{ID: uuid.New(), BoardID: boardID, Name: "TODO"}
+
+	srcBoard := func() *domain.Board {
+		return &domain.Board{ID: boardID, Name: "Team", ShortName: "TEAM", Columns: []domain.Column{column}}
+	}
+	points, outOfScale := 5, 4
+	reporter := "ivan"
+	srcTasks := []domain.Task{
+		{ID: uuid.New(), BoardID: boardID, ColumnID: column.ID, Number: 10, Title: "first", StoryPoints: &points, Reporter: &reporter},
+		{ID: uuid.New(), BoardID: boardID, ColumnID: column.ID, Number: 42, Title: "second"},
+	}
+	scale := domain.StoryPointPreset("fibonacci")
+
+	testCases := []struct {
+		name        string
+		command     Command
+		setupMock   func(*mocks.Repo)
+		expectError error
+	}{
+		{
+			name:    "Success: board copied with renumbered tasks",
+			command: Command{BoardID: boardID, Name: "Team copy", ShortName: "TEAM2"},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetBoard", mock.Anything, boardID).Return(srcBoard(), nil).Once()
+				repo.On("GetBoardTaskDetails", mock.Anything, boardID).Return(srcTasks, nil).Once()
+				repo.On("GetStoryPointScale", mock.Anything, boardID).Return(scale, nil).Once()
+				repo.On("CheckBoardShortName", mock.Anything, "TEAM2").Return(false, nil).Once()
+				repo.On("CreateBoardWithTasks", mock.Anything, mock.MatchedBy(func(b *domain.Board) bool {
+					return b.ShortName == "TEAM2" && len(b.Columns) == 1 && len(b.Tasks) == 2 &&
+						b.Tasks[0].Number == 1 && b.Tasks[1].Number == 2 &&
+						b.Tasks[1].ColumnID == b.Columns[0].ID &&
+						*b.Tasks[0].StoryPoints == 5 && *b.Tasks[0].Reporter == "ivan" &&
+						slices.Equal(b.StoryPointScale, scale)
+				})).Return(nil).Once()
+			},
+		},
+		{
+			name:    "Failure: story points out of the board scale",
+			command: Command{BoardID: boardID, Name: "Team copy", ShortName: "TEAM2"},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetBoard", mock.Anything, boardID).Return(srcBoard(), nil).Once()
+				repo.On("GetBoardTaskDetails", mock.Anything, boardID).Return([]domain.Task{
+					{ID: uuid.New(), BoardID: boardID, ColumnID: column.ID, Number: 1, Title: "stale", StoryPoints: &outOfScale},
+				}, nil).Once()
+				repo.On("GetStoryPointScale", mock.Anything, boardID).Return(scale, nil).Once()
+			},
+			expectError: ErrValidationFailed,
+		},
+		{
+			name:    "Failure: get story point scale error",
+			command: Command{BoardID: boardID, Name: "Team copy", ShortName: "TEAM2"},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetBoard", mock.Anything, boardID).Return(srcBoard(), nil).Once()
+				repo.On("GetBoardTaskDetails", mock.Anything, boardID).Return(srcTasks, nil).Once()
+				repo.On("GetStoryPointScale", mock.Anything, boardID).Return(nil, errors.New("db error")).Once()
+			},
+			expectError: ErrDuplicateBoardUnknown,
+		},
+		{
+			name:    "Failure: board not found",
+			command: Command{BoardID: boardID, Name: "Team copy", ShortName: "TEAM2"},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetBoard", mock.Anything, boardID).Return(nil, pgx.ErrNoRows).Once()
+			},
+			expectError: ErrBoardNotFound,
+		},
+		{
+			name:    "Failure: invalid short name",
+			command: Command{BoardID: boardID, Name: "Team copy", ShortName: "!"},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetBoard", mock.Anything, boardID).Return(srcBoard(), nil).Once()
+				repo.On("GetBoardTaskDetails", mock.Anything, boardID).Return(srcTasks, nil).Once()
+				repo.On("GetStoryPointScale", mock.Anything, boardID).Return(scale, nil).Once()
+			},
+			expectError: ErrValidationFailed,
+		},
+		{
+			name:    "Failure: short name is taken",
+			command: Command{BoardID: boardID, Name: "Team copy", ShortName: "TEAM"},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetBoard", mock.Anything, boardID).Return(srcBoard(), nil).Once()
+				repo.On("GetBoardTaskDetails", mock.Anything, boardID).Return(srcTasks, nil).Once()
+				repo.On("GetStoryPointScale", mock.Anything, boardID).Return(scale, nil).Once()
+				repo.On("CheckBoardShortName", mock.Anything, "TEAM").Return(true, nil).Once()
+			},
+			expectError: ErrBoardIsExists,
+		},
+		{
+			name:    "Failure: create board error",
+			command: Command{BoardID: boardID, Name: "Team copy", ShortName: "TEAM2"},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetBoard", mock.Anything, boardID).Return(srcBoard(), nil).Once()
+				repo.On("GetBoardTaskDetails", mock.Anything, boardID).Return(srcTasks, nil).Once()
+				repo.On("GetStoryPointScale", mock.Anything, boardID).Return(scale, nil).Once()
+				repo.On("CheckBoardShortName", mock.Anything, "TEAM2").Return(false, nil).Once()
+				repo.On("CreateBoardWithTasks", mock.Anything, mock.AnythingOfType("*domain.Board")).Return(errors.New("db error")).Once()
+			},
+			expectError: ErrDuplicateBoardUnknown,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := mocks.NewRepo(t)
+			tc.setupMock(repo)
+
+			uc := NewUC(repo)
+			board, err := uc.Handle(ctx, tc.command)
+
+			if tc.expectError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tc.expectError)
+				assert.Nil(t, board)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, board)
+				assert.NotEqual(t, boardID, board.ID)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,9 @@
+package enqueuewebhooks
+
+import (
+	"errors"
+)
+
+var (
+	ErrEnqueueWebhooksUnknown = errors.New("unknown error enqueueing webhook deliveries")
+)
@@ -0,0 +1,72 @@
+package enqueuewebhooks
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetWebhooks(ctx context.Context, boardID uuid.UUID, event *domain.WebhookEventType) ([]domain.Webhook, error)
+	CreateWebhookDeliveries(ctx context.Context, deliveries []domain.WebhookDelivery) error
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+// Events - события outbox, которые рассылаются подписчикам досок.
+var Events = []domain.EventType{
+	domain.EventTaskCreated,
+	domain.EventTaskUpdated,
+	domain.EventTaskMoved,
+	domain.EventTaskMovedToBoard,
+	domain.EventTaskDeleted,
+	domain.EventTaskRestored,
+	domain.EventColumnCreated,
+	domain.EventColumnUpdated,
+	domain.EventColumnDeleted,
+	domain.EventColumnRestored,
+	domain.EventBoardRestored,
+}
+
+// Handle ставит событие outbox в очередь доставки всем подпискам доски на этот тип события.
+// Доставки создаются в одной транзакции, повтор события их не дублирует.
+func (uc *UC) Handle(ctx context.Context, event domain.Event) error {
+	webhookEvent, ok := domain.WebhookEventFromDomain(event)
+	if !ok {
+		return nil
+	}
+
+	webhooks, err := uc.repo.GetWebhooks(ctx, webhookEvent.BoardID, &webhookEvent.Type)
+	if err != nil {
+		return errors.Wrap(ErrEnqueueWebhooksUnknown, err.Error())
+	}
+	if len(webhooks) == 0 {
+		return nil
+	}
+
+	payload, err := webhookEvent.Payload()
+	if err != nil {
+		return errors.Wrap(ErrEnqueueWebhooksUnknown, err.Error())
+	}
+
+	deliveries := make([]domain.WebhookDelivery, 0, len(webhooks))
+	for _, w := range webhooks {
+		deliveries = append(deliveries, domain.NewWebhookDelivery(w.ID, webhookEvent, payload))
+	}
+
+	if err := uc.repo.CreateWebhookDeliveries(ctx, deliveries); err != nil {
+		return errors.Wrap(ErrEnqueueWebhooksUnknown, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package enqueuewebhooks
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/enqueuewebhooks/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandle(t *testing.T) {
+	ctx := context.Background()
+	boardID := uuid.New()
+	task, err := domain.NewTask(uuid.New(), boardID, 1, "fix ci", nil, nil, nil)
+	require.NoError(t, err)
+	event := task.Events()[0]
+	eventType := domain.WebhookTaskCreated
+	hooks := []domain.Webhook{{ID: uuid.New()}, {ID: uuid.New()}}
+
+	testCases := []struct {
+		name        string
+		setupMock   func(*mocks.Repo)
+		expectError error
+	}{
+		{
+			name: "Success: delivery per subscribed webhook",
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetWebhooks", mock.Anything, boardID, &eventType).Return(hooks, nil).Once()
+				repo.On("CreateWebhookDeliveries", mock.Anything,
+					mock.MatchedBy(func(ds []domain.WebhookDelivery) bool {
+						return len(ds) == 2 && ds[0].WebhookID == hooks[0].ID &&
+							ds[0].EventID == event.ID && ds[0].EventType == domain.WebhookTaskCreated &&
+							ds[0].Status == domain.WebhookDeliveryPending
+					})).Return(nil).Once()
+			},
+		},
+		{
+			name: "Success: no subscribers",
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetWebhooks", mock.Anything, boardID, &eventType).Return([]domain.Webhook{}, nil).Once()
+			},
+		},
+		{
+			name: "Failure: save error",
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetWebhooks", mock.Anything, boardID, &eventType).Return(hooks, nil).Once()
+				repo.On("CreateWebhookDeliveries", mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+			},
+			expectError: ErrEnqueueWebhooksUnknown,
+		},
+	}
+
+	t.Run("Success: event type is not sent to webhooks", func(t *testing.T) {
+		repo := mocks.NewRepo(t)
+		uc := NewUC(repo)
+
+		assigned := domain.Event{ID: uuid.New(), Type: domain.EventTaskAssigned, BoardID: boardID,
+			Payload: domain.TaskAssigned{TaskID: task.ID, Assignee: "bob"}}
+		require.NoError(t, uc.Handle(ctx, assigned))
+	})
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := mocks.NewRepo(t)
+			tc.setupMock(repo)
+
+			uc := NewUC(repo)
+			err := uc.Handle(ctx, event)
+
+			if tc.expectError != nil {
+				assert.ErrorIs(t, err, tc.expectError)
+			} else {
+				require.NoError(t, err)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,80 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/KungurtsevNII/team-board-back/src/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// Repo is an autogenerated mock type for the Repo type
+type Repo struct {
+	mock.Mock
+}
+
+// CreateWebhookDeliveries provides a mock function with given fields: ctx, deliveries
+func (_m *Repo) CreateWebhookDeliveries(ctx context.Context, deliveries []domain.WebhookDelivery) error {
+	ret := _m.Called(ctx, deliveries)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWebhookDeliveries")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.WebhookDelivery) error); ok {
+		r0 = rf(ctx, deliveries)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetWebhooks provides a mock function with given fields: ctx, boardID, event
+func (_m *Repo) GetWebhooks(ctx context.Context, boardID uuid.UUID, event *domain.WebhookEventType) ([]domain.Webhook, error) {
+	ret := _m.Called(ctx, boardID, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhooks")
+	}
+
+	var r0 []domain.Webhook
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *domain.WebhookEventType) ([]domain.Webhook, error)); ok {
+		return rf(ctx, boardID, event)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *domain.WebhookEventType) []domain.Webhook); ok {
+		r0 = rf(ctx, boardID, event)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Webhook)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *domain.WebhookEventType) error); ok {
+		r1 = rf(ctx, boardID, event)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewRepo creates a new instance of Repo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Repo {
+	mock := &Repo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,11 @@
+package getattachments
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidTaskID         = errors.New("invalid task id")
+	ErrTaskNotFound          = errors.New("task not found")
+	ErrGetAttachmentsUnknown = errors.New("unknown error getting attachments")
+)
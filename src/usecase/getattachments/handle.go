@@ -0,0 +1,42 @@
+package getattachments
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetTaskByID(ctx context.Context, taskID uuid.UUID) (*domain.Task, error)
+	GetAttachments(ctx context.Context, taskID uuid.UUID) ([]domain.Attachment, error)
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+func (uc *UC) Handle(ctx context.Context, q Query) ([]domain.Attachment, error) {
+	_, err := uc.repo.GetTaskByID(ctx, q.TaskID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrTaskNotFound
+		}
+		return nil, errors.Wrap(ErrGetAttachmentsUnknown, err.Error())
+	}
+
+	attachments, err := uc.repo.GetAttachments(ctx, q.TaskID)
+	if err != nil {
+		return nil, errors.Wrap(ErrGetAttachmentsUnknown, err.Error())
+	}
+
+	return attachments, nil
+}
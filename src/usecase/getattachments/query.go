@@ -0,0 +1,17 @@
+package getattachments
+
+import (
+	"github.com/google/uuid"
+)
+
+type Query struct {
+	TaskID uuid.UUID
+}
+
+func NewQuery(taskID string) (Query, error) {
+	id, err := uuid.Parse(taskID)
+	if err != nil {
+		return Query{}, ErrInvalidTaskID
+	}
+	return Query{TaskID: id}, nil
+}
@@ -3,7 +3,11 @@ package getboard
 import "errors"
 
 var (
-	ErrBoardIsNotExists = errors.New("board is not exists")
-	ErrInvalidID        = errors.New("invalid id")
-	ErrBoardNotFound    = errors.New("board not found")
+	ErrBoardIsNotExists    = errors.New("board is not exists")
+	ErrInvalidID           = errors.New("invalid id")
+	ErrBoardNotFound       = errors.New("board not found")
+	ErrInvalidSprintFilter = errors.New("invalid sprint filter")
+	ErrNoActiveSprint      = errors.New("board has no active sprint")
+	ErrInvalidGroupBy      = errors.New("invalid group_by, expected one of: assignee, priority, tag, parent, lane")
+	ErrInvalidCellLimit    = errors.New("invalid cell limit")
 )
@@ -11,6 +11,7 @@ import (
 
 type Repo interface {
 	GetBoard(ctx context.Context, ID uuid.UUID) (*domain.Board, error)
+	GetActiveSprint(ctx context.Context, boardID uuid.UUID) (*domain.Sprint, error)
 }
 
 type UC struct {
@@ -33,5 +34,21 @@ func (uc *UC) Handle(ctx context.Context, quer Query) (*domain.Board, error) {
 		}
 		return nil, errors.Wrap(err, op)
 	}
+
+	if quer.ActiveSprintOnly {
+		sprint, err := uc.repo.GetActiveSprint(ctx, board.ID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, ErrNoActiveSprint
+			}
+			return nil, errors.Wrap(err, op)
+		}
+		board.OnlySprintTasks(sprint)
+	}
+
+	if quer.GroupBy != "" {
+		board.Lanes = board.Swimlanes(quer.GroupBy, quer.CellLimit)
+	}
+
 	return board, nil
 }
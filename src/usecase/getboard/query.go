@@ -1,17 +1,51 @@
 package getboard
 
-import "github.com/google/uuid"
+import (
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const (
+	SprintFilterActive = "active"
+)
 
 type Query struct {
 	ID uuid.UUID
+	// ActiveSprintOnly - показывать только задачи активного спринта
+	ActiveSprintOnly bool
+	// GroupBy - поле группировки задач в дорожки, пустое - без дорожек
+	GroupBy domain.GroupBy
+	// CellLimit - сколько ID задач отдавать в ячейке дорожки, 0 - все
+	CellLimit int
 }
 
-func NewQuery(ID string) (Query, error) {
+func NewQuery(ID string, sprint string, groupBy string, cellLimit int) (Query, error) {
 	uid, err := uuid.Parse(ID)
 	if err != nil {
 		return Query{}, err
 	}
-	return Query{
-		ID: uid,
-	}, nil
+
+	if sprint != "" && sprint != SprintFilterActive {
+		return Query{}, ErrInvalidSprintFilter
+	}
+
+	qry := Query{
+		ID:               uid,
+		ActiveSprintOnly: sprint == SprintFilterActive,
+	}
+
+	if groupBy != "" {
+		qry.GroupBy, err = domain.ParseGroupBy(groupBy)
+		if err != nil {
+			return Query{}, errors.Wrap(ErrInvalidGroupBy, err.Error())
+		}
+	}
+
+	if cellLimit < 0 {
+		return Query{}, ErrInvalidCellLimit
+	}
+	qry.CellLimit = cellLimit
+
+	return qry, nil
 }
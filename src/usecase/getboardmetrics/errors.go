@@ -0,0 +1,14 @@
+package getboardmetrics
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidBoardID         = errors.New("invalid board id")
+	ErrInvalidColumnID        = errors.New("invalid column id")
+	ErrInvalidPeriod          = errors.New("invalid period")
+	ErrInvalidColumns         = errors.New("invalid cycle time columns")
+	ErrBoardNotFound          = errors.New("board not found")
+	ErrGetBoardMetricsUnknown = errors.New("unknown error getting board metrics")
+)
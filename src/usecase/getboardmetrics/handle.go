@@ -0,0 +1,53 @@
+package getboardmetrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	CheckBoard(ctx context.Context, id string) bool
+	GetColumns(ctx context.Context, boardID uuid.UUID) ([]domain.Column, error)
+	GetTaskTransitions(ctx context.Context, boardID uuid.UUID, from, to time.Time) ([]domain.TaskTransition, error)
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+// Handle считает метрики по истории переходов задач, завершенных за период.
+func (uc *UC) Handle(ctx context.Context, q Query) (*domain.FlowMetrics, error) {
+	if !uc.repo.CheckBoard(ctx, q.Filter.BoardID.String()) {
+		return nil, ErrBoardNotFound
+	}
+
+	columns, err := uc.repo.GetColumns(ctx, q.Filter.BoardID)
+	if err != nil {
+		return nil, errors.Wrap(ErrGetBoardMetricsUnknown, err.Error())
+	}
+
+	transitions, err := uc.repo.GetTaskTransitions(ctx, q.Filter.BoardID, q.Filter.From, q.Filter.To.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, errors.Wrap(ErrGetBoardMetricsUnknown, err.Error())
+	}
+
+	metrics, err := domain.NewFlowMetrics(q.Filter, columns, transitions)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidMetricsColumns) {
+			return nil, errors.Wrap(ErrInvalidColumns, err.Error())
+		}
+		return nil, errors.Wrap(ErrGetBoardMetricsUnknown, err.Error())
+	}
+
+	return metrics, nil
+}
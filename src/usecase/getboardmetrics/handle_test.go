@@ -0,0 +1,79 @@
+package getboardmetrics
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/getboardmetrics/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandle(t *testing.T) {
+	ctx := context.Background()
+	boardID, taskID := uuid.New(), uuid.New()
+	todo := domain.Column{ID: uuid.New(), BoardID: boardID, OrderNum: 1}
+	doing := domain.Column{ID: uuid.New(), BoardID: boardID, OrderNum: 2}
+	done := domain.Column{ID: uuid.New(), BoardID: boardID, OrderNum: 3}
+	columns := []domain.Column{todo, doing, done}
+	at := func(d int) time.Time { return time.Date(2025, 3, d, 12, 0, 0, 0, time.UTC) }
+
+	t.Run("metrics from history", func(t *testing.T) {
+		q, err := NewQuery(boardID.String(), "2025-03-01", "2025-03-31", "", "")
+		require.NoError(t, err)
+
+		repo := mocks.NewRepo(t)
+		repo.On("CheckBoard", ctx, boardID.String()).Return(true)
+		repo.On("GetColumns", ctx, boardID).Return(columns, nil)
+		repo.On("GetTaskTransitions", ctx, boardID,
+			time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC),
+		).Return([]domain.TaskTransition{
+			{ID: uuid.New(), TaskID: taskID, BoardID: boardID, ToColumnID: todo.ID, OccurredAt: at(3)},
+			{ID: uuid.New(), TaskID: taskID, BoardID: boardID, FromColumnID: &todo.ID, ToColumnID: doing.ID, OccurredAt: at(5)},
+			{ID: uuid.New(), TaskID: taskID, BoardID: boardID, FromColumnID: &doing.ID, ToColumnID: done.ID, OccurredAt: at(6)},
+		}, nil)
+
+		m, err := NewUC(repo).Handle(ctx, q)
+		require.NoError(t, err)
+		require.Len(t, m.Tasks, 1)
+		assert.Equal(t, 72*time.Hour, m.LeadTime.P50)
+		assert.Equal(t, 24*time.Hour, m.CycleTime.P50)
+	})
+
+	t.Run("end column from another board", func(t *testing.T) {
+		q, err := NewQuery(boardID.String(), "", "", "", uuid.NewString())
+		require.NoError(t, err)
+
+		repo := mocks.NewRepo(t)
+		repo.On("CheckBoard", ctx, boardID.String()).Return(true)
+		repo.On("GetColumns", ctx, boardID).Return(columns, nil)
+		repo.On("GetTaskTransitions", ctx, boardID, q.Filter.From, q.Filter.To.AddDate(0, 0, 1)).
+			Return([]domain.TaskTransition{}, nil)
+
+		_, err = NewUC(repo).Handle(ctx, q)
+		assert.ErrorIs(t, err, ErrInvalidColumns)
+	})
+
+	t.Run("board not found", func(t *testing.T) {
+		q, err := NewQuery(boardID.String(), "", "", "", "")
+		require.NoError(t, err)
+
+		repo := mocks.NewRepo(t)
+		repo.On("CheckBoard", ctx, boardID.String()).Return(false)
+
+		_, err = NewUC(repo).Handle(ctx, q)
+		assert.ErrorIs(t, err, ErrBoardNotFound)
+	})
+
+	t.Run("invalid query", func(t *testing.T) {
+		_, err := NewQuery(boardID.String(), "2025-03-10", "2025-03-01", "", "")
+		assert.ErrorIs(t, err, ErrInvalidPeriod)
+		_, err = NewQuery(boardID.String(), "", "", "todo", "")
+		assert.ErrorIs(t, err, ErrInvalidColumnID)
+		_, err = NewQuery("board", "", "", "", "")
+		assert.ErrorIs(t, err, ErrInvalidBoardID)
+	})
+}
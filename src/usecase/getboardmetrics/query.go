@@ -0,0 +1,69 @@
+package getboardmetrics
+
+import (
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type Query struct {
+	Filter domain.FlowMetricsFilter
+}
+
+// NewQuery собирает фильтр метрик: период - необязательные даты YYYY-MM-DD
+// включительно, колонки начала и конца cycle time тоже необязательны.
+func NewQuery(boardID, from, to, startColumnID, endColumnID string) (Query, error) {
+	id, err := uuid.Parse(boardID)
+	if err != nil {
+		return Query{}, ErrInvalidBoardID
+	}
+
+	f, err := parseDate(from)
+	if err != nil {
+		return Query{}, err
+	}
+	t, err := parseDate(to)
+	if err != nil {
+		return Query{}, err
+	}
+
+	start, err := parseColumnID(startColumnID)
+	if err != nil {
+		return Query{}, err
+	}
+	end, err := parseColumnID(endColumnID)
+	if err != nil {
+		return Query{}, err
+	}
+
+	filter, err := domain.NewFlowMetricsFilter(id, f, t, start, end)
+	if err != nil {
+		return Query{}, errors.Wrap(ErrInvalidPeriod, err.Error())
+	}
+
+	return Query{Filter: filter}, nil
+}
+
+func parseDate(s string) (*time.Time, error) {
+	if s == "" {
+		return nil, nil
+	}
+	d, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidPeriod, err.Error())
+	}
+	return &d, nil
+}
+
+func parseColumnID(s string) (*uuid.UUID, error) {
+	if s == "" {
+		return nil, nil
+	}
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return nil, ErrInvalidColumnID
+	}
+	return &id, nil
+}
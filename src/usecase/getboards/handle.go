@@ -9,7 +9,7 @@ import (
 )
 
 type Repo interface {
-	GetBoards(ctx context.Context, user_id uuid.UUID) ([]domain.Board, error)
+	GetBoards(ctx context.Context, user_id uuid.UUID, includeArchived bool) ([]domain.Board, error)
 }
 
 type UC struct {
@@ -23,7 +23,7 @@ func NewUC(repo Repo) *UC {
 }
 
 func (uc *UC) Handle(ctx context.Context, cmd Query) ([]domain.Board, error) {
-	boards, err := uc.repo.GetBoards(ctx, cmd.UserID)
+	boards, err := uc.repo.GetBoards(ctx, cmd.UserID, cmd.IncludeArchived)
 	if err != nil {
 		return nil, errors.Wrap(ErrGetBoards, err.Error())
 	}
@@ -6,16 +6,18 @@ import (
 )
 
 type Query struct {
-	UserID uuid.UUID
+	UserID          uuid.UUID
+	IncludeArchived bool
 }
 
-func NewQuery(userID string) (Query, error) {
+func NewQuery(userID string, includeArchived bool) (Query, error) {
 	uid, err := uuid.Parse(userID)
 	if err != nil {
 		return Query{}, errors.Wrap(ErrInvalidUserID, err.Error())
 	}
 
 	return Query{
-		UserID: uid,
+		UserID:          uid,
+		IncludeArchived: includeArchived,
 	}, nil
 }
@@ -0,0 +1,9 @@
+package getboardtemplates
+
+import (
+	"errors"
+)
+
+var (
+	ErrGetBoardTemplatesUnknown = errors.New("unknown error getting board templates")
+)
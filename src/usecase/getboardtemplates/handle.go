@@ -0,0 +1,31 @@
+package getboardtemplates
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetBoardTemplates(ctx context.Context) ([]domain.BoardTemplate, error)
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+func (uc *UC) Handle(ctx context.Context) ([]domain.BoardTemplate, error) {
+	templates, err := uc.repo.GetBoardTemplates(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ErrGetBoardTemplatesUnknown, err.Error())
+	}
+
+	return templates, nil
+}
@@ -0,0 +1,10 @@
+package getemailpreference
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidUserID             = errors.New("invalid user id")
+	ErrGetEmailPreferenceUnknown = errors.New("unknown error getting email preference")
+)
@@ -0,0 +1,35 @@
+package getemailpreference
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetEmailPreference(ctx context.Context, userID string) (*domain.EmailPreference, error)
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+// Handle возвращает настройки пользователя. Пока они не заданы, письма не отправляются.
+func (uc *UC) Handle(ctx context.Context, q Query) (*domain.EmailPreference, error) {
+	pref, err := uc.repo.GetEmailPreference(ctx, q.UserID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &domain.EmailPreference{UserID: q.UserID, Mode: domain.EmailOff}, nil
+		}
+		return nil, errors.Wrap(ErrGetEmailPreferenceUnknown, err.Error())
+	}
+	return pref, nil
+}
@@ -0,0 +1,14 @@
+package getemailpreference
+
+const maxUserIDLen = 100
+
+type Query struct {
+	UserID string
+}
+
+func NewQuery(userID string) (Query, error) {
+	if userID == "" || len(userID) > maxUserIDLen {
+		return Query{}, ErrInvalidUserID
+	}
+	return Query{UserID: userID}, nil
+}
@@ -0,0 +1,11 @@
+package getmilestone
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidMilestoneID  = errors.New("invalid milestone id")
+	ErrMilestoneNotFound   = errors.New("milestone not found")
+	ErrGetMilestoneUnknown = errors.New("unknown error getting milestone")
+)
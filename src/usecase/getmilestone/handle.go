@@ -0,0 +1,36 @@
+package getmilestone
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetMilestoneByID(ctx context.Context, milestoneID uuid.UUID) (*domain.Milestone, error)
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+func (uc *UC) Handle(ctx context.Context, q Query) (*domain.Milestone, error) {
+	milestone, err := uc.repo.GetMilestoneByID(ctx, q.ID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrMilestoneNotFound
+		}
+		return nil, errors.Wrap(ErrGetMilestoneUnknown, err.Error())
+	}
+
+	return milestone, nil
+}
@@ -0,0 +1,20 @@
+package getmilestone
+
+import (
+	"github.com/google/uuid"
+)
+
+type Query struct {
+	ID uuid.UUID
+}
+
+func NewQuery(id string) (Query, error) {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return Query{}, ErrInvalidMilestoneID
+	}
+
+	return Query{
+		ID: uid,
+	}, nil
+}
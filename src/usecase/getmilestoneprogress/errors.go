@@ -0,0 +1,11 @@
+package getmilestoneprogress
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidMilestoneID = errors.New("invalid milestone id")
+	ErrMilestoneNotFound  = errors.New("milestone not found")
+	ErrGetProgressUnknown = errors.New("unknown error getting milestone progress")
+)
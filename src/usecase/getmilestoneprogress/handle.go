@@ -0,0 +1,43 @@
+package getmilestoneprogress
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetMilestoneByID(ctx context.Context, milestoneID uuid.UUID) (*domain.Milestone, error)
+	GetMilestoneColumnStats(ctx context.Context, milestoneID uuid.UUID) ([]domain.ColumnTaskCount, error)
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+func (uc *UC) Handle(ctx context.Context, q Query) (*domain.MilestoneProgress, error) {
+	_, err := uc.repo.GetMilestoneByID(ctx, q.MilestoneID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrMilestoneNotFound
+		}
+		return nil, errors.Wrap(ErrGetProgressUnknown, err.Error())
+	}
+
+	stats, err := uc.repo.GetMilestoneColumnStats(ctx, q.MilestoneID)
+	if err != nil {
+		return nil, errors.Wrap(ErrGetProgressUnknown, err.Error())
+	}
+
+	progress := domain.NewMilestoneProgress(q.MilestoneID, stats)
+	return &progress, nil
+}
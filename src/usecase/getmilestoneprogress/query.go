@@ -0,0 +1,20 @@
+package getmilestoneprogress
+
+import (
+	"github.com/google/uuid"
+)
+
+type Query struct {
+	MilestoneID uuid.UUID
+}
+
+func NewQuery(milestoneID string) (Query, error) {
+	uid, err := uuid.Parse(milestoneID)
+	if err != nil {
+		return Query{}, ErrInvalidMilestoneID
+	}
+
+	return Query{
+		MilestoneID: uid,
+	}, nil
+}
@@ -0,0 +1,10 @@
+package getmilestones
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidState         = errors.New("invalid milestone state")
+	ErrGetMilestonesUnknown = errors.New("unknown error getting milestones")
+)
@@ -0,0 +1,31 @@
+package getmilestones
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetMilestones(ctx context.Context, state *domain.MilestoneState) ([]domain.Milestone, error)
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+func (uc *UC) Handle(ctx context.Context, q Query) ([]domain.Milestone, error) {
+	milestones, err := uc.repo.GetMilestones(ctx, q.State)
+	if err != nil {
+		return nil, errors.Wrap(ErrGetMilestonesUnknown, err.Error())
+	}
+
+	return milestones, nil
+}
@@ -0,0 +1,24 @@
+package getmilestones
+
+import (
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+)
+
+type Query struct {
+	State *domain.MilestoneState
+}
+
+func NewQuery(state string) (Query, error) {
+	if state == "" {
+		return Query{}, nil
+	}
+
+	st := domain.MilestoneState(state)
+	if st != domain.MilestoneOpen && st != domain.MilestoneClosed {
+		return Query{}, ErrInvalidState
+	}
+
+	return Query{
+		State: &st,
+	}, nil
+}
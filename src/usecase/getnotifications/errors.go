@@ -0,0 +1,12 @@
+package getnotifications
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidUserID           = errors.New("invalid user id")
+	ErrInvalidLimit            = errors.New("invalid limit")
+	ErrInvalidOffset           = errors.New("invalid offset")
+	ErrGetNotificationsUnknown = errors.New("unknown error getting notifications")
+)
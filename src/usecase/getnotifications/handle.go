@@ -0,0 +1,42 @@
+package getnotifications
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetNotifications(ctx context.Context, userID string, unreadOnly bool, limit, offset uint) ([]domain.Notification, error)
+	CountUnreadNotifications(ctx context.Context, userID string) (int64, error)
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+type Result struct {
+	Notifications []domain.Notification
+	UnreadCount   int64
+}
+
+func (uc *UC) Handle(ctx context.Context, q Query) (Result, error) {
+	notifications, err := uc.repo.GetNotifications(ctx, q.UserID, q.UnreadOnly, q.Limit, q.Offset)
+	if err != nil {
+		return Result{}, errors.Wrap(ErrGetNotificationsUnknown, err.Error())
+	}
+
+	unread, err := uc.repo.CountUnreadNotifications(ctx, q.UserID)
+	if err != nil {
+		return Result{}, errors.Wrap(ErrGetNotificationsUnknown, err.Error())
+	}
+
+	return Result{Notifications: notifications, UnreadCount: unread}, nil
+}
@@ -0,0 +1,46 @@
+package getnotifications
+
+import (
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultLimit = 50
+	maxLimit     = 200
+	maxUserIDLen = 100
+)
+
+type Query struct {
+	UserID     string
+	UnreadOnly bool
+	Limit      uint
+	Offset     uint
+}
+
+func NewQuery(userID string, unreadOnly bool, limit, offset string) (Query, error) {
+	if userID == "" || len(userID) > maxUserIDLen {
+		return Query{}, ErrInvalidUserID
+	}
+
+	q := Query{UserID: userID, UnreadOnly: unreadOnly, Limit: defaultLimit}
+
+	if limit != "" {
+		l, err := strconv.ParseUint(limit, 10, 32)
+		if err != nil || l == 0 || l > maxLimit {
+			return Query{}, errors.Wrapf(ErrInvalidLimit, "limit must be 1-%d", maxLimit)
+		}
+		q.Limit = uint(l)
+	}
+
+	if offset != "" {
+		o, err := strconv.ParseUint(offset, 10, 32)
+		if err != nil {
+			return Query{}, errors.Wrap(ErrInvalidOffset, err.Error())
+		}
+		q.Offset = uint(o)
+	}
+
+	return q, nil
+}
@@ -0,0 +1,11 @@
+package getsprints
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidBoardID    = errors.New("invalid board id")
+	ErrBoardNotFound     = errors.New("board not found")
+	ErrGetSprintsUnknown = errors.New("unknown error getting sprints")
+)
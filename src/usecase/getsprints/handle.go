@@ -0,0 +1,37 @@
+package getsprints
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	CheckBoard(ctx context.Context, id string) bool
+	GetSprints(ctx context.Context, boardID uuid.UUID) ([]domain.Sprint, error)
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+func (uc *UC) Handle(ctx context.Context, q Query) ([]domain.Sprint, error) {
+	if !uc.repo.CheckBoard(ctx, q.BoardID.String()) {
+		return nil, ErrBoardNotFound
+	}
+
+	sprints, err := uc.repo.GetSprints(ctx, q.BoardID)
+	if err != nil {
+		return nil, errors.Wrap(ErrGetSprintsUnknown, err.Error())
+	}
+
+	return sprints, nil
+}
@@ -0,0 +1,20 @@
+package getsprints
+
+import (
+	"github.com/google/uuid"
+)
+
+type Query struct {
+	BoardID uuid.UUID
+}
+
+func NewQuery(boardID string) (Query, error) {
+	uid, err := uuid.Parse(boardID)
+	if err != nil {
+		return Query{}, ErrInvalidBoardID
+	}
+
+	return Query{
+		BoardID: uid,
+	}, nil
+}
@@ -0,0 +1,11 @@
+package getstorypointscale
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidBoardID            = errors.New("invalid board id")
+	ErrBoardNotFound             = errors.New("board not found")
+	ErrGetStoryPointScaleUnknown = errors.New("unknown error getting story point scale")
+)
@@ -0,0 +1,36 @@
+package getstorypointscale
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetStoryPointScale(ctx context.Context, boardID uuid.UUID) (domain.StoryPointScale, error)
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+func (uc *UC) Handle(ctx context.Context, q Query) (domain.StoryPointScale, error) {
+	scale, err := uc.repo.GetStoryPointScale(ctx, q.BoardID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrBoardNotFound
+		}
+		return nil, errors.Wrap(ErrGetStoryPointScaleUnknown, err.Error())
+	}
+
+	return scale, nil
+}
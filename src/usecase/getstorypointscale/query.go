@@ -0,0 +1,18 @@
+package getstorypointscale
+
+import (
+	"github.com/google/uuid"
+)
+
+type Query struct {
+	BoardID uuid.UUID
+}
+
+func NewQuery(boardID string) (Query, error) {
+	id, err := uuid.Parse(boardID)
+	if err != nil {
+		return Query{}, ErrInvalidBoardID
+	}
+
+	return Query{BoardID: id}, nil
+}
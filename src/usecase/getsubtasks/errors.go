@@ -0,0 +1,11 @@
+package getsubtasks
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidTaskID      = errors.New("invalid task id")
+	ErrTaskNotFound       = errors.New("task not found")
+	ErrGetSubtasksUnknown = errors.New("unknown error getting subtasks")
+)
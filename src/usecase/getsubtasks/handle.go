@@ -0,0 +1,42 @@
+package getsubtasks
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetTaskByID(ctx context.Context, taskID uuid.UUID) (*domain.Task, error)
+	GetTaskChildren(ctx context.Context, parentID uuid.UUID) ([]domain.Task, error)
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+func (uc *UC) Handle(ctx context.Context, q Query) ([]domain.Task, error) {
+	_, err := uc.repo.GetTaskByID(ctx, q.ParentID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrTaskNotFound
+		}
+		return nil, errors.Wrap(ErrGetSubtasksUnknown, err.Error())
+	}
+
+	children, err := uc.repo.GetTaskChildren(ctx, q.ParentID)
+	if err != nil {
+		return nil, errors.Wrap(ErrGetSubtasksUnknown, err.Error())
+	}
+
+	return children, nil
+}
@@ -0,0 +1,20 @@
+package getsubtasks
+
+import (
+	"github.com/google/uuid"
+)
+
+type Query struct {
+	ParentID uuid.UUID
+}
+
+func NewQuery(parentID string) (Query, error) {
+	uid, err := uuid.Parse(parentID)
+	if err != nil {
+		return Query{}, ErrInvalidTaskID
+	}
+
+	return Query{
+		ParentID: uid,
+	}, nil
+}
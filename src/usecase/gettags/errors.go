@@ -0,0 +1,10 @@
+package gettags
+
+import "errors"
+
+var (
+	ErrInvalidBoardID = errors.New("invalid board id")
+	ErrInvalidUserID  = errors.New("invalid user id")
+	ErrBoardNotFound  = errors.New("board not found")
+	ErrGetTagsUnknown = errors.New("unknown error getting tags")
+)
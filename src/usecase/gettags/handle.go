@@ -0,0 +1,37 @@
+package gettags
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	CheckBoard(ctx context.Context, id string) bool
+	GetTags(ctx context.Context, boardID *uuid.UUID, userID uuid.UUID, prefix string, limit uint) ([]domain.TagUsage, error)
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+func (uc *UC) Handle(ctx context.Context, q Query) ([]domain.TagUsage, error) {
+	if q.BoardID != nil && !uc.repo.CheckBoard(ctx, q.BoardID.String()) {
+		return nil, ErrBoardNotFound
+	}
+
+	tags, err := uc.repo.GetTags(ctx, q.BoardID, q.UserID, q.Prefix, q.Limit)
+	if err != nil {
+		return nil, errors.Wrap(ErrGetTagsUnknown, err.Error())
+	}
+
+	return tags, nil
+}
@@ -0,0 +1,56 @@
+package gettags
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultLimit = 20
+	maxLimit     = 100
+)
+
+type Query struct {
+	BoardID *uuid.UUID // nil - поиск по всем доскам пользователя
+	UserID  uuid.UUID
+	Prefix  string
+	Limit   uint
+}
+
+func NewBoardQuery(boardID, prefix string, limit uint) (Query, error) {
+	bID, err := uuid.Parse(boardID)
+	if err != nil {
+		return Query{}, errors.Wrap(ErrInvalidBoardID, err.Error())
+	}
+
+	return Query{
+		BoardID: &bID,
+		Prefix:  strings.TrimSpace(prefix),
+		Limit:   normalizeLimit(limit),
+	}, nil
+}
+
+func NewUserQuery(userID, prefix string, limit uint) (Query, error) {
+	uID, err := uuid.Parse(userID)
+	if err != nil {
+		return Query{}, errors.Wrap(ErrInvalidUserID, err.Error())
+	}
+
+	return Query{
+		UserID: uID,
+		Prefix: strings.TrimSpace(prefix),
+		Limit:  normalizeLimit(limit),
+	}, nil
+}
+
+func normalizeLimit(limit uint) uint {
+	if limit == 0 {
+		return defaultLimit
+	}
+	if limit > maxLimit {
+		return maxLimit
+	}
+	return limit
+}
@@ -0,0 +1,74 @@
+package gettags
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBoardQuery(t *testing.T) {
+	validBoardID := uuid.New().String()
+
+	testCases := []struct {
+		name          string
+		boardID       string
+		prefix        string
+		limit         uint
+		expectedLimit uint
+		expectError   error
+	}{
+		{
+			name:          "Success: default limit",
+			boardID:       validBoardID,
+			prefix:        " back ",
+			limit:         0,
+			expectedLimit: defaultLimit,
+		},
+		{
+			name:          "Success: limit is capped",
+			boardID:       validBoardID,
+			limit:         1000,
+			expectedLimit: maxLimit,
+		},
+		{
+			name:          "Success: custom limit",
+			boardID:       validBoardID,
+			limit:         7,
+			expectedLimit: 7,
+		},
+		{
+			name:        "Failure: invalid board ID format",
+			boardID:     "not-a-valid-uuid",
+			expectError: ErrInvalidBoardID,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			q, err := NewBoardQuery(tc.boardID, tc.prefix, tc.limit)
+
+			if tc.expectError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tc.expectError, "Wrong error type")
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, q.BoardID)
+				assert.Equal(t, tc.boardID, q.BoardID.String())
+				assert.Equal(t, tc.expectedLimit, q.Limit)
+				assert.NotContains(t, q.Prefix, " ")
+			}
+		})
+	}
+}
+
+func TestNewUserQuery(t *testing.T) {
+	q, err := NewUserQuery(uuid.New().String(), "api", 0)
+	require.NoError(t, err)
+	assert.Nil(t, q.BoardID)
+	assert.Equal(t, "api", q.Prefix)
+
+	_, err = NewUserQuery("", "api", 0)
+	assert.ErrorIs(t, err, ErrInvalidUserID)
+}
@@ -10,19 +10,29 @@ import (
 	"github.com/pkg/errors"
 )
 
+// Renderer отрисовывает Markdown-описание в безопасный HTML и превью.
+type Renderer interface {
+	Render(source string) domain.RenderedText
+}
+
 type UC struct {
-	repo Repo
+	repo     Repo
+	renderer Renderer
 }
 
-func NewUC(repo Repo) *UC {
+func NewUC(repo Repo, renderer Renderer) *UC {
 	return &UC{
-		repo: repo,
+		repo:     repo,
+		renderer: renderer,
 	}
 }
 
 
 type Repo interface {
 	GetTaskByID(ctx context.Context, taskID uuid.UUID) (*domain.Task, error)
+	GetSubtaskProgress(ctx context.Context, parentID uuid.UUID) (domain.SubtaskProgress, error)
+	GetTaskLinks(ctx context.Context, taskID uuid.UUID) ([]domain.TaskLink, error)
+	ResolveTaskRefs(ctx context.Context, refs []domain.TaskRef) ([]domain.TaskRef, error)
 }
 
 func (uc *UC) Handle(ctx context.Context, query GetTaskQuery) (*domain.Task, error) {
@@ -34,5 +44,27 @@ func (uc *UC) Handle(ctx context.Context, query GetTaskQuery) (*domain.Task, err
 		return nil, errors.Wrap(ErrGetTaskUnknown, err.Error())
 	}
 
+	progress, err := uc.repo.GetSubtaskProgress(ctx, dmn.ID)
+	if err != nil {
+		return nil, errors.Wrap(ErrGetTaskUnknown, err.Error())
+	}
+	dmn.Subtasks = &progress
+
+	links, err := uc.repo.GetTaskLinks(ctx, dmn.ID)
+	if err != nil {
+		return nil, errors.Wrap(ErrGetTaskUnknown, err.Error())
+	}
+	dmn.Links = links
+
+	dmn.References, err = uc.repo.ResolveTaskRefs(ctx, dmn.TaskRefs())
+	if err != nil {
+		return nil, errors.Wrap(ErrGetTaskUnknown, err.Error())
+	}
+
+	if dmn.Description != nil {
+		rendered := uc.renderer.Render(*dmn.Description)
+		dmn.DescriptionRendered = &rendered
+	}
+
 	return dmn, nil
 }
\ No newline at end of file
@@ -30,13 +30,23 @@ func TestHandle(t *testing.T) {
 		BoardID:     uuid.New(),
 		Number:      1,
 		Title:       "Test Task",
-		Description: strPtr("A description"),
+		Description: strPtr("A description, blocked by #TEAM-2"),
 		Tags:        []string{"test"},
 		Checklists:  []domain.Checklist{},
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
 	}
 
+	links := []domain.TaskLink{{
+		ID:         uuid.New(),
+		FromTaskID: taskID,
+		ToTaskID:   uuid.New(),
+		Type:       domain.LinkBlocks,
+	}}
+
+	refTaskID := uuid.New()
+	refs := []domain.TaskRef{{ShortName: "TEAM", Number: 2, TaskID: &refTaskID, Title: strPtr("Blocker")}}
+
 	testCases := []struct {
 		name        string
 		query       GetTaskQuery
@@ -51,10 +61,50 @@ func TestHandle(t *testing.T) {
 				repo.On("GetTaskByID", mock.Anything, taskID).
 					Return(expectedTask, nil).
 					Once()
+				repo.On("GetSubtaskProgress", mock.Anything, taskID).
+					Return(domain.SubtaskProgress{Total: 3, Done: 1}, nil).
+					Once()
+				repo.On("GetTaskLinks", mock.Anything, taskID).
+					Return(links, nil).
+					Once()
+				repo.On("ResolveTaskRefs", mock.Anything, []domain.TaskRef{{ShortName: "TEAM", Number: 2}}).
+					Return(refs, nil).
+					Once()
 			},
 			expected:    expectedTask,
 			expectError: nil,
 		},
+		{
+			name:  "Failure: subtask progress error",
+			query: GetTaskQuery{TaskID: taskID},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTaskByID", mock.Anything, taskID).
+					Return(&domain.Task{ID: taskID}, nil).
+					Once()
+				repo.On("GetSubtaskProgress", mock.Anything, taskID).
+					Return(domain.SubtaskProgress{}, errors.New("unexpected db error")).
+					Once()
+			},
+			expected:    nil,
+			expectError: ErrGetTaskUnknown,
+		},
+		{
+			name:  "Failure: task links error",
+			query: GetTaskQuery{TaskID: taskID},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTaskByID", mock.Anything, taskID).
+					Return(&domain.Task{ID: taskID}, nil).
+					Once()
+				repo.On("GetSubtaskProgress", mock.Anything, taskID).
+					Return(domain.SubtaskProgress{}, nil).
+					Once()
+				repo.On("GetTaskLinks", mock.Anything, taskID).
+					Return(nil, errors.New("unexpected db error")).
+					Once()
+			},
+			expected:    nil,
+			expectError: ErrGetTaskUnknown,
+		},
 		{
 			name:  "Failure: task not found",
 			query: GetTaskQuery{TaskID: taskID},
@@ -84,7 +134,11 @@ func TestHandle(t *testing.T) {
 			repo := mocks.NewRepo(t)
 			tc.setupMock(repo)
 
-			uc := NewUC(repo)
+			rendered := domain.RenderedText{HTML: "<p>A description</p>", Excerpt: "A description"}
+			renderer := mocks.NewRenderer(t)
+			renderer.On("Render", "A description, blocked by #TEAM-2").Return(rendered).Maybe()
+
+			uc := NewUC(repo, renderer)
 
 			task, err := uc.Handle(ctx, tc.query)
 
@@ -96,6 +150,12 @@ func TestHandle(t *testing.T) {
 				require.NoError(t, err)
 				require.NotNil(t, task)
 				assert.Equal(t, tc.expected, task)
+				require.NotNil(t, task.Subtasks)
+				assert.Equal(t, int64(3), task.Subtasks.Total)
+				assert.Equal(t, int64(1), task.Subtasks.Done)
+				assert.Equal(t, links, task.Links)
+				assert.Equal(t, refs, task.References)
+				assert.Equal(t, &rendered, task.DescriptionRendered)
 			}
 
 			repo.AssertExpectations(t)
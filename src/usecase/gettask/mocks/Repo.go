@@ -17,6 +17,34 @@ type Repo struct {
 	mock.Mock
 }
 
+// GetSubtaskProgress provides a mock function with given fields: ctx, parentID
+func (_m *Repo) GetSubtaskProgress(ctx context.Context, parentID uuid.UUID) (domain.SubtaskProgress, error) {
+	ret := _m.Called(ctx, parentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSubtaskProgress")
+	}
+
+	var r0 domain.SubtaskProgress
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (domain.SubtaskProgress, error)); ok {
+		return rf(ctx, parentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) domain.SubtaskProgress); ok {
+		r0 = rf(ctx, parentID)
+	} else {
+		r0 = ret.Get(0).(domain.SubtaskProgress)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, parentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTaskByID provides a mock function with given fields: ctx, taskID
 func (_m *Repo) GetTaskByID(ctx context.Context, taskID uuid.UUID) (*domain.Task, error) {
 	ret := _m.Called(ctx, taskID)
@@ -47,6 +75,66 @@ func (_m *Repo) GetTaskByID(ctx context.Context, taskID uuid.UUID) (*domain.Task
 	return r0, r1
 }
 
+// GetTaskLinks provides a mock function with given fields: ctx, taskID
+func (_m *Repo) GetTaskLinks(ctx context.Context, taskID uuid.UUID) ([]domain.TaskLink, error) {
+	ret := _m.Called(ctx, taskID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTaskLinks")
+	}
+
+	var r0 []domain.TaskLink
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]domain.TaskLink, error)); ok {
+		return rf(ctx, taskID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []domain.TaskLink); ok {
+		r0 = rf(ctx, taskID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.TaskLink)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, taskID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResolveTaskRefs provides a mock function with given fields: ctx, refs
+func (_m *Repo) ResolveTaskRefs(ctx context.Context, refs []domain.TaskRef) ([]domain.TaskRef, error) {
+	ret := _m.Called(ctx, refs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveTaskRefs")
+	}
+
+	var r0 []domain.TaskRef
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.TaskRef) ([]domain.TaskRef, error)); ok {
+		return rf(ctx, refs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.TaskRef) []domain.TaskRef); ok {
+		r0 = rf(ctx, refs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.TaskRef)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []domain.TaskRef) error); ok {
+		r1 = rf(ctx, refs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewRepo creates a new instance of Repo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewRepo(t interface {
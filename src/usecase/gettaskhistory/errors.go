@@ -0,0 +1,11 @@
+package gettaskhistory
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidTaskID         = errors.New("invalid task id")
+	ErrTaskNotFound          = errors.New("task not found")
+	ErrGetTaskHistoryUnknown = errors.New("unknown error getting task history")
+)
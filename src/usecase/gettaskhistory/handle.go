@@ -0,0 +1,42 @@
+package gettaskhistory
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetTaskByID(ctx context.Context, taskID uuid.UUID) (*domain.Task, error)
+	GetTaskHistory(ctx context.Context, taskID uuid.UUID) ([]domain.TaskHistoryEntry, error)
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+func (uc *UC) Handle(ctx context.Context, q Query) ([]domain.TaskHistoryEntry, error) {
+	_, err := uc.repo.GetTaskByID(ctx, q.TaskID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrTaskNotFound
+		}
+		return nil, errors.Wrap(ErrGetTaskHistoryUnknown, err.Error())
+	}
+
+	entries, err := uc.repo.GetTaskHistory(ctx, q.TaskID)
+	if err != nil {
+		return nil, errors.Wrap(ErrGetTaskHistoryUnknown, err.Error())
+	}
+
+	return entries, nil
+}
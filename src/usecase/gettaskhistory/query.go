@@ -0,0 +1,21 @@
+package gettaskhistory
+
+import (
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type Query struct {
+	TaskID uuid.UUID
+}
+
+func NewQuery(taskID string) (Query, error) {
+	uid, err := uuid.Parse(taskID)
+	if err != nil {
+		return Query{}, errors.Wrap(ErrInvalidTaskID, err.Error())
+	}
+
+	return Query{
+		TaskID: uid,
+	}, nil
+}
@@ -0,0 +1,11 @@
+package gettasklinks
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidTaskID       = errors.New("invalid task id")
+	ErrTaskNotFound        = errors.New("task not found")
+	ErrGetTaskLinksUnknown = errors.New("unknown error getting task links")
+)
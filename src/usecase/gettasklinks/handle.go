@@ -0,0 +1,42 @@
+package gettasklinks
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetTaskByID(ctx context.Context, taskID uuid.UUID) (*domain.Task, error)
+	GetTaskLinks(ctx context.Context, taskID uuid.UUID) ([]domain.TaskLink, error)
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+func (uc *UC) Handle(ctx context.Context, q Query) ([]domain.TaskLink, error) {
+	_, err := uc.repo.GetTaskByID(ctx, q.TaskID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrTaskNotFound
+		}
+		return nil, errors.Wrap(ErrGetTaskLinksUnknown, err.Error())
+	}
+
+	links, err := uc.repo.GetTaskLinks(ctx, q.TaskID)
+	if err != nil {
+		return nil, errors.Wrap(ErrGetTaskLinksUnknown, err.Error())
+	}
+
+	return links, nil
+}
@@ -0,0 +1,20 @@
+package gettasklinks
+
+import (
+	"github.com/google/uuid"
+)
+
+type Query struct {
+	TaskID uuid.UUID
+}
+
+func NewQuery(taskID string) (Query, error) {
+	uid, err := uuid.Parse(taskID)
+	if err != nil {
+		return Query{}, ErrInvalidTaskID
+	}
+
+	return Query{
+		TaskID: uid,
+	}, nil
+}
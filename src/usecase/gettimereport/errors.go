@@ -0,0 +1,12 @@
+package gettimereport
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidBoardID       = errors.New("invalid board id")
+	ErrInvalidPeriod        = errors.New("invalid period")
+	ErrBoardNotFound        = errors.New("board not found")
+	ErrGetTimeReportUnknown = errors.New("unknown error getting time report")
+)
@@ -0,0 +1,36 @@
+package gettimereport
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	CheckBoard(ctx context.Context, id string) bool
+	GetTimeReportEntries(ctx context.Context, filter domain.TimeReportFilter) ([]domain.TimeReportEntry, error)
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+func (uc *UC) Handle(ctx context.Context, q Query) (*domain.TimeReport, error) {
+	if q.Filter.BoardID != nil && !uc.repo.CheckBoard(ctx, q.Filter.BoardID.String()) {
+		return nil, ErrBoardNotFound
+	}
+
+	entries, err := uc.repo.GetTimeReportEntries(ctx, q.Filter)
+	if err != nil {
+		return nil, errors.Wrap(ErrGetTimeReportUnknown, err.Error())
+	}
+
+	return domain.NewTimeReport(q.Filter, entries), nil
+}
@@ -0,0 +1,47 @@
+package gettimereport
+
+import (
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type Query struct {
+	Filter domain.TimeReportFilter
+}
+
+// NewQuery собирает фильтр отчета: доска и пользователь необязательны,
+// период задается датами YYYY-MM-DD включительно.
+func NewQuery(boardID, userID, from, to string) (Query, error) {
+	var bID *uuid.UUID
+	if boardID != "" {
+		id, err := uuid.Parse(boardID)
+		if err != nil {
+			return Query{}, ErrInvalidBoardID
+		}
+		bID = &id
+	}
+
+	var uID *string
+	if userID != "" {
+		uID = &userID
+	}
+
+	f, err := time.Parse(time.DateOnly, from)
+	if err != nil {
+		return Query{}, errors.Wrap(ErrInvalidPeriod, err.Error())
+	}
+	t, err := time.Parse(time.DateOnly, to)
+	if err != nil {
+		return Query{}, errors.Wrap(ErrInvalidPeriod, err.Error())
+	}
+
+	filter, err := domain.NewTimeReportFilter(bID, uID, f, t)
+	if err != nil {
+		return Query{}, errors.Wrap(ErrInvalidPeriod, err.Error())
+	}
+
+	return Query{Filter: filter}, nil
+}
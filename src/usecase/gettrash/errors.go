@@ -0,0 +1,11 @@
+package gettrash
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidBoardID  = errors.New("invalid board id")
+	ErrBoardNotFound   = errors.New("board not found")
+	ErrGetTrashUnknown = errors.New("unknown error getting trash")
+)
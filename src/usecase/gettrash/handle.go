@@ -0,0 +1,44 @@
+package gettrash
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetBoardIncludingDeleted(ctx context.Context, boardID uuid.UUID) (*domain.Board, error)
+	GetTrash(ctx context.Context, board *domain.Board) (*domain.Trash, error)
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+// Handle возвращает корзину доски. Корзину удаленной доски тоже можно посмотреть,
+// чтобы понять, что вернется вместе с ней.
+func (uc *UC) Handle(ctx context.Context, q Query) (*domain.Trash, error) {
+	board, err := uc.repo.GetBoardIncludingDeleted(ctx, q.BoardID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrBoardNotFound
+		}
+		return nil, errors.Wrap(ErrGetTrashUnknown, err.Error())
+	}
+
+	trash, err := uc.repo.GetTrash(ctx, board)
+	if err != nil {
+		return nil, errors.Wrap(ErrGetTrashUnknown, err.Error())
+	}
+
+	return trash, nil
+}
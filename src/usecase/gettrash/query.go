@@ -0,0 +1,21 @@
+package gettrash
+
+import (
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type Query struct {
+	BoardID uuid.UUID
+}
+
+func NewQuery(boardID string) (Query, error) {
+	uid, err := uuid.Parse(boardID)
+	if err != nil {
+		return Query{}, errors.Wrap(ErrInvalidBoardID, err.Error())
+	}
+
+	return Query{
+		BoardID: uid,
+	}, nil
+}
@@ -0,0 +1,9 @@
+package gettrashboards
+
+import (
+	"errors"
+)
+
+var (
+	ErrGetTrashBoardsUnknown = errors.New("unknown error getting deleted boards")
+)
@@ -0,0 +1,31 @@
+package gettrashboards
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetDeletedBoards(ctx context.Context) ([]domain.Board, error)
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+func (uc *UC) Handle(ctx context.Context) ([]domain.Board, error) {
+	boards, err := uc.repo.GetDeletedBoards(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ErrGetTrashBoardsUnknown, err.Error())
+	}
+
+	return boards, nil
+}
@@ -0,0 +1,13 @@
+package getvelocity
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidBoardID     = errors.New("invalid board id")
+	ErrInvalidGrouping    = errors.New("invalid grouping")
+	ErrInvalidPeriod      = errors.New("invalid period")
+	ErrBoardNotFound      = errors.New("board not found")
+	ErrGetVelocityUnknown = errors.New("unknown error getting velocity")
+)
@@ -0,0 +1,56 @@
+package getvelocity
+
+import (
+	"context"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	CheckBoard(ctx context.Context, id string) bool
+	GetSprints(ctx context.Context, boardID uuid.UUID) ([]domain.Sprint, error)
+	GetTaskCompletions(ctx context.Context, boardID uuid.UUID, from, to time.Time) ([]domain.TaskCompletion, error)
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+// Handle строит отчет по истории перемещений: задача засчитывается в период,
+// где она впервые попала в done-колонку доски.
+func (uc *UC) Handle(ctx context.Context, q Query) (*domain.VelocityReport, error) {
+	if !uc.repo.CheckBoard(ctx, q.Filter.BoardID.String()) {
+		return nil, ErrBoardNotFound
+	}
+
+	var sprints []domain.Sprint
+	if q.Filter.GroupBy == domain.VelocityBySprint {
+		var err error
+		sprints, err = uc.repo.GetSprints(ctx, q.Filter.BoardID)
+		if err != nil {
+			return nil, errors.Wrap(ErrGetVelocityUnknown, err.Error())
+		}
+	}
+
+	periods := domain.VelocityPeriods(q.Filter, sprints)
+
+	var completions []domain.TaskCompletion
+	if from, to, ok := domain.CompletionRange(periods); ok {
+		var err error
+		completions, err = uc.repo.GetTaskCompletions(ctx, q.Filter.BoardID, from, to)
+		if err != nil {
+			return nil, errors.Wrap(ErrGetVelocityUnknown, err.Error())
+		}
+	}
+
+	return domain.NewVelocityReport(q.Filter, periods, completions), nil
+}
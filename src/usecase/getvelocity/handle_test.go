@@ -0,0 +1,74 @@
+package getvelocity
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/getvelocity/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandle(t *testing.T) {
+	ctx := context.Background()
+	boardID := uuid.New()
+	at := func(d int) time.Time { return time.Date(2025, 3, d, 0, 0, 0, 0, time.UTC) }
+	intPtr := func(v int) *int { return &v }
+
+	t.Run("by week", func(t *testing.T) {
+		q, err := NewQuery(boardID.String(), "week", "2025-03-03", "2025-03-16")
+		require.NoError(t, err)
+
+		repo := mocks.NewRepo(t)
+		repo.On("CheckBoard", ctx, boardID.String()).Return(true)
+		repo.On("GetTaskCompletions", ctx, boardID, at(3), at(17)).Return([]domain.TaskCompletion{
+			{TaskID: uuid.New(), StoryPoints: intPtr(5), CompletedAt: at(4)},
+			{TaskID: uuid.New(), StoryPoints: intPtr(3), CompletedAt: at(12)},
+		}, nil)
+
+		r, err := NewUC(repo).Handle(ctx, q)
+		require.NoError(t, err)
+		require.Len(t, r.Periods, 2)
+		assert.Equal(t, 5, r.Periods[0].Points)
+		assert.Equal(t, 3, r.Periods[1].Points)
+		assert.Equal(t, 4.0, r.AveragePoints)
+	})
+
+	t.Run("by sprint without started sprints", func(t *testing.T) {
+		q, err := NewQuery(boardID.String(), "sprint", "", "")
+		require.NoError(t, err)
+
+		repo := mocks.NewRepo(t)
+		repo.On("CheckBoard", ctx, boardID.String()).Return(true)
+		repo.On("GetSprints", ctx, boardID).Return([]domain.Sprint{
+			{ID: uuid.New(), Name: "Next", StartDate: time.Now(), EndDate: time.Now(), State: domain.SprintPlanned},
+		}, nil)
+
+		r, err := NewUC(repo).Handle(ctx, q)
+		require.NoError(t, err)
+		assert.Empty(t, r.Periods)
+	})
+
+	t.Run("board not found", func(t *testing.T) {
+		q, err := NewQuery(boardID.String(), "", "", "")
+		require.NoError(t, err)
+
+		repo := mocks.NewRepo(t)
+		repo.On("CheckBoard", ctx, boardID.String()).Return(false)
+
+		_, err = NewUC(repo).Handle(ctx, q)
+		assert.ErrorIs(t, err, ErrBoardNotFound)
+	})
+
+	t.Run("invalid query", func(t *testing.T) {
+		_, err := NewQuery(boardID.String(), "month", "", "")
+		assert.ErrorIs(t, err, ErrInvalidGrouping)
+		_, err = NewQuery(boardID.String(), "week", "03.03.2025", "")
+		assert.ErrorIs(t, err, ErrInvalidPeriod)
+		_, err = NewQuery("board", "week", "", "")
+		assert.ErrorIs(t, err, ErrInvalidBoardID)
+	})
+}
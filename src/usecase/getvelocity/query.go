@@ -0,0 +1,52 @@
+package getvelocity
+
+import (
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type Query struct {
+	Filter domain.VelocityFilter
+}
+
+// NewQuery собирает фильтр отчета: группировка week или sprint, период
+// задается необязательными датами YYYY-MM-DD включительно.
+func NewQuery(boardID, groupBy, from, to string) (Query, error) {
+	id, err := uuid.Parse(boardID)
+	if err != nil {
+		return Query{}, ErrInvalidBoardID
+	}
+
+	f, err := parseDate(from)
+	if err != nil {
+		return Query{}, err
+	}
+	t, err := parseDate(to)
+	if err != nil {
+		return Query{}, err
+	}
+
+	filter, err := domain.NewVelocityFilter(id, domain.VelocityGrouping(groupBy), f, t)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidVelocityGrouping) {
+			return Query{}, errors.Wrap(ErrInvalidGrouping, err.Error())
+		}
+		return Query{}, errors.Wrap(ErrInvalidPeriod, err.Error())
+	}
+
+	return Query{Filter: filter}, nil
+}
+
+func parseDate(s string) (*time.Time, error) {
+	if s == "" {
+		return nil, nil
+	}
+	d, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidPeriod, err.Error())
+	}
+	return &d, nil
+}
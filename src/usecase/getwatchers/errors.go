@@ -0,0 +1,13 @@
+package getwatchers
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidTaskID      = errors.New("invalid task id")
+	ErrInvalidBoardID     = errors.New("invalid board id")
+	ErrTaskNotFound       = errors.New("task not found")
+	ErrBoardNotFound      = errors.New("board not found")
+	ErrGetWatchersUnknown = errors.New("unknown error getting watchers")
+)
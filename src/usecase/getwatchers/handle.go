@@ -0,0 +1,57 @@
+package getwatchers
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetTaskByID(ctx context.Context, taskID uuid.UUID) (*domain.Task, error)
+	CheckBoard(ctx context.Context, id string) bool
+	GetTaskWatchers(ctx context.Context, taskID uuid.UUID) ([]domain.Watcher, error)
+	GetBoardWatchers(ctx context.Context, boardID uuid.UUID) ([]domain.Watcher, error)
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+// Handle возвращает явных подписчиков задачи или доски. Подписчики доски
+// в список подписчиков задачи не входят.
+func (uc *UC) Handle(ctx context.Context, q Query) ([]domain.Watcher, error) {
+	if q.TaskID != nil {
+		_, err := uc.repo.GetTaskByID(ctx, *q.TaskID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, ErrTaskNotFound
+			}
+			return nil, errors.Wrap(ErrGetWatchersUnknown, err.Error())
+		}
+
+		watchers, err := uc.repo.GetTaskWatchers(ctx, *q.TaskID)
+		if err != nil {
+			return nil, errors.Wrap(ErrGetWatchersUnknown, err.Error())
+		}
+		return watchers, nil
+	}
+
+	if !uc.repo.CheckBoard(ctx, q.BoardID.String()) {
+		return nil, ErrBoardNotFound
+	}
+
+	watchers, err := uc.repo.GetBoardWatchers(ctx, *q.BoardID)
+	if err != nil {
+		return nil, errors.Wrap(ErrGetWatchersUnknown, err.Error())
+	}
+	return watchers, nil
+}
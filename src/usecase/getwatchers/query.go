@@ -0,0 +1,26 @@
+package getwatchers
+
+import (
+	"github.com/google/uuid"
+)
+
+type Query struct {
+	TaskID  *uuid.UUID
+	BoardID *uuid.UUID
+}
+
+func NewTaskQuery(taskID string) (Query, error) {
+	id, err := uuid.Parse(taskID)
+	if err != nil {
+		return Query{}, ErrInvalidTaskID
+	}
+	return Query{TaskID: &id}, nil
+}
+
+func NewBoardQuery(boardID string) (Query, error) {
+	id, err := uuid.Parse(boardID)
+	if err != nil {
+		return Query{}, ErrInvalidBoardID
+	}
+	return Query{BoardID: &id}, nil
+}
@@ -0,0 +1,13 @@
+package getwebhookdeliveries
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidWebhookID     = errors.New("invalid webhook id")
+	ErrInvalidStatus        = errors.New("invalid delivery status")
+	ErrInvalidLimit         = errors.New("invalid limit")
+	ErrWebhookNotFound      = errors.New("webhook not found")
+	ErrGetDeliveriesUnknown = errors.New("unknown error getting webhook deliveries")
+)
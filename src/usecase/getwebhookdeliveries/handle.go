@@ -0,0 +1,46 @@
+package getwebhookdeliveries
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetWebhook(ctx context.Context, webhookID uuid.UUID) (*domain.Webhook, error)
+	GetWebhookDeliveries(
+		ctx context.Context,
+		webhookID uuid.UUID,
+		status *domain.WebhookDeliveryStatus,
+		limit uint,
+	) ([]domain.WebhookDelivery, error)
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+func (uc *UC) Handle(ctx context.Context, query Query) ([]domain.WebhookDelivery, error) {
+	if _, err := uc.repo.GetWebhook(ctx, query.WebhookID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrWebhookNotFound
+		}
+		return nil, errors.Wrap(ErrGetDeliveriesUnknown, err.Error())
+	}
+
+	deliveries, err := uc.repo.GetWebhookDeliveries(ctx, query.WebhookID, query.Status, query.Limit)
+	if err != nil {
+		return nil, errors.Wrap(ErrGetDeliveriesUnknown, err.Error())
+	}
+
+	return deliveries, nil
+}
@@ -0,0 +1,47 @@
+package getwebhookdeliveries
+
+import (
+	"strconv"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultLimit = 50
+	maxLimit     = 200
+)
+
+type Query struct {
+	WebhookID uuid.UUID
+	Status    *domain.WebhookDeliveryStatus
+	Limit     uint
+}
+
+func NewQuery(webhookID string, status string, limit string) (Query, error) {
+	id, err := uuid.Parse(webhookID)
+	if err != nil {
+		return Query{}, errors.Wrap(ErrInvalidWebhookID, err.Error())
+	}
+
+	q := Query{WebhookID: id, Limit: defaultLimit}
+
+	if status != "" {
+		s, err := domain.ParseWebhookDeliveryStatus(status)
+		if err != nil {
+			return Query{}, errors.Wrap(ErrInvalidStatus, err.Error())
+		}
+		q.Status = &s
+	}
+
+	if limit != "" {
+		l, err := strconv.ParseUint(limit, 10, 32)
+		if err != nil || l == 0 || l > maxLimit {
+			return Query{}, errors.Wrapf(ErrInvalidLimit, "limit must be 1-%d", maxLimit)
+		}
+		q.Limit = uint(l)
+	}
+
+	return q, nil
+}
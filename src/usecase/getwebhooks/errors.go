@@ -0,0 +1,11 @@
+package getwebhooks
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidBoardID     = errors.New("invalid board id")
+	ErrBoardNotFound      = errors.New("board not found")
+	ErrGetWebhooksUnknown = errors.New("unknown error getting webhooks")
+)
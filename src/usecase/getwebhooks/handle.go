@@ -0,0 +1,45 @@
+package getwebhooks
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetBoardIncludingDeleted(ctx context.Context, boardID uuid.UUID) (*domain.Board, error)
+	GetWebhooks(ctx context.Context, boardID uuid.UUID, event *domain.WebhookEventType) ([]domain.Webhook, error)
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+func (uc *UC) Handle(ctx context.Context, query Query) ([]domain.Webhook, error) {
+	board, err := uc.repo.GetBoardIncludingDeleted(ctx, query.BoardID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrBoardNotFound
+		}
+		return nil, errors.Wrap(ErrGetWebhooksUnknown, err.Error())
+	}
+	if board.DeletedAt != nil {
+		return nil, ErrBoardNotFound
+	}
+
+	webhooks, err := uc.repo.GetWebhooks(ctx, query.BoardID, nil)
+	if err != nil {
+		return nil, errors.Wrap(ErrGetWebhooksUnknown, err.Error())
+	}
+
+	return webhooks, nil
+}
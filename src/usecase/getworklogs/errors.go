@@ -0,0 +1,11 @@
+package getworklogs
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidTaskID      = errors.New("invalid task id")
+	ErrTaskNotFound       = errors.New("task not found")
+	ErrGetWorklogsUnknown = errors.New("unknown error getting worklogs")
+)
@@ -0,0 +1,55 @@
+package getworklogs
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetTaskByID(ctx context.Context, taskID uuid.UUID) (*domain.Task, error)
+	GetWorklogs(ctx context.Context, taskID uuid.UUID) ([]domain.Worklog, error)
+}
+
+// Result - записи о времени вместе с оценками задачи.
+type Result struct {
+	Task     *domain.Task
+	Worklogs []domain.Worklog
+	// TotalMinutes - всего списано на задачу
+	TotalMinutes int
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+func (uc *UC) Handle(ctx context.Context, q Query) (*Result, error) {
+	task, err := uc.repo.GetTaskByID(ctx, q.TaskID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrTaskNotFound
+		}
+		return nil, errors.Wrap(ErrGetWorklogsUnknown, err.Error())
+	}
+
+	worklogs, err := uc.repo.GetWorklogs(ctx, q.TaskID)
+	if err != nil {
+		return nil, errors.Wrap(ErrGetWorklogsUnknown, err.Error())
+	}
+
+	res := &Result{Task: task, Worklogs: worklogs}
+	for _, w := range worklogs {
+		res.TotalMinutes += w.Minutes
+	}
+
+	return res, nil
+}
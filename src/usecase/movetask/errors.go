@@ -2,6 +2,8 @@ package movetask
 
 import (
 	"errors"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
 )
 
 var (
@@ -10,4 +12,6 @@ var (
 	ErrTaskNotFound     = errors.New("task not found")
 	ErrMoveTaskUnknown  = errors.New("failed to move task")
 	ErrColumnNotInBoard = errors.New("column does not belong to task's board")
+	// ErrTaskIsBlocked приходит из domain.CheckBlockers вместе с ключами блокеров
+	ErrTaskIsBlocked = domain.ErrTaskIsBlocked
 )
@@ -10,11 +10,15 @@ import (
 
 type UC struct {
 	repo Repo
+	// rejectBlocked - запрещать перенос заблокированной задачи в завершающую колонку
+	// вместо предупреждения.
+	rejectBlocked bool
 }
 
-func NewUC(repo Repo) *UC {
+func NewUC(repo Repo, rejectBlocked bool) *UC {
 	return &UC{
-		repo: repo,
+		repo:          repo,
+		rejectBlocked: rejectBlocked,
 	}
 }
 
@@ -22,9 +26,16 @@ type Repo interface {
 	CheckColumnInBoard(ctx context.Context, boardID uuid.UUID, columnID uuid.UUID) (bool, error)
 	GetTaskByID(ctx context.Context, taskID uuid.UUID) (*domain.Task, error)
 	UpdateTask(ctx context.Context, task *domain.Task) error
+	GetColumns(ctx context.Context, boardID uuid.UUID) ([]domain.Column, error)
+	GetOpenBlockers(ctx context.Context, taskID uuid.UUID) ([]domain.Task, error)
 }
 
-func (uc *UC) Handle(ctx context.Context, cmd MoveTaskCommand) (*domain.Task, error) {
+type Result struct {
+	Task     *domain.Task
+	Warnings []string
+}
+
+func (uc *UC) Handle(ctx context.Context, cmd MoveTaskCommand) (*Result, error) {
 	task, err := uc.repo.GetTaskByID(ctx, cmd.TaskID)
 	if err != nil {
 		return nil, errors.Wrap(ErrTaskNotFound, err.Error())
@@ -41,15 +52,40 @@ func (uc *UC) Handle(ctx context.Context, cmd MoveTaskCommand) (*domain.Task, er
 	err = task.MoveToColumn(cmd.ColumnID)
 	if err != nil {
 		if errors.Is(err, domain.ErrAlreadyInColumn) {
-			return task, nil
+			return &Result{Task: task}, nil
 		}
 		return nil, errors.Wrap(ErrMoveTaskUnknown, err.Error())
 	}
 
+	warnings, err := uc.checkBlockers(ctx, task)
+	if err != nil {
+		return nil, err
+	}
+
 	err = uc.repo.UpdateTask(ctx, task)
 	if err != nil {
 		return nil, errors.Wrap(ErrMoveTaskUnknown, err.Error())
 	}
 
-	return task, nil
+	return &Result{
+		Task:     task,
+		Warnings: warnings,
+	}, nil
+}
+
+// checkBlockers проверяет открытые блокирующие задачи при переносе в завершающую колонку.
+func (uc *UC) checkBlockers(ctx context.Context, task *domain.Task) ([]string, error) {
+	columns, err := uc.repo.GetColumns(ctx, task.BoardID)
+	if err != nil {
+		return nil, errors.Wrap(ErrMoveTaskUnknown, err.Error())
+	}
+	if !domain.IsDoneColumn(columns, task.ColumnID) {
+		return nil, nil
+	}
+
+	blockers, err := uc.repo.GetOpenBlockers(ctx, task.ID)
+	if err != nil {
+		return nil, errors.Wrap(ErrMoveTaskUnknown, err.Error())
+	}
+	return domain.CheckBlockers(blockers, uc.rejectBlocked)
 }
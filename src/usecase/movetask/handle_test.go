@@ -0,0 +1,142 @@
+package movetask
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/movetask/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func TestHandle(t *testing.T) {
+	ctx := context.Background()
+	boardID := uuid.New()
+	todoID := uuid.New()
+	doneID := uuid.New()
+
+	columns := []domain.Column{
+		{ID: todoID, BoardID: boardID, OrderNum: 0},
+		{ID: doneID, BoardID: boardID, OrderNum: 1},
+	}
+	blockers := []domain.Task{
+		{ID: uuid.New(), BoardShortName: strPtr("OPS"), Number: 3},
+		{ID: uuid.New(), BoardShortName: strPtr("TEAM"), Number: 12},
+	}
+
+	newTask := func() *domain.Task {
+		return &domain.Task{ID: uuid.New(), BoardID: boardID, ColumnID: todoID}
+	}
+
+	testCases := []struct {
+		name             string
+		rejectBlocked    bool
+		columnID         uuid.UUID
+		setupMock        func(repo *mocks.Repo, task *domain.Task)
+		expectedWarnings []string
+		expectError      error
+	}{
+		{
+			name:     "Success: move to regular column",
+			columnID: doneID,
+			setupMock: func(repo *mocks.Repo, task *domain.Task) {
+				cols := []domain.Column{
+					{ID: todoID, BoardID: boardID, OrderNum: 0},
+					{ID: doneID, BoardID: boardID, OrderNum: 1},
+					{ID: uuid.New(), BoardID: boardID, OrderNum: 2},
+				}
+				repo.On("GetTaskByID", mock.Anything, task.ID).Return(task, nil).Once()
+				repo.On("CheckColumnInBoard", mock.Anything, boardID, doneID).Return(true, nil).Once()
+				repo.On("GetColumns", mock.Anything, boardID).Return(cols, nil).Once()
+				repo.On("UpdateTask", mock.Anything, task).Return(nil).Once()
+			},
+		},
+		{
+			name:     "Success: move to done column without blockers",
+			columnID: doneID,
+			setupMock: func(repo *mocks.Repo, task *domain.Task) {
+				repo.On("GetTaskByID", mock.Anything, task.ID).Return(task, nil).Once()
+				repo.On("CheckColumnInBoard", mock.Anything, boardID, doneID).Return(true, nil).Once()
+				repo.On("GetColumns", mock.Anything, boardID).Return(columns, nil).Once()
+				repo.On("GetOpenBlockers", mock.Anything, task.ID).Return([]domain.Task{}, nil).Once()
+				repo.On("UpdateTask", mock.Anything, task).Return(nil).Once()
+			},
+		},
+		{
+			name:     "Success: blocked task moved with warning",
+			columnID: doneID,
+			setupMock: func(repo *mocks.Repo, task *domain.Task) {
+				repo.On("GetTaskByID", mock.Anything, task.ID).Return(task, nil).Once()
+				repo.On("CheckColumnInBoard", mock.Anything, boardID, doneID).Return(true, nil).Once()
+				repo.On("GetColumns", mock.Anything, boardID).Return(columns, nil).Once()
+				repo.On("GetOpenBlockers", mock.Anything, task.ID).Return(blockers, nil).Once()
+				repo.On("UpdateTask", mock.Anything, task).Return(nil).Once()
+			},
+			expectedWarnings: []string{"task is blocked by OPS-3, TEAM-12"},
+		},
+		{
+			name:          "Failure: blocked task rejected",
+			rejectBlocked: true,
+			columnID:      doneID,
+			setupMock: func(repo *mocks.Repo, task *domain.Task) {
+				repo.On("GetTaskByID", mock.Anything, task.ID).Return(task, nil).Once()
+				repo.On("CheckColumnInBoard", mock.Anything, boardID, doneID).Return(true, nil).Once()
+				repo.On("GetColumns", mock.Anything, boardID).Return(columns, nil).Once()
+				repo.On("GetOpenBlockers", mock.Anything, task.ID).Return(blockers, nil).Once()
+			},
+			expectError: ErrTaskIsBlocked,
+		},
+		{
+			name:     "Failure: column not in board",
+			columnID: doneID,
+			setupMock: func(repo *mocks.Repo, task *domain.Task) {
+				repo.On("GetTaskByID", mock.Anything, task.ID).Return(task, nil).Once()
+				repo.On("CheckColumnInBoard", mock.Anything, boardID, doneID).Return(false, nil).Once()
+			},
+			expectError: ErrColumnNotInBoard,
+		},
+		{
+			name:     "Failure: get blockers error",
+			columnID: doneID,
+			setupMock: func(repo *mocks.Repo, task *domain.Task) {
+				repo.On("GetTaskByID", mock.Anything, task.ID).Return(task, nil).Once()
+				repo.On("CheckColumnInBoard", mock.Anything, boardID, doneID).Return(true, nil).Once()
+				repo.On("GetColumns", mock.Anything, boardID).Return(columns, nil).Once()
+				repo.On("GetOpenBlockers", mock.Anything, task.ID).Return(nil, errors.New("db error")).Once()
+			},
+			expectError: ErrMoveTaskUnknown,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			task := newTask()
+			repo := mocks.NewRepo(t)
+			tc.setupMock(repo, task)
+
+			uc := NewUC(repo, tc.rejectBlocked)
+			res, err := uc.Handle(ctx, MoveTaskCommand{TaskID: task.ID, ColumnID: tc.columnID})
+
+			if tc.expectError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tc.expectError)
+				assert.Nil(t, res)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+				assert.Equal(t, tc.columnID, res.Task.ColumnID)
+				assert.Equal(t, tc.expectedWarnings, res.Warnings)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package movetasktoboard
+
+import (
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type Command struct {
+	TaskID   uuid.UUID
+	BoardID  uuid.UUID
+	ColumnID uuid.UUID
+	// Замена тегов для целевой доски: старый -> новый, пустой новый - удалить тег
+	TagMapping map[string]string
+}
+
+func NewCommand(taskID string, boardID string, columnID string, tagMapping map[string]string) (Command, error) {
+	tID, err := uuid.Parse(taskID)
+	if err != nil {
+		return Command{}, errors.Wrap(ErrInvalidTaskID, err.Error())
+	}
+	bID, err := uuid.Parse(boardID)
+	if err != nil {
+		return Command{}, errors.Wrap(ErrInvalidBoardID, err.Error())
+	}
+	cID, err := uuid.Parse(columnID)
+	if err != nil {
+		return Command{}, errors.Wrap(ErrInvalidColumnID, err.Error())
+	}
+
+	return Command{
+		TaskID:     tID,
+		BoardID:    bID,
+		ColumnID:   cID,
+		TagMapping: tagMapping,
+	}, nil
+}
@@ -0,0 +1,17 @@
+package movetasktoboard
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidTaskID          = errors.New("invalid task id")
+	ErrInvalidBoardID         = errors.New("invalid board id")
+	ErrInvalidColumnID        = errors.New("invalid column id")
+	ErrTaskNotFound           = errors.New("task not found")
+	ErrBoardNotFound          = errors.New("board not found")
+	ErrColumnNotInBoard       = errors.New("column not found in target board")
+	ErrTaskAlreadyInBoard     = errors.New("task already in target board")
+	ErrStoryPointsNotInScale  = errors.New("task story points are not in the target board scale")
+	ErrMoveTaskToBoardUnknown = errors.New("unknown error moving task to board")
+)
@@ -0,0 +1,111 @@
+package movetasktoboard
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetTaskByID(ctx context.Context, taskID uuid.UUID) (*domain.Task, error)
+	GetBoardIncludingDeleted(ctx context.Context, boardID uuid.UUID) (*domain.Board, error)
+	CheckColumnInBoard(ctx context.Context, boardID uuid.UUID, columnID uuid.UUID) (bool, error)
+	GetLastNumberTask(ctx context.Context, boardID uuid.UUID) (int64, error)
+	GetTags(ctx context.Context, boardID *uuid.UUID, userID uuid.UUID, prefix string, limit uint) ([]domain.TagUsage, error)
+	GetStoryPointScale(ctx context.Context, boardID uuid.UUID) (domain.StoryPointScale, error)
+	MoveTaskToBoard(ctx context.Context, task *domain.Task, move domain.BoardMove, entry domain.TaskHistoryEntry) error
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+// Handle переносит задачу в другую доску под следующим свободным номером.
+// Старый ключ задачи продолжает открываться через редирект.
+func (uc *UC) Handle(ctx context.Context, cmd Command) (*domain.Task, domain.BoardMove, error) {
+	task, err := uc.repo.GetTaskByID(ctx, cmd.TaskID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.BoardMove{}, ErrTaskNotFound
+		}
+		return nil, domain.BoardMove{}, errors.Wrap(ErrMoveTaskToBoardUnknown, err.Error())
+	}
+	if task.BoardID == cmd.BoardID {
+		return nil, domain.BoardMove{}, ErrTaskAlreadyInBoard
+	}
+
+	source, err := uc.repo.GetBoardIncludingDeleted(ctx, task.BoardID)
+	if err != nil {
+		return nil, domain.BoardMove{}, errors.Wrap(ErrMoveTaskToBoardUnknown, err.Error())
+	}
+
+	target, err := uc.repo.GetBoardIncludingDeleted(ctx, cmd.BoardID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.BoardMove{}, ErrBoardNotFound
+		}
+		return nil, domain.BoardMove{}, errors.Wrap(ErrMoveTaskToBoardUnknown, err.Error())
+	}
+	if target.DeletedAt != nil {
+		return nil, domain.BoardMove{}, ErrBoardNotFound
+	}
+
+	ex, err := uc.repo.CheckColumnInBoard(ctx, cmd.BoardID, cmd.ColumnID)
+	if err != nil {
+		return nil, domain.BoardMove{}, errors.Wrap(ErrMoveTaskToBoardUnknown, err.Error())
+	}
+	if !ex {
+		return nil, domain.BoardMove{}, ErrColumnNotInBoard
+	}
+
+	number, err := uc.repo.GetLastNumberTask(ctx, cmd.BoardID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.BoardMove{}, errors.Wrap(ErrMoveTaskToBoardUnknown, err.Error())
+	}
+	number++
+
+	usages, err := uc.repo.GetTags(ctx, &cmd.BoardID, uuid.Nil, "", 0)
+	if err != nil {
+		return nil, domain.BoardMove{}, errors.Wrap(ErrMoveTaskToBoardUnknown, err.Error())
+	}
+	targetTags := make([]string, 0, len(usages))
+	for _, u := range usages {
+		targetTags = append(targetTags, u.Name)
+	}
+
+	scale, err := uc.repo.GetStoryPointScale(ctx, cmd.BoardID)
+	if err != nil {
+		return nil, domain.BoardMove{}, errors.Wrap(ErrMoveTaskToBoardUnknown, err.Error())
+	}
+
+	move, err := task.MoveToBoard(source, target, cmd.ColumnID, number, targetTags, cmd.TagMapping, scale)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrTaskAlreadyInBoard):
+			return nil, domain.BoardMove{}, errors.Wrap(ErrTaskAlreadyInBoard, err.Error())
+		case errors.Is(err, domain.ErrInvalidStoryPoints):
+			return nil, domain.BoardMove{}, errors.Wrap(ErrStoryPointsNotInScale, err.Error())
+		default:
+			return nil, domain.BoardMove{}, errors.Wrap(ErrMoveTaskToBoardUnknown, err.Error())
+		}
+	}
+
+	err = uc.repo.MoveTaskToBoard(ctx, task, move, move.HistoryEntry(task.ID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.BoardMove{}, ErrTaskNotFound
+		}
+		return nil, domain.BoardMove{}, errors.Wrap(ErrMoveTaskToBoardUnknown, err.Error())
+	}
+
+	return task, move, nil
+}
@@ -0,0 +1,171 @@
+package movetasktoboard
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/movetasktoboard/mocks"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandle(t *testing.T) {
+	ctx := context.Background()
+	taskID := uuid.New()
+	sourceID := uuid.New()
+	targetID := uuid.New()
+	columnID := uuid.New()
+
+	source := &domain.Board{ID: sourceID, ShortName: "OPS"}
+	target := &domain.Board{ID: targetID, ShortName: "TEAM"}
+	newTask := func() *domain.Task {
+		return &domain.Task{ID: taskID, BoardID: sourceID, Number: 7, Tags: []string{"bug", "infra"}}
+	}
+	command := Command{TaskID: taskID, BoardID: targetID, ColumnID: columnID}
+
+	testCases := []struct {
+		name        string
+		command     Command
+		setupMock   func(*mocks.Repo)
+		expectError error
+	}{
+		{
+			name:    "Success: task moved with next number and history",
+			command: command,
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTaskByID", mock.Anything, taskID).Return(newTask(), nil).Once()
+				repo.On("GetBoardIncludingDeleted", mock.Anything, sourceID).Return(source, nil).Once()
+				repo.On("GetBoardIncludingDeleted", mock.Anything, targetID).Return(target, nil).Once()
+				repo.On("CheckColumnInBoard", mock.Anything, targetID, columnID).Return(true, nil).Once()
+				repo.On("GetLastNumberTask", mock.Anything, targetID).Return(int64(41), nil).Once()
+				repo.On("GetTags", mock.Anything, &targetID, uuid.Nil, "", uint(0)).
+					Return([]domain.TagUsage{{Name: "bug", Count: 3}}, nil).Once()
+				repo.On("GetStoryPointScale", mock.Anything, targetID).Return(domain.DefaultStoryPointScale(), nil).Once()
+				repo.On("MoveTaskToBoard", mock.Anything,
+					mock.MatchedBy(func(task *domain.Task) bool {
+						return task.BoardID == targetID && task.Number == 42 && len(task.Tags) == 1
+					}),
+					mock.MatchedBy(func(move domain.BoardMove) bool {
+						return move.FromKey == "OPS-7" && move.ToKey == "TEAM-42"
+					}),
+					mock.MatchedBy(func(entry domain.TaskHistoryEntry) bool {
+						return entry.TaskID == taskID && entry.Kind == domain.TaskHistoryMovedToBoard
+					}),
+				).Return(nil).Once()
+			},
+		},
+		{
+			name:    "Failure: task not found",
+			command: command,
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTaskByID", mock.Anything, taskID).Return(nil, pgx.ErrNoRows).Once()
+			},
+			expectError: ErrTaskNotFound,
+		},
+		{
+			name:    "Failure: task already in target board",
+			command: Command{TaskID: taskID, BoardID: sourceID, ColumnID: columnID},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTaskByID", mock.Anything, taskID).Return(newTask(), nil).Once()
+			},
+			expectError: ErrTaskAlreadyInBoard,
+		},
+		{
+			name:    "Failure: target board not found",
+			command: command,
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTaskByID", mock.Anything, taskID).Return(newTask(), nil).Once()
+				repo.On("GetBoardIncludingDeleted", mock.Anything, sourceID).Return(source, nil).Once()
+				repo.On("GetBoardIncludingDeleted", mock.Anything, targetID).Return(nil, pgx.ErrNoRows).Once()
+			},
+			expectError: ErrBoardNotFound,
+		},
+		{
+			name:    "Failure: column from another board",
+			command: command,
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTaskByID", mock.Anything, taskID).Return(newTask(), nil).Once()
+				repo.On("GetBoardIncludingDeleted", mock.Anything, sourceID).Return(source, nil).Once()
+				repo.On("GetBoardIncludingDeleted", mock.Anything, targetID).Return(target, nil).Once()
+				repo.On("CheckColumnInBoard", mock.Anything, targetID, columnID).Return(false, nil).Once()
+			},
+			expectError: ErrColumnNotInBoard,
+		},
+		{
+			name:    "Failure: save error",
+			command: command,
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTaskByID", mock.Anything, taskID).Return(newTask(), nil).Once()
+				repo.On("GetBoardIncludingDeleted", mock.Anything, sourceID).Return(source, nil).Once()
+				repo.On("GetBoardIncludingDeleted", mock.Anything, targetID).Return(target, nil).Once()
+				repo.On("CheckColumnInBoard", mock.Anything, targetID, columnID).Return(true, nil).Once()
+				repo.On("GetLastNumberTask", mock.Anything, targetID).Return(int64(0), pgx.ErrNoRows).Once()
+				repo.On("GetTags", mock.Anything, &targetID, uuid.Nil, "", uint(0)).Return([]domain.TagUsage{}, nil).Once()
+				repo.On("GetStoryPointScale", mock.Anything, targetID).Return(domain.DefaultStoryPointScale(), nil).Once()
+				repo.On("MoveTaskToBoard", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(errors.New("db error")).Once()
+			},
+			expectError: ErrMoveTaskToBoardUnknown,
+		},
+		{
+			name:    "Failure: story points outside target board scale",
+			command: command,
+			setupMock: func(repo *mocks.Repo) {
+				task := newTask()
+				points := 4
+				task.StoryPoints = &points
+				repo.On("GetTaskByID", mock.Anything, taskID).Return(task, nil).Once()
+				repo.On("GetBoardIncludingDeleted", mock.Anything, sourceID).Return(source, nil).Once()
+				repo.On("GetBoardIncludingDeleted", mock.Anything, targetID).Return(target, nil).Once()
+				repo.On("CheckColumnInBoard", mock.Anything, targetID, columnID).Return(true, nil).Once()
+				repo.On("GetLastNumberTask", mock.Anything, targetID).Return(int64(41), nil).Once()
+				repo.On("GetTags", mock.Anything, &targetID, uuid.Nil, "", uint(0)).Return([]domain.TagUsage{}, nil).Once()
+				repo.On("GetStoryPointScale", mock.Anything, targetID).Return(domain.DefaultStoryPointScale(), nil).Once()
+			},
+			expectError: ErrStoryPointsNotInScale,
+		},
+		{
+			name:    "Failure: task deleted while moving",
+			command: command,
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTaskByID", mock.Anything, taskID).Return(newTask(), nil).Once()
+				repo.On("GetBoardIncludingDeleted", mock.Anything, sourceID).Return(source, nil).Once()
+				repo.On("GetBoardIncludingDeleted", mock.Anything, targetID).Return(target, nil).Once()
+				repo.On("CheckColumnInBoard", mock.Anything, targetID, columnID).Return(true, nil).Once()
+				repo.On("GetLastNumberTask", mock.Anything, targetID).Return(int64(41), nil).Once()
+				repo.On("GetTags", mock.Anything, &targetID, uuid.Nil, "", uint(0)).Return([]domain.TagUsage{}, nil).Once()
+				repo.On("GetStoryPointScale", mock.Anything, targetID).Return(domain.DefaultStoryPointScale(), nil).Once()
+				repo.On("MoveTaskToBoard", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(pgx.ErrNoRows).Once()
+			},
+			expectError: ErrTaskNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := mocks.NewRepo(t)
+			tc.setupMock(repo)
+
+			uc := NewUC(repo)
+			task, move, err := uc.Handle(ctx, tc.command)
+
+			if tc.expectError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tc.expectError)
+				assert.Nil(t, task)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, task)
+				assert.Equal(t, []string{"infra"}, move.DroppedTags)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package notifyduesoon
+
+import (
+	"time"
+)
+
+type Command struct {
+	// Сроки считаются от даты Now
+	Now time.Time
+}
+
+func NewCommand() Command {
+	return Command{
+		Now: time.Now().UTC(),
+	}
+}
@@ -0,0 +1,9 @@
+package notifyduesoon
+
+import (
+	"errors"
+)
+
+var (
+	ErrNotifyDueSoonUnknown = errors.New("unknown error notifying about due tasks")
+)
@@ -0,0 +1,43 @@
+package notifyduesoon
+
+import (
+	"context"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetTaskDeadlines(ctx context.Context, from, to time.Time) ([]domain.TaskDeadline, error)
+	CreateNotifications(ctx context.Context, notifications []domain.Notification) error
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+// Handle напоминает исполнителям о задачах, срок которых наступает сегодня или завтра.
+// Напоминание о том же сроке создается один раз, сколько бы раз ни запускался Handle.
+// Возвращает число найденных напоминаний, включая уже созданные раньше.
+func (uc *UC) Handle(ctx context.Context, cmd Command) (int, error) {
+	from, to := domain.DueSoonRange(cmd.Now)
+
+	deadlines, err := uc.repo.GetTaskDeadlines(ctx, from, to)
+	if err != nil {
+		return 0, errors.Wrap(ErrNotifyDueSoonUnknown, err.Error())
+	}
+
+	notifications := domain.DueSoonNotifications(deadlines, cmd.Now)
+	if err := uc.repo.CreateNotifications(ctx, notifications); err != nil {
+		return 0, errors.Wrap(ErrNotifyDueSoonUnknown, err.Error())
+	}
+
+	return len(notifications), nil
+}
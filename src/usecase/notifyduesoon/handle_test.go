@@ -0,0 +1,92 @@
+package notifyduesoon
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/notifyduesoon/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandle(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 12, 10, 9, 0, 0, 0, time.UTC)
+	from, to := domain.DueSoonRange(now)
+	deadline := domain.TaskDeadline{
+		TaskID: uuid.New(), BoardID: uuid.New(), Title: "Fix login", Assignee: "alice",
+		DueDate: to, Source: domain.DeadlineMilestone, SourceName: "v1.0",
+	}
+
+	testCases := []struct {
+		name          string
+		setupMock     func(*mocks.Repo)
+		expectedCount int
+		expectError   error
+	}{
+		{
+			name: "Success: assignee reminded",
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTaskDeadlines", mock.Anything, from, to).
+					Return([]domain.TaskDeadline{deadline}, nil).Once()
+				repo.On("CreateNotifications", mock.Anything, mock.MatchedBy(func(ns []domain.Notification) bool {
+					return len(ns) == 1 && ns[0].UserID == "alice" &&
+						ns[0].Type == domain.NotificationTaskDueSoon && ns[0].TaskID == deadline.TaskID
+				})).Return(nil).Once()
+			},
+			expectedCount: 1,
+		},
+		{
+			name: "Success: nothing is due",
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTaskDeadlines", mock.Anything, from, to).
+					Return(nil, nil).Once()
+				repo.On("CreateNotifications", mock.Anything, []domain.Notification{}).
+					Return(nil).Once()
+			},
+		},
+		{
+			name: "Failure: deadlines error",
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTaskDeadlines", mock.Anything, from, to).
+					Return(nil, errors.New("db error")).Once()
+			},
+			expectError: ErrNotifyDueSoonUnknown,
+		},
+		{
+			name: "Failure: notifications error",
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTaskDeadlines", mock.Anything, from, to).
+					Return([]domain.TaskDeadline{deadline}, nil).Once()
+				repo.On("CreateNotifications", mock.Anything, mock.Anything).
+					Return(errors.New("db error")).Once()
+			},
+			expectError: ErrNotifyDueSoonUnknown,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := mocks.NewRepo(t)
+			tc.setupMock(repo)
+
+			uc := NewUC(repo)
+			count, err := uc.Handle(ctx, Command{Now: now})
+
+			if tc.expectError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tc.expectError)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectedCount, count)
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
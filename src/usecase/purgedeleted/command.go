@@ -0,0 +1,31 @@
+package purgedeleted
+
+import (
+	"time"
+)
+
+type Command struct {
+	// Удаляется все, что лежит в корзине с момента раньше Before
+	Before time.Time
+	// Ключи идемпотентности удаляются, если истекли раньше Now
+	Now       time.Time
+	BatchSize int
+	DryRun    bool
+}
+
+func NewCommand(retention time.Duration, batchSize int, dryRun bool) (Command, error) {
+	if retention <= 0 {
+		return Command{}, ErrInvalidRetention
+	}
+	if batchSize <= 0 {
+		return Command{}, ErrInvalidBatchSize
+	}
+
+	now := time.Now().UTC()
+	return Command{
+		Before:    now.Add(-retention),
+		Now:       now,
+		BatchSize: batchSize,
+		DryRun:    dryRun,
+	}, nil
+}
@@ -0,0 +1,11 @@
+package purgedeleted
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidRetention = errors.New("retention must be positive")
+	ErrInvalidBatchSize = errors.New("batch size must be positive")
+	ErrPurgeUnknown     = errors.New("unknown error purging deleted data")
+)
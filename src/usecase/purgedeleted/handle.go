@@ -0,0 +1,86 @@
+package purgedeleted
+
+import (
+	"context"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	PurgeDeleted(ctx context.Context, before time.Time, limit int) (domain.PurgeStats, []string, error)
+	CountPurgeable(ctx context.Context, before time.Time) (domain.PurgeStats, error)
+	DeleteExpiredIdempotencyKeys(ctx context.Context, now time.Time, limit int) (int64, error)
+	CountExpiredIdempotencyKeys(ctx context.Context, now time.Time) (int64, error)
+}
+
+type Storage interface {
+	Delete(ctx context.Context, key string) error
+}
+
+type UC struct {
+	repo    Repo
+	storage Storage
+}
+
+func NewUC(repo Repo, storage Storage) *UC {
+	return &UC{
+		repo:    repo,
+		storage: storage,
+	}
+}
+
+// Handle удаляет одну пачку корзины и истекших ключей идемпотентности.
+// Вызывающий повторяет, пока Total() не станет 0.
+// Содержимое вложений удаленных задач стирается из хранилища после того, как
+// удаление в базе закоммичено: иначе откат оставил бы записи без содержимого.
+// При DryRun ничего не удаляет и возвращает, сколько удалит полный прогон.
+func (uc *UC) Handle(ctx context.Context, cmd Command) (domain.PurgeStats, error) {
+	if cmd.DryRun {
+		stats, err := uc.repo.CountPurgeable(ctx, cmd.Before)
+		if err != nil {
+			return domain.PurgeStats{}, errors.Wrap(ErrPurgeUnknown, err.Error())
+		}
+		stats.IdempotencyKeys, err = uc.repo.CountExpiredIdempotencyKeys(ctx, cmd.Now)
+		if err != nil {
+			return domain.PurgeStats{}, errors.Wrap(ErrPurgeUnknown, err.Error())
+		}
+		return stats, nil
+	}
+
+	stats, storageKeys, err := uc.repo.PurgeDeleted(ctx, cmd.Before, cmd.BatchSize)
+	// ключи возвращаются и при ошибке: записи уже удалены, содержимое надо стереть
+	if blobErr := uc.deleteBlobs(ctx, storageKeys); blobErr != nil && err == nil {
+		err = blobErr
+	}
+	if err != nil {
+		return stats, errors.Wrap(ErrPurgeUnknown, err.Error())
+	}
+
+	stats.IdempotencyKeys, err = uc.repo.DeleteExpiredIdempotencyKeys(ctx, cmd.Now, cmd.BatchSize)
+	if err != nil {
+		return stats, errors.Wrap(ErrPurgeUnknown, err.Error())
+	}
+
+	return stats, nil
+}
+
+// deleteBlobs стирает все ключи, не останавливаясь на ошибке, и возвращает первую из них.
+func (uc *UC) deleteBlobs(ctx context.Context, keys []string) error {
+	var firstErr error
+	failed := 0
+	for _, key := range keys {
+		if err := uc.storage.Delete(ctx, key); err != nil {
+			failed++
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	if firstErr != nil {
+		return errors.Wrapf(firstErr, "delete %d of %d attachment blobs", failed, len(keys))
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package purgedeleted
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/purgedeleted/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCommand(t *testing.T) {
+	cmd, err := NewCommand(24*time.Hour, 100, true)
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().UTC().Add(-24*time.Hour), cmd.Before, time.Minute)
+	assert.True(t, cmd.DryRun)
+
+	_, err = NewCommand(0, 100, false)
+	assert.ErrorIs(t, err, ErrInvalidRetention)
+
+	_, err = NewCommand(time.Hour, 0, false)
+	assert.ErrorIs(t, err, ErrInvalidBatchSize)
+}
+
+func TestHandle(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().UTC()
+	before := now.Add(-720 * time.Hour)
+
+	testCases := []struct {
+		name          string
+		command       Command
+		setupMock     func(*mocks.Repo, *mocks.Storage)
+		expectedStats domain.PurgeStats
+		expectError   error
+	}{
+		{
+			name:    "Success: one batch purged",
+			command: Command{Before: before, Now: now, BatchSize: 500},
+			setupMock: func(repo *mocks.Repo, storage *mocks.Storage) {
+				repo.On("PurgeDeleted", mock.Anything, before, 500).
+					Return(domain.PurgeStats{Boards: 1, Columns: 2, Tasks: 500}, []string{"tasks/1/a", "tasks/2/b"}, nil).Once()
+				storage.On("Delete", mock.Anything, "tasks/1/a").Return(nil).Once()
+				storage.On("Delete", mock.Anything, "tasks/2/b").Return(nil).Once()
+				repo.On("DeleteExpiredIdempotencyKeys", mock.Anything, now, 500).
+					Return(int64(40), nil).Once()
+			},
+			expectedStats: domain.PurgeStats{Boards: 1, Columns: 2, Tasks: 500, IdempotencyKeys: 40},
+		},
+		{
+			name:    "Success: dry run only counts",
+			command: Command{Before: before, Now: now, BatchSize: 500, DryRun: true},
+			setupMock: func(repo *mocks.Repo, storage *mocks.Storage) {
+				repo.On("CountPurgeable", mock.Anything, before).
+					Return(domain.PurgeStats{Boards: 3, Columns: 10, Tasks: 1200}, nil).Once()
+				repo.On("CountExpiredIdempotencyKeys", mock.Anything, now).
+					Return(int64(90), nil).Once()
+			},
+			expectedStats: domain.PurgeStats{Boards: 3, Columns: 10, Tasks: 1200, IdempotencyKeys: 90},
+		},
+		{
+			name:    "Failure: purge error",
+			command: Command{Before: before, Now: now, BatchSize: 500},
+			setupMock: func(repo *mocks.Repo, storage *mocks.Storage) {
+				repo.On("PurgeDeleted", mock.Anything, before, 500).
+					Return(domain.PurgeStats{Tasks: 500}, []string{"tasks/1/a"}, errors.New("db error")).Once()
+				storage.On("Delete", mock.Anything, "tasks/1/a").Return(nil).Once()
+			},
+			expectedStats: domain.PurgeStats{Tasks: 500},
+			expectError:   ErrPurgeUnknown,
+		},
+		{
+			name:    "Failure: blob delete error does not stop other blobs",
+			command: Command{Before: before, Now: now, BatchSize: 500},
+			setupMock: func(repo *mocks.Repo, storage *mocks.Storage) {
+				repo.On("PurgeDeleted", mock.Anything, before, 500).
+					Return(domain.PurgeStats{Tasks: 2}, []string{"tasks/1/a", "tasks/2/b"}, nil).Once()
+				storage.On("Delete", mock.Anything, "tasks/1/a").Return(errors.New("s3 error")).Once()
+				storage.On("Delete", mock.Anything, "tasks/2/b").Return(nil).Once()
+			},
+			expectedStats: domain.PurgeStats{Tasks: 2},
+			expectError:   ErrPurgeUnknown,
+		},
+		{
+			name:    "Failure: idempotency keys cleanup error",
+			command: Command{Before: before, Now: now, BatchSize: 500},
+			setupMock: func(repo *mocks.Repo, storage *mocks.Storage) {
+				repo.On("PurgeDeleted", mock.Anything, before, 500).
+					Return(domain.PurgeStats{Tasks: 3}, nil, nil).Once()
+				repo.On("DeleteExpiredIdempotencyKeys", mock.Anything, now, 500).
+					Return(int64(0), errors.New("db error")).Once()
+			},
+			expectedStats: domain.PurgeStats{Tasks: 3},
+			expectError:   ErrPurgeUnknown,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := mocks.NewRepo(t)
+			storage := mocks.NewStorage(t)
+			tc.setupMock(repo, storage)
+
+			uc := NewUC(repo, storage)
+			stats, err := uc.Handle(ctx, tc.command)
+
+			if tc.expectError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tc.expectError)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectedStats, stats)
+
+			repo.AssertExpectations(t)
+			storage.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package putcolumn
+
+import (
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type Command struct {
+	ColumnID uuid.UUID `validate:"required,uuid"`
+	Name     string    `validate:"required,min=1,max=100"`
+	IsDone   bool
+	WipLimit *int64 `validate:"omitempty,gt=0"`
+}
+
+func NewCommand(columnID, name string, isDone bool, wipLimit *int64) (Command, error) {
+	validate := validator.New()
+
+	cID, err := uuid.Parse(columnID)
+	if err != nil {
+		return Command{}, errors.Wrap(ErrInvalidUUID, err.Error())
+	}
+
+	cmd := Command{
+		ColumnID: cID,
+		Name:     name,
+		IsDone:   isDone,
+		WipLimit: wipLimit,
+	}
+
+	err = validate.Struct(cmd)
+	if err != nil {
+		return Command{}, errors.Wrap(ErrValidationFailed, err.Error())
+	}
+
+	return cmd, nil
+}
@@ -0,0 +1,12 @@
+package putcolumn
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidUUID      = errors.New("invalid uuid")
+	ErrValidationFailed = errors.New("validation failed")
+	ErrColumnNotFound   = errors.New("column not found")
+	ErrPutColumnUnknown = errors.New("unknown error while putting column")
+)
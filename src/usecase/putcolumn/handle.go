@@ -0,0 +1,47 @@
+package putcolumn
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetColumnByID(ctx context.Context, columnID uuid.UUID) (*domain.Column, error)
+	UpdateColumn(ctx context.Context, column *domain.Column) error
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+func (uc *UC) Handle(ctx context.Context, cmd Command) (*domain.Column, error) {
+	column, err := uc.repo.GetColumnByID(ctx, cmd.ColumnID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrColumnNotFound
+		}
+		return nil, errors.Wrap(ErrPutColumnUnknown, err.Error())
+	}
+
+	err = column.Update(cmd.Name, cmd.IsDone, cmd.WipLimit)
+	if err != nil {
+		return nil, errors.Wrap(ErrValidationFailed, err.Error())
+	}
+
+	err = uc.repo.UpdateColumn(ctx, column)
+	if err != nil {
+		return nil, errors.Wrap(ErrPutColumnUnknown, err.Error())
+	}
+
+	return column, nil
+}
@@ -0,0 +1,27 @@
+package putemailpreference
+
+import (
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/pkg/errors"
+)
+
+const maxUserIDLen = 100
+
+type Command struct {
+	UserID string
+	Email  string
+	Mode   domain.EmailMode
+}
+
+func NewCommand(userID, email, mode string) (Command, error) {
+	if userID == "" || len(userID) > maxUserIDLen {
+		return Command{}, ErrInvalidUserID
+	}
+
+	m, err := domain.ParseEmailMode(mode)
+	if err != nil {
+		return Command{}, errors.Wrap(ErrValidationFailed, err.Error())
+	}
+
+	return Command{UserID: userID, Email: email, Mode: m}, nil
+}
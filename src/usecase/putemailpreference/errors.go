@@ -0,0 +1,11 @@
+package putemailpreference
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidUserID             = errors.New("invalid user id")
+	ErrValidationFailed          = errors.New("validation failed")
+	ErrPutEmailPreferenceUnknown = errors.New("unknown error saving email preference")
+)
@@ -0,0 +1,35 @@
+package putemailpreference
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	SaveEmailPreference(ctx context.Context, pref *domain.EmailPreference) error
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+func (uc *UC) Handle(ctx context.Context, cmd Command) (*domain.EmailPreference, error) {
+	pref, err := domain.NewEmailPreference(cmd.UserID, cmd.Email, cmd.Mode)
+	if err != nil {
+		return nil, errors.Wrap(ErrValidationFailed, err.Error())
+	}
+
+	if err := uc.repo.SaveEmailPreference(ctx, pref); err != nil {
+		return nil, errors.Wrap(ErrPutEmailPreferenceUnknown, err.Error())
+	}
+
+	return pref, nil
+}
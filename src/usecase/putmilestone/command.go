@@ -0,0 +1,50 @@
+package putmilestone
+
+import (
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type Command struct {
+	ID          uuid.UUID `validate:"required,uuid"`
+	Title       string    `validate:"required,min=1,max=255"`
+	Description *string
+	TargetDate  *time.Time
+	State       string `validate:"required,oneof=open closed"`
+}
+
+func NewCommand(id, title string, description, targetDate *string, state string) (Command, error) {
+	validate := validator.New()
+
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return Command{}, errors.Wrap(ErrInvalidUUID, err.Error())
+	}
+
+	var td *time.Time
+	if targetDate != nil && *targetDate != "" {
+		parsed, err := time.Parse(time.DateOnly, *targetDate)
+		if err != nil {
+			return Command{}, errors.Wrap(ErrInvalidTargetDate, err.Error())
+		}
+		td = &parsed
+	}
+
+	cmd := Command{
+		ID:          uid,
+		Title:       title,
+		Description: description,
+		TargetDate:  td,
+		State:       state,
+	}
+
+	err = validate.Struct(cmd)
+	if err != nil {
+		return Command{}, errors.Wrap(ErrValidationFailed, err.Error())
+	}
+
+	return cmd, nil
+}
@@ -0,0 +1,13 @@
+package putmilestone
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidUUID         = errors.New("invalid uuid")
+	ErrInvalidTargetDate   = errors.New("invalid target date")
+	ErrValidationFailed    = errors.New("validation failed")
+	ErrMilestoneNotFound   = errors.New("milestone not found")
+	ErrPutMilestoneUnknown = errors.New("unknown error while putting milestone")
+)
@@ -0,0 +1,47 @@
+package putmilestone
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetMilestoneByID(ctx context.Context, milestoneID uuid.UUID) (*domain.Milestone, error)
+	UpdateMilestone(ctx context.Context, milestone *domain.Milestone) error
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+func (uc *UC) Handle(ctx context.Context, cmd Command) (*domain.Milestone, error) {
+	milestone, err := uc.repo.GetMilestoneByID(ctx, cmd.ID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrMilestoneNotFound
+		}
+		return nil, errors.Wrap(ErrPutMilestoneUnknown, err.Error())
+	}
+
+	err = milestone.Update(cmd.Title, cmd.Description, cmd.TargetDate, cmd.State)
+	if err != nil {
+		return nil, errors.Wrap(ErrValidationFailed, err.Error())
+	}
+
+	err = uc.repo.UpdateMilestone(ctx, milestone)
+	if err != nil {
+		return nil, errors.Wrap(ErrPutMilestoneUnknown, err.Error())
+	}
+
+	return milestone, nil
+}
@@ -0,0 +1,14 @@
+package putsprint
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidUUID      = errors.New("invalid uuid")
+	ErrInvalidDate      = errors.New("invalid date")
+	ErrValidationFailed = errors.New("validation failed")
+	ErrSprintNotFound   = errors.New("sprint not found")
+	ErrSprintClosed     = errors.New("sprint is closed")
+	ErrPutSprintUnknown = errors.New("unknown error while putting sprint")
+)
@@ -0,0 +1,50 @@
+package putsprint
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetSprintByID(ctx context.Context, sprintID uuid.UUID) (*domain.Sprint, error)
+	UpdateSprint(ctx context.Context, sprint *domain.Sprint) error
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+func (uc *UC) Handle(ctx context.Context, cmd Command) (*domain.Sprint, error) {
+	sprint, err := uc.repo.GetSprintByID(ctx, cmd.SprintID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrSprintNotFound
+		}
+		return nil, errors.Wrap(ErrPutSprintUnknown, err.Error())
+	}
+
+	err = sprint.Update(cmd.Name, cmd.Goal, cmd.StartDate, cmd.EndDate)
+	if err != nil {
+		if errors.Is(err, domain.ErrSprintClosed) {
+			return nil, ErrSprintClosed
+		}
+		return nil, errors.Wrap(ErrValidationFailed, err.Error())
+	}
+
+	err = uc.repo.UpdateSprint(ctx, sprint)
+	if err != nil {
+		return nil, errors.Wrap(ErrPutSprintUnknown, err.Error())
+	}
+
+	return sprint, nil
+}
@@ -12,16 +12,24 @@ type Command struct {
 	BoardID     uuid.UUID `validate:"required,uuid"`
 	ColumnID    uuid.UUID `validate:"required,uuid"`
 	Title       string    `validate:"required,min=1,max=255"`
+	// Number == 0 - номер не передан. Номер задачи не меняется, см. UC.Handle
 	Number      int64     
-	Description *string
+	Description *string `validate:"omitempty,max=20000"`
 	Tags        []string
 	Checklists  []domain.Checklist
+	// SyncChecklists - заменить чеклист Description списком задач "- [ ]" из описания
+	SyncChecklists bool
+	Assignee    *string `validate:"omitempty,max=100"`
+	Priority    *domain.Priority
+	Lane        *string `validate:"omitempty,max=100"`
 }
 
 func NewCommand(
 	taskID, boardID, columnID, title string,
 	number int64, description *string,
 	tags []string, checklists []domain.Checklist,
+	assignee, priority, lane *string,
+	syncChecklists bool,
 ) (Command, error) {
 	validate := validator.New()
 
@@ -40,6 +48,15 @@ func NewCommand(
 		return Command{}, errors.Wrap(ErrValidationFailed, err.Error())
 	}
 
+	var prt *domain.Priority
+	if priority != nil {
+		p, err := domain.ParsePriority(*priority)
+		if err != nil {
+			return Command{}, errors.Wrap(ErrValidationFailed, err.Error())
+		}
+		prt = &p
+	}
+
 	cmd := Command{
 		TaskID:      tID,
 		BoardID:     bID,
@@ -49,6 +66,10 @@ func NewCommand(
 		Description: description,
 		Tags:        tags,
 		Checklists:  checklists,
+		SyncChecklists: syncChecklists,
+		Assignee:    assignee,
+		Priority:    prt,
+		Lane:        lane,
 	}
 
 	err = validate.Struct(cmd)
@@ -7,4 +7,6 @@ var (
 	ErrTaskNotFound = errors.New("task not found")
 	ErrPutTaskUnknown = errors.New("unknown error while putting task")
 	ErrColumnNotFound = errors.New("column not found")
+	ErrBoardChangeNotAllowed = errors.New("task board can't be changed here, use POST /v1/tasks/{task_id}/move-to-board")
+	ErrNumberChangeNotAllowed = errors.New("task number can't be changed")
 )
@@ -22,6 +22,7 @@ type Repo interface {
 	CheckColumnInBoard(ctx context.Context, boardID uuid.UUID, columnID uuid.UUID) (bool, error)
 	GetTaskByID(ctx context.Context, taskID uuid.UUID) (*domain.Task, error)
 	UpdateTask(ctx context.Context, task *domain.Task) error
+	ResolveTaskRefs(ctx context.Context, refs []domain.TaskRef) ([]domain.TaskRef, error)
 }
 
 func (uc *UC) Handle(ctx context.Context, cmd Command) (task *domain.Task, err error) {
@@ -29,6 +30,14 @@ func (uc *UC) Handle(ctx context.Context, cmd Command) (task *domain.Task, err e
 	if err != nil {
 		return nil, ErrTaskNotFound
 	}
+	// Перенос в другую доску требует нового номера и редиректа - это movetasktoboard
+	if foundDmn.BoardID != cmd.BoardID {
+		return nil, ErrBoardChangeNotAllowed
+	}
+	// Номер - часть ключа задачи, на него ссылаются описания и редиректы
+	if cmd.Number != 0 && cmd.Number != foundDmn.Number {
+		return nil, ErrNumberChangeNotAllowed
+	}
 
 	ex, err := uc.repo.CheckColumnInBoard(ctx, cmd.BoardID, cmd.ColumnID) 
 	if err != nil {
@@ -38,20 +47,35 @@ func (uc *UC) Handle(ctx context.Context, cmd Command) (task *domain.Task, err e
 		return nil, ErrColumnNotFound
 	}
 
+	checklists := cmd.Checklists
+	if cmd.SyncChecklists {
+		checklists = domain.SyncTaskListChecklist(cmd.Description, checklists)
+	}
+
 	foundDmn.Update(
 		cmd.ColumnID,
 		cmd.BoardID,
-		cmd.Number,
+		foundDmn.Number,
 		cmd.Title,
 		cmd.Description,
 		cmd.Tags,
-		cmd.Checklists,
+		checklists,
 	)
 
+	err = foundDmn.SetLaneFields(cmd.Assignee, cmd.Priority, cmd.Lane)
+	if err != nil {
+		return nil, errors.Wrap(ErrValidationFailed, err.Error())
+	}
+
 	err = uc.repo.UpdateTask(ctx, foundDmn)
 	if err != nil {
 		return nil, errors.Wrap(ErrPutTaskUnknown, err.Error())
 	}
 
+	foundDmn.References, err = uc.repo.ResolveTaskRefs(ctx, foundDmn.TaskRefs())
+	if err != nil {
+		return nil, errors.Wrap(ErrPutTaskUnknown, err.Error())
+	}
+
 	return foundDmn, nil
 }
\ No newline at end of file
@@ -0,0 +1,114 @@
+package puttask
+
+import (
+	"context"
+	"testing"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/puttask/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandle(t *testing.T) {
+	ctx := context.Background()
+	taskID := uuid.New()
+	boardID := uuid.New()
+	columnID := uuid.New()
+
+	taskList := "- [ ] write\n- [x] review"
+
+	testCases := []struct {
+		name        string
+		command     Command
+		setupMock   func(*mocks.Repo)
+		expectError error
+	}{
+		{
+			name:    "Success: task updated in the same board",
+			command: Command{TaskID: taskID, BoardID: boardID, ColumnID: columnID, Number: 3, Title: "new title"},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTaskByID", mock.Anything, taskID).Return(&domain.Task{ID: taskID, BoardID: boardID, Number: 3}, nil).Once()
+				repo.On("CheckColumnInBoard", mock.Anything, boardID, columnID).Return(true, nil).Once()
+				repo.On("UpdateTask", mock.Anything, mock.MatchedBy(func(task *domain.Task) bool {
+					return task.Title == "new title"
+				})).Return(nil).Once()
+				repo.On("ResolveTaskRefs", mock.Anything, []domain.TaskRef(nil)).Return([]domain.TaskRef{}, nil).Once()
+			},
+		},
+		{
+			name: "Success: task list in description syncs the checklist",
+			command: Command{TaskID: taskID, BoardID: boardID, ColumnID: columnID, Number: 3, Title: "new title",
+				Description: &taskList, SyncChecklists: true},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTaskByID", mock.Anything, taskID).Return(&domain.Task{ID: taskID, BoardID: boardID, Number: 3}, nil).Once()
+				repo.On("CheckColumnInBoard", mock.Anything, boardID, columnID).Return(true, nil).Once()
+				repo.On("UpdateTask", mock.Anything, mock.MatchedBy(func(task *domain.Task) bool {
+					return len(task.Checklists) == 1 && task.Checklists[0].Title == domain.TaskListChecklistTitle &&
+						len(task.Checklists[0].Items) == 2 && task.Checklists[0].Items[1].Completed
+				})).Return(nil).Once()
+				repo.On("ResolveTaskRefs", mock.Anything, []domain.TaskRef(nil)).Return([]domain.TaskRef{}, nil).Once()
+			},
+		},
+		{
+			name:    "Failure: board change goes through move-to-board",
+			command: Command{TaskID: taskID, BoardID: uuid.New(), ColumnID: columnID, Number: 3, Title: "new title"},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTaskByID", mock.Anything, taskID).Return(&domain.Task{ID: taskID, BoardID: boardID, Number: 3}, nil).Once()
+			},
+			expectError: ErrBoardChangeNotAllowed,
+		},
+		{
+			name:    "Failure: number can't be changed",
+			command: Command{TaskID: taskID, BoardID: boardID, ColumnID: columnID, Number: 4, Title: "new title"},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTaskByID", mock.Anything, taskID).Return(&domain.Task{ID: taskID, BoardID: boardID, Number: 3}, nil).Once()
+			},
+			expectError: ErrNumberChangeNotAllowed,
+		},
+		{
+			name:    "Success: omitted number keeps the current one",
+			command: Command{TaskID: taskID, BoardID: boardID, ColumnID: columnID, Title: "new title"},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTaskByID", mock.Anything, taskID).Return(&domain.Task{ID: taskID, BoardID: boardID, Number: 3}, nil).Once()
+				repo.On("CheckColumnInBoard", mock.Anything, boardID, columnID).Return(true, nil).Once()
+				repo.On("UpdateTask", mock.Anything, mock.MatchedBy(func(task *domain.Task) bool {
+					return task.Number == 3
+				})).Return(nil).Once()
+				repo.On("ResolveTaskRefs", mock.Anything, []domain.TaskRef(nil)).Return([]domain.TaskRef{}, nil).Once()
+			},
+		},
+		{
+			name:    "Failure: column not in board",
+			command: Command{TaskID: taskID, BoardID: boardID, ColumnID: columnID, Number: 3, Title: "new title"},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTaskByID", mock.Anything, taskID).Return(&domain.Task{ID: taskID, BoardID: boardID, Number: 3}, nil).Once()
+				repo.On("CheckColumnInBoard", mock.Anything, boardID, columnID).Return(false, nil).Once()
+			},
+			expectError: ErrColumnNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := mocks.NewRepo(t)
+			tc.setupMock(repo)
+
+			uc := NewUC(repo)
+			task, err := uc.Handle(ctx, tc.command)
+
+			if tc.expectError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tc.expectError)
+				assert.Nil(t, task)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, task)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
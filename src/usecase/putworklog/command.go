@@ -0,0 +1,48 @@
+package putworklog
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type Command struct {
+	TaskID    uuid.UUID
+	WorklogID uuid.UUID
+	// UserID - кто меняет запись, менять можно только свои
+	UserID  string
+	Minutes int
+	Date    time.Time
+	Note    *string
+}
+
+func NewCommand(taskID, worklogID, userID string, minutes int, date string, note *string) (Command, error) {
+	tID, err := uuid.Parse(taskID)
+	if err != nil {
+		return Command{}, ErrInvalidTaskID
+	}
+
+	wID, err := uuid.Parse(worklogID)
+	if err != nil {
+		return Command{}, ErrInvalidWorklogID
+	}
+
+	if userID == "" {
+		return Command{}, ErrInvalidUserID
+	}
+
+	d, err := time.Parse(time.DateOnly, date)
+	if err != nil {
+		return Command{}, errors.Wrap(ErrValidationFailed, err.Error())
+	}
+
+	return Command{
+		TaskID:    tID,
+		WorklogID: wID,
+		UserID:    userID,
+		Minutes:   minutes,
+		Date:      d,
+		Note:      note,
+	}, nil
+}
@@ -0,0 +1,15 @@
+package putworklog
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidTaskID     = errors.New("invalid task id")
+	ErrInvalidWorklogID  = errors.New("invalid worklog id")
+	ErrInvalidUserID     = errors.New("invalid user id")
+	ErrValidationFailed  = errors.New("validation failed")
+	ErrWorklogNotFound   = errors.New("worklog not found")
+	ErrNotWorklogAuthor  = errors.New("only the author can change a worklog")
+	ErrPutWorklogUnknown = errors.New("unknown error updating worklog")
+)
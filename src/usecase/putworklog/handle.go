@@ -0,0 +1,49 @@
+package putworklog
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetWorklog(ctx context.Context, taskID, worklogID uuid.UUID) (*domain.Worklog, error)
+	UpdateWorklog(ctx context.Context, worklog *domain.Worklog, prevMinutes int) error
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+func (uc *UC) Handle(ctx context.Context, cmd Command) (*domain.Worklog, error) {
+	worklog, err := uc.repo.GetWorklog(ctx, cmd.TaskID, cmd.WorklogID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrWorklogNotFound
+		}
+		return nil, errors.Wrap(ErrPutWorklogUnknown, err.Error())
+	}
+	if worklog.UserID != cmd.UserID {
+		return nil, ErrNotWorklogAuthor
+	}
+
+	prevMinutes := worklog.Minutes
+	if err := worklog.Update(cmd.Minutes, cmd.Date, cmd.Note); err != nil {
+		return nil, errors.Wrap(ErrValidationFailed, err.Error())
+	}
+
+	if err := uc.repo.UpdateWorklog(ctx, worklog, prevMinutes); err != nil {
+		return nil, errors.Wrap(ErrPutWorklogUnknown, err.Error())
+	}
+
+	return worklog, nil
+}
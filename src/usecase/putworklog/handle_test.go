@@ -0,0 +1,65 @@
+package putworklog
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/putworklog/mocks"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandle(t *testing.T) {
+	ctx := context.Background()
+	day := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	newWorklog := func(t *testing.T) *domain.Worklog {
+		w, err := domain.NewWorklog(uuid.New(), "alice", 90, day, nil)
+		require.NoError(t, err)
+		return w
+	}
+
+	t.Run("Success: previous minutes passed to repo", func(t *testing.T) {
+		w := newWorklog(t)
+		repo := mocks.NewRepo(t)
+		repo.On("GetWorklog", ctx, w.TaskID, w.ID).Return(w, nil)
+		repo.On("UpdateWorklog", ctx, mock.MatchedBy(func(u *domain.Worklog) bool {
+			return u.Minutes == 30
+		}), 90).Return(nil)
+
+		res, err := NewUC(repo).Handle(ctx, Command{TaskID: w.TaskID, WorklogID: w.ID, UserID: "alice", Minutes: 30, Date: day})
+		require.NoError(t, err)
+		assert.Equal(t, 30, res.Minutes)
+	})
+
+	t.Run("Error: not the author", func(t *testing.T) {
+		w := newWorklog(t)
+		repo := mocks.NewRepo(t)
+		repo.On("GetWorklog", ctx, w.TaskID, w.ID).Return(w, nil)
+
+		_, err := NewUC(repo).Handle(ctx, Command{TaskID: w.TaskID, WorklogID: w.ID, UserID: "bob", Minutes: 30, Date: day})
+		assert.ErrorIs(t, err, ErrNotWorklogAuthor)
+	})
+
+	t.Run("Error: invalid minutes", func(t *testing.T) {
+		w := newWorklog(t)
+		repo := mocks.NewRepo(t)
+		repo.On("GetWorklog", ctx, w.TaskID, w.ID).Return(w, nil)
+
+		_, err := NewUC(repo).Handle(ctx, Command{TaskID: w.TaskID, WorklogID: w.ID, UserID: "alice", Minutes: 0, Date: day})
+		assert.ErrorIs(t, err, ErrValidationFailed)
+	})
+
+	t.Run("Error: not found", func(t *testing.T) {
+		repo := mocks.NewRepo(t)
+		repo.On("GetWorklog", ctx, mock.Anything, mock.Anything).Return(nil, pgx.ErrNoRows)
+
+		_, err := NewUC(repo).Handle(ctx, Command{TaskID: uuid.New(), WorklogID: uuid.New(), UserID: "alice", Minutes: 30, Date: day})
+		assert.ErrorIs(t, err, ErrWorklogNotFound)
+	})
+}
@@ -0,0 +1,79 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/KungurtsevNII/team-board-back/src/domain"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// Repo is an autogenerated mock type for the Repo type
+type Repo struct {
+	mock.Mock
+}
+
+// GetWorklog provides a mock function with given fields: ctx, taskID, worklogID
+func (_m *Repo) GetWorklog(ctx context.Context, taskID uuid.UUID, worklogID uuid.UUID) (*domain.Worklog, error) {
+	ret := _m.Called(ctx, taskID, worklogID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorklog")
+	}
+
+	var r0 *domain.Worklog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*domain.Worklog, error)); ok {
+		return rf(ctx, taskID, worklogID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *domain.Worklog); ok {
+		r0 = rf(ctx, taskID, worklogID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Worklog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, taskID, worklogID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateWorklog provides a mock function with given fields: ctx, worklog, prevMinutes
+func (_m *Repo) UpdateWorklog(ctx context.Context, worklog *domain.Worklog, prevMinutes int) error {
+	ret := _m.Called(ctx, worklog, prevMinutes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWorklog")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Worklog, int) error); ok {
+		r0 = rf(ctx, worklog, prevMinutes)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewRepo creates a new instance of Repo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Repo {
+	mock := &Repo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,14 @@
+package readallnotifications
+
+const maxUserIDLen = 100
+
+type Command struct {
+	UserID string
+}
+
+func NewCommand(userID string) (Command, error) {
+	if userID == "" || len(userID) > maxUserIDLen {
+		return Command{}, ErrInvalidUserID
+	}
+	return Command{UserID: userID}, nil
+}
@@ -0,0 +1,10 @@
+package readallnotifications
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidUserID               = errors.New("invalid user id")
+	ErrReadAllNotificationsUnknown = errors.New("unknown error marking notifications read")
+)
@@ -0,0 +1,31 @@
+package readallnotifications
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	MarkAllNotificationsRead(ctx context.Context, userID string, now time.Time) (int64, error)
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+// Handle возвращает, сколько уведомлений было отмечено прочитанными.
+func (uc *UC) Handle(ctx context.Context, cmd Command) (int64, error) {
+	updated, err := uc.repo.MarkAllNotificationsRead(ctx, cmd.UserID, time.Now().UTC())
+	if err != nil {
+		return 0, errors.Wrap(ErrReadAllNotificationsUnknown, err.Error())
+	}
+	return updated, nil
+}
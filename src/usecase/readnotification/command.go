@@ -0,0 +1,26 @@
+package readnotification
+
+import (
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const maxUserIDLen = 100
+
+type Command struct {
+	UserID         string
+	NotificationID uuid.UUID
+}
+
+func NewCommand(userID, notificationID string) (Command, error) {
+	if userID == "" || len(userID) > maxUserIDLen {
+		return Command{}, ErrInvalidUserID
+	}
+
+	id, err := uuid.Parse(notificationID)
+	if err != nil {
+		return Command{}, errors.Wrap(ErrInvalidNotificationID, err.Error())
+	}
+
+	return Command{UserID: userID, NotificationID: id}, nil
+}
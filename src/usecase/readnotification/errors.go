@@ -0,0 +1,12 @@
+package readnotification
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidUserID           = errors.New("invalid user id")
+	ErrInvalidNotificationID   = errors.New("invalid notification id")
+	ErrNotificationNotFound    = errors.New("notification not found")
+	ErrReadNotificationUnknown = errors.New("unknown error marking notification read")
+)
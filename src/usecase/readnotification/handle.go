@@ -0,0 +1,37 @@
+package readnotification
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	MarkNotificationRead(ctx context.Context, userID string, notificationID uuid.UUID, now time.Time) error
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+// Handle отмечает уведомление прочитанным. Чужое уведомление не отличается
+// от несуществующего.
+func (uc *UC) Handle(ctx context.Context, cmd Command) error {
+	err := uc.repo.MarkNotificationRead(ctx, cmd.UserID, cmd.NotificationID, time.Now().UTC())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotificationNotFound
+		}
+		return errors.Wrap(ErrReadNotificationUnknown, err.Error())
+	}
+	return nil
+}
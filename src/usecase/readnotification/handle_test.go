@@ -0,0 +1,50 @@
+package readnotification
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/KungurtsevNII/team-board-back/src/usecase/readnotification/mocks"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandle(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+
+	testCases := []struct {
+		name        string
+		repoErr     error
+		expectError error
+	}{
+		{name: "Success"},
+		{name: "Failure: foreign or missing notification", repoErr: pgx.ErrNoRows, expectError: ErrNotificationNotFound},
+		{name: "Failure: repo error", repoErr: errors.New("db down"), expectError: ErrReadNotificationUnknown},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := mocks.NewRepo(t)
+			repo.On("MarkNotificationRead", mock.Anything, "alice", id, mock.Anything).Return(tc.repoErr).Once()
+
+			err := NewUC(repo).Handle(ctx, Command{UserID: "alice", NotificationID: id})
+			if tc.expectError != nil {
+				assert.ErrorIs(t, err, tc.expectError)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestNewCommand(t *testing.T) {
+	_, err := NewCommand("", uuid.NewString())
+	assert.ErrorIs(t, err, ErrInvalidUserID)
+
+	_, err = NewCommand("alice", "not-a-uuid")
+	assert.ErrorIs(t, err, ErrInvalidNotificationID)
+}
@@ -0,0 +1,50 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// Repo is an autogenerated mock type for the Repo type
+type Repo struct {
+	mock.Mock
+}
+
+// MarkNotificationRead provides a mock function with given fields: ctx, userID, notificationID, now
+func (_m *Repo) MarkNotificationRead(ctx context.Context, userID string, notificationID uuid.UUID, now time.Time) error {
+	ret := _m.Called(ctx, userID, notificationID, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkNotificationRead")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, time.Time) error); ok {
+		r0 = rf(ctx, userID, notificationID, now)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewRepo creates a new instance of Repo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Repo {
+	mock := &Repo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,23 @@
+package redeliverwebhook
+
+import (
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type Command struct {
+	WebhookID  uuid.UUID
+	DeliveryID uuid.UUID
+}
+
+func NewCommand(webhookID string, deliveryID string) (Command, error) {
+	wID, err := uuid.Parse(webhookID)
+	if err != nil {
+		return Command{}, errors.Wrap(ErrInvalidWebhookID, err.Error())
+	}
+	dID, err := uuid.Parse(deliveryID)
+	if err != nil {
+		return Command{}, errors.Wrap(ErrInvalidDeliveryID, err.Error())
+	}
+	return Command{WebhookID: wID, DeliveryID: dID}, nil
+}
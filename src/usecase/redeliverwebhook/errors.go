@@ -0,0 +1,13 @@
+package redeliverwebhook
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidWebhookID  = errors.New("invalid webhook id")
+	ErrInvalidDeliveryID = errors.New("invalid delivery id")
+	ErrDeliveryNotFound  = errors.New("webhook delivery not found")
+	ErrDeliveryPending   = errors.New("webhook delivery is already pending")
+	ErrRedeliverUnknown  = errors.New("unknown error redelivering webhook")
+)
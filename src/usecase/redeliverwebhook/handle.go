@@ -0,0 +1,52 @@
+package redeliverwebhook
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetWebhookDelivery(ctx context.Context, deliveryID uuid.UUID) (*domain.WebhookDelivery, error)
+	UpdateWebhookDelivery(ctx context.Context, delivery *domain.WebhookDelivery) error
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+// Handle ставит доставку (в том числе dead) в очередь заново с тем же телом события.
+func (uc *UC) Handle(ctx context.Context, cmd Command) (*domain.WebhookDelivery, error) {
+	delivery, err := uc.repo.GetWebhookDelivery(ctx, cmd.DeliveryID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrDeliveryNotFound
+		}
+		return nil, errors.Wrap(ErrRedeliverUnknown, err.Error())
+	}
+	if delivery.WebhookID != cmd.WebhookID {
+		return nil, ErrDeliveryNotFound
+	}
+
+	if err := delivery.Redeliver(); err != nil {
+		if errors.Is(err, domain.ErrWebhookDeliveryPending) {
+			return nil, ErrDeliveryPending
+		}
+		return nil, errors.Wrap(ErrRedeliverUnknown, err.Error())
+	}
+
+	if err := uc.repo.UpdateWebhookDelivery(ctx, delivery); err != nil {
+		return nil, errors.Wrap(ErrRedeliverUnknown, err.Error())
+	}
+
+	return delivery, nil
+}
@@ -0,0 +1,90 @@
+package redeliverwebhook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/redeliverwebhook/mocks"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandle(t *testing.T) {
+	ctx := context.Background()
+	webhookID := uuid.New()
+	deliveryID := uuid.New()
+	newDelivery := func(status domain.WebhookDeliveryStatus) *domain.WebhookDelivery {
+		return &domain.WebhookDelivery{ID: deliveryID, WebhookID: webhookID, Status: status, Attempts: 8}
+	}
+	command := Command{WebhookID: webhookID, DeliveryID: deliveryID}
+
+	testCases := []struct {
+		name        string
+		command     Command
+		setupMock   func(*mocks.Repo)
+		expectError error
+	}{
+		{
+			name:    "Success: dead delivery queued again",
+			command: command,
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetWebhookDelivery", mock.Anything, deliveryID).
+					Return(newDelivery(domain.WebhookDeliveryDead), nil).Once()
+				repo.On("UpdateWebhookDelivery", mock.Anything, mock.MatchedBy(func(d *domain.WebhookDelivery) bool {
+					return d.Status == domain.WebhookDeliveryPending && d.Attempts == 0 && d.NextAttemptAt != nil
+				})).Return(nil).Once()
+			},
+		},
+		{
+			name:    "Failure: delivery still pending",
+			command: command,
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetWebhookDelivery", mock.Anything, deliveryID).
+					Return(newDelivery(domain.WebhookDeliveryPending), nil).Once()
+			},
+			expectError: ErrDeliveryPending,
+		},
+		{
+			name:    "Failure: delivery of another webhook",
+			command: Command{WebhookID: uuid.New(), DeliveryID: deliveryID},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetWebhookDelivery", mock.Anything, deliveryID).
+					Return(newDelivery(domain.WebhookDeliveryDelivered), nil).Once()
+			},
+			expectError: ErrDeliveryNotFound,
+		},
+		{
+			name:    "Failure: delivery not found",
+			command: command,
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetWebhookDelivery", mock.Anything, deliveryID).Return(nil, pgx.ErrNoRows).Once()
+			},
+			expectError: ErrDeliveryNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := mocks.NewRepo(t)
+			tc.setupMock(repo)
+
+			uc := NewUC(repo)
+			delivery, err := uc.Handle(ctx, tc.command)
+
+			if tc.expectError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tc.expectError)
+				assert.Nil(t, delivery)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, domain.WebhookDeliveryPending, delivery.Status)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
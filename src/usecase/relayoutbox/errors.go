@@ -0,0 +1,10 @@
+package relayoutbox
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidBatchSize   = errors.New("batch size must be positive")
+	ErrRelayOutboxUnknown = errors.New("unknown error relaying outbox events")
+)
@@ -0,0 +1,119 @@
+package relayoutbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	ClaimOutboxEvents(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]domain.Event, error)
+	MarkOutboxEventsPublished(ctx context.Context, ids []uuid.UUID, now time.Time) error
+	MarkOutboxEventFailed(ctx context.Context, id uuid.UUID, reason string, now time.Time, policy domain.OutboxRetryPolicy) (bool, error)
+	ReleaseOutboxEvents(ctx context.Context, ids []uuid.UUID) error
+}
+
+// Sink получает опубликованные события. Доставка "хотя бы один раз":
+// после сбоя событие может прийти повторно, получатель должен это переживать.
+type Sink interface {
+	Name() string
+	Publish(ctx context.Context, event domain.Event) error
+}
+
+type UC struct {
+	repo  Repo
+	sinks []Sink
+	// lease - на сколько забранные события скрываются от других relay
+	lease  time.Duration
+	policy domain.OutboxRetryPolicy
+}
+
+func NewUC(repo Repo, sinks []Sink, lease time.Duration, policy domain.OutboxRetryPolicy) *UC {
+	return &UC{
+		repo:   repo,
+		sinks:  sinks,
+		lease:  lease,
+		policy: policy,
+	}
+}
+
+// Stats - итог пачки. Parked - сколько из упавших исчерпали попытки.
+type Stats struct {
+	Published int
+	Failed    int
+	Parked    int
+}
+
+// Handle публикует одну пачку событий в порядке возникновения. Упавшее событие
+// откладывается по политике повторов или паркуется, остальные публикуются дальше.
+// Следующие события того же агрегата в этой пачке не публикуются и возвращаются
+// в очередь, чтобы порядок внутри агрегата сохранился.
+func (uc *UC) Handle(ctx context.Context, cmd Command) (Stats, error) {
+	var stats Stats
+
+	events, err := uc.repo.ClaimOutboxEvents(ctx, time.Now().UTC(), uc.lease, cmd.BatchSize)
+	if err != nil {
+		return stats, errors.Wrap(ErrRelayOutboxUnknown, err.Error())
+	}
+
+	published := make([]uuid.UUID, 0, len(events))
+	var deferred []uuid.UUID
+	blocked := map[uuid.UUID]bool{}
+	// при ошибке базы уже опубликованное все равно помечается, остальное
+	// вернется в очередь по истечении lease
+	var markErr error
+	for _, event := range events {
+		if blocked[event.AggregateID] {
+			deferred = append(deferred, event.ID)
+			continue
+		}
+
+		publishErr := uc.publish(ctx, event)
+		if publishErr == nil {
+			published = append(published, event.ID)
+			continue
+		}
+
+		stats.Failed++
+		blocked[event.AggregateID] = true
+		parked, err := uc.repo.MarkOutboxEventFailed(ctx, event.ID, publishErr.Error(), time.Now().UTC(), uc.policy)
+		if err != nil {
+			markErr = err
+			break
+		}
+		if parked {
+			stats.Parked++
+		}
+	}
+
+	if err := uc.repo.MarkOutboxEventsPublished(ctx, published, time.Now().UTC()); err != nil {
+		return stats, errors.Wrap(ErrRelayOutboxUnknown, err.Error())
+	}
+	stats.Published = len(published)
+
+	if markErr != nil {
+		return stats, errors.Wrap(ErrRelayOutboxUnknown, markErr.Error())
+	}
+
+	if len(deferred) > 0 {
+		if err := uc.repo.ReleaseOutboxEvents(ctx, deferred); err != nil {
+			return stats, errors.Wrap(ErrRelayOutboxUnknown, err.Error())
+		}
+	}
+
+	return stats, nil
+}
+
+// publish отдает событие всем получателям. Если один из них упал, событие
+// уйдет повторно и тем, кто его уже получил.
+func (uc *UC) publish(ctx context.Context, event domain.Event) error {
+	for _, sink := range uc.sinks {
+		if err := sink.Publish(ctx, event); err != nil {
+			return errors.Wrapf(err, "sink %s", sink.Name())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package relayoutbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/relayoutbox/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSink struct {
+	received []uuid.UUID
+	failOn   map[uuid.UUID]error
+}
+
+func (s *fakeSink) Name() string { return "fake" }
+
+func (s *fakeSink) Publish(_ context.Context, event domain.Event) error {
+	if err, ok := s.failOn[event.ID]; ok {
+		return err
+	}
+	s.received = append(s.received, event.ID)
+	return nil
+}
+
+func TestHandle(t *testing.T) {
+	ctx := context.Background()
+	policy := domain.OutboxRetryPolicy{MaxAttempts: 3, BaseDelay: time.Second, MaxDelay: time.Minute}
+
+	newEvents := func(n int) []domain.Event {
+		task, err := domain.NewTask(uuid.New(), uuid.New(), 1, "Task", nil, nil, nil)
+		require.NoError(t, err)
+		for len(task.Events()) < n {
+			task.Update(uuid.New(), task.BoardID, 1, "Task", nil, nil, nil)
+		}
+		return task.Events()[:n]
+	}
+
+	t.Run("Success: events published in order to every sink", func(t *testing.T) {
+		events := newEvents(3)
+		first, second := &fakeSink{}, &fakeSink{}
+
+		repo := mocks.NewRepo(t)
+		repo.On("ClaimOutboxEvents", mock.Anything, mock.Anything, time.Minute, 10).Return(events, nil).Once()
+		repo.On("MarkOutboxEventsPublished", mock.Anything,
+			[]uuid.UUID{events[0].ID, events[1].ID, events[2].ID}, mock.Anything).Return(nil).Once()
+
+		uc := NewUC(repo, []Sink{first, second}, time.Minute, policy)
+		stats, err := uc.Handle(ctx, Command{BatchSize: 10})
+		require.NoError(t, err)
+		assert.Equal(t, Stats{Published: 3}, stats)
+		assert.Equal(t, []uuid.UUID{events[0].ID, events[1].ID, events[2].ID}, first.received)
+		assert.Equal(t, first.received, second.received)
+	})
+
+	t.Run("Success: failure defers only the same aggregate, the rest is published", func(t *testing.T) {
+		taskEvents := newEvents(2)
+		other := newEvents(1)[0]
+		events := []domain.Event{taskEvents[0], other, taskEvents[1]}
+		sink := &fakeSink{failOn: map[uuid.UUID]error{taskEvents[0].ID: errors.New("broker down")}}
+
+		repo := mocks.NewRepo(t)
+		repo.On("ClaimOutboxEvents", mock.Anything, mock.Anything, time.Minute, 10).Return(events, nil).Once()
+		repo.On("MarkOutboxEventFailed", mock.Anything, taskEvents[0].ID, mock.MatchedBy(func(reason string) bool {
+			return assert.Contains(t, reason, "broker down") && assert.Contains(t, reason, "sink fake")
+		}), mock.Anything, policy).Return(false, nil).Once()
+		repo.On("MarkOutboxEventsPublished", mock.Anything, []uuid.UUID{other.ID}, mock.Anything).
+			Return(nil).Once()
+		repo.On("ReleaseOutboxEvents", mock.Anything, []uuid.UUID{taskEvents[1].ID}).Return(nil).Once()
+
+		uc := NewUC(repo, []Sink{sink}, time.Minute, policy)
+		stats, err := uc.Handle(ctx, Command{BatchSize: 10})
+		require.NoError(t, err)
+		assert.Equal(t, Stats{Published: 1, Failed: 1}, stats)
+		assert.Equal(t, []uuid.UUID{other.ID}, sink.received)
+	})
+
+	t.Run("Success: poison event is parked and does not block the batch", func(t *testing.T) {
+		poison := newEvents(1)[0]
+		next := newEvents(1)[0]
+		sink := &fakeSink{failOn: map[uuid.UUID]error{poison.ID: errors.New("bad payload")}}
+
+		repo := mocks.NewRepo(t)
+		repo.On("ClaimOutboxEvents", mock.Anything, mock.Anything, time.Minute, 10).
+			Return([]domain.Event{poison, next}, nil).Once()
+		repo.On("MarkOutboxEventFailed", mock.Anything, poison.ID, mock.Anything, mock.Anything, policy).
+			Return(true, nil).Once()
+		repo.On("MarkOutboxEventsPublished", mock.Anything, []uuid.UUID{next.ID}, mock.Anything).
+			Return(nil).Once()
+
+		uc := NewUC(repo, []Sink{sink}, time.Minute, policy)
+		stats, err := uc.Handle(ctx, Command{BatchSize: 10})
+		require.NoError(t, err)
+		assert.Equal(t, Stats{Published: 1, Failed: 1, Parked: 1}, stats)
+	})
+
+	t.Run("Failure: mark failed error still marks published events", func(t *testing.T) {
+		first, failing := newEvents(1)[0], newEvents(1)[0]
+		sink := &fakeSink{failOn: map[uuid.UUID]error{failing.ID: errors.New("broker down")}}
+
+		repo := mocks.NewRepo(t)
+		repo.On("ClaimOutboxEvents", mock.Anything, mock.Anything, time.Minute, 10).
+			Return([]domain.Event{first, failing}, nil).Once()
+		repo.On("MarkOutboxEventFailed", mock.Anything, failing.ID, mock.Anything, mock.Anything, policy).
+			Return(false, errors.New("db down")).Once()
+		repo.On("MarkOutboxEventsPublished", mock.Anything, []uuid.UUID{first.ID}, mock.Anything).
+			Return(nil).Once()
+
+		uc := NewUC(repo, []Sink{sink}, time.Minute, policy)
+		stats, err := uc.Handle(ctx, Command{BatchSize: 10})
+		assert.ErrorIs(t, err, ErrRelayOutboxUnknown)
+		assert.Equal(t, 1, stats.Published)
+	})
+
+	t.Run("Failure: claim error", func(t *testing.T) {
+		repo := mocks.NewRepo(t)
+		repo.On("ClaimOutboxEvents", mock.Anything, mock.Anything, time.Minute, 10).
+			Return(nil, errors.New("db down")).Once()
+
+		uc := NewUC(repo, nil, time.Minute, policy)
+		_, err := uc.Handle(ctx, Command{BatchSize: 10})
+		assert.ErrorIs(t, err, ErrRelayOutboxUnknown)
+	})
+}
@@ -0,0 +1,127 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/KungurtsevNII/team-board-back/src/domain"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// Repo is an autogenerated mock type for the Repo type
+type Repo struct {
+	mock.Mock
+}
+
+// ClaimOutboxEvents provides a mock function with given fields: ctx, now, lease, limit
+func (_m *Repo) ClaimOutboxEvents(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]domain.Event, error) {
+	ret := _m.Called(ctx, now, lease, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimOutboxEvents")
+	}
+
+	var r0 []domain.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Duration, int) ([]domain.Event, error)); ok {
+		return rf(ctx, now, lease, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Duration, int) []domain.Event); ok {
+		r0 = rf(ctx, now, lease, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Duration, int) error); ok {
+		r1 = rf(ctx, now, lease, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkOutboxEventFailed provides a mock function with given fields: ctx, id, reason, now, policy
+func (_m *Repo) MarkOutboxEventFailed(ctx context.Context, id uuid.UUID, reason string, now time.Time, policy domain.OutboxRetryPolicy) (bool, error) {
+	ret := _m.Called(ctx, id, reason, now, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkOutboxEventFailed")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, time.Time, domain.OutboxRetryPolicy) (bool, error)); ok {
+		return rf(ctx, id, reason, now, policy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, time.Time, domain.OutboxRetryPolicy) bool); ok {
+		r0 = rf(ctx, id, reason, now, policy)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, time.Time, domain.OutboxRetryPolicy) error); ok {
+		r1 = rf(ctx, id, reason, now, policy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkOutboxEventsPublished provides a mock function with given fields: ctx, ids, now
+func (_m *Repo) MarkOutboxEventsPublished(ctx context.Context, ids []uuid.UUID, now time.Time) error {
+	ret := _m.Called(ctx, ids, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkOutboxEventsPublished")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID, time.Time) error); ok {
+		r0 = rf(ctx, ids, now)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReleaseOutboxEvents provides a mock function with given fields: ctx, ids
+func (_m *Repo) ReleaseOutboxEvents(ctx context.Context, ids []uuid.UUID) error {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseOutboxEvents")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) error); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewRepo creates a new instance of Repo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Repo {
+	mock := &Repo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
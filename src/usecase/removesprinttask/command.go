@@ -0,0 +1,28 @@
+package removesprinttask
+
+import (
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type Command struct {
+	SprintID uuid.UUID
+	TaskID   uuid.UUID
+}
+
+func NewCommand(sprintID, taskID string) (Command, error) {
+	sID, err := uuid.Parse(sprintID)
+	if err != nil {
+		return Command{}, errors.Wrap(ErrInvalidUUID, err.Error())
+	}
+
+	tID, err := uuid.Parse(taskID)
+	if err != nil {
+		return Command{}, errors.Wrap(ErrInvalidUUID, err.Error())
+	}
+
+	return Command{
+		SprintID: sID,
+		TaskID:   tID,
+	}, nil
+}
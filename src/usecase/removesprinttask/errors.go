@@ -0,0 +1,12 @@
+package removesprinttask
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidUUID             = errors.New("invalid uuid")
+	ErrTaskNotFound            = errors.New("task not found")
+	ErrTaskNotInSprint         = errors.New("task is not in the sprint")
+	ErrRemoveSprintTaskUnknown = errors.New("unknown error removing task from sprint")
+)
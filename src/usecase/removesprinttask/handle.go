@@ -0,0 +1,47 @@
+package removesprinttask
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetTaskByID(ctx context.Context, taskID uuid.UUID) (*domain.Task, error)
+	UpdateTask(ctx context.Context, task *domain.Task) error
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+func (uc *UC) Handle(ctx context.Context, cmd Command) error {
+	task, err := uc.repo.GetTaskByID(ctx, cmd.TaskID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrTaskNotFound
+		}
+		return errors.Wrap(ErrRemoveSprintTaskUnknown, err.Error())
+	}
+
+	err = task.RemoveFromSprint(cmd.SprintID)
+	if err != nil {
+		return ErrTaskNotInSprint
+	}
+
+	err = uc.repo.UpdateTask(ctx, task)
+	if err != nil {
+		return errors.Wrap(ErrRemoveSprintTaskUnknown, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,11 @@
+package resolvetaskkey
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidTaskKey        = errors.New("invalid task key")
+	ErrTaskNotFound          = errors.New("task not found")
+	ErrResolveTaskKeyUnknown = errors.New("unknown error resolving task key")
+)
@@ -0,0 +1,81 @@
+package resolvetaskkey
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	ResolveTaskKey(ctx context.Context, shortName string, number int64) (uuid.UUID, bool, error)
+	GetTaskByID(ctx context.Context, taskID uuid.UUID) (*domain.Task, error)
+	GetBoardIncludingDeleted(ctx context.Context, boardID uuid.UUID) (*domain.Board, error)
+	ResolveTaskRefs(ctx context.Context, refs []domain.TaskRef) ([]domain.TaskRef, error)
+}
+
+// Renderer отрисовывает Markdown-описание в безопасный HTML и превью.
+type Renderer interface {
+	Render(source string) domain.RenderedText
+}
+
+type UC struct {
+	repo     Repo
+	renderer Renderer
+}
+
+func NewUC(repo Repo, renderer Renderer) *UC {
+	return &UC{
+		repo:     repo,
+		renderer: renderer,
+	}
+}
+
+// Result - найденная задача; Redirected, если ключ принадлежал задаче до переноса в другую доску.
+type Result struct {
+	Task       *domain.Task
+	Redirected bool
+}
+
+func (uc *UC) Handle(ctx context.Context, q Query) (*Result, error) {
+	taskID, redirected, err := uc.repo.ResolveTaskKey(ctx, q.ShortName, q.Number)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrTaskNotFound
+		}
+		return nil, errors.Wrap(ErrResolveTaskKeyUnknown, err.Error())
+	}
+
+	task, err := uc.repo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrTaskNotFound
+		}
+		return nil, errors.Wrap(ErrResolveTaskKeyUnknown, err.Error())
+	}
+
+	// Для актуального ключа нужна доска, в которой задача сейчас
+	board, err := uc.repo.GetBoardIncludingDeleted(ctx, task.BoardID)
+	if err != nil {
+		return nil, errors.Wrap(ErrResolveTaskKeyUnknown, err.Error())
+	}
+	task.BoardName = &board.Name
+	task.BoardShortName = &board.ShortName
+
+	task.References, err = uc.repo.ResolveTaskRefs(ctx, task.TaskRefs())
+	if err != nil {
+		return nil, errors.Wrap(ErrResolveTaskKeyUnknown, err.Error())
+	}
+
+	if task.Description != nil {
+		rendered := uc.renderer.Render(*task.Description)
+		task.DescriptionRendered = &rendered
+	}
+
+	return &Result{
+		Task:       task,
+		Redirected: redirected,
+	}, nil
+}
@@ -0,0 +1,41 @@
+package resolvetaskkey
+
+import (
+	"context"
+	"testing"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/resolvetaskkey/mocks"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandle(t *testing.T) {
+	ctx := context.Background()
+	taskID := uuid.New()
+	boardID := uuid.New()
+
+	t.Run("Success: old key redirects to moved task", func(t *testing.T) {
+		repo := mocks.NewRepo(t)
+		repo.On("ResolveTaskKey", mock.Anything, "OPS", int64(7)).Return(taskID, true, nil).Once()
+		repo.On("GetTaskByID", mock.Anything, taskID).Return(&domain.Task{ID: taskID, BoardID: boardID, Number: 42}, nil).Once()
+		repo.On("GetBoardIncludingDeleted", mock.Anything, boardID).Return(&domain.Board{ID: boardID, ShortName: "TEAM"}, nil).Once()
+		repo.On("ResolveTaskRefs", mock.Anything, []domain.TaskRef(nil)).Return([]domain.TaskRef{}, nil).Once()
+
+		res, err := NewUC(repo, mocks.NewRenderer(t)).Handle(ctx, Query{ShortName: "OPS", Number: 7})
+		require.NoError(t, err)
+		assert.True(t, res.Redirected)
+		assert.Equal(t, "TEAM-42", res.Task.Key())
+	})
+
+	t.Run("Failure: unknown key", func(t *testing.T) {
+		repo := mocks.NewRepo(t)
+		repo.On("ResolveTaskKey", mock.Anything, "OPS", int64(7)).Return(uuid.Nil, false, pgx.ErrNoRows).Once()
+
+		_, err := NewUC(repo, mocks.NewRenderer(t)).Handle(ctx, Query{ShortName: "OPS", Number: 7})
+		assert.ErrorIs(t, err, ErrTaskNotFound)
+	})
+}
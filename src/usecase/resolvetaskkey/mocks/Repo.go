@@ -0,0 +1,158 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/KungurtsevNII/team-board-back/src/domain"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// Repo is an autogenerated mock type for the Repo type
+type Repo struct {
+	mock.Mock
+}
+
+// GetBoardIncludingDeleted provides a mock function with given fields: ctx, boardID
+func (_m *Repo) GetBoardIncludingDeleted(ctx context.Context, boardID uuid.UUID) (*domain.Board, error) {
+	ret := _m.Called(ctx, boardID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBoardIncludingDeleted")
+	}
+
+	var r0 *domain.Board
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.Board, error)); ok {
+		return rf(ctx, boardID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.Board); ok {
+		r0 = rf(ctx, boardID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Board)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, boardID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTaskByID provides a mock function with given fields: ctx, taskID
+func (_m *Repo) GetTaskByID(ctx context.Context, taskID uuid.UUID) (*domain.Task, error) {
+	ret := _m.Called(ctx, taskID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTaskByID")
+	}
+
+	var r0 *domain.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.Task, error)); ok {
+		return rf(ctx, taskID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.Task); ok {
+		r0 = rf(ctx, taskID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, taskID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResolveTaskKey provides a mock function with given fields: ctx, shortName, number
+func (_m *Repo) ResolveTaskKey(ctx context.Context, shortName string, number int64) (uuid.UUID, bool, error) {
+	ret := _m.Called(ctx, shortName, number)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveTaskKey")
+	}
+
+	var r0 uuid.UUID
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) (uuid.UUID, bool, error)); ok {
+		return rf(ctx, shortName, number)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) uuid.UUID); ok {
+		r0 = rf(ctx, shortName, number)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) bool); ok {
+		r1 = rf(ctx, shortName, number)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, int64) error); ok {
+		r2 = rf(ctx, shortName, number)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ResolveTaskRefs provides a mock function with given fields: ctx, refs
+func (_m *Repo) ResolveTaskRefs(ctx context.Context, refs []domain.TaskRef) ([]domain.TaskRef, error) {
+	ret := _m.Called(ctx, refs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveTaskRefs")
+	}
+
+	var r0 []domain.TaskRef
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.TaskRef) ([]domain.TaskRef, error)); ok {
+		return rf(ctx, refs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.TaskRef) []domain.TaskRef); ok {
+		r0 = rf(ctx, refs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.TaskRef)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []domain.TaskRef) error); ok {
+		r1 = rf(ctx, refs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewRepo creates a new instance of Repo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Repo {
+	mock := &Repo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
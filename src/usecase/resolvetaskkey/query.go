@@ -0,0 +1,23 @@
+package resolvetaskkey
+
+import (
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/pkg/errors"
+)
+
+type Query struct {
+	ShortName string
+	Number    int64
+}
+
+func NewQuery(key string) (Query, error) {
+	shortName, number, err := domain.ParseTaskKey(key)
+	if err != nil {
+		return Query{}, errors.Wrap(ErrInvalidTaskKey, err.Error())
+	}
+
+	return Query{
+		ShortName: shortName,
+		Number:    number,
+	}, nil
+}
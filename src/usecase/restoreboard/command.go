@@ -0,0 +1,21 @@
+package restoreboard
+
+import (
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type Command struct {
+	BoardID uuid.UUID
+}
+
+func NewCommand(boardID string) (Command, error) {
+	uid, err := uuid.Parse(boardID)
+	if err != nil {
+		return Command{}, errors.Wrap(ErrInvalidBoardID, err.Error())
+	}
+
+	return Command{
+		BoardID: uid,
+	}, nil
+}
@@ -0,0 +1,13 @@
+package restoreboard
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidBoardID      = errors.New("invalid board id")
+	ErrBoardNotFound       = errors.New("board not found")
+	ErrBoardNotDeleted     = errors.New("board is not deleted")
+	ErrBoardIsExists       = errors.New("board with this shortname already exists")
+	ErrRestoreBoardUnknown = errors.New("unknown error restoring board")
+)
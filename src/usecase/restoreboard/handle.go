@@ -0,0 +1,62 @@
+package restoreboard
+
+import (
+	"context"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetBoardIncludingDeleted(ctx context.Context, boardID uuid.UUID) (*domain.Board, error)
+	CheckBoardShortName(ctx context.Context, shortName string) (bool, error)
+	RestoreBoard(ctx context.Context, board *domain.Board, deletedAt time.Time) error
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+// Handle восстанавливает доску вместе с колонками и задачами, удаленными вместе с ней.
+// Пока доска лежала в корзине, ее короткое имя могла занять другая доска.
+func (uc *UC) Handle(ctx context.Context, cmd Command) (*domain.Board, error) {
+	board, err := uc.repo.GetBoardIncludingDeleted(ctx, cmd.BoardID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrBoardNotFound
+		}
+		return nil, errors.Wrap(ErrRestoreBoardUnknown, err.Error())
+	}
+	if board.DeletedAt == nil {
+		return nil, ErrBoardNotDeleted
+	}
+	deletedAt := *board.DeletedAt
+
+	exists, err := uc.repo.CheckBoardShortName(ctx, board.ShortName)
+	if err != nil {
+		return nil, errors.Wrap(ErrRestoreBoardUnknown, err.Error())
+	}
+	if exists {
+		return nil, ErrBoardIsExists
+	}
+
+	if err := board.Restore(); err != nil {
+		return nil, errors.Wrap(ErrBoardNotDeleted, err.Error())
+	}
+
+	err = uc.repo.RestoreBoard(ctx, board, deletedAt)
+	if err != nil {
+		return nil, errors.Wrap(ErrRestoreBoardUnknown, err.Error())
+	}
+
+	return board, nil
+}
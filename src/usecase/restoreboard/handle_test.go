@@ -0,0 +1,96 @@
+package restoreboard
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/restoreboard/mocks"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandle(t *testing.T) {
+	ctx := context.Background()
+	boardID := uuid.New()
+	deletedAt := time.Now().UTC().Add(-time.Hour)
+
+	deletedBoard := func() *domain.Board {
+		return &domain.Board{ID: boardID, ShortName: "TEAM", DeletedAt: &deletedAt}
+	}
+
+	testCases := []struct {
+		name        string
+		setupMock   func(*mocks.Repo)
+		expectError error
+	}{
+		{
+			name: "Success: board restored with its cascade",
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetBoardIncludingDeleted", mock.Anything, boardID).Return(deletedBoard(), nil).Once()
+				repo.On("CheckBoardShortName", mock.Anything, "TEAM").Return(false, nil).Once()
+				repo.On("RestoreBoard", mock.Anything, mock.MatchedBy(func(b *domain.Board) bool {
+					return b.DeletedAt == nil
+				}), deletedAt).Return(nil).Once()
+			},
+		},
+		{
+			name: "Failure: board not found",
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetBoardIncludingDeleted", mock.Anything, boardID).Return(nil, pgx.ErrNoRows).Once()
+			},
+			expectError: ErrBoardNotFound,
+		},
+		{
+			name: "Failure: board is not deleted",
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetBoardIncludingDeleted", mock.Anything, boardID).Return(&domain.Board{ID: boardID}, nil).Once()
+			},
+			expectError: ErrBoardNotDeleted,
+		},
+		{
+			name: "Failure: short name taken while in trash",
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetBoardIncludingDeleted", mock.Anything, boardID).Return(deletedBoard(), nil).Once()
+				repo.On("CheckBoardShortName", mock.Anything, "TEAM").Return(true, nil).Once()
+			},
+			expectError: ErrBoardIsExists,
+		},
+		{
+			name: "Failure: restore error",
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetBoardIncludingDeleted", mock.Anything, boardID).Return(deletedBoard(), nil).Once()
+				repo.On("CheckBoardShortName", mock.Anything, "TEAM").Return(false, nil).Once()
+				repo.On("RestoreBoard", mock.Anything, mock.AnythingOfType("*domain.Board"), deletedAt).Return(errors.New("db error")).Once()
+			},
+			expectError: ErrRestoreBoardUnknown,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := mocks.NewRepo(t)
+			tc.setupMock(repo)
+
+			uc := NewUC(repo)
+			board, err := uc.Handle(ctx, Command{BoardID: boardID})
+
+			if tc.expectError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tc.expectError)
+				assert.Nil(t, board)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, board)
+				assert.Nil(t, board.DeletedAt)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package restorecolumn
+
+import (
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type Command struct {
+	ColumnID uuid.UUID
+}
+
+func NewCommand(columnID string) (Command, error) {
+	uid, err := uuid.Parse(columnID)
+	if err != nil {
+		return Command{}, errors.Wrap(ErrInvalidColumnID, err.Error())
+	}
+
+	return Command{
+		ColumnID: uid,
+	}, nil
+}
@@ -0,0 +1,13 @@
+package restorecolumn
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidColumnID      = errors.New("invalid column id")
+	ErrColumnNotFound       = errors.New("column not found")
+	ErrColumnNotDeleted     = errors.New("column is not deleted")
+	ErrBoardDeleted         = errors.New("board is deleted, restore the board first")
+	ErrRestoreColumnUnknown = errors.New("unknown error restoring column")
+)
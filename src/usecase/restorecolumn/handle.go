@@ -0,0 +1,58 @@
+package restorecolumn
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetColumnIncludingDeleted(ctx context.Context, columnID uuid.UUID) (*domain.Column, error)
+	GetBoardIncludingDeleted(ctx context.Context, boardID uuid.UUID) (*domain.Board, error)
+	RestoreColumn(ctx context.Context, column *domain.Column) error
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+func (uc *UC) Handle(ctx context.Context, cmd Command) (*domain.Column, error) {
+	column, err := uc.repo.GetColumnIncludingDeleted(ctx, cmd.ColumnID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrColumnNotFound
+		}
+		return nil, errors.Wrap(ErrRestoreColumnUnknown, err.Error())
+	}
+
+	board, err := uc.repo.GetBoardIncludingDeleted(ctx, column.BoardID)
+	if err != nil {
+		return nil, errors.Wrap(ErrRestoreColumnUnknown, err.Error())
+	}
+	if board.DeletedAt != nil {
+		return nil, ErrBoardDeleted
+	}
+
+	if err := column.Restore(); err != nil {
+		return nil, errors.Wrap(ErrColumnNotDeleted, err.Error())
+	}
+
+	err = uc.repo.RestoreColumn(ctx, column)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrColumnNotFound
+		}
+		return nil, errors.Wrap(ErrRestoreColumnUnknown, err.Error())
+	}
+
+	return column, nil
+}
@@ -0,0 +1,21 @@
+package restoretask
+
+import (
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type Command struct {
+	TaskID uuid.UUID
+}
+
+func NewCommand(taskID string) (Command, error) {
+	uid, err := uuid.Parse(taskID)
+	if err != nil {
+		return Command{}, errors.Wrap(ErrInvalidTaskID, err.Error())
+	}
+
+	return Command{
+		TaskID: uid,
+	}, nil
+}
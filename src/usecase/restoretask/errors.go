@@ -0,0 +1,14 @@
+package restoretask
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidTaskID      = errors.New("invalid task id")
+	ErrTaskNotFound       = errors.New("task not found")
+	ErrTaskNotDeleted     = errors.New("task is not deleted")
+	ErrBoardDeleted       = errors.New("board is deleted, restore the board first")
+	ErrColumnDeleted      = errors.New("column is deleted, restore the column first")
+	ErrRestoreTaskUnknown = errors.New("unknown error restoring task")
+)
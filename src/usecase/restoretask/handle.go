@@ -0,0 +1,71 @@
+package restoretask
+
+import (
+	"context"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetTaskIncludingDeleted(ctx context.Context, taskID uuid.UUID) (*domain.Task, error)
+	GetBoardIncludingDeleted(ctx context.Context, boardID uuid.UUID) (*domain.Board, error)
+	GetColumnIncludingDeleted(ctx context.Context, columnID uuid.UUID) (*domain.Column, error)
+	RestoreTask(ctx context.Context, task *domain.Task, deletedAt time.Time) (int64, error)
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+// Handle восстанавливает задачу вместе с подзадачами, удаленными каскадом.
+// Возвращает задачу и общее число восстановленных задач.
+func (uc *UC) Handle(ctx context.Context, cmd Command) (*domain.Task, int64, error) {
+	task, err := uc.repo.GetTaskIncludingDeleted(ctx, cmd.TaskID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, 0, ErrTaskNotFound
+		}
+		return nil, 0, errors.Wrap(ErrRestoreTaskUnknown, err.Error())
+	}
+	if task.DeletedAt == nil {
+		return nil, 0, ErrTaskNotDeleted
+	}
+	deletedAt := *task.DeletedAt
+
+	board, err := uc.repo.GetBoardIncludingDeleted(ctx, task.BoardID)
+	if err != nil {
+		return nil, 0, errors.Wrap(ErrRestoreTaskUnknown, err.Error())
+	}
+	if board.DeletedAt != nil {
+		return nil, 0, ErrBoardDeleted
+	}
+
+	column, err := uc.repo.GetColumnIncludingDeleted(ctx, task.ColumnID)
+	if err != nil {
+		return nil, 0, errors.Wrap(ErrRestoreTaskUnknown, err.Error())
+	}
+	if column.DeletedAt != nil {
+		return nil, 0, ErrColumnDeleted
+	}
+
+	if err := task.Restore(); err != nil {
+		return nil, 0, errors.Wrap(ErrTaskNotDeleted, err.Error())
+	}
+
+	restored, err := uc.repo.RestoreTask(ctx, task, deletedAt)
+	if err != nil {
+		return nil, 0, errors.Wrap(ErrRestoreTaskUnknown, err.Error())
+	}
+
+	return task, restored, nil
+}
@@ -0,0 +1,99 @@
+package restoretask
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/restoretask/mocks"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandle(t *testing.T) {
+	ctx := context.Background()
+	boardID := uuid.New()
+	columnID := uuid.New()
+	taskID := uuid.New()
+	deletedAt := time.Now().UTC().Add(-time.Hour)
+
+	deletedTask := func() *domain.Task {
+		return &domain.Task{ID: taskID, BoardID: boardID, ColumnID: columnID, DeletedAt: &deletedAt}
+	}
+
+	testCases := []struct {
+		name             string
+		setupMock        func(*mocks.Repo)
+		expectedRestored int64
+		expectError      error
+	}{
+		{
+			name: "Success: task restored with subtasks",
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTaskIncludingDeleted", mock.Anything, taskID).Return(deletedTask(), nil).Once()
+				repo.On("GetBoardIncludingDeleted", mock.Anything, boardID).Return(&domain.Board{ID: boardID}, nil).Once()
+				repo.On("GetColumnIncludingDeleted", mock.Anything, columnID).Return(&domain.Column{ID: columnID}, nil).Once()
+				repo.On("RestoreTask", mock.Anything, mock.AnythingOfType("*domain.Task"), deletedAt).Return(int64(3), nil).Once()
+			},
+			expectedRestored: 3,
+		},
+		{
+			name: "Failure: task not found",
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTaskIncludingDeleted", mock.Anything, taskID).Return(nil, pgx.ErrNoRows).Once()
+			},
+			expectError: ErrTaskNotFound,
+		},
+		{
+			name: "Failure: task is not deleted",
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTaskIncludingDeleted", mock.Anything, taskID).Return(&domain.Task{ID: taskID}, nil).Once()
+			},
+			expectError: ErrTaskNotDeleted,
+		},
+		{
+			name: "Failure: board is deleted",
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTaskIncludingDeleted", mock.Anything, taskID).Return(deletedTask(), nil).Once()
+				repo.On("GetBoardIncludingDeleted", mock.Anything, boardID).Return(&domain.Board{ID: boardID, DeletedAt: &deletedAt}, nil).Once()
+			},
+			expectError: ErrBoardDeleted,
+		},
+		{
+			name: "Failure: column is deleted",
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTaskIncludingDeleted", mock.Anything, taskID).Return(deletedTask(), nil).Once()
+				repo.On("GetBoardIncludingDeleted", mock.Anything, boardID).Return(&domain.Board{ID: boardID}, nil).Once()
+				repo.On("GetColumnIncludingDeleted", mock.Anything, columnID).Return(&domain.Column{ID: columnID, DeletedAt: &deletedAt}, nil).Once()
+			},
+			expectError: ErrColumnDeleted,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := mocks.NewRepo(t)
+			tc.setupMock(repo)
+
+			uc := NewUC(repo)
+			task, restored, err := uc.Handle(ctx, Command{TaskID: taskID})
+
+			if tc.expectError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tc.expectError)
+				assert.Nil(t, task)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, task)
+				assert.Nil(t, task.DeletedAt)
+				assert.Equal(t, tc.expectedRestored, restored)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
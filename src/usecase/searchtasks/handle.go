@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 )
 
@@ -12,6 +13,8 @@ type Repo interface {
 		ctx context.Context, 
 		tags []string, 
 		query string,
+		milestoneID *uuid.UUID,
+		mentionedUser string,
 		limit, offset uint) ([]domain.Task, error)
 }
 
@@ -26,7 +29,7 @@ func NewUC(repo Repo) *UC {
 }
 
 func (uc *UC) Handle(ctx context.Context, q Query) ([]domain.Task, error) {
-	tasks, err := uc.repo.SearchTasks(ctx, q.Tags, q.Query, q.Limit, q.Offset)
+	tasks, err := uc.repo.SearchTasks(ctx, q.Tags, q.Query, q.MilestoneID, q.MentionedUser, q.Limit, q.Offset)
 	if err != nil {
 		return nil, errors.Wrap(ErrSearchTasks, err.Error())
 	}
@@ -1,26 +1,49 @@
 package searchtasks
 
+import (
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
 
 const(
 	maxRows = 25
+	maxUserIDLen = 100
 )
 
 type Query struct {
-	Tags   []string
-	Query  string
-	Limit  uint
-	Offset uint
+	Tags        []string
+	Query       string
+	MilestoneID *uuid.UUID
+	// MentionedUser - только задачи, где в описании упомянут пользователь
+	MentionedUser string
+	Limit       uint
+	Offset      uint
 }
 
-func NewQuery(tags []string, query string, limit, offset uint) (Query, error) {
+func NewQuery(tags []string, query string, milestoneID string, mentionedUser string, limit, offset uint) (Query, error) {
 	if limit == 0 || limit > maxRows {
 		limit = maxRows
 	}
 
+	var mID *uuid.UUID
+	if milestoneID != "" {
+		id, err := uuid.Parse(milestoneID)
+		if err != nil {
+			return Query{}, errors.Wrap(ErrValidationFailed, err.Error())
+		}
+		mID = &id
+	}
+
+	if len(mentionedUser) > maxUserIDLen {
+		return Query{}, errors.Wrap(ErrValidationFailed, "mentioned user is too long")
+	}
+
 	return Query{
-		Tags:   tags,
-		Query:  query,
-		Limit:  limit,
-		Offset: offset,
+		Tags:        tags,
+		Query:       query,
+		MilestoneID: mID,
+		MentionedUser: mentionedUser,
+		Limit:       limit,
+		Offset:      offset,
 	}, nil
 }
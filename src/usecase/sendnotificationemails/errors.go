@@ -0,0 +1,10 @@
+package sendnotificationemails
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidBatchSize              = errors.New("batch size must be positive")
+	ErrSendNotificationEmailsUnknown = errors.New("unknown error sending notification emails")
+)
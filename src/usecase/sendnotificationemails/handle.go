@@ -0,0 +1,143 @@
+package sendnotificationemails
+
+import (
+	"context"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	ClaimInstantNotificationEmails(ctx context.Context, now time.Time, limit int) ([]domain.NotificationEmail, error)
+	ReleaseNotificationEmails(ctx context.Context, ids []uuid.UUID) error
+	GetDueDigests(ctx context.Context, scheduled time.Time, limit int) ([]domain.EmailPreference, error)
+	ClaimDigest(ctx context.Context, pref *domain.EmailPreference, scheduled, now time.Time) ([]domain.Notification, bool, error)
+	ReleaseDigest(ctx context.Context, pref *domain.EmailPreference, ids []uuid.UUID) error
+}
+
+// Sender отправляет письмо пользователю.
+type Sender interface {
+	Send(ctx context.Context, msg domain.NotificationEmail) error
+}
+
+type UC struct {
+	repo   Repo
+	sender Sender
+	// digestHour - час UTC, в который уходят дайджесты
+	digestHour int
+	now        func() time.Time
+}
+
+func NewUC(repo Repo, sender Sender, digestHour int) *UC {
+	return &UC{
+		repo:       repo,
+		sender:     sender,
+		digestHour: digestHour,
+		now:        func() time.Time { return time.Now().UTC() },
+	}
+}
+
+type Stats struct {
+	Sent    int
+	Failed  int
+	Digests int
+}
+
+func (s Stats) Total() int {
+	return s.Sent + s.Failed
+}
+
+// Handle отправляет одну пачку мгновенных писем и дайджестов, время которых пришло.
+// Письмо отмечается отправленным до отправки: если сервис упадет посередине,
+// письмо потеряется, но не уйдет дважды. Ошибка SMTP возвращает письмо в очередь.
+func (uc *UC) Handle(ctx context.Context, cmd Command) (Stats, error) {
+	var stats Stats
+
+	if err := uc.sendInstant(ctx, cmd, &stats); err != nil {
+		return stats, err
+	}
+	if err := uc.sendDigests(ctx, cmd, &stats); err != nil {
+		return stats, err
+	}
+
+	return stats, nil
+}
+
+func (uc *UC) sendInstant(ctx context.Context, cmd Command, stats *Stats) error {
+	emails, err := uc.repo.ClaimInstantNotificationEmails(ctx, uc.now(), cmd.BatchSize)
+	if err != nil {
+		return errors.Wrap(ErrSendNotificationEmailsUnknown, err.Error())
+	}
+
+	var failed []uuid.UUID
+	for _, email := range emails {
+		if err := uc.sender.Send(ctx, email); err != nil {
+			stats.Failed++
+			failed = append(failed, notificationIDs(email.Notifications)...)
+			continue
+		}
+		stats.Sent++
+	}
+
+	if err := uc.repo.ReleaseNotificationEmails(ctx, failed); err != nil {
+		return errors.Wrap(ErrSendNotificationEmailsUnknown, err.Error())
+	}
+	return nil
+}
+
+func (uc *UC) sendDigests(ctx context.Context, cmd Command, stats *Stats) error {
+	now := uc.now()
+	scheduled, err := domain.LastDigestTime(now, uc.digestHour)
+	if err != nil {
+		return errors.Wrap(ErrSendNotificationEmailsUnknown, err.Error())
+	}
+
+	prefs, err := uc.repo.GetDueDigests(ctx, scheduled, cmd.BatchSize)
+	if err != nil {
+		return errors.Wrap(ErrSendNotificationEmailsUnknown, err.Error())
+	}
+
+	for i := range prefs {
+		pref := &prefs[i]
+		if !pref.DigestDue(scheduled) {
+			continue
+		}
+
+		notifications, claimed, err := uc.repo.ClaimDigest(ctx, pref, scheduled, now)
+		if err != nil {
+			return errors.Wrap(ErrSendNotificationEmailsUnknown, err.Error())
+		}
+		// дайджест забрал другой экземпляр или писать не о чем
+		if !claimed || len(notifications) == 0 {
+			continue
+		}
+
+		err = uc.sender.Send(ctx, domain.NotificationEmail{
+			UserID:        pref.UserID,
+			To:            pref.Email,
+			Digest:        true,
+			Notifications: notifications,
+		})
+		if err != nil {
+			stats.Failed++
+			if err := uc.repo.ReleaseDigest(ctx, pref, notificationIDs(notifications)); err != nil {
+				return errors.Wrap(ErrSendNotificationEmailsUnknown, err.Error())
+			}
+			continue
+		}
+		stats.Sent++
+		stats.Digests++
+	}
+
+	return nil
+}
+
+func notificationIDs(notifications []domain.Notification) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(notifications))
+	for _, n := range notifications {
+		ids = append(ids, n.ID)
+	}
+	return ids
+}
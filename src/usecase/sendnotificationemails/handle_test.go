@@ -0,0 +1,110 @@
+package sendnotificationemails
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/repository/email"
+	"github.com/KungurtsevNII/team-board-back/src/repository/email/smtptest"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/sendnotificationemails/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandle(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 12, 3, 9, 30, 0, 0, time.UTC)
+	scheduled := time.Date(2025, 12, 3, 8, 0, 0, 0, time.UTC)
+
+	notification := func(userID, text string) domain.Notification {
+		return domain.Notification{
+			ID: uuid.New(), UserID: userID, Type: domain.NotificationTaskAssigned,
+			BoardID: uuid.New(), TaskID: uuid.New(), Text: text, CreatedAt: now.Add(-time.Hour),
+		}
+	}
+
+	newUC := func(repo Repo, server *smtptest.Server) *UC {
+		sender := email.New(email.Config{
+			Host: server.Host, Port: server.Port, From: "noreply@teamboard.local", Timeout: time.Second,
+		})
+		uc := NewUC(repo, sender, 8)
+		uc.now = func() time.Time { return now }
+		return uc
+	}
+
+	t.Run("Success: instant emails and due digest are sent", func(t *testing.T) {
+		server := smtptest.NewServer(t)
+
+		instant := domain.NotificationEmail{
+			UserID: "alice", To: "alice@example.com",
+			Notifications: []domain.Notification{notification("alice", "You were assigned")},
+		}
+		digestPref := domain.EmailPreference{UserID: "bob", Email: "bob@example.com", Mode: domain.EmailDigest}
+		digest := []domain.Notification{notification("bob", "first"), notification("bob", "second")}
+
+		repo := mocks.NewRepo(t)
+		repo.On("ClaimInstantNotificationEmails", mock.Anything, now, 10).
+			Return([]domain.NotificationEmail{instant}, nil).Once()
+		repo.On("ReleaseNotificationEmails", mock.Anything, []uuid.UUID(nil)).Return(nil).Once()
+		repo.On("GetDueDigests", mock.Anything, scheduled, 10).
+			Return([]domain.EmailPreference{digestPref}, nil).Once()
+		repo.On("ClaimDigest", mock.Anything, &digestPref, scheduled, now).Return(digest, true, nil).Once()
+
+		stats, err := newUC(repo, server).Handle(ctx, Command{BatchSize: 10})
+		require.NoError(t, err)
+		assert.Equal(t, Stats{Sent: 2, Digests: 1}, stats)
+
+		msgs := server.Messages()
+		require.Len(t, msgs, 2)
+		assert.Equal(t, []string{"alice@example.com"}, msgs[0].To)
+		assert.Equal(t, []string{"bob@example.com"}, msgs[1].To)
+		assert.Contains(t, msgs[1].Parse(t).Header.Get("Subject"), "2 unread")
+	})
+
+	t.Run("Success: failed emails go back to the queue", func(t *testing.T) {
+		server := smtptest.NewServer(t)
+		server.RejectRcpt("alice@example.com")
+		server.RejectRcpt("bob@example.com")
+
+		instant := domain.NotificationEmail{
+			UserID: "alice", To: "alice@example.com",
+			Notifications: []domain.Notification{notification("alice", "You were assigned")},
+		}
+		digestPref := domain.EmailPreference{UserID: "bob", Email: "bob@example.com", Mode: domain.EmailDigest}
+		digest := []domain.Notification{notification("bob", "first")}
+
+		repo := mocks.NewRepo(t)
+		repo.On("ClaimInstantNotificationEmails", mock.Anything, now, 10).
+			Return([]domain.NotificationEmail{instant}, nil).Once()
+		repo.On("ReleaseNotificationEmails", mock.Anything, []uuid.UUID{instant.Notifications[0].ID}).Return(nil).Once()
+		repo.On("GetDueDigests", mock.Anything, scheduled, 10).
+			Return([]domain.EmailPreference{digestPref}, nil).Once()
+		repo.On("ClaimDigest", mock.Anything, &digestPref, scheduled, now).Return(digest, true, nil).Once()
+		repo.On("ReleaseDigest", mock.Anything, &digestPref, []uuid.UUID{digest[0].ID}).Return(nil).Once()
+
+		stats, err := newUC(repo, server).Handle(ctx, Command{BatchSize: 10})
+		require.NoError(t, err)
+		assert.Equal(t, Stats{Failed: 2}, stats)
+		assert.Empty(t, server.Messages())
+	})
+
+	t.Run("Success: digest claimed elsewhere is skipped", func(t *testing.T) {
+		server := smtptest.NewServer(t)
+		digestPref := domain.EmailPreference{UserID: "bob", Email: "bob@example.com", Mode: domain.EmailDigest}
+
+		repo := mocks.NewRepo(t)
+		repo.On("ClaimInstantNotificationEmails", mock.Anything, now, 10).Return(nil, nil).Once()
+		repo.On("ReleaseNotificationEmails", mock.Anything, []uuid.UUID(nil)).Return(nil).Once()
+		repo.On("GetDueDigests", mock.Anything, scheduled, 10).
+			Return([]domain.EmailPreference{digestPref}, nil).Once()
+		repo.On("ClaimDigest", mock.Anything, &digestPref, scheduled, now).Return(nil, false, nil).Once()
+
+		stats, err := newUC(repo, server).Handle(ctx, Command{BatchSize: 10})
+		require.NoError(t, err)
+		assert.Zero(t, stats.Total())
+	})
+}
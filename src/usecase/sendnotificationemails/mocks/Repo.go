@@ -0,0 +1,166 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/KungurtsevNII/team-board-back/src/domain"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// Repo is an autogenerated mock type for the Repo type
+type Repo struct {
+	mock.Mock
+}
+
+// ClaimDigest provides a mock function with given fields: ctx, pref, scheduled, now
+func (_m *Repo) ClaimDigest(ctx context.Context, pref *domain.EmailPreference, scheduled time.Time, now time.Time) ([]domain.Notification, bool, error) {
+	ret := _m.Called(ctx, pref, scheduled, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimDigest")
+	}
+
+	var r0 []domain.Notification
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.EmailPreference, time.Time, time.Time) ([]domain.Notification, bool, error)); ok {
+		return rf(ctx, pref, scheduled, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.EmailPreference, time.Time, time.Time) []domain.Notification); ok {
+		r0 = rf(ctx, pref, scheduled, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.EmailPreference, time.Time, time.Time) bool); ok {
+		r1 = rf(ctx, pref, scheduled, now)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *domain.EmailPreference, time.Time, time.Time) error); ok {
+		r2 = rf(ctx, pref, scheduled, now)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ClaimInstantNotificationEmails provides a mock function with given fields: ctx, now, limit
+func (_m *Repo) ClaimInstantNotificationEmails(ctx context.Context, now time.Time, limit int) ([]domain.NotificationEmail, error) {
+	ret := _m.Called(ctx, now, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimInstantNotificationEmails")
+	}
+
+	var r0 []domain.NotificationEmail
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]domain.NotificationEmail, error)); ok {
+		return rf(ctx, now, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []domain.NotificationEmail); ok {
+		r0 = rf(ctx, now, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.NotificationEmail)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, now, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDueDigests provides a mock function with given fields: ctx, scheduled, limit
+func (_m *Repo) GetDueDigests(ctx context.Context, scheduled time.Time, limit int) ([]domain.EmailPreference, error) {
+	ret := _m.Called(ctx, scheduled, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDueDigests")
+	}
+
+	var r0 []domain.EmailPreference
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]domain.EmailPreference, error)); ok {
+		return rf(ctx, scheduled, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []domain.EmailPreference); ok {
+		r0 = rf(ctx, scheduled, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.EmailPreference)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, scheduled, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReleaseDigest provides a mock function with given fields: ctx, pref, ids
+func (_m *Repo) ReleaseDigest(ctx context.Context, pref *domain.EmailPreference, ids []uuid.UUID) error {
+	ret := _m.Called(ctx, pref, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseDigest")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.EmailPreference, []uuid.UUID) error); ok {
+		r0 = rf(ctx, pref, ids)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReleaseNotificationEmails provides a mock function with given fields: ctx, ids
+func (_m *Repo) ReleaseNotificationEmails(ctx context.Context, ids []uuid.UUID) error {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseNotificationEmails")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) error); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewRepo creates a new instance of Repo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Repo {
+	mock := &Repo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
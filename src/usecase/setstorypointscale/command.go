@@ -0,0 +1,30 @@
+package setstorypointscale
+
+import (
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type Command struct {
+	BoardID uuid.UUID
+	Scale   domain.StoryPointScale
+}
+
+// NewCommand принимает либо имя готовой шкалы, либо свои значения.
+func NewCommand(boardID, preset string, values []int) (Command, error) {
+	id, err := uuid.Parse(boardID)
+	if err != nil {
+		return Command{}, ErrInvalidBoardID
+	}
+
+	scale, err := domain.NewStoryPointScale(preset, values)
+	if err != nil {
+		return Command{}, errors.Wrap(ErrInvalidScale, err.Error())
+	}
+
+	return Command{
+		BoardID: id,
+		Scale:   scale,
+	}, nil
+}
@@ -0,0 +1,12 @@
+package setstorypointscale
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidBoardID            = errors.New("invalid board id")
+	ErrInvalidScale              = errors.New("invalid story point scale")
+	ErrBoardNotFound             = errors.New("board not found")
+	ErrSetStoryPointScaleUnknown = errors.New("unknown error setting story point scale")
+)
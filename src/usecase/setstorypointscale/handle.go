@@ -0,0 +1,35 @@
+package setstorypointscale
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	SetStoryPointScale(ctx context.Context, boardID uuid.UUID, scale domain.StoryPointScale) error
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+func (uc *UC) Handle(ctx context.Context, cmd Command) (domain.StoryPointScale, error) {
+	if err := uc.repo.SetStoryPointScale(ctx, cmd.BoardID, cmd.Scale); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrBoardNotFound
+		}
+		return nil, errors.Wrap(ErrSetStoryPointScaleUnknown, err.Error())
+	}
+
+	return cmd.Scale, nil
+}
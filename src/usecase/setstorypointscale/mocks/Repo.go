@@ -0,0 +1,49 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/KungurtsevNII/team-board-back/src/domain"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// Repo is an autogenerated mock type for the Repo type
+type Repo struct {
+	mock.Mock
+}
+
+// SetStoryPointScale provides a mock function with given fields: ctx, boardID, scale
+func (_m *Repo) SetStoryPointScale(ctx context.Context, boardID uuid.UUID, scale domain.StoryPointScale) error {
+	ret := _m.Called(ctx, boardID, scale)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetStoryPointScale")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, domain.StoryPointScale) error); ok {
+		r0 = rf(ctx, boardID, scale)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewRepo creates a new instance of Repo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Repo {
+	mock := &Repo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
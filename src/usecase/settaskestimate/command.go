@@ -0,0 +1,26 @@
+package settaskestimate
+
+import (
+	"github.com/google/uuid"
+)
+
+type Command struct {
+	TaskID uuid.UUID
+	// Оценки в минутах
+	OriginalMinutes *int
+	// RemainingMinutes == nil - оставить текущую, см. UC.Handle
+	RemainingMinutes *int
+}
+
+func NewCommand(taskID string, originalMinutes, remainingMinutes *int) (Command, error) {
+	id, err := uuid.Parse(taskID)
+	if err != nil {
+		return Command{}, ErrInvalidTaskID
+	}
+
+	return Command{
+		TaskID:           id,
+		OriginalMinutes:  originalMinutes,
+		RemainingMinutes: remainingMinutes,
+	}, nil
+}
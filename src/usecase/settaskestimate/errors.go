@@ -0,0 +1,12 @@
+package settaskestimate
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidTaskID          = errors.New("invalid task id")
+	ErrInvalidEstimate        = errors.New("invalid estimate")
+	ErrTaskNotFound           = errors.New("task not found")
+	ErrSetTaskEstimateUnknown = errors.New("unknown error setting task estimate")
+)
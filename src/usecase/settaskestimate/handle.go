@@ -0,0 +1,57 @@
+package settaskestimate
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetTaskByID(ctx context.Context, taskID uuid.UUID) (*domain.Task, error)
+	UpdateTask(ctx context.Context, task *domain.Task) error
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+// Handle задает оценки задачи. Если оставшаяся оценка не передана, она
+// сохраняется, а если ее еще не было - берется равной исходной. Без обеих
+// оценок задача остается без оценки.
+func (uc *UC) Handle(ctx context.Context, cmd Command) (*domain.Task, error) {
+	task, err := uc.repo.GetTaskByID(ctx, cmd.TaskID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrTaskNotFound
+		}
+		return nil, errors.Wrap(ErrSetTaskEstimateUnknown, err.Error())
+	}
+
+	remaining := cmd.RemainingMinutes
+	if remaining == nil && cmd.OriginalMinutes != nil {
+		remaining = task.RemainingEstimate
+		if remaining == nil {
+			r := *cmd.OriginalMinutes
+			remaining = &r
+		}
+	}
+
+	if err := task.SetEstimates(cmd.OriginalMinutes, remaining); err != nil {
+		return nil, errors.Wrap(ErrInvalidEstimate, err.Error())
+	}
+
+	if err := uc.repo.UpdateTask(ctx, task); err != nil {
+		return nil, errors.Wrap(ErrSetTaskEstimateUnknown, err.Error())
+	}
+
+	return task, nil
+}
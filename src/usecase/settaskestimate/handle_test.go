@@ -0,0 +1,77 @@
+package settaskestimate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/settaskestimate/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandle(t *testing.T) {
+	ctx := context.Background()
+	intPtr := func(v int) *int { return &v }
+
+	testCases := []struct {
+		name              string
+		task              *domain.Task
+		original          *int
+		remaining         *int
+		expectedRemaining *int
+		expectError       error
+	}{
+		{
+			name:              "first estimate fills remaining",
+			task:              &domain.Task{ID: uuid.New()},
+			original:          intPtr(480),
+			expectedRemaining: intPtr(480),
+		},
+		{
+			name:              "explicit remaining kept",
+			task:              &domain.Task{ID: uuid.New()},
+			original:          intPtr(480),
+			remaining:         intPtr(60),
+			expectedRemaining: intPtr(60),
+		},
+		{
+			name:              "re-estimate keeps remaining",
+			task:              &domain.Task{ID: uuid.New(), OriginalEstimate: intPtr(480), RemainingEstimate: intPtr(100)},
+			original:          intPtr(600),
+			expectedRemaining: intPtr(100),
+		},
+		{
+			name: "both empty clears estimates",
+			task: &domain.Task{ID: uuid.New(), OriginalEstimate: intPtr(480), RemainingEstimate: intPtr(100)},
+		},
+		{
+			name:        "negative estimate",
+			task:        &domain.Task{ID: uuid.New()},
+			original:    intPtr(-5),
+			expectError: ErrInvalidEstimate,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := mocks.NewRepo(t)
+			repo.On("GetTaskByID", ctx, tc.task.ID).Return(tc.task, nil)
+			if tc.expectError == nil {
+				repo.On("UpdateTask", ctx, tc.task).Return(nil)
+			}
+
+			task, err := NewUC(repo).Handle(ctx, Command{
+				TaskID: tc.task.ID, OriginalMinutes: tc.original, RemainingMinutes: tc.remaining,
+			})
+			if tc.expectError != nil {
+				assert.ErrorIs(t, err, tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.original, task.OriginalEstimate)
+			assert.Equal(t, tc.expectedRemaining, task.RemainingEstimate)
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package settaskmilestone
+
+import (
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type Command struct {
+	TaskID uuid.UUID
+	// MilestoneID == nil - отвязать задачу от milestone
+	MilestoneID *uuid.UUID
+}
+
+func NewCommand(taskID string, milestoneID *string) (Command, error) {
+	tID, err := uuid.Parse(taskID)
+	if err != nil {
+		return Command{}, errors.Wrap(ErrInvalidUUID, err.Error())
+	}
+
+	var mID *uuid.UUID
+	if milestoneID != nil {
+		id, err := uuid.Parse(*milestoneID)
+		if err != nil {
+			return Command{}, errors.Wrap(ErrInvalidUUID, err.Error())
+		}
+		mID = &id
+	}
+
+	return Command{
+		TaskID:      tID,
+		MilestoneID: mID,
+	}, nil
+}
@@ -0,0 +1,13 @@
+package settaskmilestone
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidUUID             = errors.New("invalid uuid")
+	ErrTaskNotFound            = errors.New("task not found")
+	ErrMilestoneNotFound       = errors.New("milestone not found")
+	ErrMilestoneClosed         = errors.New("milestone is closed")
+	ErrSetTaskMilestoneUnknown = errors.New("unknown error setting task milestone")
+)
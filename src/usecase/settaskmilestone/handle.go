@@ -0,0 +1,62 @@
+package settaskmilestone
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetTaskByID(ctx context.Context, taskID uuid.UUID) (*domain.Task, error)
+	GetMilestoneByID(ctx context.Context, milestoneID uuid.UUID) (*domain.Milestone, error)
+	UpdateTask(ctx context.Context, task *domain.Task) error
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+func (uc *UC) Handle(ctx context.Context, cmd Command) (*domain.Task, error) {
+	task, err := uc.repo.GetTaskByID(ctx, cmd.TaskID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrTaskNotFound
+		}
+		return nil, errors.Wrap(ErrSetTaskMilestoneUnknown, err.Error())
+	}
+
+	var milestone *domain.Milestone
+	if cmd.MilestoneID != nil {
+		milestone, err = uc.repo.GetMilestoneByID(ctx, *cmd.MilestoneID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, ErrMilestoneNotFound
+			}
+			return nil, errors.Wrap(ErrSetTaskMilestoneUnknown, err.Error())
+		}
+	}
+
+	err = task.SetMilestone(milestone)
+	if err != nil {
+		if errors.Is(err, domain.ErrMilestoneClosed) {
+			return nil, ErrMilestoneClosed
+		}
+		return nil, errors.Wrap(ErrSetTaskMilestoneUnknown, err.Error())
+	}
+
+	err = uc.repo.UpdateTask(ctx, task)
+	if err != nil {
+		return nil, errors.Wrap(ErrSetTaskMilestoneUnknown, err.Error())
+	}
+
+	return task, nil
+}
@@ -0,0 +1,109 @@
+package settaskmilestone
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/settaskmilestone/mocks"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandle(t *testing.T) {
+	ctx := context.Background()
+	taskID := uuid.New()
+	milestoneID := uuid.New()
+
+	openMilestone := &domain.Milestone{ID: milestoneID, State: domain.MilestoneOpen}
+	closedMilestone := &domain.Milestone{ID: milestoneID, State: domain.MilestoneClosed}
+
+	testCases := []struct {
+		name              string
+		command           Command
+		setupMock         func(*mocks.Repo)
+		expectedMilestone *uuid.UUID
+		expectError       error
+	}{
+		{
+			name:    "Success: task attached to milestone",
+			command: Command{TaskID: taskID, MilestoneID: &milestoneID},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTaskByID", mock.Anything, taskID).Return(&domain.Task{ID: taskID}, nil).Once()
+				repo.On("GetMilestoneByID", mock.Anything, milestoneID).Return(openMilestone, nil).Once()
+				repo.On("UpdateTask", mock.Anything, mock.AnythingOfType("*domain.Task")).Return(nil).Once()
+			},
+			expectedMilestone: &milestoneID,
+		},
+		{
+			name:    "Success: task detached from milestone",
+			command: Command{TaskID: taskID},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTaskByID", mock.Anything, taskID).Return(&domain.Task{ID: taskID, MilestoneID: &milestoneID}, nil).Once()
+				repo.On("UpdateTask", mock.Anything, mock.AnythingOfType("*domain.Task")).Return(nil).Once()
+			},
+		},
+		{
+			name:    "Failure: task not found",
+			command: Command{TaskID: taskID, MilestoneID: &milestoneID},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTaskByID", mock.Anything, taskID).Return(nil, pgx.ErrNoRows).Once()
+			},
+			expectError: ErrTaskNotFound,
+		},
+		{
+			name:    "Failure: milestone not found",
+			command: Command{TaskID: taskID, MilestoneID: &milestoneID},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTaskByID", mock.Anything, taskID).Return(&domain.Task{ID: taskID}, nil).Once()
+				repo.On("GetMilestoneByID", mock.Anything, milestoneID).Return(nil, pgx.ErrNoRows).Once()
+			},
+			expectError: ErrMilestoneNotFound,
+		},
+		{
+			name:    "Failure: milestone is closed",
+			command: Command{TaskID: taskID, MilestoneID: &milestoneID},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTaskByID", mock.Anything, taskID).Return(&domain.Task{ID: taskID}, nil).Once()
+				repo.On("GetMilestoneByID", mock.Anything, milestoneID).Return(closedMilestone, nil).Once()
+			},
+			expectError: ErrMilestoneClosed,
+		},
+		{
+			name:    "Failure: update task error",
+			command: Command{TaskID: taskID, MilestoneID: &milestoneID},
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetTaskByID", mock.Anything, taskID).Return(&domain.Task{ID: taskID}, nil).Once()
+				repo.On("GetMilestoneByID", mock.Anything, milestoneID).Return(openMilestone, nil).Once()
+				repo.On("UpdateTask", mock.Anything, mock.AnythingOfType("*domain.Task")).Return(errors.New("db error")).Once()
+			},
+			expectError: ErrSetTaskMilestoneUnknown,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := mocks.NewRepo(t)
+			tc.setupMock(repo)
+
+			uc := NewUC(repo)
+			task, err := uc.Handle(ctx, tc.command)
+
+			if tc.expectError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tc.expectError)
+				assert.Nil(t, task)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, task)
+				assert.Equal(t, tc.expectedMilestone, task.MilestoneID)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package settaskstorypoints
+
+import (
+	"github.com/google/uuid"
+)
+
+type Command struct {
+	TaskID uuid.UUID
+	// StoryPoints == nil - снять оценку
+	StoryPoints *int
+}
+
+func NewCommand(taskID string, storyPoints *int) (Command, error) {
+	id, err := uuid.Parse(taskID)
+	if err != nil {
+		return Command{}, ErrInvalidTaskID
+	}
+
+	return Command{
+		TaskID:      id,
+		StoryPoints: storyPoints,
+	}, nil
+}
@@ -0,0 +1,12 @@
+package settaskstorypoints
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidTaskID             = errors.New("invalid task id")
+	ErrInvalidStoryPoints        = errors.New("invalid story points")
+	ErrTaskNotFound              = errors.New("task not found")
+	ErrSetTaskStoryPointsUnknown = errors.New("unknown error setting task story points")
+)
@@ -0,0 +1,52 @@
+package settaskstorypoints
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetTaskByID(ctx context.Context, taskID uuid.UUID) (*domain.Task, error)
+	GetStoryPointScale(ctx context.Context, boardID uuid.UUID) (domain.StoryPointScale, error)
+	UpdateTask(ctx context.Context, task *domain.Task) error
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+// Handle проверяет оценку по шкале доски, на которой сейчас задача.
+func (uc *UC) Handle(ctx context.Context, cmd Command) (*domain.Task, error) {
+	task, err := uc.repo.GetTaskByID(ctx, cmd.TaskID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrTaskNotFound
+		}
+		return nil, errors.Wrap(ErrSetTaskStoryPointsUnknown, err.Error())
+	}
+
+	scale, err := uc.repo.GetStoryPointScale(ctx, task.BoardID)
+	if err != nil {
+		return nil, errors.Wrap(ErrSetTaskStoryPointsUnknown, err.Error())
+	}
+
+	if err := task.SetStoryPoints(cmd.StoryPoints, scale); err != nil {
+		return nil, errors.Wrap(ErrInvalidStoryPoints, err.Error())
+	}
+
+	if err := uc.repo.UpdateTask(ctx, task); err != nil {
+		return nil, errors.Wrap(ErrSetTaskStoryPointsUnknown, err.Error())
+	}
+
+	return task, nil
+}
@@ -0,0 +1,60 @@
+package settaskstorypoints
+
+import (
+	"context"
+	"testing"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/settaskstorypoints/mocks"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandle(t *testing.T) {
+	ctx := context.Background()
+	intPtr := func(v int) *int { return &v }
+
+	testCases := []struct {
+		name        string
+		points      *int
+		scale       domain.StoryPointScale
+		expectError error
+	}{
+		{name: "points in default scale", points: intPtr(8), scale: domain.DefaultStoryPointScale()},
+		{name: "points in board scale", points: intPtr(4), scale: domain.StoryPointScale{1, 2, 4}},
+		{name: "clear points", scale: domain.DefaultStoryPointScale()},
+		{name: "points out of scale", points: intPtr(4), scale: domain.DefaultStoryPointScale(), expectError: ErrInvalidStoryPoints},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			task := &domain.Task{ID: uuid.New(), BoardID: uuid.New(), StoryPoints: intPtr(1)}
+
+			repo := mocks.NewRepo(t)
+			repo.On("GetTaskByID", ctx, task.ID).Return(task, nil)
+			repo.On("GetStoryPointScale", ctx, task.BoardID).Return(tc.scale, nil)
+			if tc.expectError == nil {
+				repo.On("UpdateTask", ctx, task).Return(nil)
+			}
+
+			got, err := NewUC(repo).Handle(ctx, Command{TaskID: task.ID, StoryPoints: tc.points})
+			if tc.expectError != nil {
+				assert.ErrorIs(t, err, tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.points, got.StoryPoints)
+		})
+	}
+
+	t.Run("task not found", func(t *testing.T) {
+		taskID := uuid.New()
+		repo := mocks.NewRepo(t)
+		repo.On("GetTaskByID", ctx, taskID).Return(nil, pgx.ErrNoRows)
+
+		_, err := NewUC(repo).Handle(ctx, Command{TaskID: taskID, StoryPoints: intPtr(1)})
+		assert.ErrorIs(t, err, ErrTaskNotFound)
+	})
+}
@@ -0,0 +1,20 @@
+package startsprint
+
+import (
+	"github.com/google/uuid"
+)
+
+type Command struct {
+	SprintID uuid.UUID
+}
+
+func NewCommand(sprintID string) (Command, error) {
+	uid, err := uuid.Parse(sprintID)
+	if err != nil {
+		return Command{}, ErrInvalidSprintID
+	}
+
+	return Command{
+		SprintID: uid,
+	}, nil
+}
@@ -0,0 +1,13 @@
+package startsprint
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidSprintID    = errors.New("invalid sprint id")
+	ErrSprintNotFound     = errors.New("sprint not found")
+	ErrSprintNotPlanned   = errors.New("sprint is not planned")
+	ErrActiveSprintExists = errors.New("board already has an active sprint")
+	ErrStartSprintUnknown = errors.New("unknown error starting sprint")
+)
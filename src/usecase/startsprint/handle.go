@@ -0,0 +1,57 @@
+package startsprint
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetSprintByID(ctx context.Context, sprintID uuid.UUID) (*domain.Sprint, error)
+	GetActiveSprint(ctx context.Context, boardID uuid.UUID) (*domain.Sprint, error)
+	UpdateSprint(ctx context.Context, sprint *domain.Sprint) error
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+func (uc *UC) Handle(ctx context.Context, cmd Command) (*domain.Sprint, error) {
+	sprint, err := uc.repo.GetSprintByID(ctx, cmd.SprintID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrSprintNotFound
+		}
+		return nil, errors.Wrap(ErrStartSprintUnknown, err.Error())
+	}
+
+	_, err = uc.repo.GetActiveSprint(ctx, sprint.BoardID)
+	if err == nil {
+		return nil, ErrActiveSprintExists
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, errors.Wrap(ErrStartSprintUnknown, err.Error())
+	}
+
+	err = sprint.Start()
+	if err != nil {
+		return nil, ErrSprintNotPlanned
+	}
+
+	// гонку двух одновременных стартов закрывает уникальный индекс idx_sprints_one_active
+	err = uc.repo.UpdateSprint(ctx, sprint)
+	if err != nil {
+		return nil, errors.Wrap(ErrStartSprintUnknown, err.Error())
+	}
+
+	return sprint, nil
+}
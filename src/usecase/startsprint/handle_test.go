@@ -0,0 +1,97 @@
+package startsprint
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/startsprint/mocks"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandle(t *testing.T) {
+	ctx := context.Background()
+	boardID := uuid.New()
+	sprintID := uuid.New()
+
+	planned := func() *domain.Sprint {
+		return &domain.Sprint{ID: sprintID, BoardID: boardID, State: domain.SprintPlanned}
+	}
+
+	testCases := []struct {
+		name        string
+		setupMock   func(*mocks.Repo)
+		expectError error
+	}{
+		{
+			name: "Success: sprint started",
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetSprintByID", mock.Anything, sprintID).Return(planned(), nil).Once()
+				repo.On("GetActiveSprint", mock.Anything, boardID).Return(nil, pgx.ErrNoRows).Once()
+				repo.On("UpdateSprint", mock.Anything, mock.AnythingOfType("*domain.Sprint")).Return(nil).Once()
+			},
+		},
+		{
+			name: "Failure: sprint not found",
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetSprintByID", mock.Anything, sprintID).Return(nil, pgx.ErrNoRows).Once()
+			},
+			expectError: ErrSprintNotFound,
+		},
+		{
+			name: "Failure: board already has an active sprint",
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetSprintByID", mock.Anything, sprintID).Return(planned(), nil).Once()
+				repo.On("GetActiveSprint", mock.Anything, boardID).
+					Return(&domain.Sprint{ID: uuid.New(), BoardID: boardID, State: domain.SprintActive}, nil).Once()
+			},
+			expectError: ErrActiveSprintExists,
+		},
+		{
+			name: "Failure: sprint already closed",
+			setupMock: func(repo *mocks.Repo) {
+				s := planned()
+				s.State = domain.SprintClosed
+				repo.On("GetSprintByID", mock.Anything, sprintID).Return(s, nil).Once()
+				repo.On("GetActiveSprint", mock.Anything, boardID).Return(nil, pgx.ErrNoRows).Once()
+			},
+			expectError: ErrSprintNotPlanned,
+		},
+		{
+			name: "Failure: update sprint error",
+			setupMock: func(repo *mocks.Repo) {
+				repo.On("GetSprintByID", mock.Anything, sprintID).Return(planned(), nil).Once()
+				repo.On("GetActiveSprint", mock.Anything, boardID).Return(nil, pgx.ErrNoRows).Once()
+				repo.On("UpdateSprint", mock.Anything, mock.AnythingOfType("*domain.Sprint")).Return(errors.New("db error")).Once()
+			},
+			expectError: ErrStartSprintUnknown,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := mocks.NewRepo(t)
+			tc.setupMock(repo)
+
+			uc := NewUC(repo)
+			sprint, err := uc.Handle(ctx, Command{SprintID: sprintID})
+
+			if tc.expectError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tc.expectError)
+				assert.Nil(t, sprint)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, sprint)
+				assert.Equal(t, domain.SprintActive, sprint.State)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
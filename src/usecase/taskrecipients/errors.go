@@ -0,0 +1,10 @@
+package taskrecipients
+
+import (
+	"errors"
+)
+
+var (
+	ErrNotTaskEvent             = errors.New("event is not a task event")
+	ErrResolveRecipientsUnknown = errors.New("unknown error resolving recipients")
+)
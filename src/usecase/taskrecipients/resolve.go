@@ -0,0 +1,62 @@
+package taskrecipients
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetTaskWatchers(ctx context.Context, taskID uuid.UUID) ([]domain.Watcher, error)
+	GetBoardWatchers(ctx context.Context, boardID uuid.UUID) ([]domain.Watcher, error)
+}
+
+// Resolver отвечает на вопрос "кому интересно это изменение задачи".
+// Уведомления, письма и другие потребители событий movetask, puttask
+// и deletetask получают получателей только через него.
+type Resolver struct {
+	repo Repo
+}
+
+func NewResolver(repo Repo) *Resolver {
+	return &Resolver{
+		repo: repo,
+	}
+}
+
+// Resolve возвращает подписчиков задачи и ее доски без повторов, а при переносе
+// в другую доску - и подписчиков исходной доски. Автор и исполнитель подписаны
+// на задачу автоматически, поэтому отдельно не добавляются.
+func (r *Resolver) Resolve(ctx context.Context, event domain.Event) ([]string, error) {
+	switch event.Type {
+	case domain.EventTaskCreated, domain.EventTaskUpdated, domain.EventTaskMoved, domain.EventTaskMovedToBoard,
+		domain.EventTaskDeleted, domain.EventTaskAssigned, domain.EventTaskMentioned:
+	default:
+		return nil, errors.Wrap(ErrNotTaskEvent, string(event.Type))
+	}
+
+	taskWatchers, err := r.repo.GetTaskWatchers(ctx, event.AggregateID)
+	if err != nil {
+		return nil, errors.Wrap(ErrResolveRecipientsUnknown, err.Error())
+	}
+
+	boardWatchers, err := r.repo.GetBoardWatchers(ctx, event.BoardID)
+	if err != nil {
+		return nil, errors.Wrap(ErrResolveRecipientsUnknown, err.Error())
+	}
+
+	watchers := append(taskWatchers, boardWatchers...)
+
+	// задача ушла в другую доску: подписчики старой доски тоже узнают об этом
+	if moved, ok := event.Payload.(domain.TaskMovedToBoard); ok {
+		sourceWatchers, err := r.repo.GetBoardWatchers(ctx, moved.FromBoardID)
+		if err != nil {
+			return nil, errors.Wrap(ErrResolveRecipientsUnknown, err.Error())
+		}
+		watchers = append(watchers, sourceWatchers...)
+	}
+
+	return domain.WatcherUserIDs(watchers), nil
+}
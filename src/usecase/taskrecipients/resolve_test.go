@@ -0,0 +1,75 @@
+package taskrecipients
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/taskrecipients/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolve(t *testing.T) {
+	ctx := context.Background()
+
+	task, err := domain.NewTask(uuid.New(), uuid.New(), 1, "Task", nil, nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, task.MoveToColumn(uuid.New()))
+	moved := task.Events()[len(task.Events())-1]
+
+	watcher := func(user string) domain.Watcher {
+		return domain.Watcher{UserID: user}
+	}
+
+	t.Run("Success: task and board watchers are merged", func(t *testing.T) {
+		repo := mocks.NewRepo(t)
+		repo.On("GetTaskWatchers", mock.Anything, task.ID).
+			Return([]domain.Watcher{watcher("carol"), watcher("alice")}, nil).Once()
+		repo.On("GetBoardWatchers", mock.Anything, task.BoardID).
+			Return([]domain.Watcher{watcher("alice"), watcher("bob")}, nil).Once()
+
+		users, err := NewResolver(repo).Resolve(ctx, moved)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"alice", "bob", "carol"}, users)
+	})
+
+	t.Run("Success: source board watchers learn about a move to another board", func(t *testing.T) {
+		sourceID, targetID := uuid.New(), uuid.New()
+		event := domain.Event{
+			Type: domain.EventTaskMovedToBoard, AggregateID: task.ID, BoardID: targetID,
+			Payload: domain.TaskMovedToBoard{TaskID: task.ID, FromBoardID: sourceID, ToBoardID: targetID},
+		}
+
+		repo := mocks.NewRepo(t)
+		repo.On("GetTaskWatchers", mock.Anything, task.ID).
+			Return([]domain.Watcher{watcher("alice")}, nil).Once()
+		repo.On("GetBoardWatchers", mock.Anything, targetID).
+			Return([]domain.Watcher{watcher("bob")}, nil).Once()
+		repo.On("GetBoardWatchers", mock.Anything, sourceID).
+			Return([]domain.Watcher{watcher("alice"), watcher("dave")}, nil).Once()
+
+		users, err := NewResolver(repo).Resolve(ctx, event)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"alice", "bob", "dave"}, users)
+	})
+
+	t.Run("Failure: column events are rejected", func(t *testing.T) {
+		column, err := domain.NewColumn(uuid.New(), "Todo", 1)
+		require.NoError(t, err)
+
+		_, err = NewResolver(mocks.NewRepo(t)).Resolve(ctx, column.Events()[0])
+		assert.ErrorIs(t, err, ErrNotTaskEvent)
+	})
+
+	t.Run("Failure: repo error", func(t *testing.T) {
+		repo := mocks.NewRepo(t)
+		repo.On("GetTaskWatchers", mock.Anything, task.ID).Return(nil, errors.New("db down")).Once()
+
+		_, err := NewResolver(repo).Resolve(ctx, moved)
+		assert.ErrorIs(t, err, ErrResolveRecipientsUnknown)
+	})
+}
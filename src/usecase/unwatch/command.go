@@ -0,0 +1,39 @@
+package unwatch
+
+import (
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type Command struct {
+	Watcher domain.Watcher
+}
+
+func NewTaskCommand(userID, taskID string) (Command, error) {
+	id, err := uuid.Parse(taskID)
+	if err != nil {
+		return Command{}, ErrInvalidTaskID
+	}
+
+	w, err := domain.NewTaskWatcher(userID, id)
+	if err != nil {
+		return Command{}, errors.Wrap(ErrInvalidUserID, err.Error())
+	}
+
+	return Command{Watcher: *w}, nil
+}
+
+func NewBoardCommand(userID, boardID string) (Command, error) {
+	id, err := uuid.Parse(boardID)
+	if err != nil {
+		return Command{}, ErrInvalidBoardID
+	}
+
+	w, err := domain.NewBoardWatcher(userID, id)
+	if err != nil {
+		return Command{}, errors.Wrap(ErrInvalidUserID, err.Error())
+	}
+
+	return Command{Watcher: *w}, nil
+}
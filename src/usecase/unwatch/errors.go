@@ -0,0 +1,12 @@
+package unwatch
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidUserID  = errors.New("invalid user id")
+	ErrInvalidTaskID  = errors.New("invalid task id")
+	ErrInvalidBoardID = errors.New("invalid board id")
+	ErrUnwatchUnknown = errors.New("unknown error unwatching")
+)
@@ -0,0 +1,31 @@
+package unwatch
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	RemoveWatcher(ctx context.Context, watcher domain.Watcher) error
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+// Handle отписывает пользователя. Отписка от удаленной задачи или без
+// подписки не ошибка - результат тот же.
+func (uc *UC) Handle(ctx context.Context, cmd Command) error {
+	if err := uc.repo.RemoveWatcher(ctx, cmd.Watcher); err != nil {
+		return errors.Wrap(ErrUnwatchUnknown, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package uploadattachment
+
+import (
+	"io"
+
+	"github.com/google/uuid"
+)
+
+const maxUserIDLen = 100
+
+type Command struct {
+	UserID  string
+	TaskID  uuid.UUID
+	Name    string
+	Size    int64
+	Content io.Reader
+}
+
+func NewCommand(userID, taskID, name string, size int64, content io.Reader) (Command, error) {
+	if userID == "" || len(userID) > maxUserIDLen {
+		return Command{}, ErrInvalidUserID
+	}
+
+	id, err := uuid.Parse(taskID)
+	if err != nil {
+		return Command{}, ErrInvalidTaskID
+	}
+
+	return Command{
+		UserID:  userID,
+		TaskID:  id,
+		Name:    name,
+		Size:    size,
+		Content: content,
+	}, nil
+}
@@ -0,0 +1,16 @@
+package uploadattachment
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidUserID  = errors.New("invalid user id")
+	ErrInvalidTaskID  = errors.New("invalid task id")
+	ErrInvalidName    = errors.New("invalid file name")
+	ErrEmptyFile      = errors.New("file is empty")
+	ErrFileTooLarge   = errors.New("file is too large")
+	ErrTypeNotAllowed = errors.New("file type is not allowed")
+	ErrTaskNotFound   = errors.New("task not found")
+	ErrUploadUnknown  = errors.New("unknown error uploading attachment")
+)
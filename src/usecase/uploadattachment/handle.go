@@ -0,0 +1,94 @@
+package uploadattachment
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/gabriel-vasile/mimetype"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+// Сколько байт начала файла читать для определения типа, столько же
+// по умолчанию смотрит mimetype.
+const sniffLen = 3072
+
+type Repo interface {
+	GetTaskByID(ctx context.Context, taskID uuid.UUID) (*domain.Task, error)
+	CreateAttachment(ctx context.Context, attachment *domain.Attachment) error
+}
+
+type Storage interface {
+	Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error
+	Delete(ctx context.Context, key string) error
+}
+
+type UC struct {
+	repo    Repo
+	storage Storage
+	policy  domain.AttachmentPolicy
+}
+
+func NewUC(repo Repo, storage Storage, policy domain.AttachmentPolicy) *UC {
+	return &UC{
+		repo:    repo,
+		storage: storage,
+		policy:  policy,
+	}
+}
+
+// Handle сохраняет файл в хранилище и записывает метаданные. Тип определяется
+// по содержимому, а не по имени или заголовку от клиента. Если запись
+// в базу не удалась, объект удаляется из хранилища.
+func (uc *UC) Handle(ctx context.Context, cmd Command) (*domain.Attachment, error) {
+	if err := uc.policy.CheckSize(cmd.Size); err != nil {
+		return nil, ErrFileTooLarge
+	}
+
+	task, err := uc.repo.GetTaskByID(ctx, cmd.TaskID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrTaskNotFound
+		}
+		return nil, errors.Wrap(ErrUploadUnknown, err.Error())
+	}
+
+	head := make([]byte, min(cmd.Size, sniffLen))
+	n, err := io.ReadFull(cmd.Content, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return nil, errors.Wrap(ErrUploadUnknown, err.Error())
+	}
+	head = head[:n]
+
+	mimeType := mimetype.Detect(head).String()
+	if err := uc.policy.CheckType(mimeType); err != nil {
+		return nil, ErrTypeNotAllowed
+	}
+
+	attachment, err := domain.NewAttachment(task.ID, cmd.Name, mimeType, cmd.UserID, cmd.Size)
+	if err != nil {
+		if errors.Is(err, domain.ErrAttachmentEmpty) {
+			return nil, ErrEmptyFile
+		}
+		return nil, errors.Wrap(ErrInvalidName, err.Error())
+	}
+
+	hash := sha256.New()
+	content := io.TeeReader(io.MultiReader(bytes.NewReader(head), cmd.Content), hash)
+	if err := uc.storage.Put(ctx, attachment.StorageKey, content, attachment.Size, mimeType); err != nil {
+		return nil, errors.Wrap(ErrUploadUnknown, err.Error())
+	}
+	attachment.Checksum = hex.EncodeToString(hash.Sum(nil))
+
+	if err := uc.repo.CreateAttachment(ctx, attachment); err != nil {
+		_ = uc.storage.Delete(context.WithoutCancel(ctx), attachment.StorageKey)
+		return nil, errors.Wrap(ErrUploadUnknown, err.Error())
+	}
+
+	return attachment, nil
+}
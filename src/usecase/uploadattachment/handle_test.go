@@ -0,0 +1,107 @@
+package uploadattachment
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/KungurtsevNII/team-board-back/src/usecase/uploadattachment/mocks"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandle(t *testing.T) {
+	ctx := context.Background()
+	task := &domain.Task{ID: uuid.New()}
+	policy := domain.AttachmentPolicy{MaxSize: 1 << 20, AllowedTypes: []string{"text/plain", "image/*"}}
+
+	text := strings.Repeat("plain text line\n", 300)
+	sum := sha256.Sum256([]byte(text))
+	pngHeader := "\x89PNG\r\n\x1a\n"
+
+	command := func(name, content string) Command {
+		return Command{UserID: "alice", TaskID: task.ID, Name: name, Size: int64(len(content)), Content: strings.NewReader(content)}
+	}
+
+	t.Run("Success: stored with detected type and checksum", func(t *testing.T) {
+		repo := mocks.NewRepo(t)
+		storage := mocks.NewStorage(t)
+		repo.On("GetTaskByID", ctx, task.ID).Return(task, nil)
+
+		var stored string
+		storage.On("Put", ctx, mock.AnythingOfType("string"), mock.Anything, int64(len(text)), "text/plain; charset=utf-8").
+			Run(func(args mock.Arguments) {
+				data, err := io.ReadAll(args.Get(2).(io.Reader))
+				require.NoError(t, err)
+				stored = string(data)
+			}).Return(nil)
+		repo.On("CreateAttachment", ctx, mock.AnythingOfType("*domain.Attachment")).Return(nil)
+
+		// расширение не влияет на тип
+		a, err := NewUC(repo, storage, policy).Handle(ctx, command("notes.exe", text))
+		require.NoError(t, err)
+		assert.Equal(t, text, stored)
+		assert.Equal(t, "notes.exe", a.Name)
+		assert.Equal(t, "text/plain; charset=utf-8", a.MimeType)
+		assert.Equal(t, hex.EncodeToString(sum[:]), a.Checksum)
+		assert.Equal(t, "alice", a.UploadedBy)
+	})
+
+	t.Run("Error: too large", func(t *testing.T) {
+		repo := mocks.NewRepo(t)
+		storage := mocks.NewStorage(t)
+
+		_, err := NewUC(repo, storage, domain.AttachmentPolicy{MaxSize: 10}).Handle(ctx, command("a.txt", text))
+		assert.ErrorIs(t, err, ErrFileTooLarge)
+	})
+
+	t.Run("Error: type not allowed", func(t *testing.T) {
+		repo := mocks.NewRepo(t)
+		storage := mocks.NewStorage(t)
+		repo.On("GetTaskByID", ctx, task.ID).Return(task, nil)
+
+		_, err := NewUC(repo, storage, policy).Handle(ctx, command("a.png", "%PDF-1.7\n..."))
+		assert.ErrorIs(t, err, ErrTypeNotAllowed)
+	})
+
+	t.Run("Error: empty file", func(t *testing.T) {
+		repo := mocks.NewRepo(t)
+		storage := mocks.NewStorage(t)
+		repo.On("GetTaskByID", ctx, task.ID).Return(task, nil)
+
+		_, err := NewUC(repo, storage, domain.AttachmentPolicy{MaxSize: 10}).Handle(ctx, command("a.txt", ""))
+		assert.ErrorIs(t, err, ErrEmptyFile)
+	})
+
+	t.Run("Error: task not found", func(t *testing.T) {
+		repo := mocks.NewRepo(t)
+		storage := mocks.NewStorage(t)
+		repo.On("GetTaskByID", ctx, task.ID).Return(nil, pgx.ErrNoRows)
+
+		_, err := NewUC(repo, storage, policy).Handle(ctx, command("a.txt", text))
+		assert.ErrorIs(t, err, ErrTaskNotFound)
+	})
+
+	t.Run("Error: db failed, object removed", func(t *testing.T) {
+		repo := mocks.NewRepo(t)
+		storage := mocks.NewStorage(t)
+		repo.On("GetTaskByID", ctx, task.ID).Return(task, nil)
+
+		var key string
+		storage.On("Put", ctx, mock.AnythingOfType("string"), mock.Anything, int64(len(pngHeader)), "image/png").
+			Run(func(args mock.Arguments) { key = args.String(1) }).Return(nil)
+		repo.On("CreateAttachment", ctx, mock.AnythingOfType("*domain.Attachment")).Return(errors.New("db down"))
+		storage.On("Delete", mock.Anything, mock.MatchedBy(func(k string) bool { return k == key })).Return(nil)
+
+		_, err := NewUC(repo, storage, policy).Handle(ctx, command("a.png", pngHeader))
+		assert.ErrorIs(t, err, ErrUploadUnknown)
+	})
+}
@@ -0,0 +1,14 @@
+package watch
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidUserID  = errors.New("invalid user id")
+	ErrInvalidTaskID  = errors.New("invalid task id")
+	ErrInvalidBoardID = errors.New("invalid board id")
+	ErrTaskNotFound   = errors.New("task not found")
+	ErrBoardNotFound  = errors.New("board not found")
+	ErrWatchUnknown   = errors.New("unknown error watching")
+)
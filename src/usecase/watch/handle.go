@@ -0,0 +1,48 @@
+package watch
+
+import (
+	"context"
+
+	"github.com/KungurtsevNII/team-board-back/src/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type Repo interface {
+	GetTaskByID(ctx context.Context, taskID uuid.UUID) (*domain.Task, error)
+	CheckBoard(ctx context.Context, id string) bool
+	AddWatchers(ctx context.Context, watchers []domain.Watcher) error
+}
+
+type UC struct {
+	repo Repo
+}
+
+func NewUC(repo Repo) *UC {
+	return &UC{
+		repo: repo,
+	}
+}
+
+// Handle подписывает пользователя на задачу или доску. Повторная подписка
+// ничего не меняет.
+func (uc *UC) Handle(ctx context.Context, cmd Command) error {
+	if cmd.Watcher.TaskID != nil {
+		_, err := uc.repo.GetTaskByID(ctx, *cmd.Watcher.TaskID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrTaskNotFound
+			}
+			return errors.Wrap(ErrWatchUnknown, err.Error())
+		}
+	} else if !uc.repo.CheckBoard(ctx, cmd.Watcher.BoardID.String()) {
+		return ErrBoardNotFound
+	}
+
+	if err := uc.repo.AddWatchers(ctx, []domain.Watcher{cmd.Watcher}); err != nil {
+		return errors.Wrap(ErrWatchUnknown, err.Error())
+	}
+
+	return nil
+}